@@ -0,0 +1,140 @@
+// This set of code handles the QoS specs of the Block Storage v3 API and
+// their associations with volume types.
+// Gophercloud does not support QoS specs yet.
+package openstack
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// QoS is a Block Storage QoS spec.
+type QoS struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name"`
+	Consumer string            `json:"consumer"`
+	Specs    map[string]string `json:"specs"`
+}
+
+// QoSCreateOpts represents the attributes used when creating a new QoS
+// spec. The key/value specs are sent alongside the name and consumer.
+type QoSCreateOpts struct {
+	Name     string
+	Consumer string
+	Specs    map[string]string
+}
+
+// ToQoSCreateMap casts a QoSCreateOpts struct to a map.
+func (opts QoSCreateOpts) ToQoSCreateMap() (map[string]interface{}, error) {
+	b := make(map[string]interface{})
+	for k, v := range opts.Specs {
+		b[k] = v
+	}
+	b["name"] = opts.Name
+	if opts.Consumer != "" {
+		b["consumer"] = opts.Consumer
+	}
+
+	return map[string]interface{}{"qos_specs": b}, nil
+}
+
+// QoSResult is the result of a create or get request.
+type QoSResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a QoSResult as a QoS.
+func (r QoSResult) Extract() (*QoS, error) {
+	var s struct {
+		QoS *QoS `json:"qos_specs"`
+	}
+	err := r.ExtractInto(&s)
+	return s.QoS, err
+}
+
+// QoSAssociation is an association of a QoS spec with a volume type.
+type QoSAssociation struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	AssociationType string `json:"association_type"`
+}
+
+// QoSAssociationsResult is the result of an associations request.
+type QoSAssociationsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a QoSAssociationsResult as a list of QoSAssociation.
+func (r QoSAssociationsResult) Extract() ([]QoSAssociation, error) {
+	var s struct {
+		Associations []QoSAssociation `json:"qos_associations"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Associations, err
+}
+
+func blockStorageV3QoSCreate(client *gophercloud.ServiceClient, opts QoSCreateOpts) (r QoSResult) {
+	b, err := opts.ToQoSCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("qos-specs"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func blockStorageV3QoSGet(client *gophercloud.ServiceClient, qosID string) (r QoSResult) {
+	_, r.Err = client.Get(client.ServiceURL("qos-specs", qosID), &r.Body, nil)
+	return
+}
+
+// blockStorageV3QoSSetKeys creates or updates the given keys of a QoS spec,
+// including its consumer. Other keys are left untouched.
+func blockStorageV3QoSSetKeys(client *gophercloud.ServiceClient, qosID string, keys map[string]string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"qos_specs": keys,
+	}
+	_, r.Err = client.Put(client.ServiceURL("qos-specs", qosID), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func blockStorageV3QoSDeleteKeys(client *gophercloud.ServiceClient, qosID string, keys []string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"keys": keys,
+	}
+	_, r.Err = client.Put(client.ServiceURL("qos-specs", qosID, "delete_keys"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func blockStorageV3QoSDelete(client *gophercloud.ServiceClient, qosID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("qos-specs", qosID), nil)
+	return
+}
+
+func blockStorageV3QoSAssociate(client *gophercloud.ServiceClient, qosID, volumeTypeID string) (r gophercloud.ErrResult) {
+	query := url.Values{"vol_type_id": []string{volumeTypeID}}
+	_, r.Err = client.Get(client.ServiceURL("qos-specs", qosID, "associate")+"?"+query.Encode(), nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func blockStorageV3QoSDisassociate(client *gophercloud.ServiceClient, qosID, volumeTypeID string) (r gophercloud.ErrResult) {
+	query := url.Values{"vol_type_id": []string{volumeTypeID}}
+	_, r.Err = client.Get(client.ServiceURL("qos-specs", qosID, "disassociate")+"?"+query.Encode(), nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func blockStorageV3QoSAssociations(client *gophercloud.ServiceClient, qosID string) (r QoSAssociationsResult) {
+	_, r.Err = client.Get(client.ServiceURL("qos-specs", qosID, "associations"), &r.Body, nil)
+	return
+}
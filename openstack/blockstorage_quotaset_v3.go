@@ -0,0 +1,130 @@
+// This set of code handles the os-quota-sets API of the Block Storage
+// service, which is used by the openstack_blockstorage_quotaset_v3 resource.
+// Besides the global quotas, Block Storage has quotas per volume type, whose
+// keys are the name of the quota and of the volume type joined by an
+// underscore, e.g. volumes_ssd.
+// Gophercloud does not support this API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// BlockStorageQuotaSet is a set of Block Storage quotas of a project.
+type BlockStorageQuotaSet struct {
+	Volumes            int `json:"volumes"`
+	Snapshots          int `json:"snapshots"`
+	Gigabytes          int `json:"gigabytes"`
+	PerVolumeGigabytes int `json:"per_volume_gigabytes"`
+	Backups            int `json:"backups"`
+	BackupGigabytes    int `json:"backup_gigabytes"`
+	Groups             int `json:"groups"`
+
+	// VolumeTypeQuotas holds all other quotas of the quota set, which are
+	// the quotas per volume type.
+	VolumeTypeQuotas map[string]int `json:"-"`
+}
+
+// BlockStorageQuotaSetUpdateOpts represents the attributes used when
+// updating the Block Storage quotas of a project. Only set quotas are
+// updated.
+type BlockStorageQuotaSetUpdateOpts struct {
+	Volumes            *int `json:"volumes,omitempty"`
+	Snapshots          *int `json:"snapshots,omitempty"`
+	Gigabytes          *int `json:"gigabytes,omitempty"`
+	PerVolumeGigabytes *int `json:"per_volume_gigabytes,omitempty"`
+	Backups            *int `json:"backups,omitempty"`
+	BackupGigabytes    *int `json:"backup_gigabytes,omitempty"`
+	Groups             *int `json:"groups,omitempty"`
+
+	VolumeTypeQuotas map[string]int `json:"-"`
+}
+
+// ToBlockStorageQuotaSetUpdateMap casts a BlockStorageQuotaSetUpdateOpts
+// struct to a map.
+func (opts BlockStorageQuotaSetUpdateOpts) ToBlockStorageQuotaSetUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "quota_set")
+	if err != nil {
+		return nil, err
+	}
+
+	quotaSet := b["quota_set"].(map[string]interface{})
+	for k, v := range opts.VolumeTypeQuotas {
+		quotaSet[k] = v
+	}
+
+	return b, nil
+}
+
+// BlockStorageQuotaSetResult is the result of a get or update request.
+type BlockStorageQuotaSetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a BlockStorageQuotaSetResult as a BlockStorageQuotaSet.
+func (r BlockStorageQuotaSetResult) Extract() (*BlockStorageQuotaSet, error) {
+	var s struct {
+		QuotaSet *BlockStorageQuotaSet `json:"quota_set"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw struct {
+		QuotaSet map[string]interface{} `json:"quota_set"`
+	}
+	err = r.ExtractInto(&raw)
+	if err != nil {
+		return nil, err
+	}
+
+	globalQuotas := map[string]bool{
+		"id":                   true,
+		"volumes":              true,
+		"snapshots":            true,
+		"gigabytes":            true,
+		"per_volume_gigabytes": true,
+		"backups":              true,
+		"backup_gigabytes":     true,
+		"groups":               true,
+	}
+
+	s.QuotaSet.VolumeTypeQuotas = make(map[string]int)
+	for k, v := range raw.QuotaSet {
+		if globalQuotas[k] {
+			continue
+		}
+		if f, ok := v.(float64); ok {
+			s.QuotaSet.VolumeTypeQuotas[k] = int(f)
+		}
+	}
+
+	return s.QuotaSet, nil
+}
+
+func blockStorageV3QuotaSetGet(client *gophercloud.ServiceClient, projectID string) (r BlockStorageQuotaSetResult) {
+	_, r.Err = client.Get(client.ServiceURL("os-quota-sets", projectID), &r.Body, nil)
+	return
+}
+
+func blockStorageV3QuotaSetUpdate(client *gophercloud.ServiceClient, projectID string, opts BlockStorageQuotaSetUpdateOpts) (r BlockStorageQuotaSetResult) {
+	b, err := opts.ToBlockStorageQuotaSetUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("os-quota-sets", projectID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// blockStorageV3QuotaSetDelete reverts the Block Storage quotas of a
+// project to their defaults.
+func blockStorageV3QuotaSetDelete(client *gophercloud.ServiceClient, projectID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("os-quota-sets", projectID), &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
@@ -0,0 +1,212 @@
+// This set of code handles snapshots of the Block Storage v3 API.
+// Gophercloud does not support the Block Storage v3 API yet, so the
+// requests are built here.
+package openstack
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/v2/volumes"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// SnapshotV3 is a Block Storage v3 volume snapshot.
+type SnapshotV3 struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	VolumeID    string            `json:"volume_id"`
+	Status      string            `json:"status"`
+	Size        int               `json:"size"`
+	Metadata    map[string]string `json:"metadata"`
+	CreatedAt   time.Time         `json:"-"`
+}
+
+func (r *SnapshotV3) UnmarshalJSON(b []byte) error {
+	type tmp SnapshotV3
+	var s struct {
+		tmp
+		CreatedAt gophercloud.JSONRFC3339MilliNoZ `json:"created_at"`
+	}
+	err := json.Unmarshal(b, &s)
+	if err != nil {
+		return err
+	}
+	*r = SnapshotV3(s.tmp)
+
+	r.CreatedAt = time.Time(s.CreatedAt)
+
+	return nil
+}
+
+// SnapshotV3CreateOpts represents the attributes used when creating a new
+// Block Storage v3 volume snapshot.
+type SnapshotV3CreateOpts struct {
+	VolumeID    string            `json:"volume_id" required:"true"`
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Force       bool              `json:"force,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// ToSnapshotV3CreateMap casts a SnapshotV3CreateOpts struct to a map.
+func (opts SnapshotV3CreateOpts) ToSnapshotV3CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "snapshot")
+}
+
+// SnapshotV3UpdateOpts represents the attributes used when updating an
+// existing Block Storage v3 volume snapshot. The metadata of a snapshot is
+// updated with blockStorageV3SnapshotMetadataReplace.
+type SnapshotV3UpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToSnapshotV3UpdateMap casts a SnapshotV3UpdateOpts struct to a map.
+func (opts SnapshotV3UpdateOpts) ToSnapshotV3UpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "snapshot")
+}
+
+// SnapshotV3Result is the result of a create, get or update request.
+type SnapshotV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a SnapshotV3Result as a SnapshotV3.
+func (r SnapshotV3Result) Extract() (*SnapshotV3, error) {
+	var s struct {
+		Snapshot *SnapshotV3 `json:"snapshot"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Snapshot, err
+}
+
+func blockStorageV3SnapshotCreate(client *gophercloud.ServiceClient, opts SnapshotV3CreateOpts) (r SnapshotV3Result) {
+	b, err := opts.ToSnapshotV3CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("snapshots"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func blockStorageV3SnapshotGet(client *gophercloud.ServiceClient, snapshotID string) (r SnapshotV3Result) {
+	_, r.Err = client.Get(client.ServiceURL("snapshots", snapshotID), &r.Body, nil)
+	return
+}
+
+func blockStorageV3SnapshotUpdate(client *gophercloud.ServiceClient, snapshotID string, opts SnapshotV3UpdateOpts) (r SnapshotV3Result) {
+	b, err := opts.ToSnapshotV3UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("snapshots", snapshotID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// blockStorageV3SnapshotMetadataReplace replaces all metadata of a snapshot
+// with the given metadata.
+func blockStorageV3SnapshotMetadataReplace(client *gophercloud.ServiceClient, snapshotID string, metadata map[string]string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"metadata": metadata,
+	}
+	_, r.Err = client.Put(client.ServiceURL("snapshots", snapshotID, "metadata"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func blockStorageV3SnapshotDelete(client *gophercloud.ServiceClient, snapshotID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("snapshots", snapshotID), nil)
+	return
+}
+
+// SnapshotV3ListOpts represents the attributes used when listing Block
+// Storage v3 volume snapshots.
+type SnapshotV3ListOpts struct {
+	Name     string `q:"name"`
+	Status   string `q:"status"`
+	VolumeID string `q:"volume_id"`
+}
+
+// ToSnapshotV3ListQuery formats a SnapshotV3ListOpts into a query string.
+func (opts SnapshotV3ListOpts) ToSnapshotV3ListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// SnapshotV3Page is a page of Block Storage v3 volume snapshots.
+type SnapshotV3Page struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a SnapshotV3Page contains no snapshots.
+func (r SnapshotV3Page) IsEmpty() (bool, error) {
+	snapshots, err := blockStorageV3ExtractSnapshots(r)
+	return len(snapshots) == 0, err
+}
+
+// NextPageURL returns the URL of the next page of snapshots.
+func (r SnapshotV3Page) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"snapshots_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+func blockStorageV3SnapshotList(client *gophercloud.ServiceClient, opts SnapshotV3ListOpts) pagination.Pager {
+	query, err := opts.ToSnapshotV3ListQuery()
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	url := client.ServiceURL("snapshots", "detail") + query
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return SnapshotV3Page{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// blockStorageV3ExtractSnapshots extracts the snapshots of a page returned
+// by blockStorageV3SnapshotList.
+func blockStorageV3ExtractSnapshots(page pagination.Page) ([]SnapshotV3, error) {
+	var s struct {
+		Snapshots []SnapshotV3 `json:"snapshots"`
+	}
+	err := (page.(SnapshotV3Page)).ExtractInto(&s)
+	return s.Snapshots, err
+}
+
+// blockStorageV3SnapshotDependentVolumes returns the IDs of the volumes
+// which were created from a snapshot and still exist. The Block Storage
+// volume list is the same in the v2 and v3 APIs, so Gophercloud's v2 volumes
+// package is used with the v3 client.
+func blockStorageV3SnapshotDependentVolumes(client *gophercloud.ServiceClient, snapshotID string) ([]string, error) {
+	var volumeIDs []string
+	err := volumes.List(client, volumes.ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		volumeList, err := volumes.ExtractVolumes(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, v := range volumeList {
+			if v.SnapshotID == snapshotID {
+				volumeIDs = append(volumeIDs, v.ID)
+			}
+		}
+
+		return true, nil
+	})
+
+	return volumeIDs, err
+}
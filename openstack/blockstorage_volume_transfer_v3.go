@@ -0,0 +1,88 @@
+// This set of code handles volume transfers of the Block Storage v3 API,
+// which hand a volume over from one project to another.
+// Gophercloud does not support volume transfers yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// VolumeTransfer is a Block Storage volume transfer. The AuthKey is only
+// returned when the transfer is created.
+type VolumeTransfer struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	VolumeID string `json:"volume_id"`
+	AuthKey  string `json:"auth_key"`
+}
+
+// VolumeTransferCreateOpts represents the attributes used when creating a
+// new volume transfer.
+type VolumeTransferCreateOpts struct {
+	VolumeID string `json:"volume_id" required:"true"`
+	Name     string `json:"name,omitempty"`
+}
+
+// ToVolumeTransferCreateMap casts a VolumeTransferCreateOpts struct to a map.
+func (opts VolumeTransferCreateOpts) ToVolumeTransferCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "transfer")
+}
+
+// VolumeTransferAcceptOpts represents the attributes used when accepting a
+// volume transfer.
+type VolumeTransferAcceptOpts struct {
+	AuthKey string `json:"auth_key" required:"true"`
+}
+
+// ToVolumeTransferAcceptMap casts a VolumeTransferAcceptOpts struct to a map.
+func (opts VolumeTransferAcceptOpts) ToVolumeTransferAcceptMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "accept")
+}
+
+// VolumeTransferResult is the result of a create, get or accept request.
+type VolumeTransferResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a VolumeTransferResult as a VolumeTransfer.
+func (r VolumeTransferResult) Extract() (*VolumeTransfer, error) {
+	var s struct {
+		Transfer *VolumeTransfer `json:"transfer"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Transfer, err
+}
+
+func blockStorageV3VolumeTransferCreate(client *gophercloud.ServiceClient, opts VolumeTransferCreateOpts) (r VolumeTransferResult) {
+	b, err := opts.ToVolumeTransferCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("os-volume-transfer"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func blockStorageV3VolumeTransferGet(client *gophercloud.ServiceClient, transferID string) (r VolumeTransferResult) {
+	_, r.Err = client.Get(client.ServiceURL("os-volume-transfer", transferID), &r.Body, nil)
+	return
+}
+
+func blockStorageV3VolumeTransferAccept(client *gophercloud.ServiceClient, transferID string, opts VolumeTransferAcceptOpts) (r VolumeTransferResult) {
+	b, err := opts.ToVolumeTransferAcceptMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("os-volume-transfer", transferID, "accept"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func blockStorageV3VolumeTransferDelete(client *gophercloud.ServiceClient, transferID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("os-volume-transfer", transferID), nil)
+	return
+}
@@ -0,0 +1,200 @@
+// This set of code handles volume types of the Block Storage v3 API,
+// including their extra specs and encryption specs.
+// Gophercloud does not support volume types yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// VolumeType is a Block Storage volume type.
+type VolumeType struct {
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	IsPublic    bool              `json:"is_public"`
+	ExtraSpecs  map[string]string `json:"extra_specs"`
+}
+
+// VolumeTypeCreateOpts represents the attributes used when creating a new
+// volume type.
+type VolumeTypeCreateOpts struct {
+	Name        string            `json:"name" required:"true"`
+	Description string            `json:"description,omitempty"`
+	IsPublic    *bool             `json:"os-volume-type-access:is_public,omitempty"`
+	ExtraSpecs  map[string]string `json:"extra_specs,omitempty"`
+}
+
+// ToVolumeTypeCreateMap casts a VolumeTypeCreateOpts struct to a map.
+func (opts VolumeTypeCreateOpts) ToVolumeTypeCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "volume_type")
+}
+
+// VolumeTypeUpdateOpts represents the attributes used when updating an
+// existing volume type. The extra specs of a volume type are updated with
+// blockStorageV3VolumeTypeExtraSpecsCreate and
+// blockStorageV3VolumeTypeExtraSpecDelete.
+type VolumeTypeUpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	IsPublic    *bool   `json:"is_public,omitempty"`
+}
+
+// ToVolumeTypeUpdateMap casts a VolumeTypeUpdateOpts struct to a map.
+func (opts VolumeTypeUpdateOpts) ToVolumeTypeUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "volume_type")
+}
+
+// VolumeTypeResult is the result of a create, get or update request.
+type VolumeTypeResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a VolumeTypeResult as a VolumeType.
+func (r VolumeTypeResult) Extract() (*VolumeType, error) {
+	var s struct {
+		VolumeType *VolumeType `json:"volume_type"`
+	}
+	err := r.ExtractInto(&s)
+	return s.VolumeType, err
+}
+
+func blockStorageV3VolumeTypeCreate(client *gophercloud.ServiceClient, opts VolumeTypeCreateOpts) (r VolumeTypeResult) {
+	b, err := opts.ToVolumeTypeCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("types"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func blockStorageV3VolumeTypeGet(client *gophercloud.ServiceClient, volumeTypeID string) (r VolumeTypeResult) {
+	_, r.Err = client.Get(client.ServiceURL("types", volumeTypeID), &r.Body, nil)
+	return
+}
+
+func blockStorageV3VolumeTypeUpdate(client *gophercloud.ServiceClient, volumeTypeID string, opts VolumeTypeUpdateOpts) (r VolumeTypeResult) {
+	b, err := opts.ToVolumeTypeUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("types", volumeTypeID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func blockStorageV3VolumeTypeDelete(client *gophercloud.ServiceClient, volumeTypeID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("types", volumeTypeID), nil)
+	return
+}
+
+// blockStorageV3VolumeTypeExtraSpecsCreate creates or updates the given
+// extra specs of a volume type. Other extra specs are left untouched.
+func blockStorageV3VolumeTypeExtraSpecsCreate(client *gophercloud.ServiceClient, volumeTypeID string, extraSpecs map[string]string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"extra_specs": extraSpecs,
+	}
+	_, r.Err = client.Post(client.ServiceURL("types", volumeTypeID, "extra_specs"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func blockStorageV3VolumeTypeExtraSpecDelete(client *gophercloud.ServiceClient, volumeTypeID, key string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("types", volumeTypeID, "extra_specs", key), nil)
+	return
+}
+
+// VolumeTypeEncryption is the encryption spec of a volume type.
+type VolumeTypeEncryption struct {
+	EncryptionID    string `json:"encryption_id"`
+	VolumeTypeID    string `json:"volume_type_id"`
+	Provider        string `json:"provider"`
+	Cipher          string `json:"cipher"`
+	KeySize         int    `json:"key_size"`
+	ControlLocation string `json:"control_location"`
+}
+
+// VolumeTypeEncryptionOpts represents the attributes used when creating or
+// updating the encryption spec of a volume type.
+type VolumeTypeEncryptionOpts struct {
+	Provider        string `json:"provider" required:"true"`
+	Cipher          string `json:"cipher,omitempty"`
+	KeySize         int    `json:"key_size,omitempty"`
+	ControlLocation string `json:"control_location,omitempty"`
+}
+
+// ToVolumeTypeEncryptionMap casts a VolumeTypeEncryptionOpts struct to a map.
+func (opts VolumeTypeEncryptionOpts) ToVolumeTypeEncryptionMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "encryption")
+}
+
+// VolumeTypeEncryptionResult is the result of a create or update request
+// of a volume type encryption spec.
+type VolumeTypeEncryptionResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a VolumeTypeEncryptionResult as a VolumeTypeEncryption.
+func (r VolumeTypeEncryptionResult) Extract() (*VolumeTypeEncryption, error) {
+	var s struct {
+		Encryption *VolumeTypeEncryption `json:"encryption"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Encryption, err
+}
+
+// VolumeTypeEncryptionGetResult is the result of a get request of a volume
+// type encryption spec. Unlike the other requests, the spec is not wrapped
+// in an "encryption" key, and it is empty if the volume type isn't
+// encrypted.
+type VolumeTypeEncryptionGetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a VolumeTypeEncryptionGetResult as a
+// VolumeTypeEncryption.
+func (r VolumeTypeEncryptionGetResult) Extract() (*VolumeTypeEncryption, error) {
+	var s VolumeTypeEncryption
+	err := r.ExtractInto(&s)
+	return &s, err
+}
+
+func blockStorageV3VolumeTypeEncryptionCreate(client *gophercloud.ServiceClient, volumeTypeID string, opts VolumeTypeEncryptionOpts) (r VolumeTypeEncryptionResult) {
+	b, err := opts.ToVolumeTypeEncryptionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("types", volumeTypeID, "encryption"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func blockStorageV3VolumeTypeEncryptionGet(client *gophercloud.ServiceClient, volumeTypeID string) (r VolumeTypeEncryptionGetResult) {
+	_, r.Err = client.Get(client.ServiceURL("types", volumeTypeID, "encryption"), &r.Body, nil)
+	return
+}
+
+func blockStorageV3VolumeTypeEncryptionUpdate(client *gophercloud.ServiceClient, volumeTypeID, encryptionID string, opts VolumeTypeEncryptionOpts) (r VolumeTypeEncryptionResult) {
+	b, err := opts.ToVolumeTypeEncryptionMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("types", volumeTypeID, "encryption", encryptionID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func blockStorageV3VolumeTypeEncryptionDelete(client *gophercloud.ServiceClient, volumeTypeID, encryptionID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("types", volumeTypeID, "encryption", encryptionID), nil)
+	return
+}
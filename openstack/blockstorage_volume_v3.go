@@ -0,0 +1,345 @@
+// This set of code handles volumes of the Block Storage v3 API.
+//
+// The v3 API is versioned with microversions. Volume groups were added in
+// microversion 3.13, extending in-use volumes in microversion 3.42 and
+// multiattach volumes in microversion 3.50.
+// Gophercloud does not support the Block Storage v3 API yet, so the
+// requests are built here.
+package openstack
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+const (
+	// blockStorageV3GroupMicroversion is the minimum Block Storage API
+	// microversion which supports volume groups.
+	blockStorageV3GroupMicroversion = "3.13"
+
+	// blockStorageV3OnlineExtendMicroversion is the minimum Block Storage
+	// API microversion which supports extending in-use volumes.
+	blockStorageV3OnlineExtendMicroversion = "3.42"
+
+	// blockStorageV3MultiattachMicroversion is the minimum Block Storage API
+	// microversion which supports multiattach volumes.
+	blockStorageV3MultiattachMicroversion = "3.50"
+
+	// blockStorageV3MaxMicroversion is the highest Block Storage API
+	// microversion used by this provider.
+	blockStorageV3MaxMicroversion = blockStorageV3MultiattachMicroversion
+)
+
+// VolumeV3Attachment is an attachment of a Block Storage v3 volume.
+type VolumeV3Attachment struct {
+	ID           string `json:"id"`
+	AttachmentID string `json:"attachment_id"`
+	ServerID     string `json:"server_id"`
+	Device       string `json:"device"`
+}
+
+// VolumeV3 is a Block Storage v3 volume.
+type VolumeV3 struct {
+	ID                 string               `json:"id"`
+	Status             string               `json:"status"`
+	Size               int                  `json:"size"`
+	AvailabilityZone   string               `json:"availability_zone"`
+	Name               string               `json:"name"`
+	Description        string               `json:"description"`
+	VolumeType         string               `json:"volume_type"`
+	SnapshotID         string               `json:"snapshot_id"`
+	SourceVolID        string               `json:"source_volid"`
+	ConsistencyGroupID string               `json:"consistencygroup_id"`
+	GroupID            string               `json:"group_id"`
+	Multiattach        bool                 `json:"multiattach"`
+	Metadata           map[string]string    `json:"metadata"`
+	Attachments        []VolumeV3Attachment `json:"attachments"`
+}
+
+// VolumeV3CreateOpts represents the attributes used when creating a new
+// Block Storage v3 volume.
+type VolumeV3CreateOpts struct {
+	Size               int               `json:"size" required:"true"`
+	AvailabilityZone   string            `json:"availability_zone,omitempty"`
+	ConsistencyGroupID string            `json:"consistencygroup_id,omitempty"`
+	GroupID            string            `json:"group_id,omitempty"`
+	Description        string            `json:"description,omitempty"`
+	Metadata           map[string]string `json:"metadata,omitempty"`
+	Name               string            `json:"name,omitempty"`
+	SnapshotID         string            `json:"snapshot_id,omitempty"`
+	SourceReplica      string            `json:"source_replica,omitempty"`
+	SourceVolID        string            `json:"source_volid,omitempty"`
+	ImageID            string            `json:"imageRef,omitempty"`
+	VolumeType         string            `json:"volume_type,omitempty"`
+	Multiattach        bool              `json:"multiattach,omitempty"`
+
+	// SchedulerHints are sent next to the volume in the request body.
+	SchedulerHints *VolumeV3SchedulerHints `json:"-"`
+}
+
+// ToVolumeV3CreateMap casts a VolumeV3CreateOpts struct to a map.
+func (opts VolumeV3CreateOpts) ToVolumeV3CreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "volume")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.SchedulerHints != nil {
+		hints, err := gophercloud.BuildRequestBody(opts.SchedulerHints, "")
+		if err != nil {
+			return nil, err
+		}
+		b["OS-SCH-HNT:scheduler_hints"] = hints
+	}
+
+	return b, nil
+}
+
+// VolumeV3SchedulerHints represents the hints which are passed to the Block
+// Storage scheduler when creating a volume.
+type VolumeV3SchedulerHints struct {
+	// SameHost is a list of volume IDs. The volume is created on the same
+	// back-end as these volumes.
+	SameHost []string `json:"same_host,omitempty"`
+
+	// DifferentHost is a list of volume IDs. The volume is created on a
+	// different back-end than these volumes.
+	DifferentHost []string `json:"different_host,omitempty"`
+
+	// LocalToInstance is the ID of an instance. The volume is created on the
+	// host of this instance.
+	LocalToInstance string `json:"local_to_instance,omitempty"`
+}
+
+// VolumeV3UpdateOpts represents the attributes used when updating an
+// existing Block Storage v3 volume.
+type VolumeV3UpdateOpts struct {
+	Name        *string           `json:"name,omitempty"`
+	Description *string           `json:"description,omitempty"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// ToVolumeV3UpdateMap casts a VolumeV3UpdateOpts struct to a map.
+func (opts VolumeV3UpdateOpts) ToVolumeV3UpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "volume")
+}
+
+// VolumeV3Result is the result of a create, get or update request.
+type VolumeV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a VolumeV3Result as a VolumeV3.
+func (r VolumeV3Result) Extract() (*VolumeV3, error) {
+	var s struct {
+		Volume *VolumeV3 `json:"volume"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Volume, err
+}
+
+func blockStorageV3VolumeCreate(client *gophercloud.ServiceClient, opts VolumeV3CreateOpts) (r VolumeV3Result) {
+	b, err := opts.ToVolumeV3CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("volumes"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func blockStorageV3VolumeGet(client *gophercloud.ServiceClient, volumeID string) (r VolumeV3Result) {
+	_, r.Err = client.Get(client.ServiceURL("volumes", volumeID), &r.Body, nil)
+	return
+}
+
+func blockStorageV3VolumeUpdate(client *gophercloud.ServiceClient, volumeID string, opts VolumeV3UpdateOpts) (r VolumeV3Result) {
+	b, err := opts.ToVolumeV3UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("volumes", volumeID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func blockStorageV3VolumeDelete(client *gophercloud.ServiceClient, volumeID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("volumes", volumeID), nil)
+	return
+}
+
+// VolumeV3ListOpts represents the attributes used when listing Block Storage
+// v3 volumes.
+type VolumeV3ListOpts struct {
+	Name   string `q:"name"`
+	Status string `q:"status"`
+}
+
+// ToVolumeV3ListQuery formats a VolumeV3ListOpts into a query string.
+func (opts VolumeV3ListOpts) ToVolumeV3ListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// VolumeV3Page is a page of Block Storage v3 volumes.
+type VolumeV3Page struct {
+	pagination.LinkedPageBase
+}
+
+// IsEmpty returns true if a VolumeV3Page contains no volumes.
+func (r VolumeV3Page) IsEmpty() (bool, error) {
+	volumes, err := blockStorageV3ExtractVolumes(r)
+	return len(volumes) == 0, err
+}
+
+// NextPageURL returns the URL of the next page of volumes.
+func (r VolumeV3Page) NextPageURL() (string, error) {
+	var s struct {
+		Links []gophercloud.Link `json:"volumes_links"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return "", err
+	}
+	return gophercloud.ExtractNextURL(s.Links)
+}
+
+func blockStorageV3VolumeList(client *gophercloud.ServiceClient, opts VolumeV3ListOpts) pagination.Pager {
+	query, err := opts.ToVolumeV3ListQuery()
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	url := client.ServiceURL("volumes", "detail") + query
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return VolumeV3Page{pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// blockStorageV3ExtractVolumes extracts the volumes of a page returned by
+// blockStorageV3VolumeList.
+func blockStorageV3ExtractVolumes(page pagination.Page) ([]VolumeV3, error) {
+	var s struct {
+		Volumes []VolumeV3 `json:"volumes"`
+	}
+	err := (page.(VolumeV3Page)).ExtractInto(&s)
+	return s.Volumes, err
+}
+
+// blockStorageV3ServerMicroversion returns the highest microversion of the
+// Block Storage v3 API supported by the server. It is read from the version
+// document at the root of the Block Storage endpoint.
+func blockStorageV3ServerMicroversion(client *gophercloud.ServiceClient) (string, error) {
+	rootURL := client.Endpoint
+	if i := strings.Index(rootURL, "/v3"); i != -1 {
+		rootURL = rootURL[:i+1]
+	}
+
+	var s struct {
+		Versions []struct {
+			ID      string `json:"id"`
+			Version string `json:"version"`
+		} `json:"versions"`
+	}
+	_, err := client.Get(rootURL, &s, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 300},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range s.Versions {
+		if strings.HasPrefix(v.ID, "v3") {
+			// Servers without microversions only support the base
+			// version of the API.
+			if v.Version == "" {
+				return "3.0", nil
+			}
+			return v.Version, nil
+		}
+	}
+
+	return "", fmt.Errorf("Block Storage v3 API is not available")
+}
+
+// blockStorageV3NegotiateMicroversion returns a copy of the given client
+// which uses the highest microversion supported by both the server and this
+// provider. An error is returned if that microversion is lower than
+// required.
+func blockStorageV3NegotiateMicroversion(client *gophercloud.ServiceClient, required string) (*gophercloud.ServiceClient, error) {
+	serverMicroversion, err := blockStorageV3ServerMicroversion(client)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving Block Storage API microversion: %s", err)
+	}
+
+	microversion := blockStorageV3MaxMicroversion
+	older, err := blockStorageV3MicroversionOlder(serverMicroversion, microversion)
+	if err != nil {
+		return nil, err
+	}
+	if older {
+		microversion = serverMicroversion
+	}
+
+	if required != "" {
+		older, err = blockStorageV3MicroversionOlder(microversion, required)
+		if err != nil {
+			return nil, err
+		}
+		if older {
+			return nil, fmt.Errorf(
+				"Block Storage API microversion %s is required, but the server only supports up to %s",
+				required, serverMicroversion)
+		}
+	}
+
+	negotiatedClient := *client
+	negotiatedClient.Microversion = microversion
+
+	return &negotiatedClient, nil
+}
+
+// blockStorageV3MicroversionOlder reports whether microversion a is older
+// than microversion b.
+func blockStorageV3MicroversionOlder(a, b string) (bool, error) {
+	aMajor, aMinor, err := parseBlockStorageV3Microversion(a)
+	if err != nil {
+		return false, err
+	}
+
+	bMajor, bMinor, err := parseBlockStorageV3Microversion(b)
+	if err != nil {
+		return false, err
+	}
+
+	if aMajor != bMajor {
+		return aMajor < bMajor, nil
+	}
+	return aMinor < bMinor, nil
+}
+
+func parseBlockStorageV3Microversion(microversion string) (int, int, error) {
+	parts := strings.SplitN(microversion, ".", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("Invalid microversion: %s", microversion)
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid microversion: %s", microversion)
+	}
+
+	minor, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, fmt.Errorf("Invalid microversion: %s", microversion)
+	}
+
+	return major, minor, nil
+}
@@ -0,0 +1,39 @@
+// This set of code handles the os-availability-zone API of the Compute
+// service, which is used by the openstack_compute_availability_zones_v2
+// data source. Gophercloud does not support this API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// AvailabilityZone is a Compute availability zone.
+type AvailabilityZone struct {
+	ZoneName  string                `json:"zoneName"`
+	ZoneState AvailabilityZoneState `json:"zoneState"`
+}
+
+// AvailabilityZoneState is the state of a Compute availability zone.
+type AvailabilityZoneState struct {
+	Available bool `json:"available"`
+}
+
+// AvailabilityZoneListResult is the result of a list request.
+type AvailabilityZoneListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an AvailabilityZoneListResult as a list of
+// AvailabilityZones.
+func (r AvailabilityZoneListResult) Extract() ([]AvailabilityZone, error) {
+	var s struct {
+		AvailabilityZoneInfo []AvailabilityZone `json:"availabilityZoneInfo"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AvailabilityZoneInfo, err
+}
+
+func computeV2AvailabilityZoneList(client *gophercloud.ServiceClient) (r AvailabilityZoneListResult) {
+	_, r.Err = client.Get(client.ServiceURL("os-availability-zone"), &r.Body, nil)
+	return
+}
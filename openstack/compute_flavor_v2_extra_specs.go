@@ -0,0 +1,71 @@
+// This set of code handles the extra specs of an openstack_compute_flavor_v2
+// resource.
+//
+// Flavor extra specs are not yet supported by Gophercloud, so the requests
+// are built here.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+)
+
+// FlavorExtraSpecsResult is the result of a flavor extra specs request.
+type FlavorExtraSpecsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a FlavorExtraSpecsResult as a map of extra specs.
+func (r FlavorExtraSpecsResult) Extract() (map[string]string, error) {
+	var s struct {
+		ExtraSpecs map[string]string `json:"extra_specs"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ExtraSpecs, err
+}
+
+// computeV2FlavorExtraSpecsList retrieves the extra specs of a flavor.
+func computeV2FlavorExtraSpecsList(client *gophercloud.ServiceClient, flavorID string) (r FlavorExtraSpecsResult) {
+	_, r.Err = client.Get(client.ServiceURL("flavors", flavorID, "os-extra_specs"), &r.Body, nil)
+	return
+}
+
+// computeV2FlavorExtraSpecsCreate creates or updates the given extra specs
+// of a flavor.
+func computeV2FlavorExtraSpecsCreate(client *gophercloud.ServiceClient, flavorID string, extraSpecs map[string]string) (r FlavorExtraSpecsResult) {
+	b := map[string]interface{}{
+		"extra_specs": extraSpecs,
+	}
+	_, r.Err = client.Post(client.ServiceURL("flavors", flavorID, "os-extra_specs"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// computeV2FlavorExtraSpecDelete deletes a single extra spec of a flavor.
+func computeV2FlavorExtraSpecDelete(client *gophercloud.ServiceClient, flavorID, key string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("flavors", flavorID, "os-extra_specs", key), nil)
+	return
+}
+
+// resourceFlavorExtraSpecsV2 converts a raw extra_specs map into a map of
+// strings.
+func resourceFlavorExtraSpecsV2(v interface{}) map[string]string {
+	extraSpecs := make(map[string]string)
+	for key, value := range v.(map[string]interface{}) {
+		extraSpecs[key] = value.(string)
+	}
+	return extraSpecs
+}
+
+// resourceFlavorEphemeralV2 extracts the ephemeral disk size of a flavor,
+// which is not exposed by Gophercloud's Flavor.
+func resourceFlavorEphemeralV2(r flavors.GetResult) (int, error) {
+	var s struct {
+		Flavor struct {
+			Ephemeral int `json:"OS-FLV-EXT-DATA:ephemeral"`
+		} `json:"flavor"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Flavor.Ephemeral, err
+}
@@ -0,0 +1,31 @@
+// This set of code handles the description of an openstack_compute_instance_v2
+// resource.
+//
+// Server descriptions were added in Compute API microversion 2.19 and are not
+// yet supported by Gophercloud, so the requests are built here.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+)
+
+// computeV2InstanceDescriptionMicroversion is the minimum Compute API
+// microversion which supports server descriptions.
+const computeV2InstanceDescriptionMicroversion = "2.19"
+
+// computeV2InstanceDescriptionUpdate updates the description of a server.
+func computeV2InstanceDescriptionUpdate(client *gophercloud.ServiceClient, serverID, description string) (r servers.UpdateResult) {
+	descriptionClient := *client
+	descriptionClient.Microversion = computeV2InstanceDescriptionMicroversion
+
+	b := map[string]interface{}{
+		"server": map[string]interface{}{
+			"description": description,
+		},
+	}
+	_, r.Err = descriptionClient.Put(descriptionClient.ServiceURL("servers", serverID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
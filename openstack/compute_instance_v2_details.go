@@ -0,0 +1,46 @@
+// This set of code retrieves the attributes of an openstack_compute_instance_v2
+// resource which are only returned by newer Compute API microversions.
+//
+// Gophercloud does not yet support these microversions, so the server is
+// retrieved once at the highest microversion needed and the attributes are
+// extracted here.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+)
+
+// computeV2InstanceDetailsMicroversion is the Compute API microversion
+// which returns the tags, description and locked status of a server as
+// part of the server itself. It is the highest of
+// computeV2InstanceTagsMicroversion, computeV2InstanceDescriptionMicroversion
+// and computeV2InstanceLockedMicroversion.
+const computeV2InstanceDetailsMicroversion = computeV2InstanceTagsMicroversion
+
+// InstanceDetailsV2 holds the attributes of a server which are not part of
+// servers.Server.
+type InstanceDetailsV2 struct {
+	availabilityzones.ServerExt
+	Tags        []string `json:"tags"`
+	Description *string  `json:"description"`
+	Locked      bool     `json:"locked"`
+}
+
+// computeV2InstanceDetailsGet retrieves the attributes of a server which are
+// not part of servers.Server with a single request.
+func computeV2InstanceDetailsGet(client *gophercloud.ServiceClient, serverID string) (*InstanceDetailsV2, error) {
+	detailsClient := *client
+	detailsClient.Microversion = computeV2InstanceDetailsMicroversion
+
+	var s struct {
+		Server InstanceDetailsV2 `json:"server"`
+	}
+	err := servers.Get(&detailsClient, serverID).ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s.Server, nil
+}
@@ -0,0 +1,33 @@
+// This set of code handles listing servers filtered by tags.
+//
+// The tags and tags-any filters were added in Compute API microversion 2.26
+// and are not yet supported by Gophercloud's servers.ListOpts, so the query
+// is built here.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// InstanceListOpts represents the server list filters used by the
+// openstack_compute_instance_ids_v2 data source.
+type InstanceListOpts struct {
+	// Name is a regular expression to match the server name against.
+	Name string `q:"name"`
+
+	// Status is the status of the servers to return.
+	Status string `q:"status"`
+
+	// Tags is a comma-separated list of tags which all must be present.
+	Tags string `q:"tags"`
+
+	// TagsAny is a comma-separated list of tags of which at least one
+	// must be present.
+	TagsAny string `q:"tags-any"`
+}
+
+// ToServerListQuery formats an InstanceListOpts into a query string.
+func (opts InstanceListOpts) ToServerListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
@@ -0,0 +1,25 @@
+// This set of code handles the lock state of an openstack_compute_instance_v2
+// resource.
+//
+// The locked status of a server is returned by Compute API microversion 2.9
+// and later, which is not yet supported by Gophercloud. It is retrieved along
+// with the other details of the server, see computeV2InstanceDetailsGet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// computeV2InstanceLockedMicroversion is the minimum Compute API
+// microversion which returns the locked status of a server.
+const computeV2InstanceLockedMicroversion = "2.9"
+
+// setInstanceLocked locks or unlocks a server.
+func setInstanceLocked(client *gophercloud.ServiceClient, serverID string, locked bool) error {
+	action := "unlock"
+	if locked {
+		action = "lock"
+	}
+
+	return computeV2InstanceAction(client, serverID, action).ExtractErr()
+}
@@ -13,6 +13,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/tenantnetworks"
@@ -20,6 +22,7 @@ import (
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
 // InstanceNIC is a structured representation of a Gophercloud servers.Server
@@ -486,3 +489,273 @@ func getInstanceAccessAddresses(
 
 	return hostv4, hostv6
 }
+
+// updateInstanceNetworks attaches and detaches network interfaces so an
+// existing instance matches its network configuration without having to be
+// recreated.
+//
+// Networks are compared by their position in the list. When the uuid, name,
+// port, fixed_ip_v4, or fixed_ip_v6 of a network has changed, its interface
+// is detached and a new interface is attached in its place. The fixed IPs of
+// a network with a port can't change, see checkInstanceNetworkPortFixedIPs. Networks added
+// to the end of the list are attached and networks removed from the end are
+// detached.
+//
+// If an interface can't be attached or detached after others already were,
+// the networks are read back from the instance so the state matches the
+// interfaces the instance actually has.
+func updateInstanceNetworks(
+	d *schema.ResourceData, meta interface{}, computeClient *gophercloud.ServiceClient) (err error) {
+
+	oldNetworksRaw, newNetworksRaw := d.GetChange("network")
+	oldNetworks := oldNetworksRaw.([]interface{})
+	newNetworks := newNetworksRaw.([]interface{})
+
+	attachments, err := computeV2InterfaceAttachmentList(computeClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving interfaces of OpenStack server (%s): %s", d.Id(), err)
+	}
+
+	var changed bool
+	defer func() {
+		if err == nil || !changed {
+			return
+		}
+
+		networks, flattenErr := flattenInstanceNetworks(d, meta)
+		if flattenErr != nil {
+			log.Printf("[WARN] Unable to read back networks of OpenStack server (%s): %s", d.Id(), flattenErr)
+			return
+		}
+		d.Set("network", networks)
+	}()
+
+	var toDetach []map[string]interface{}
+	toAttach := make(map[int]map[string]interface{})
+
+	for i, v := range newNetworks {
+		newNetwork := v.(map[string]interface{})
+		if i >= len(oldNetworks) {
+			toAttach[i] = newNetwork
+			continue
+		}
+
+		oldNetwork := oldNetworks[i].(map[string]interface{})
+		if instanceNetworkChanged(oldNetwork, newNetwork) {
+			toDetach = append(toDetach, oldNetwork)
+			toAttach[i] = expandInstanceNetworkChange(oldNetwork, newNetwork)
+		}
+	}
+
+	for i := len(newNetworks); i < len(oldNetworks); i++ {
+		toDetach = append(toDetach, oldNetworks[i].(map[string]interface{}))
+	}
+
+	for _, network := range toDetach {
+		portID := getInstanceNetworkPortID(network, attachments)
+		if portID == "" {
+			return fmt.Errorf("Unable to determine the port of network %s on OpenStack server (%s)", network["name"], d.Id())
+		}
+
+		log.Printf("[DEBUG] Detaching port %s from OpenStack server (%s)", portID, d.Id())
+		err := computeV2InterfaceAttachmentDelete(computeClient, d.Id(), portID).ExtractErr()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); !ok {
+				return fmt.Errorf("Error detaching port %s from OpenStack server (%s): %s", portID, d.Id(), err)
+			}
+		}
+
+		changed = true
+
+		err = waitForComputeV2InterfaceAttachmentDetach(computeClient, d.Id(), portID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return fmt.Errorf("Error waiting for port %s to detach from OpenStack server (%s): %s", portID, d.Id(), err)
+		}
+	}
+
+	// Attach the interfaces in the order of the network list, so the
+	// instance reports them in that order, too.
+	attachIndices := make([]int, 0, len(toAttach))
+	for i := range toAttach {
+		attachIndices = append(attachIndices, i)
+	}
+	sort.Ints(attachIndices)
+
+	for _, i := range attachIndices {
+		network := toAttach[i]
+		queryType, queryTerm := "name", network["name"].(string)
+		if v := network["uuid"].(string); v != "" {
+			queryType, queryTerm = "id", v
+		}
+		if v := network["port"].(string); v != "" {
+			queryType, queryTerm = "port", v
+		}
+
+		if queryTerm == "" {
+			return fmt.Errorf(
+				"At least one of network.uuid, network.name, or network.port must be set.")
+		}
+
+		networkInfo, err := getInstanceNetworkInfo(d, meta, queryType, queryTerm)
+		if err != nil {
+			return err
+		}
+
+		attachOpts := InterfaceAttachmentCreateOpts{
+			PortID: network["port"].(string),
+		}
+
+		if attachOpts.PortID == "" {
+			attachOpts.NetworkID = networkInfo["uuid"].(string)
+			for _, k := range []string{"fixed_ip_v4", "fixed_ip_v6"} {
+				if v := network[k].(string); v != "" {
+					attachOpts.FixedIPs = append(attachOpts.FixedIPs, InterfaceAttachmentFixedIP{
+						IPAddress: v,
+					})
+				}
+			}
+		}
+
+		log.Printf("[DEBUG] Attaching interface to OpenStack server (%s): %#v", d.Id(), attachOpts)
+		_, err = computeV2InterfaceAttachmentCreate(computeClient, d.Id(), attachOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error attaching interface to OpenStack server (%s): %s", d.Id(), err)
+		}
+
+		changed = true
+
+		toAttach[i]["uuid"] = networkInfo["uuid"]
+		toAttach[i]["name"] = networkInfo["name"]
+	}
+
+	// Save the resolved networks so values carried over from the previous
+	// networks don't leak into the next read.
+	networks := make([]map[string]interface{}, len(newNetworks))
+	for i, v := range newNetworks {
+		network := v.(map[string]interface{})
+		networks[i] = map[string]interface{}{
+			"uuid":           network["uuid"],
+			"name":           network["name"],
+			"port":           network["port"],
+			"fixed_ip_v4":    network["fixed_ip_v4"],
+			"fixed_ip_v6":    network["fixed_ip_v6"],
+			"access_network": network["access_network"],
+		}
+
+		if attached, ok := toAttach[i]; ok {
+			for _, k := range []string{"uuid", "name", "port", "fixed_ip_v4", "fixed_ip_v6"} {
+				networks[i][k] = attached[k]
+			}
+		}
+	}
+	d.Set("network", networks)
+
+	return nil
+}
+
+// instanceNetworkChanged returns true if an attribute which identifies the
+// interface of a network has changed.
+func instanceNetworkChanged(oldNetwork, newNetwork map[string]interface{}) bool {
+	for _, k := range []string{"uuid", "name", "port", "fixed_ip_v4", "fixed_ip_v6"} {
+		if oldNetwork[k] != newNetwork[k] {
+			return true
+		}
+	}
+
+	return false
+}
+
+// expandInstanceNetworkChange determines the network to attach in place of
+// a changed network.
+//
+// Attributes which weren't set in the configuration keep their previously
+// computed values, so only the attributes which changed can be trusted.
+func expandInstanceNetworkChange(oldNetwork, newNetwork map[string]interface{}) map[string]interface{} {
+	v := map[string]interface{}{
+		"uuid":        "",
+		"name":        "",
+		"port":        "",
+		"fixed_ip_v4": "",
+		"fixed_ip_v6": "",
+	}
+
+	switch {
+	case newNetwork["port"] != oldNetwork["port"] && newNetwork["port"] != "":
+		v["port"] = newNetwork["port"]
+		return v
+	case newNetwork["uuid"] != oldNetwork["uuid"] && newNetwork["uuid"] != "":
+		v["uuid"] = newNetwork["uuid"]
+	case newNetwork["name"] != oldNetwork["name"] && newNetwork["name"] != "":
+		v["name"] = newNetwork["name"]
+	default:
+		// Only the fixed IPs changed, so stay on the same network.
+		v["uuid"] = oldNetwork["uuid"]
+		v["name"] = oldNetwork["name"]
+		v["fixed_ip_v4"] = newNetwork["fixed_ip_v4"]
+		v["fixed_ip_v6"] = newNetwork["fixed_ip_v6"]
+		return v
+	}
+
+	// The network changed, so only use fixed IPs which were changed, too.
+	for _, k := range []string{"fixed_ip_v4", "fixed_ip_v6"} {
+		if newNetwork[k] != oldNetwork[k] {
+			v[k] = newNetwork[k]
+		}
+	}
+
+	return v
+}
+
+// checkInstanceNetworkPortFixedIPs returns an error if a diff changes the
+// fixed IPs of a network whose port stays the same. The fixed IPs belong to
+// the port, which isn't managed by the instance, so they have to be changed
+// on the port instead.
+func checkInstanceNetworkPortFixedIPs(s *terraform.InstanceState, diff *terraform.InstanceDiff) error {
+	if s == nil || s.ID == "" || diff == nil || diff.RequiresNew() {
+		return nil
+	}
+
+	for k, attr := range diff.Attributes {
+		parts := strings.Split(k, ".")
+		if len(parts) != 3 || parts[0] != "network" || (parts[2] != "fixed_ip_v4" && parts[2] != "fixed_ip_v6") {
+			continue
+		}
+
+		if attr == nil || attr.NewComputed || attr.Old == attr.New {
+			continue
+		}
+
+		portKey := fmt.Sprintf("network.%s.port", parts[1])
+		port := s.Attributes[portKey]
+		if port == "" {
+			continue
+		}
+
+		if portDiff, ok := diff.Attributes[portKey]; ok && portDiff.New != port {
+			continue
+		}
+
+		return fmt.Errorf(
+			"network.%s.%s can't be changed because network.%s uses port %s. Change the fixed IPs of the port instead.",
+			parts[1], parts[2], parts[1], port)
+	}
+
+	return nil
+}
+
+// getInstanceNetworkPortID determines the port of the interface which
+// belongs to a network of an instance.
+func getInstanceNetworkPortID(network map[string]interface{}, attachments []InterfaceAttachment) string {
+	if v, ok := network["port"].(string); ok && v != "" {
+		return v
+	}
+
+	mac, _ := network["mac"].(string)
+	for _, attachment := range attachments {
+		if mac != "" && attachment.MACAddr == mac {
+			return attachment.PortID
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,148 @@
+// This set of code handles the power state of an openstack_compute_instance_v2
+// resource.
+//
+// Shelving is not yet supported by Gophercloud, so those server actions are
+// built here.
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/startstop"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// computeV2InstanceAction performs a server action which doesn't take any
+// arguments, such as "shelve" or "unshelve".
+func computeV2InstanceAction(client *gophercloud.ServiceClient, serverID, action string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		action: nil,
+	}
+	_, r.Err = client.Post(client.ServiceURL("servers", serverID, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// flattenInstancePowerState converts a server status into a power_state.
+// An empty string is returned for statuses without a matching power_state.
+//
+// A shelved server which is not yet offloaded is reported as
+// shelved_offloaded, since the Compute service offloads it on its own
+// depending on shelved_offload_time.
+func flattenInstancePowerState(status string) string {
+	switch status {
+	case "ACTIVE":
+		return "active"
+	case "SHUTOFF":
+		return "shutoff"
+	case "SHELVED", "SHELVED_OFFLOADED":
+		return "shelved_offloaded"
+	}
+
+	return ""
+}
+
+// setInstancePowerState moves a server into the given power_state, waiting
+// for each of the intermediate states along the way.
+func setInstancePowerState(client *gophercloud.ServiceClient, serverID, powerState string, timeout time.Duration) error {
+	server, err := servers.Get(client, serverID).Extract()
+	if err != nil {
+		return err
+	}
+
+	status := server.Status
+	log.Printf("[DEBUG] Changing power state of OpenStack server (%s) from %s to %s", serverID, status, powerState)
+
+	// Unshelve first if the server needs to be running or stopped.
+	if powerState != "shelved_offloaded" && (status == "SHELVED" || status == "SHELVED_OFFLOADED") {
+		err = computeV2InstanceAction(client, serverID, "unshelve").ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error unshelving OpenStack server (%s): %s", serverID, err)
+		}
+
+		status, err = waitForInstancePowerState(client, serverID, []string{"SHELVED", "SHELVED_OFFLOADED"}, []string{"ACTIVE"}, timeout)
+		if err != nil {
+			return fmt.Errorf("Error waiting for OpenStack server (%s) to unshelve: %s", serverID, err)
+		}
+	}
+
+	switch powerState {
+	case "active":
+		if status == "SHUTOFF" {
+			err = startstop.Start(client, serverID).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error starting OpenStack server (%s): %s", serverID, err)
+			}
+
+			_, err = waitForInstancePowerState(client, serverID, []string{"SHUTOFF"}, []string{"ACTIVE"}, timeout)
+			if err != nil {
+				return fmt.Errorf("Error waiting for OpenStack server (%s) to start: %s", serverID, err)
+			}
+		}
+	case "shutoff":
+		if status == "ACTIVE" {
+			err = startstop.Stop(client, serverID).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error stopping OpenStack server (%s): %s", serverID, err)
+			}
+
+			_, err = waitForInstancePowerState(client, serverID, []string{"ACTIVE"}, []string{"SHUTOFF"}, timeout)
+			if err != nil {
+				return fmt.Errorf("Error waiting for OpenStack server (%s) to stop: %s", serverID, err)
+			}
+		}
+	case "shelved_offloaded":
+		if status == "ACTIVE" || status == "SHUTOFF" {
+			err = computeV2InstanceAction(client, serverID, "shelve").ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error shelving OpenStack server (%s): %s", serverID, err)
+			}
+
+			status, err = waitForInstancePowerState(client, serverID, []string{"ACTIVE", "SHUTOFF"}, []string{"SHELVED", "SHELVED_OFFLOADED"}, timeout)
+			if err != nil {
+				return fmt.Errorf("Error waiting for OpenStack server (%s) to shelve: %s", serverID, err)
+			}
+		}
+
+		// Depending on shelved_offload_time, the server might have
+		// been shelved without being offloaded.
+		if status == "SHELVED" {
+			err = computeV2InstanceAction(client, serverID, "shelveOffload").ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error offloading OpenStack server (%s): %s", serverID, err)
+			}
+
+			_, err = waitForInstancePowerState(client, serverID, []string{"SHELVED"}, []string{"SHELVED_OFFLOADED"}, timeout)
+			if err != nil {
+				return fmt.Errorf("Error waiting for OpenStack server (%s) to offload: %s", serverID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// waitForInstancePowerState waits for a server to reach one of the target
+// statuses and returns the status it reached.
+func waitForInstancePowerState(client *gophercloud.ServiceClient, serverID string, pending, target []string, timeout time.Duration) (string, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    pending,
+		Target:     target,
+		Refresh:    ServerV2StateRefreshFunc(client, serverID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	v, err := stateConf.WaitForState()
+	if err != nil {
+		return "", err
+	}
+
+	return v.(*servers.Server).Status, nil
+}
@@ -0,0 +1,73 @@
+// This set of code handles the rebuild of an openstack_compute_instance_v2
+// resource whose user_data changed.
+//
+// Changing the user data of a server when rebuilding it was added in Compute
+// API microversion 2.57 and is not yet supported by Gophercloud, so the
+// request is built here.
+package openstack
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+)
+
+// computeV2InstanceRebuildUserDataMicroversion is the minimum Compute API
+// microversion which supports changing the user data of a server when
+// rebuilding it.
+const computeV2InstanceRebuildUserDataMicroversion = "2.57"
+
+// computeV2InstanceRebuild rebuilds a server from the given image with new
+// user data. The addresses and volumes of the server are kept.
+func computeV2InstanceRebuild(client *gophercloud.ServiceClient, serverID, imageID, userData string) (r servers.RebuildResult) {
+	rebuildClient := *client
+	rebuildClient.Microversion = computeV2InstanceRebuildUserDataMicroversion
+
+	rebuild := map[string]interface{}{
+		"imageRef":  imageID,
+		"user_data": nil,
+	}
+	if userData != "" {
+		rebuild["user_data"] = base64.StdEncoding.EncodeToString([]byte(userData))
+	}
+
+	b := map[string]interface{}{
+		"rebuild": rebuild,
+	}
+	_, r.Err = rebuildClient.Post(rebuildClient.ServiceURL("servers", serverID, "action"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// rebuildInstanceUserData rebuilds a server from its current image with new
+// user data and waits for the rebuild to finish.
+func rebuildInstanceUserData(client *gophercloud.ServiceClient, serverID, userData string, timeout time.Duration) error {
+	server, err := servers.Get(client, serverID).Extract()
+	if err != nil {
+		return err
+	}
+
+	imageID, _ := server.Image["id"].(string)
+	if imageID == "" {
+		return fmt.Errorf("OpenStack server (%s) was booted from a volume and can't be rebuilt", serverID)
+	}
+
+	log.Printf("[DEBUG] Rebuilding OpenStack server (%s) from image %s with new user data", serverID, imageID)
+	_, err = computeV2InstanceRebuild(client, serverID, imageID, userData).Extract()
+	if err != nil {
+		return fmt.Errorf("Error rebuilding OpenStack server (%s): %s", serverID, err)
+	}
+
+	// A server which was stopped before the rebuild stays stopped.
+	_, err = waitForInstancePowerState(client, serverID, []string{"REBUILD"}, []string{"ACTIVE", "SHUTOFF"}, timeout)
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack server (%s) to rebuild: %s", serverID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+// This set of code handles the server tags of an openstack_compute_instance_v2
+// resource.
+//
+// Server tags were added in Compute API microversion 2.26 and are not yet
+// supported by Gophercloud, so the requests are built here.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// computeV2InstanceTagsMicroversion is the minimum Compute API
+// microversion which supports server tags.
+const computeV2InstanceTagsMicroversion = "2.26"
+
+// InstanceTagsResult is the result of a server tags request.
+type InstanceTagsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an InstanceTagsResult as a list of tags.
+func (r InstanceTagsResult) Extract() ([]string, error) {
+	var s struct {
+		Tags []string `json:"tags"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Tags, err
+}
+
+// computeV2InstanceTagsList retrieves the tags of a server.
+func computeV2InstanceTagsList(client *gophercloud.ServiceClient, serverID string) (r InstanceTagsResult) {
+	tagsClient := *client
+	tagsClient.Microversion = computeV2InstanceTagsMicroversion
+
+	_, r.Err = tagsClient.Get(tagsClient.ServiceURL("servers", serverID, "tags"), &r.Body, nil)
+	return
+}
+
+// computeV2InstanceTagsReplace replaces all tags of a server with the given
+// list of tags.
+func computeV2InstanceTagsReplace(client *gophercloud.ServiceClient, serverID string, tags []string) (r InstanceTagsResult) {
+	tagsClient := *client
+	tagsClient.Microversion = computeV2InstanceTagsMicroversion
+
+	b := map[string]interface{}{
+		"tags": tags,
+	}
+	_, r.Err = tagsClient.Put(tagsClient.ServiceURL("servers", serverID, "tags"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// resourceInstanceTagsV2 returns the configured tags of an instance.
+func resourceInstanceTagsV2(d *schema.ResourceData) []string {
+	rawTags := d.Get("tags").(*schema.Set).List()
+	tags := make([]string, len(rawTags))
+	for i, raw := range rawTags {
+		tags[i] = raw.(string)
+	}
+	return tags
+}
@@ -0,0 +1,157 @@
+// This set of code handles the os-interface API of the Compute service,
+// which is used to attach and detach network interfaces to and from a
+// server. Gophercloud does not support this API yet.
+package openstack
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// InterfaceAttachment is a network interface attached to a server.
+type InterfaceAttachment struct {
+	PortState string                       `json:"port_state"`
+	FixedIPs  []InterfaceAttachmentFixedIP `json:"fixed_ips"`
+	PortID    string                       `json:"port_id"`
+	NetID     string                       `json:"net_id"`
+	MACAddr   string                       `json:"mac_addr"`
+}
+
+// InterfaceAttachmentFixedIP is a fixed IP address of an attached interface.
+type InterfaceAttachmentFixedIP struct {
+	SubnetID  string `json:"subnet_id,omitempty"`
+	IPAddress string `json:"ip_address,omitempty"`
+}
+
+// InterfaceAttachmentCreateOpts represents the attributes used when
+// attaching an interface to a server. Either a PortID or a NetworkID
+// must be given.
+type InterfaceAttachmentCreateOpts struct {
+	PortID    string                       `json:"port_id,omitempty"`
+	NetworkID string                       `json:"net_id,omitempty"`
+	FixedIPs  []InterfaceAttachmentFixedIP `json:"fixed_ips,omitempty"`
+}
+
+// ToInterfaceAttachmentCreateMap casts an InterfaceAttachmentCreateOpts
+// struct to a map.
+func (opts InterfaceAttachmentCreateOpts) ToInterfaceAttachmentCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "interfaceAttachment")
+}
+
+// InterfaceAttachmentResult is the result of a create or get request.
+type InterfaceAttachmentResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an InterfaceAttachmentResult as an InterfaceAttachment.
+func (r InterfaceAttachmentResult) Extract() (*InterfaceAttachment, error) {
+	var s struct {
+		InterfaceAttachment *InterfaceAttachment `json:"interfaceAttachment"`
+	}
+	err := r.ExtractInto(&s)
+	return s.InterfaceAttachment, err
+}
+
+// InterfaceAttachmentListResult is the result of a list request.
+type InterfaceAttachmentListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an InterfaceAttachmentListResult as a list of
+// InterfaceAttachments.
+func (r InterfaceAttachmentListResult) Extract() ([]InterfaceAttachment, error) {
+	var s struct {
+		InterfaceAttachments []InterfaceAttachment `json:"interfaceAttachments"`
+	}
+	err := r.ExtractInto(&s)
+	return s.InterfaceAttachments, err
+}
+
+func computeV2InterfaceAttachmentCreate(client *gophercloud.ServiceClient, serverID string, opts InterfaceAttachmentCreateOpts) (r InterfaceAttachmentResult) {
+	b, err := opts.ToInterfaceAttachmentCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("servers", serverID, "os-interface"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func computeV2InterfaceAttachmentGet(client *gophercloud.ServiceClient, serverID, portID string) (r InterfaceAttachmentResult) {
+	_, r.Err = client.Get(client.ServiceURL("servers", serverID, "os-interface", portID), &r.Body, nil)
+	return
+}
+
+func computeV2InterfaceAttachmentList(client *gophercloud.ServiceClient, serverID string) (r InterfaceAttachmentListResult) {
+	_, r.Err = client.Get(client.ServiceURL("servers", serverID, "os-interface"), &r.Body, nil)
+	return
+}
+
+func computeV2InterfaceAttachmentDelete(client *gophercloud.ServiceClient, serverID, portID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("servers", serverID, "os-interface", portID), nil)
+	return
+}
+
+// waitForComputeV2InterfaceAttachmentAttach waits until the interface with
+// the given port is attached to the server.
+func waitForComputeV2InterfaceAttachmentAttach(client *gophercloud.ServiceClient, serverID, portID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ATTACHING"},
+		Target:     []string{"ATTACHED"},
+		Refresh:    computeV2InterfaceAttachmentAttachRefreshFunc(client, serverID, portID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+// waitForComputeV2InterfaceAttachmentDetach waits until the interface with
+// the given port is no longer attached to the server.
+func waitForComputeV2InterfaceAttachmentDetach(client *gophercloud.ServiceClient, serverID, portID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"ATTACHED"},
+		Target:     []string{"DETACHED"},
+		Refresh:    computeV2InterfaceAttachmentDetachRefreshFunc(client, serverID, portID),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func computeV2InterfaceAttachmentDetachRefreshFunc(client *gophercloud.ServiceClient, serverID, portID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		attachment, err := computeV2InterfaceAttachmentGet(client, serverID, portID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return attachment, "DETACHED", nil
+			}
+			return nil, "", err
+		}
+
+		return attachment, "ATTACHED", nil
+	}
+}
+
+func computeV2InterfaceAttachmentAttachRefreshFunc(client *gophercloud.ServiceClient, serverID, portID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		attachment, err := computeV2InterfaceAttachmentGet(client, serverID, portID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return attachment, "ATTACHING", nil
+			}
+			return nil, "", err
+		}
+
+		return attachment, "ATTACHED", nil
+	}
+}
@@ -0,0 +1,76 @@
+// This set of code handles the os-quota-sets API of the Compute service,
+// which is used by the openstack_compute_quotaset_v2 resource.
+// Gophercloud does not support this API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// QuotaSet is a set of Compute quotas of a project.
+type QuotaSet struct {
+	Instances          int `json:"instances"`
+	Cores              int `json:"cores"`
+	RAM                int `json:"ram"`
+	KeyPairs           int `json:"key_pairs"`
+	MetadataItems      int `json:"metadata_items"`
+	ServerGroups       int `json:"server_groups"`
+	ServerGroupMembers int `json:"server_group_members"`
+}
+
+// QuotaSetUpdateOpts represents the attributes used when updating the
+// Compute quotas of a project. Only set quotas are updated.
+type QuotaSetUpdateOpts struct {
+	Instances          *int `json:"instances,omitempty"`
+	Cores              *int `json:"cores,omitempty"`
+	RAM                *int `json:"ram,omitempty"`
+	KeyPairs           *int `json:"key_pairs,omitempty"`
+	MetadataItems      *int `json:"metadata_items,omitempty"`
+	ServerGroups       *int `json:"server_groups,omitempty"`
+	ServerGroupMembers *int `json:"server_group_members,omitempty"`
+}
+
+// ToQuotaSetUpdateMap casts a QuotaSetUpdateOpts struct to a map.
+func (opts QuotaSetUpdateOpts) ToQuotaSetUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "quota_set")
+}
+
+// QuotaSetResult is the result of a get or update request.
+type QuotaSetResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a QuotaSetResult as a QuotaSet.
+func (r QuotaSetResult) Extract() (*QuotaSet, error) {
+	var s struct {
+		QuotaSet *QuotaSet `json:"quota_set"`
+	}
+	err := r.ExtractInto(&s)
+	return s.QuotaSet, err
+}
+
+func computeV2QuotaSetGet(client *gophercloud.ServiceClient, projectID string) (r QuotaSetResult) {
+	_, r.Err = client.Get(client.ServiceURL("os-quota-sets", projectID), &r.Body, nil)
+	return
+}
+
+func computeV2QuotaSetUpdate(client *gophercloud.ServiceClient, projectID string, opts QuotaSetUpdateOpts) (r QuotaSetResult) {
+	b, err := opts.ToQuotaSetUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("os-quota-sets", projectID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// computeV2QuotaSetDelete reverts the Compute quotas of a project to their
+// defaults.
+func computeV2QuotaSetDelete(client *gophercloud.ServiceClient, projectID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("os-quota-sets", projectID), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -0,0 +1,114 @@
+// This set of code handles the microversioned server group features of an
+// openstack_compute_servergroup_v2 resource.
+//
+// The soft-affinity and soft-anti-affinity policies were added in Compute API
+// microversion 2.15. Server group rules were added in microversion 2.64,
+// which also replaced the list of policies with a single policy. Gophercloud
+// does not support rules yet, so those requests are built here.
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// computeV2ServerGroupSoftPoliciesMicroversion is the minimum Compute
+	// API microversion which supports the soft-affinity and
+	// soft-anti-affinity policies.
+	computeV2ServerGroupSoftPoliciesMicroversion = "2.15"
+
+	// computeV2ServerGroupRulesMicroversion is the minimum Compute API
+	// microversion which supports server group rules.
+	computeV2ServerGroupRulesMicroversion = "2.64"
+)
+
+// ServerGroupRules represents the rules of a server group.
+type ServerGroupRules struct {
+	MaxServerPerHost int `json:"max_server_per_host,omitempty"`
+}
+
+// ServerGroupRulesCreateOpts represents the attributes used when creating a
+// new server group with rules.
+type ServerGroupRulesCreateOpts struct {
+	Name       string            `json:"name" required:"true"`
+	Policy     string            `json:"policy" required:"true"`
+	Rules      *ServerGroupRules `json:"rules,omitempty"`
+	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToServerGroupCreateMap casts a ServerGroupRulesCreateOpts struct to a map.
+func (opts ServerGroupRulesCreateOpts) ToServerGroupCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "server_group")
+}
+
+// ServerGroupWithRules is a server group as returned by Compute API
+// microversion 2.64 and later.
+type ServerGroupWithRules struct {
+	ID      string           `json:"id"`
+	Name    string           `json:"name"`
+	Policy  string           `json:"policy"`
+	Rules   ServerGroupRules `json:"rules"`
+	Members []string         `json:"members"`
+}
+
+// ServerGroupWithRulesResult is the result of a server group get request
+// using microversion 2.64 or later.
+type ServerGroupWithRulesResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ServerGroupWithRulesResult as a ServerGroupWithRules.
+func (r ServerGroupWithRulesResult) Extract() (*ServerGroupWithRules, error) {
+	var s struct {
+		ServerGroup *ServerGroupWithRules `json:"server_group"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ServerGroup, err
+}
+
+// computeV2ServerGroupWithRulesGet retrieves a server group including its
+// rules.
+func computeV2ServerGroupWithRulesGet(client *gophercloud.ServiceClient, id string) (r ServerGroupWithRulesResult) {
+	rulesClient := *client
+	rulesClient.Microversion = computeV2ServerGroupRulesMicroversion
+
+	_, r.Err = rulesClient.Get(rulesClient.ServiceURL("os-server-groups", id), &r.Body, nil)
+	return
+}
+
+// serverGroupV2Microversion returns the Compute API microversion needed to
+// create a server group with the given policies and rules.
+func serverGroupV2Microversion(policies []string, rules *ServerGroupRules) string {
+	if rules != nil {
+		return computeV2ServerGroupRulesMicroversion
+	}
+
+	for _, p := range policies {
+		if p == "soft-affinity" || p == "soft-anti-affinity" {
+			return computeV2ServerGroupSoftPoliciesMicroversion
+		}
+	}
+
+	return ""
+}
+
+// resourceServerGroupRulesV2 returns the configured rules of a server group.
+func resourceServerGroupRulesV2(d *schema.ResourceData) (*ServerGroupRules, error) {
+	rawRules := d.Get("rules").([]interface{})
+	if len(rawRules) == 0 || rawRules[0] == nil {
+		return nil, nil
+	}
+
+	rules := rawRules[0].(map[string]interface{})
+	policies := resourceServerGroupPoliciesV2(d)
+	if len(policies) != 1 {
+		return nil, fmt.Errorf("Exactly one policy must be set when using rules")
+	}
+
+	return &ServerGroupRules{
+		MaxServerPerHost: rules["max_server_per_host"].(int),
+	}, nil
+}
@@ -7,9 +7,12 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"sort"
+	"strings"
 
 	"github.com/gophercloud/gophercloud"
 	"github.com/gophercloud/gophercloud/openstack"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/swauth"
 	"github.com/gophercloud/utils/openstack/clientconfig"
 	"github.com/hashicorp/terraform/helper/pathorcontents"
@@ -17,24 +20,25 @@ import (
 )
 
 type Config struct {
-	CACertFile       string
-	ClientCertFile   string
-	ClientKeyFile    string
-	Cloud            string
-	DomainID         string
-	DomainName       string
-	EndpointType     string
-	IdentityEndpoint string
-	Insecure         bool
-	Password         string
-	Region           string
-	Swauth           bool
-	TenantID         string
-	TenantName       string
-	Token            string
-	Username         string
-	UserID           string
-	useOctavia       bool
+	CACertFile         string
+	ClientCertFile     string
+	ClientKeyFile      string
+	Cloud              string
+	DomainID           string
+	DomainName         string
+	EndpointType       string
+	IdentityAPIVersion string
+	IdentityEndpoint   string
+	Insecure           bool
+	Password           string
+	Region             string
+	Swauth             bool
+	TenantID           string
+	TenantName         string
+	Token              string
+	Username           string
+	UserID             string
+	useOctavia         bool
 
 	OsClient *gophercloud.ProviderClient
 }
@@ -63,6 +67,8 @@ func (c *Config) LoadAndValidate() error {
 		return fmt.Errorf("Invalid endpoint type provided")
 	}
 
+	identityAPIVersion := c.IdentityAPIVersion
+
 	ao := &gophercloud.AuthOptions{}
 
 	// If a cloud entry was given, base AuthOptions on a clouds.yaml file.
@@ -76,6 +82,16 @@ func (c *Config) LoadAndValidate() error {
 		if err != nil {
 			return err
 		}
+
+		// The identity_api_version of the provider takes precedence over
+		// the one of the cloud entry.
+		if identityAPIVersion == "" {
+			cloud, err := clientconfig.GetCloudFromYAML(clientOpts)
+			if err != nil {
+				return err
+			}
+			identityAPIVersion = cloud.IdentityAPIVersion
+		}
 	} else {
 		ao = &gophercloud.AuthOptions{
 			DomainID:         c.DomainID,
@@ -90,6 +106,11 @@ func (c *Config) LoadAndValidate() error {
 		}
 	}
 
+	identityAPIVersion, err := normalizeIdentityAPIVersion(identityAPIVersion)
+	if err != nil {
+		return err
+	}
+
 	client, err := openstack.NewClient(ao.IdentityEndpoint)
 	if err != nil {
 		return err
@@ -149,7 +170,15 @@ func (c *Config) LoadAndValidate() error {
 
 	// If using Swift Authentication, there's no need to validate authentication normally.
 	if !c.Swauth {
-		err = openstack.Authenticate(client, *ao)
+		// Skip version discovery if an Identity version was explicitly set.
+		switch identityAPIVersion {
+		case "2":
+			err = openstack.AuthenticateV2(client, *ao, gophercloud.EndpointOpts{})
+		case "3":
+			err = openstack.AuthenticateV3(client, ao, gophercloud.EndpointOpts{})
+		default:
+			err = openstack.Authenticate(client, *ao)
+		}
 		if err != nil {
 			return err
 		}
@@ -157,9 +186,84 @@ func (c *Config) LoadAndValidate() error {
 
 	c.OsClient = client
 
+	// Make sure the region exists in the service catalog so that an
+	// invalid region doesn't surface later as a missing endpoint.
+	if c.Region != "" && !c.Swauth {
+		if err := c.validateRegion(); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// normalizeIdentityAPIVersion returns the major Identity API version of
+// an identity_api_version setting, which is either "2" or "3". An empty
+// version is returned unchanged, which means the version is discovered.
+func normalizeIdentityAPIVersion(version string) (string, error) {
+	switch version {
+	case "":
+		return "", nil
+	case "2", "2.0":
+		return "2", nil
+	case "3", "3.0":
+		return "3", nil
+	}
+
+	return "", fmt.Errorf("Invalid identity API version provided: %s", version)
+}
+
+// validateRegion checks the provider-level region against the regions
+// listed in the service catalog of the current token.
+func (c *Config) validateRegion() error {
+	identityClient, err := openstack.NewIdentityV3(c.OsClient, gophercloud.EndpointOpts{})
+	if err != nil {
+		return err
+	}
+
+	catalog, err := tokens.Get(identityClient, c.OsClient.TokenID).ExtractServiceCatalog()
+	if err != nil {
+		// Not every cloud allows the catalog to be retrieved this way
+		// (for example, Identity v2), so don't fail if it can't be.
+		log.Printf("[WARN] Unable to retrieve the service catalog, region %s was not validated: %s", c.Region, err)
+		return nil
+	}
+
+	regions := catalogRegions(catalog)
+	if len(regions) == 0 {
+		return nil
+	}
+
+	for _, region := range regions {
+		if region == c.Region {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Region %q was not found in the service catalog. Available regions are: %s",
+		c.Region, strings.Join(regions, ", "))
+}
+
+// catalogRegions returns the sorted, unique list of regions found in a
+// service catalog.
+func catalogRegions(catalog *tokens.ServiceCatalog) []string {
+	seen := make(map[string]bool)
+	var regions []string
+	for _, entry := range catalog.Entries {
+		for _, endpoint := range entry.Endpoints {
+			if endpoint.Region == "" || seen[endpoint.Region] {
+				continue
+			}
+			seen[endpoint.Region] = true
+			regions = append(regions, endpoint.Region)
+		}
+	}
+
+	sort.Strings(regions)
+
+	return regions
+}
+
 func (c *Config) determineRegion(region string) string {
 	// If a resource-level region was not specified, and a provider-level region was set,
 	// use the provider-level region.
@@ -185,6 +289,30 @@ func (c *Config) blockStorageV2Client(region string) (*gophercloud.ServiceClient
 	})
 }
 
+// blockStorageV3Client returns a client for the Block Storage v3 API.
+// Gophercloud does not provide a Block Storage v3 client yet, so the
+// "volumev3" endpoint is looked up here. The client type is "volume" so
+// that microversions are sent in the "OpenStack-API-Version: volume"
+// header expected by Cinder.
+func (c *Config) blockStorageV3Client(region string) (*gophercloud.ServiceClient, error) {
+	eo := gophercloud.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getEndpointType(),
+	}
+	eo.ApplyDefaults("volumev3")
+
+	url, err := c.OsClient.EndpointLocator(eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gophercloud.ServiceClient{
+		ProviderClient: c.OsClient,
+		Endpoint:       url,
+		Type:           "volume",
+	}, nil
+}
+
 func (c *Config) computeV2Client(region string) (*gophercloud.ServiceClient, error) {
 	return openstack.NewComputeV2(c.OsClient, gophercloud.EndpointOpts{
 		Region:       c.determineRegion(region),
@@ -192,6 +320,29 @@ func (c *Config) computeV2Client(region string) (*gophercloud.ServiceClient, err
 	})
 }
 
+// containerInfraV1Client returns a client for the Container Infrastructure
+// Management (Magnum) v1 API. Gophercloud does not provide a Container
+// Infrastructure client yet, so the "container-infra" endpoint is looked up
+// here. The endpoint is versioned, e.g. http://magnum:9511/v1.
+func (c *Config) containerInfraV1Client(region string) (*gophercloud.ServiceClient, error) {
+	eo := gophercloud.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getEndpointType(),
+	}
+	eo.ApplyDefaults("container-infra")
+
+	url, err := c.OsClient.EndpointLocator(eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gophercloud.ServiceClient{
+		ProviderClient: c.OsClient,
+		Endpoint:       url,
+		Type:           "container-infra",
+	}, nil
+}
+
 func (c *Config) dnsV2Client(region string) (*gophercloud.ServiceClient, error) {
 	return openstack.NewDNSV2(c.OsClient, gophercloud.EndpointOpts{
 		Region:       c.determineRegion(region),
@@ -213,6 +364,29 @@ func (c *Config) imageV2Client(region string) (*gophercloud.ServiceClient, error
 	})
 }
 
+// keyManagerV1Client returns a client for the Key Manager (Barbican) v1
+// API. Gophercloud does not provide a Key Manager client yet, so the
+// "key-manager" endpoint is looked up here. The endpoint is not versioned,
+// so the resources are located below "v1/".
+func (c *Config) keyManagerV1Client(region string) (*gophercloud.ServiceClient, error) {
+	eo := gophercloud.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getEndpointType(),
+	}
+	eo.ApplyDefaults("key-manager")
+
+	url, err := c.OsClient.EndpointLocator(eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gophercloud.ServiceClient{
+		ProviderClient: c.OsClient,
+		Endpoint:       url,
+		ResourceBase:   url + "v1/",
+	}, nil
+}
+
 func (c *Config) networkingV2Client(region string) (*gophercloud.ServiceClient, error) {
 	return openstack.NewNetworkV2(c.OsClient, gophercloud.EndpointOpts{
 		Region:       c.determineRegion(region),
@@ -235,6 +409,53 @@ func (c *Config) objectStorageV1Client(region string) (*gophercloud.ServiceClien
 	})
 }
 
+func (c *Config) orchestrationV1Client(region string) (*gophercloud.ServiceClient, error) {
+	return openstack.NewOrchestrationV1(c.OsClient, gophercloud.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getEndpointType(),
+	})
+}
+
+// sharedfilesystemV2Client returns a client for the Shared File Systems
+// (Manila) v2 API. The API is versioned with microversions, and the client
+// always uses sharedfilesystemV2Microversion.
+func (c *Config) sharedfilesystemV2Client(region string) (*gophercloud.ServiceClient, error) {
+	client, err := openstack.NewSharedFileSystemV2(c.OsClient, gophercloud.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getEndpointType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client.Microversion = sharedfilesystemV2Microversion
+
+	return client, nil
+}
+
+// workflowV2Client returns a client for the Workflow (Mistral) v2 API.
+// Gophercloud does not provide a Workflow client yet, so the "workflowv2"
+// endpoint is looked up here. The endpoint is versioned, e.g.
+// http://mistral:8989/v2.
+func (c *Config) workflowV2Client(region string) (*gophercloud.ServiceClient, error) {
+	eo := gophercloud.EndpointOpts{
+		Region:       c.determineRegion(region),
+		Availability: c.getEndpointType(),
+	}
+	eo.ApplyDefaults("workflowv2")
+
+	url, err := c.OsClient.EndpointLocator(eo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gophercloud.ServiceClient{
+		ProviderClient: c.OsClient,
+		Endpoint:       url,
+		Type:           "workflowv2",
+	}, nil
+}
+
 func (c *Config) loadBalancerV2Client(region string) (*gophercloud.ServiceClient, error) {
 	return openstack.NewLoadBalancerV2(c.OsClient, gophercloud.EndpointOpts{
 		Region:       c.determineRegion(region),
@@ -0,0 +1,69 @@
+package openstack
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
+)
+
+func TestCatalogRegions(t *testing.T) {
+	catalog := &tokens.ServiceCatalog{
+		Entries: []tokens.CatalogEntry{
+			tokens.CatalogEntry{
+				Type: "compute",
+				Endpoints: []tokens.Endpoint{
+					tokens.Endpoint{Region: "RegionTwo", Interface: "public"},
+					tokens.Endpoint{Region: "RegionOne", Interface: "public"},
+					tokens.Endpoint{Region: "RegionOne", Interface: "internal"},
+				},
+			},
+			tokens.CatalogEntry{
+				Type: "identity",
+				Endpoints: []tokens.Endpoint{
+					tokens.Endpoint{Region: "", Interface: "public"},
+					tokens.Endpoint{Region: "RegionThree", Interface: "public"},
+				},
+			},
+		},
+	}
+
+	expected := []string{"RegionOne", "RegionThree", "RegionTwo"}
+	actual := catalogRegions(catalog)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestCatalogRegions_empty(t *testing.T) {
+	actual := catalogRegions(&tokens.ServiceCatalog{})
+	if len(actual) != 0 {
+		t.Fatalf("Expected no regions, got %#v", actual)
+	}
+}
+
+func TestNormalizeIdentityAPIVersion(t *testing.T) {
+	versions := map[string]string{
+		"":    "",
+		"2":   "2",
+		"2.0": "2",
+		"3":   "3",
+		"3.0": "3",
+	}
+
+	for version, expected := range versions {
+		actual, err := normalizeIdentityAPIVersion(version)
+		if err != nil {
+			t.Fatalf("Unexpected error for version %q: %s", version, err)
+		}
+		if actual != expected {
+			t.Fatalf("Expected %q for version %q, got %q", expected, version, actual)
+		}
+	}
+
+	for _, version := range []string{"1", "2.1", "v3", "4"} {
+		if _, err := normalizeIdentityAPIVersion(version); err == nil {
+			t.Fatalf("Expected an error for version %q", version)
+		}
+	}
+}
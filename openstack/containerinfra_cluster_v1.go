@@ -0,0 +1,151 @@
+// This set of code handles clusters and their certificates of the Container
+// Infrastructure Management (Magnum) v1 API.
+// Gophercloud does not support the Container Infrastructure API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ClusterV1 is a Container Infrastructure v1 cluster.
+type ClusterV1 struct {
+	UUID              string            `json:"uuid"`
+	Name              string            `json:"name"`
+	ClusterTemplateID string            `json:"cluster_template_id"`
+	Keypair           string            `json:"keypair"`
+	MasterCount       int               `json:"master_count"`
+	NodeCount         int               `json:"node_count"`
+	CreateTimeout     int               `json:"create_timeout"`
+	DiscoveryURL      string            `json:"discovery_url"`
+	DockerVolumeSize  int               `json:"docker_volume_size"`
+	Labels            map[string]string `json:"labels"`
+	FlavorID          string            `json:"flavor_id"`
+	MasterFlavorID    string            `json:"master_flavor_id"`
+	Status            string            `json:"status"`
+	StatusReason      string            `json:"status_reason"`
+	StackID           string            `json:"stack_id"`
+	APIAddress        string            `json:"api_address"`
+	COEVersion        string            `json:"coe_version"`
+	MasterAddresses   []string          `json:"master_addresses"`
+	NodeAddresses     []string          `json:"node_addresses"`
+	ProjectID         string            `json:"project_id"`
+	UserID            string            `json:"user_id"`
+	CreatedAt         string            `json:"created_at"`
+	UpdatedAt         string            `json:"updated_at"`
+}
+
+// ClusterV1CreateOpts represents the attributes used when creating a new
+// Container Infrastructure v1 cluster.
+type ClusterV1CreateOpts struct {
+	Name              string            `json:"name,omitempty"`
+	ClusterTemplateID string            `json:"cluster_template_id" required:"true"`
+	Keypair           string            `json:"keypair,omitempty"`
+	MasterCount       int               `json:"master_count,omitempty"`
+	NodeCount         int               `json:"node_count,omitempty"`
+	CreateTimeout     int               `json:"create_timeout,omitempty"`
+	DiscoveryURL      string            `json:"discovery_url,omitempty"`
+	DockerVolumeSize  int               `json:"docker_volume_size,omitempty"`
+	Labels            map[string]string `json:"labels,omitempty"`
+	FlavorID          string            `json:"flavor_id,omitempty"`
+	MasterFlavorID    string            `json:"master_flavor_id,omitempty"`
+}
+
+// ToClusterV1CreateMap casts a ClusterV1CreateOpts struct to a map.
+func (opts ClusterV1CreateOpts) ToClusterV1CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ClusterV1Result is the result of a get request. Create and update
+// requests only return the uuid of the cluster.
+type ClusterV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ClusterV1Result as a ClusterV1.
+func (r ClusterV1Result) Extract() (*ClusterV1, error) {
+	var s *ClusterV1
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func containerInfraV1ClusterCreate(client *gophercloud.ServiceClient, opts ClusterV1CreateOpts) (r ClusterV1Result) {
+	b, err := opts.ToClusterV1CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("clusters"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func containerInfraV1ClusterGet(client *gophercloud.ServiceClient, clusterID string) (r ClusterV1Result) {
+	_, r.Err = client.Get(client.ServiceURL("clusters", clusterID), &r.Body, nil)
+	return
+}
+
+func containerInfraV1ClusterUpdate(client *gophercloud.ServiceClient, clusterID string, patches []ContainerInfraV1Patch) (r ClusterV1Result) {
+	b := containerInfraV1PatchBody(patches)
+	_, r.Err = client.Patch(client.ServiceURL("clusters", clusterID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func containerInfraV1ClusterDelete(client *gophercloud.ServiceClient, clusterID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("clusters", clusterID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// CertificateV1 is a certificate of a Container Infrastructure v1 cluster.
+type CertificateV1 struct {
+	ClusterUUID string `json:"cluster_uuid"`
+	PEM         string `json:"pem"`
+}
+
+// CertificateV1CreateOpts represents the attributes used when signing a
+// client certificate of a Container Infrastructure v1 cluster.
+type CertificateV1CreateOpts struct {
+	ClusterUUID string `json:"cluster_uuid" required:"true"`
+	CSR         string `json:"csr" required:"true"`
+}
+
+// ToCertificateV1CreateMap casts a CertificateV1CreateOpts struct to a map.
+func (opts CertificateV1CreateOpts) ToCertificateV1CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// CertificateV1Result is the result of a create or get request.
+type CertificateV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CertificateV1Result as a CertificateV1.
+func (r CertificateV1Result) Extract() (*CertificateV1, error) {
+	var s *CertificateV1
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// containerInfraV1CertificateGet retrieves the CA certificate of a cluster.
+func containerInfraV1CertificateGet(client *gophercloud.ServiceClient, clusterID string) (r CertificateV1Result) {
+	_, r.Err = client.Get(client.ServiceURL("certificates", clusterID), &r.Body, nil)
+	return
+}
+
+// containerInfraV1CertificateCreate signs a client certificate with the CA
+// of a cluster.
+func containerInfraV1CertificateCreate(client *gophercloud.ServiceClient, opts CertificateV1CreateOpts) (r CertificateV1Result) {
+	b, err := opts.ToCertificateV1CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("certificates"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
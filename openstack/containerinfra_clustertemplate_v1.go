@@ -0,0 +1,153 @@
+// This set of code handles cluster templates of the Container
+// Infrastructure Management (Magnum) v1 API. Magnum updates its objects
+// with JSON patch operations, which are shared by all Magnum resources.
+// Gophercloud does not support the Container Infrastructure API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ContainerInfraV1Patch is a single JSON patch operation on an attribute of
+// a Magnum object.
+type ContainerInfraV1Patch struct {
+	Op    string
+	Path  string
+	Value interface{}
+}
+
+// ToContainerInfraV1PatchMap assembles a request body based on
+// ContainerInfraV1Patch.
+func (p ContainerInfraV1Patch) ToContainerInfraV1PatchMap() map[string]interface{} {
+	m := map[string]interface{}{
+		"op":   p.Op,
+		"path": "/" + p.Path,
+	}
+	if p.Op != "remove" {
+		m["value"] = p.Value
+	}
+	return m
+}
+
+func containerInfraV1PatchBody(patches []ContainerInfraV1Patch) []map[string]interface{} {
+	b := make([]map[string]interface{}, len(patches))
+	for i, p := range patches {
+		b[i] = p.ToContainerInfraV1PatchMap()
+	}
+	return b
+}
+
+// ClusterTemplateV1 is a Container Infrastructure v1 cluster template.
+type ClusterTemplateV1 struct {
+	UUID                string            `json:"uuid"`
+	Name                string            `json:"name"`
+	COE                 string            `json:"coe"`
+	ImageID             string            `json:"image_id"`
+	FlavorID            string            `json:"flavor_id"`
+	MasterFlavorID      string            `json:"master_flavor_id"`
+	KeypairID           string            `json:"keypair_id"`
+	ExternalNetworkID   string            `json:"external_network_id"`
+	FixedNetwork        string            `json:"fixed_network"`
+	FixedSubnet         string            `json:"fixed_subnet"`
+	NetworkDriver       string            `json:"network_driver"`
+	VolumeDriver        string            `json:"volume_driver"`
+	DockerVolumeSize    int               `json:"docker_volume_size"`
+	DockerStorageDriver string            `json:"docker_storage_driver"`
+	DNSNameServer       string            `json:"dns_nameserver"`
+	HTTPProxy           string            `json:"http_proxy"`
+	HTTPSProxy          string            `json:"https_proxy"`
+	NoProxy             string            `json:"no_proxy"`
+	Labels              map[string]string `json:"labels"`
+	ServerType          string            `json:"server_type"`
+	APIServerPort       int               `json:"apiserver_port"`
+	ClusterDistro       string            `json:"cluster_distro"`
+	TLSDisabled         bool              `json:"tls_disabled"`
+	Public              bool              `json:"public"`
+	RegistryEnabled     bool              `json:"registry_enabled"`
+	MasterLBEnabled     bool              `json:"master_lb_enabled"`
+	FloatingIPEnabled   bool              `json:"floating_ip_enabled"`
+	ProjectID           string            `json:"project_id"`
+	UserID              string            `json:"user_id"`
+	CreatedAt           string            `json:"created_at"`
+	UpdatedAt           string            `json:"updated_at"`
+}
+
+// ClusterTemplateV1CreateOpts represents the attributes used when creating
+// a new Container Infrastructure v1 cluster template.
+type ClusterTemplateV1CreateOpts struct {
+	Name                string            `json:"name,omitempty"`
+	COE                 string            `json:"coe" required:"true"`
+	ImageID             string            `json:"image_id" required:"true"`
+	FlavorID            string            `json:"flavor_id,omitempty"`
+	MasterFlavorID      string            `json:"master_flavor_id,omitempty"`
+	KeypairID           string            `json:"keypair_id,omitempty"`
+	ExternalNetworkID   string            `json:"external_network_id,omitempty"`
+	FixedNetwork        string            `json:"fixed_network,omitempty"`
+	FixedSubnet         string            `json:"fixed_subnet,omitempty"`
+	NetworkDriver       string            `json:"network_driver,omitempty"`
+	VolumeDriver        string            `json:"volume_driver,omitempty"`
+	DockerVolumeSize    *int              `json:"docker_volume_size,omitempty"`
+	DockerStorageDriver string            `json:"docker_storage_driver,omitempty"`
+	DNSNameServer       string            `json:"dns_nameserver,omitempty"`
+	HTTPProxy           string            `json:"http_proxy,omitempty"`
+	HTTPSProxy          string            `json:"https_proxy,omitempty"`
+	NoProxy             string            `json:"no_proxy,omitempty"`
+	Labels              map[string]string `json:"labels,omitempty"`
+	ServerType          string            `json:"server_type,omitempty"`
+	APIServerPort       *int              `json:"apiserver_port,omitempty"`
+	TLSDisabled         bool              `json:"tls_disabled"`
+	Public              bool              `json:"public"`
+	RegistryEnabled     bool              `json:"registry_enabled"`
+	MasterLBEnabled     bool              `json:"master_lb_enabled"`
+	FloatingIPEnabled   bool              `json:"floating_ip_enabled"`
+}
+
+// ToClusterTemplateV1CreateMap casts a ClusterTemplateV1CreateOpts struct to
+// a map.
+func (opts ClusterTemplateV1CreateOpts) ToClusterTemplateV1CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ClusterTemplateV1Result is the result of a create, get or update request.
+type ClusterTemplateV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ClusterTemplateV1Result as a ClusterTemplateV1.
+func (r ClusterTemplateV1Result) Extract() (*ClusterTemplateV1, error) {
+	var s *ClusterTemplateV1
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func containerInfraV1ClusterTemplateCreate(client *gophercloud.ServiceClient, opts ClusterTemplateV1CreateOpts) (r ClusterTemplateV1Result) {
+	b, err := opts.ToClusterTemplateV1CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("clustertemplates"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func containerInfraV1ClusterTemplateGet(client *gophercloud.ServiceClient, templateID string) (r ClusterTemplateV1Result) {
+	_, r.Err = client.Get(client.ServiceURL("clustertemplates", templateID), &r.Body, nil)
+	return
+}
+
+func containerInfraV1ClusterTemplateUpdate(client *gophercloud.ServiceClient, templateID string, patches []ContainerInfraV1Patch) (r ClusterTemplateV1Result) {
+	b := containerInfraV1PatchBody(patches)
+	_, r.Err = client.Patch(client.ServiceURL("clustertemplates", templateID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func containerInfraV1ClusterTemplateDelete(client *gophercloud.ServiceClient, templateID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("clustertemplates", templateID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,106 @@
+// This set of code handles nodegroups of clusters of the Container
+// Infrastructure Management (Magnum) v1 API. Nodegroups were added in
+// microversion 1.9, which every request has to ask for.
+// Gophercloud does not support the Container Infrastructure API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// containerInfraV1NodeGroupHeaders are the headers of requests to the
+// nodegroups API.
+var containerInfraV1NodeGroupHeaders = map[string]string{
+	"OpenStack-API-Version": "container-infra 1.9",
+}
+
+// NodeGroupV1 is a nodegroup of a Container Infrastructure v1 cluster.
+type NodeGroupV1 struct {
+	UUID             string            `json:"uuid"`
+	Name             string            `json:"name"`
+	ClusterID        string            `json:"cluster_id"`
+	Role             string            `json:"role"`
+	FlavorID         string            `json:"flavor_id"`
+	ImageID          string            `json:"image_id"`
+	DockerVolumeSize int               `json:"docker_volume_size"`
+	Labels           map[string]string `json:"labels"`
+	NodeCount        int               `json:"node_count"`
+	MinNodeCount     int               `json:"min_node_count"`
+	MaxNodeCount     int               `json:"max_node_count"`
+	NodeAddresses    []string          `json:"node_addresses"`
+	IsDefault        bool              `json:"is_default"`
+	Status           string            `json:"status"`
+	StatusReason     string            `json:"status_reason"`
+	ProjectID        string            `json:"project_id"`
+	CreatedAt        string            `json:"created_at"`
+	UpdatedAt        string            `json:"updated_at"`
+}
+
+// NodeGroupV1CreateOpts represents the attributes used when creating a new
+// nodegroup of a Container Infrastructure v1 cluster.
+type NodeGroupV1CreateOpts struct {
+	Name             string            `json:"name" required:"true"`
+	Role             string            `json:"role,omitempty"`
+	FlavorID         string            `json:"flavor_id,omitempty"`
+	ImageID          string            `json:"image_id,omitempty"`
+	DockerVolumeSize int               `json:"docker_volume_size,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	NodeCount        int               `json:"node_count,omitempty"`
+	MinNodeCount     int               `json:"min_node_count,omitempty"`
+	MaxNodeCount     int               `json:"max_node_count,omitempty"`
+}
+
+// ToNodeGroupV1CreateMap casts a NodeGroupV1CreateOpts struct to a map.
+func (opts NodeGroupV1CreateOpts) ToNodeGroupV1CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// NodeGroupV1Result is the result of a create, get or update request.
+type NodeGroupV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a NodeGroupV1Result as a NodeGroupV1.
+func (r NodeGroupV1Result) Extract() (*NodeGroupV1, error) {
+	var s *NodeGroupV1
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func containerInfraV1NodeGroupCreate(client *gophercloud.ServiceClient, clusterID string, opts NodeGroupV1CreateOpts) (r NodeGroupV1Result) {
+	b, err := opts.ToNodeGroupV1CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("clusters", clusterID, "nodegroups"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes:     []int{202},
+		MoreHeaders: containerInfraV1NodeGroupHeaders,
+	})
+	return
+}
+
+func containerInfraV1NodeGroupGet(client *gophercloud.ServiceClient, clusterID, nodeGroupID string) (r NodeGroupV1Result) {
+	_, r.Err = client.Get(client.ServiceURL("clusters", clusterID, "nodegroups", nodeGroupID), &r.Body, &gophercloud.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: containerInfraV1NodeGroupHeaders,
+	})
+	return
+}
+
+func containerInfraV1NodeGroupUpdate(client *gophercloud.ServiceClient, clusterID, nodeGroupID string, patches []ContainerInfraV1Patch) (r NodeGroupV1Result) {
+	b := containerInfraV1PatchBody(patches)
+	_, r.Err = client.Patch(client.ServiceURL("clusters", clusterID, "nodegroups", nodeGroupID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes:     []int{202},
+		MoreHeaders: containerInfraV1NodeGroupHeaders,
+	})
+	return
+}
+
+func containerInfraV1NodeGroupDelete(client *gophercloud.ServiceClient, clusterID, nodeGroupID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("clusters", clusterID, "nodegroups", nodeGroupID), &gophercloud.RequestOpts{
+		OkCodes:     []int{204},
+		MoreHeaders: containerInfraV1NodeGroupHeaders,
+	})
+	return
+}
@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceBlockStorageSnapshotV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockStorageSnapshotV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"most_recent": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageSnapshotV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	listOpts := SnapshotV3ListOpts{
+		Name:     d.Get("name").(string),
+		Status:   d.Get("status").(string),
+		VolumeID: d.Get("volume_id").(string),
+	}
+
+	pages, err := blockStorageV3SnapshotList(blockStorageClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve snapshots: %s", err)
+	}
+
+	allSnapshots, err := blockStorageV3ExtractSnapshots(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract snapshots: %s", err)
+	}
+
+	if len(allSnapshots) < 1 {
+		return fmt.Errorf("No snapshot found matching %+v", listOpts)
+	}
+
+	var snapshot SnapshotV3
+	if len(allSnapshots) > 1 {
+		recent := d.Get("most_recent").(bool)
+		log.Printf("[DEBUG] Multiple snapshots found and `most_recent` is set to: %t", recent)
+		if !recent {
+			return fmt.Errorf("More than one snapshot found matching %+v. Please try a more "+
+				"specific search criteria, or set `most_recent` attribute to true.", listOpts)
+		}
+		snapshot = mostRecentSnapshotV3(allSnapshots)
+	} else {
+		snapshot = allSnapshots[0]
+	}
+
+	log.Printf("[DEBUG] Retrieved snapshot %s: %+v", snapshot.ID, snapshot)
+	d.SetId(snapshot.ID)
+
+	d.Set("name", snapshot.Name)
+	d.Set("status", snapshot.Status)
+	d.Set("volume_id", snapshot.VolumeID)
+	d.Set("description", snapshot.Description)
+	d.Set("size", snapshot.Size)
+	d.Set("metadata", snapshot.Metadata)
+	d.Set("created_at", snapshot.CreatedAt.Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+type snapshotV3Sort []SnapshotV3
+
+func (a snapshotV3Sort) Len() int      { return len(a) }
+func (a snapshotV3Sort) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a snapshotV3Sort) Less(i, j int) bool {
+	return a[i].CreatedAt.Before(a[j].CreatedAt)
+}
+
+// Returns the most recent snapshot out of a slice of snapshots.
+func mostRecentSnapshotV3(snapshots []SnapshotV3) SnapshotV3 {
+	sortedSnapshots := snapshots
+	sort.Sort(snapshotV3Sort(sortedSnapshots))
+	return sortedSnapshots[len(sortedSnapshots)-1]
+}
@@ -0,0 +1,98 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackBlockStorageV3SnapshotDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackBlockStorageV3SnapshotDataSource_snapshots,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackBlockStorageV3SnapshotDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotDataSourceID("data.openstack_blockstorage_snapshot_v3.snapshot_1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_blockstorage_snapshot_v3.snapshot_1", "id",
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_snapshot_v3.snapshot_1", "name", "snapshot_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_snapshot_v3.snapshot_1", "size", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_snapshot_v3.snapshot_1", "status", "available"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOpenStackBlockStorageV3SnapshotDataSource_mostRecent,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotDataSourceID("data.openstack_blockstorage_snapshot_v3.snapshot_1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_blockstorage_snapshot_v3.snapshot_1", "id",
+						"openstack_blockstorage_snapshot_v3.snapshot_2", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_snapshot_v3.snapshot_1", "name", "snapshot_2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3SnapshotDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find snapshot data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Snapshot data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+// snapshot_2 depends on snapshot_1, so it is always the most recent one.
+const testAccOpenStackBlockStorageV3SnapshotDataSource_snapshots = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "snapshot_1"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_2" {
+  name = "snapshot_2"
+  description = "${openstack_blockstorage_snapshot_v3.snapshot_1.id}"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+`
+
+var testAccOpenStackBlockStorageV3SnapshotDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "${openstack_blockstorage_snapshot_v3.snapshot_1.name}"
+}
+`, testAccOpenStackBlockStorageV3SnapshotDataSource_snapshots)
+
+var testAccOpenStackBlockStorageV3SnapshotDataSource_mostRecent = fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  most_recent = true
+}
+`, testAccOpenStackBlockStorageV3SnapshotDataSource_snapshots)
@@ -0,0 +1,152 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceBlockStorageVolumeV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceBlockStorageVolumeV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"volume_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"multiattach": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"attachment": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBlockStorageVolumeV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	listOpts := VolumeV3ListOpts{
+		Name:   d.Get("name").(string),
+		Status: d.Get("status").(string),
+	}
+
+	pages, err := blockStorageV3VolumeList(blockStorageClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve volumes: %s", err)
+	}
+
+	allVolumes, err := blockStorageV3ExtractVolumes(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract volumes: %s", err)
+	}
+
+	// Block Storage can't filter volumes by metadata, so they are filtered
+	// here.
+	metadata := resourceVolumeMetadataV2(d)
+	var volumes []VolumeV3
+	for _, v := range allVolumes {
+		if dataSourceBlockStorageVolumeV3MetadataMatches(v.Metadata, metadata) {
+			volumes = append(volumes, v)
+		}
+	}
+
+	if len(volumes) < 1 {
+		return fmt.Errorf("No volume found matching %+v and metadata %+v", listOpts, metadata)
+	}
+
+	if len(volumes) > 1 {
+		return fmt.Errorf("More than one volume found matching %+v and metadata %+v", listOpts, metadata)
+	}
+
+	v := volumes[0]
+
+	log.Printf("[DEBUG] Retrieved volume %s: %+v", v.ID, v)
+	d.SetId(v.ID)
+
+	d.Set("name", v.Name)
+	d.Set("status", v.Status)
+	d.Set("metadata", v.Metadata)
+	d.Set("size", v.Size)
+	d.Set("volume_type", v.VolumeType)
+	d.Set("availability_zone", v.AvailabilityZone)
+	d.Set("multiattach", v.Multiattach)
+	d.Set("region", GetRegion(d, config))
+
+	attachments := make([]map[string]interface{}, len(v.Attachments))
+	for i, attachment := range v.Attachments {
+		attachments[i] = map[string]interface{}{
+			"id":          attachment.AttachmentID,
+			"instance_id": attachment.ServerID,
+			"device":      attachment.Device,
+		}
+	}
+	d.Set("attachment", attachments)
+
+	return nil
+}
+
+// dataSourceBlockStorageVolumeV3MetadataMatches reports whether the metadata
+// of a volume contains all of the wanted key/value pairs.
+func dataSourceBlockStorageVolumeV3MetadataMatches(metadata, wanted map[string]string) bool {
+	for k, v := range wanted {
+		if metadata[k] != v {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,93 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackBlockStorageV3VolumeDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackBlockStorageV3VolumeDataSource_volume,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackBlockStorageV3VolumeDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeDataSourceID("data.openstack_blockstorage_volume_v3.volume_1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_blockstorage_volume_v3.volume_1", "id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_volume_v3.volume_1", "name", "volume_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_volume_v3.volume_1", "size", "1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_volume_v3.volume_1", "status", "available"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_volume_v3.volume_1", "attachment.#", "0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOpenStackBlockStorageV3VolumeDataSource_metadata,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeDataSourceID("data.openstack_blockstorage_volume_v3.volume_1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_blockstorage_volume_v3.volume_1", "id",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_blockstorage_volume_v3.volume_1", "metadata.role", "data"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeDataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find volume data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Volume data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackBlockStorageV3VolumeDataSource_volume = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+  metadata {
+    role = "data"
+  }
+}
+`
+
+var testAccOpenStackBlockStorageV3VolumeDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "${openstack_blockstorage_volume_v3.volume_1.name}"
+}
+`, testAccOpenStackBlockStorageV3VolumeDataSource_volume)
+
+var testAccOpenStackBlockStorageV3VolumeDataSource_metadata = fmt.Sprintf(`
+%s
+
+data "openstack_blockstorage_volume_v3" "volume_1" {
+  status = "available"
+  metadata {
+    role = "${openstack_blockstorage_volume_v3.volume_1.metadata.role}"
+  }
+}
+`, testAccOpenStackBlockStorageV3VolumeDataSource_volume)
@@ -0,0 +1,75 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeAvailabilityZonesV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeAvailabilityZonesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "available",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "available" && value != "unavailable" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'available' and 'unavailable' are supported values for 'state'"))
+					}
+					return
+				},
+			},
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceComputeAvailabilityZonesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	zones, err := computeV2AvailabilityZoneList(computeClient).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve availability zones: %s", err)
+	}
+
+	available := d.Get("state").(string) == "available"
+	names := []string{}
+	for _, zone := range zones {
+		if zone.ZoneState.Available == available {
+			names = append(names, zone.ZoneName)
+		}
+	}
+	sort.Strings(names)
+
+	log.Printf("[DEBUG] Retrieved availability zones: %v", names)
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(names, ","))))
+
+	d.Set("names", names)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
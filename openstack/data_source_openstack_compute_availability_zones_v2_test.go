@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOpenStackComputeAvailabilityZonesV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeAvailabilityZonesV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.openstack_compute_availability_zones_v2.zones", "names.#", regexp.MustCompile("[1-9]\\d*")),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackComputeAvailabilityZonesV2DataSource_basic = `
+data "openstack_compute_availability_zones_v2" "zones" {}
+`
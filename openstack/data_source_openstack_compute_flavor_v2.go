@@ -0,0 +1,211 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeFlavorV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeFlavorV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"min_vcpus": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"min_ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"min_disk": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"extra_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"vcpus": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"disk": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"swap": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"ephemeral": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rx_tx_factor": &schema.Schema{
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"is_public": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeFlavorV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	flavorID := d.Get("flavor_id").(string)
+
+	// Search for the smallest flavor matching the filters if no ID was
+	// given.
+	if flavorID == "" {
+		listOpts := flavors.ListOpts{
+			MinDisk: d.Get("min_disk").(int),
+			MinRAM:  d.Get("min_ram").(int),
+		}
+
+		log.Printf("[DEBUG] openstack_compute_flavor_v2 list options: %#v", listOpts)
+
+		pages, err := flavors.ListDetail(computeClient, listOpts).AllPages()
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve flavors: %s", err)
+		}
+
+		allFlavors, err := flavors.ExtractFlavors(pages)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve flavors: %s", err)
+		}
+
+		name := d.Get("name").(string)
+		minVCPUs := d.Get("min_vcpus").(int)
+		extraSpecs := d.Get("extra_specs").(map[string]interface{})
+
+		var matches []flavors.Flavor
+		for _, flavor := range allFlavors {
+			if name != "" && flavor.Name != name {
+				continue
+			}
+
+			if flavor.VCPUs < minVCPUs {
+				continue
+			}
+
+			if len(extraSpecs) > 0 {
+				specs, err := computeV2FlavorExtraSpecsList(computeClient, flavor.ID).Extract()
+				if err != nil {
+					return fmt.Errorf("Unable to retrieve extra specs of flavor %s: %s", flavor.ID, err)
+				}
+
+				if !dataSourceComputeFlavorV2ExtraSpecsMatch(specs, extraSpecs) {
+					continue
+				}
+			}
+
+			matches = append(matches, flavor)
+		}
+
+		if len(matches) < 1 {
+			return fmt.Errorf("Your query returned no results. " +
+				"Please change your search criteria and try again.")
+		}
+
+		sort.Sort(flavorSort(matches))
+		flavorID = matches[0].ID
+	}
+
+	r := flavors.Get(computeClient, flavorID)
+	flavor, err := r.Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve flavor %s: %s", flavorID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved Flavor %s: %+v", flavor.ID, flavor)
+	d.SetId(flavor.ID)
+
+	d.Set("flavor_id", flavor.ID)
+	d.Set("name", flavor.Name)
+	d.Set("vcpus", flavor.VCPUs)
+	d.Set("ram", flavor.RAM)
+	d.Set("disk", flavor.Disk)
+	d.Set("swap", flavor.Swap)
+	d.Set("rx_tx_factor", flavor.RxTxFactor)
+	d.Set("is_public", flavor.IsPublic)
+
+	ephemeral, err := resourceFlavorEphemeralV2(r)
+	if err != nil {
+		return err
+	}
+	d.Set("ephemeral", ephemeral)
+
+	extraSpecs, err := computeV2FlavorExtraSpecsList(computeClient, flavor.ID).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve extra specs of flavor %s: %s", flavor.ID, err)
+	}
+	d.Set("extra_specs", extraSpecs)
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// dataSourceComputeFlavorV2ExtraSpecsMatch returns true if all of the given
+// filter key/value pairs are present in the extra specs of a flavor.
+func dataSourceComputeFlavorV2ExtraSpecsMatch(extraSpecs map[string]string, filter map[string]interface{}) bool {
+	for k, v := range filter {
+		if value, ok := extraSpecs[k]; !ok || value != v.(string) {
+			return false
+		}
+	}
+	return true
+}
+
+// flavorSort orders flavors from smallest to largest by vCPUs, RAM and disk.
+type flavorSort []flavors.Flavor
+
+func (a flavorSort) Len() int      { return len(a) }
+func (a flavorSort) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a flavorSort) Less(i, j int) bool {
+	if a[i].VCPUs != a[j].VCPUs {
+		return a[i].VCPUs < a[j].VCPUs
+	}
+	if a[i].RAM != a[j].RAM {
+		return a[i].RAM < a[j].RAM
+	}
+	if a[i].Disk != a[j].Disk {
+		return a[i].Disk < a[j].Disk
+	}
+	return a[i].Name < a[j].Name
+}
@@ -0,0 +1,94 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOpenStackComputeFlavorV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeFlavorV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_flavor_v2.flavor_1", "flavor_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_flavor_v2.flavor_1", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenStackComputeFlavorV2DataSource_extraSpecs(t *testing.T) {
+	var flavorName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeFlavorV2DataSource_flavor(flavorName),
+			},
+			resource.TestStep{
+				Config: testAccOpenStackComputeFlavorV2DataSource_extraSpecs(flavorName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_flavor_v2.flavor_1", "flavor_id",
+						"openstack_compute_flavor_v2.flavor_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavor_v2.flavor_1", "name", flavorName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavor_v2.flavor_1", "vcpus", "2"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_flavor_v2.flavor_1", "extra_specs.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackComputeFlavorV2DataSource_basic = `
+data "openstack_compute_flavor_v2" "flavor_1" {
+  min_vcpus = 1
+  min_ram = 512
+}
+`
+
+func testAccOpenStackComputeFlavorV2DataSource_flavor(flavorName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_compute_flavor_v2" "flavor_1" {
+      name = "%s"
+      ram = 512
+      vcpus = 2
+      disk = 5
+
+      extra_specs {
+        "tf-acc:flavor" = "%s"
+      }
+    }
+    `, flavorName, flavorName)
+}
+
+func testAccOpenStackComputeFlavorV2DataSource_extraSpecs(flavorName string) string {
+	return fmt.Sprintf(`
+    %s
+
+    data "openstack_compute_flavor_v2" "flavor_1" {
+      min_vcpus = 2
+
+      extra_specs {
+        "tf-acc:flavor" = "%s"
+      }
+    }
+    `, testAccOpenStackComputeFlavorV2DataSource_flavor(flavorName), flavorName)
+}
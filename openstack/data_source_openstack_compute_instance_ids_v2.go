@@ -0,0 +1,134 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeInstanceIdsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeInstanceIdsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name_regex": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tags_any": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstanceIdsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	tags := dataSourceComputeInstanceIdsV2Tags(d.Get("tags").(*schema.Set))
+	tagsAny := dataSourceComputeInstanceIdsV2Tags(d.Get("tags_any").(*schema.Set))
+
+	listOpts := InstanceListOpts{
+		Name:    d.Get("name_regex").(string),
+		Status:  d.Get("status").(string),
+		Tags:    strings.Join(tags, ","),
+		TagsAny: strings.Join(tagsAny, ","),
+	}
+
+	// Filtering by tags requires a newer Compute microversion.
+	listClient := *computeClient
+	if len(tags) > 0 || len(tagsAny) > 0 {
+		listClient.Microversion = computeV2InstanceTagsMicroversion
+	}
+
+	log.Printf("[DEBUG] openstack_compute_instance_ids_v2 list options: %#v", listOpts)
+
+	pages, err := servers.List(&listClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve instances: %s", err)
+	}
+
+	allServers, err := servers.ExtractServers(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve instances: %s", err)
+	}
+
+	// The Compute API can't filter by metadata, so do it here.
+	metadata := d.Get("metadata").(map[string]interface{})
+	ids := []string{}
+	for _, server := range allServers {
+		if computeInstanceMetadataMatches(server.Metadata, metadata) {
+			ids = append(ids, server.ID)
+		}
+	}
+	sort.Strings(ids)
+
+	log.Printf("[DEBUG] Retrieved instance IDs: %v", ids)
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+
+	d.Set("ids", ids)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// dataSourceComputeInstanceIdsV2Tags returns the given tags as a sorted
+// list of strings.
+func dataSourceComputeInstanceIdsV2Tags(v *schema.Set) []string {
+	tags := []string{}
+	for _, raw := range v.List() {
+		tags = append(tags, raw.(string))
+	}
+	sort.Strings(tags)
+	return tags
+}
+
+// computeInstanceMetadataMatches returns true if all of the given filter
+// key/value pairs are present in the metadata of an instance.
+func computeInstanceMetadataMatches(metadata map[string]string, filter map[string]interface{}) bool {
+	for k, v := range filter {
+		if value, ok := metadata[k]; !ok || value != v.(string) {
+			return false
+		}
+	}
+	return true
+}
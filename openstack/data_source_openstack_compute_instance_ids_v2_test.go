@@ -0,0 +1,67 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOpenStackComputeInstanceIdsV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeInstanceIdsV2DataSource_instances,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackComputeInstanceIdsV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_ids_v2.tags", "ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_ids_v2.metadata", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_instance_ids_v2.metadata", "ids.0",
+						"openstack_compute_instance_v2.instance_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackComputeInstanceIdsV2DataSource_instances = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  tags = ["tf-acc-ids", "web"]
+  metadata {
+    role = "frontend"
+  }
+}
+
+resource "openstack_compute_instance_v2" "instance_2" {
+  name = "instance_2"
+  security_groups = ["default"]
+  tags = ["tf-acc-ids", "db"]
+  metadata {
+    role = "backend"
+  }
+}
+`
+
+var testAccOpenStackComputeInstanceIdsV2DataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_compute_instance_ids_v2" "tags" {
+  tags = ["tf-acc-ids"]
+}
+
+data "openstack_compute_instance_ids_v2" "metadata" {
+  tags = ["tf-acc-ids"]
+  metadata {
+    role = "frontend"
+  }
+}
+`, testAccOpenStackComputeInstanceIdsV2DataSource_instances)
@@ -0,0 +1,88 @@
+package openstack
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeInstancePasswordV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeInstancePasswordV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"private_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"encrypted_password": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"password": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstancePasswordV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	r := servers.GetPassword(computeClient, instanceId)
+
+	encryptedPassword, err := r.ExtractPassword(nil)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve password of instance %s: %s", instanceId, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved encrypted password of instance %s", instanceId)
+	d.SetId(instanceId)
+
+	d.Set("encrypted_password", encryptedPassword)
+	d.Set("region", GetRegion(d, config))
+
+	if v, ok := d.GetOk("private_key"); ok && encryptedPassword != "" {
+		block, _ := pem.Decode([]byte(v.(string)))
+		if block == nil {
+			return fmt.Errorf("Unable to decode private_key: no PEM data found")
+		}
+
+		privateKey, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return fmt.Errorf("Unable to parse private_key: %s", err)
+		}
+
+		password, err := r.ExtractPassword(privateKey)
+		if err != nil {
+			return fmt.Errorf("Unable to decrypt password of instance %s: %s", instanceId, err)
+		}
+
+		d.Set("password", password)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+// Linux guests don't store a password, so this only checks that the
+// password can be retrieved.
+func TestAccOpenStackComputeInstancePasswordV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeInstancePasswordV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_instance_password_v2.password", "id",
+						"openstack_compute_instance_v2.instance_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_password_v2.password", "encrypted_password", ""),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackComputeInstancePasswordV2DataSource_basic = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+
+data "openstack_compute_instance_password_v2" "password" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+}
+`
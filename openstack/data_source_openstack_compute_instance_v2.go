@@ -0,0 +1,236 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/availabilityzones"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/flavors"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/images"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceComputeInstanceV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceComputeInstanceV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"image_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"flavor_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"key_pair": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"security_groups": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"network": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fixed_ip_v4": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fixed_ip_v6": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"mac": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"access_ip_v4": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"access_ip_v6": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"power_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+	if instanceID == "" && name == "" {
+		return fmt.Errorf("One of instance_id or name must be set")
+	}
+
+	// Look up the ID by name if no ID was given. The name filter of the
+	// Compute API is a regular expression, so anchor it to match exactly.
+	if instanceID == "" {
+		listOpts := servers.ListOpts{
+			Name: fmt.Sprintf("^%s$", regexp.QuoteMeta(name)),
+		}
+
+		pages, err := servers.List(computeClient, listOpts).AllPages()
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve instances: %s", err)
+		}
+
+		allServers, err := servers.ExtractServers(pages)
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve instances: %s", err)
+		}
+
+		if len(allServers) < 1 {
+			return fmt.Errorf("No Instance found with name: %s", name)
+		}
+
+		if len(allServers) > 1 {
+			return fmt.Errorf("More than one Instance found with name: %s", name)
+		}
+
+		instanceID = allServers[0].ID
+	}
+
+	// Build a custom struct for the availability zone extension
+	var server struct {
+		servers.Server
+		availabilityzones.ServerExt
+	}
+
+	err = servers.Get(computeClient, instanceID).ExtractInto(&server)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve instance %s: %s", instanceID, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved Instance %s: %+v", server.ID, server)
+	d.SetId(server.ID)
+
+	d.Set("instance_id", server.ID)
+	d.Set("name", server.Name)
+	d.Set("key_pair", server.KeyName)
+	d.Set("metadata", server.Metadata)
+	d.Set("availability_zone", server.AvailabilityZone)
+	d.Set("access_ip_v4", server.AccessIPv4)
+	d.Set("access_ip_v6", server.AccessIPv6)
+	d.Set("power_state", flattenInstancePowerState(server.Status))
+
+	networks := []map[string]interface{}{}
+	for _, instanceAddresses := range getInstanceAddresses(server.Addresses) {
+		for _, instanceNIC := range instanceAddresses.InstanceNICs {
+			networks = append(networks, map[string]interface{}{
+				"name":        instanceAddresses.NetworkName,
+				"fixed_ip_v4": instanceNIC.FixedIPv4,
+				"fixed_ip_v6": instanceNIC.FixedIPv6,
+				"mac":         instanceNIC.MAC,
+			})
+		}
+	}
+	d.Set("network", networks)
+
+	secGrpNames := []string{}
+	for _, sg := range server.SecurityGroups {
+		secGrpNames = append(secGrpNames, sg["name"].(string))
+	}
+	d.Set("security_groups", secGrpNames)
+
+	if flavorId, ok := server.Flavor["id"].(string); ok {
+		d.Set("flavor_id", flavorId)
+
+		flavor, err := flavors.Get(computeClient, flavorId).Extract()
+		if err != nil {
+			return err
+		}
+		d.Set("flavor_name", flavor.Name)
+	}
+
+	// Instances booted from volumes don't have an image.
+	if imageId, ok := server.Image["id"].(string); ok {
+		d.Set("image_id", imageId)
+
+		image, err := images.Get(computeClient, imageId).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); !ok {
+				return err
+			}
+		} else {
+			d.Set("image_name", image.Name)
+		}
+	}
+
+	// Tags require a newer Compute microversion which not all clouds
+	// support, so only warn if they can't be retrieved.
+	tags, err := computeV2InstanceTagsList(computeClient, server.ID).Extract()
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve tags of OpenStack server (%s): %s", server.ID, err)
+	} else {
+		d.Set("tags", tags)
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
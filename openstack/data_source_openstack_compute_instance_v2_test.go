@@ -0,0 +1,98 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackComputeInstanceV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeInstanceV2DataSource_instance,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackComputeInstanceV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceV2DataSourceID("data.openstack_compute_instance_v2.instance"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_v2.instance", "name", "instance_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_v2.instance", "metadata.foo", "bar"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_instance_v2.instance", "flavor_id",
+						"openstack_compute_instance_v2.instance_1", "flavor_id"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_instance_v2.instance", "network.0.fixed_ip_v4",
+						"openstack_compute_instance_v2.instance_1", "network.0.fixed_ip_v4"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenStackComputeInstanceV2DataSource_instanceID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeInstanceV2DataSource_instance,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackComputeInstanceV2DataSource_instanceID,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceV2DataSourceID("data.openstack_compute_instance_v2.instance"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_instance_v2.instance", "name", "instance_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeInstanceV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find instance data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Instance data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackComputeInstanceV2DataSource_instance = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  metadata {
+    foo = "bar"
+  }
+}
+`
+
+var testAccOpenStackComputeInstanceV2DataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_compute_instance_v2" "instance" {
+  name = "${openstack_compute_instance_v2.instance_1.name}"
+}
+`, testAccOpenStackComputeInstanceV2DataSource_instance)
+
+var testAccOpenStackComputeInstanceV2DataSource_instanceID = fmt.Sprintf(`
+%s
+
+data "openstack_compute_instance_v2" "instance" {
+  instance_id = "${openstack_compute_instance_v2.instance_1.id}"
+}
+`, testAccOpenStackComputeInstanceV2DataSource_instance)
@@ -0,0 +1,64 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackComputeKeypairV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackComputeKeypairV2DataSource_keypair,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackComputeKeypairV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeKeypairV2DataSourceID("data.openstack_compute_keypair_v2.kp"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_compute_keypair_v2.kp", "name", "kp_1"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_compute_keypair_v2.kp", "fingerprint"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_compute_keypair_v2.kp", "public_key",
+						"openstack_compute_keypair_v2.kp_1", "public_key"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeKeypairV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find keypair data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Keypair data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackComputeKeypairV2DataSource_keypair = `
+resource "openstack_compute_keypair_v2" "kp_1" {
+  name = "kp_1"
+  public_key = "ssh-rsa AAAAB3NzaC1yc2EAAAADAQABAAABAQDAjpC1hwiOCCmKEWxJ4qzTTsJbKzndLo1BCz5PcwtUnflmU+gHJtWMZKpuEGVi29h0A/+ydKek1O18k10Ff+4tyFjiHDQAT9+OfgWf7+b1yK+qDip3X1C0UPMbwHlTfSGWLGZquwhvEFx9k3h/M+VtMvwR1lJ9LUyTAImnNjWG7TAIPmui30HvM2UiFEmqkr4ijq45MyX2+fLIePLRIFuu1p4whjHAQYufqyno3BS48icQb4p6iVEZPo4AE2o9oIyQvj2mx4dk5Y8CgSETOZTYDOR3rU2fZTRDRgPJDH9FWvQjF5tA0p3d9CoWWd2s6GKKbfoUIi8R/Db1BSPJwkqB jrp-hp-pc"
+}
+`
+
+var testAccOpenStackComputeKeypairV2DataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_compute_keypair_v2" "kp" {
+  name = "${openstack_compute_keypair_v2.kp_1.name}"
+}
+`, testAccOpenStackComputeKeypairV2DataSource_keypair)
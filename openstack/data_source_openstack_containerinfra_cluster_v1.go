@@ -0,0 +1,173 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceContainerInfraClusterV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceContainerInfraClusterV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"cluster_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"cluster_id"},
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status_reason": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"cluster_template_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"keypair": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"master_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"node_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"master_flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"stack_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"api_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"coe_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"master_addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"node_addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceContainerInfraClusterV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	// Magnum looks up clusters by either their ID or their name. A name
+	// which isn't unique is rejected.
+	ident := d.Get("cluster_id").(string)
+	if ident == "" {
+		ident = d.Get("name").(string)
+	}
+
+	if ident == "" {
+		return fmt.Errorf("One of cluster_id or name must be set")
+	}
+
+	cluster, err := containerInfraV1ClusterGet(containerInfraClient, ident).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve cluster %s: %s", ident, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved cluster %s: %+v", cluster.UUID, cluster)
+	d.SetId(cluster.UUID)
+
+	d.Set("cluster_id", cluster.UUID)
+	d.Set("name", cluster.Name)
+	d.Set("status", cluster.Status)
+	d.Set("status_reason", cluster.StatusReason)
+	d.Set("cluster_template_id", cluster.ClusterTemplateID)
+	d.Set("keypair", cluster.Keypair)
+	d.Set("master_count", cluster.MasterCount)
+	d.Set("node_count", cluster.NodeCount)
+	d.Set("flavor_id", cluster.FlavorID)
+	d.Set("master_flavor_id", cluster.MasterFlavorID)
+	d.Set("labels", cluster.Labels)
+	d.Set("stack_id", cluster.StackID)
+	d.Set("api_address", cluster.APIAddress)
+	d.Set("coe_version", cluster.COEVersion)
+	d.Set("master_addresses", cluster.MasterAddresses)
+	d.Set("node_addresses", cluster.NodeAddresses)
+	d.Set("project_id", cluster.ProjectID)
+	d.Set("created_at", cluster.CreatedAt)
+	d.Set("updated_at", cluster.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
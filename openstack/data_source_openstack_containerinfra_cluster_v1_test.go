@@ -0,0 +1,44 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContainerInfraClusterV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerInfraClusterV1DataSource_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_containerinfra_cluster_v1.cluster_1", "id",
+						"openstack_containerinfra_cluster_v1.cluster_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_containerinfra_cluster_v1.cluster_1", "status", "CREATE_COMPLETE"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_containerinfra_cluster_v1.cluster_1", "api_address"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_containerinfra_cluster_v1.cluster_1", "node_addresses.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccContainerInfraClusterV1DataSource_basic() string {
+	return fmt.Sprintf(`
+%s
+
+data "openstack_containerinfra_cluster_v1" "cluster_1" {
+  name = "${openstack_containerinfra_cluster_v1.cluster_1.name}"
+}
+`, testAccContainerInfraClusterV1_basic(1))
+}
@@ -0,0 +1,81 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceIdentityGroupV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityGroupV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"domain_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityGroupV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	listOpts := IdentityGroupV3ListOpts{
+		DomainID: d.Get("domain_id").(string),
+		Name:     d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_identity_group_v3 list options: %#v", listOpts)
+
+	allGroups, err := identityV3GroupList(identityClient, listOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve groups: %s", err)
+	}
+
+	if len(allGroups) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allGroups) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", allGroups)
+		return fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	group := allGroups[0]
+
+	log.Printf("[DEBUG] Retrieved OpenStack group %s: %#v", group.ID, group)
+	d.SetId(group.ID)
+
+	d.Set("description", group.Description)
+	d.Set("domain_id", group.DomainID)
+	d.Set("name", group.Name)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceIdentityProjectIdsV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityProjectIdsV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"name_regex": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateRegexp,
+			},
+
+			"not_tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"not_tags_any": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"parent_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"tags_any": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityProjectIdsV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	listOpts := IdentityProjectV3ListOpts{
+		DomainID:   d.Get("domain_id").(string),
+		ParentID:   d.Get("parent_id").(string),
+		Tags:       dataSourceIdentityProjectIdsV3Tags(d.Get("tags").(*schema.Set)),
+		TagsAny:    dataSourceIdentityProjectIdsV3Tags(d.Get("tags_any").(*schema.Set)),
+		NotTags:    dataSourceIdentityProjectIdsV3Tags(d.Get("not_tags").(*schema.Set)),
+		NotTagsAny: dataSourceIdentityProjectIdsV3Tags(d.Get("not_tags_any").(*schema.Set)),
+	}
+
+	log.Printf("[DEBUG] openstack_identity_project_ids_v3 list options: %#v", listOpts)
+
+	allProjects, err := identityV3ProjectList(identityClient, listOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve projects: %s", err)
+	}
+
+	// The Identity service can only filter projects by their exact name,
+	// so the name regex is applied here.
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	ids := []string{}
+	for _, project := range allProjects {
+		if nameRegex != nil && !nameRegex.MatchString(project.Name) {
+			continue
+		}
+		ids = append(ids, project.ID)
+	}
+	sort.Strings(ids)
+
+	log.Printf("[DEBUG] Retrieved project IDs: %v", ids)
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+
+	d.Set("ids", ids)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// dataSourceIdentityProjectIdsV3Tags returns the given tags as a sorted,
+// comma-separated list.
+func dataSourceIdentityProjectIdsV3Tags(v *schema.Set) string {
+	tags := []string{}
+	for _, raw := range v.List() {
+		tags = append(tags, raw.(string))
+	}
+	sort.Strings(tags)
+	return strings.Join(tags, ",")
+}
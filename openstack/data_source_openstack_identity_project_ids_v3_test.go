@@ -0,0 +1,67 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOpenStackIdentityProjectIdsV3DataSource_basic(t *testing.T) {
+	tag := fmt.Sprintf("tf-acc-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackIdentityProjectIdsV3DataSource_projects(tag),
+			},
+			resource.TestStep{
+				Config: testAccOpenStackIdentityProjectIdsV3DataSource_basic(tag),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_project_ids_v3.tags", "ids.#", "2"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_project_ids_v3.not_tags", "ids.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_project_ids_v3.not_tags", "ids.0",
+						"openstack_identity_project_v3.project_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackIdentityProjectIdsV3DataSource_projects(tag string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_project_v3" "project_1" {
+      name = "%s-1"
+      tags = ["%s", "billing"]
+    }
+
+    resource "openstack_identity_project_v3" "project_2" {
+      name = "%s-2"
+      tags = ["%s", "internal"]
+    }
+  `, tag, tag, tag, tag)
+}
+
+func testAccOpenStackIdentityProjectIdsV3DataSource_basic(tag string) string {
+	return fmt.Sprintf(`
+    %s
+
+    data "openstack_identity_project_ids_v3" "tags" {
+      tags = ["%s"]
+    }
+
+    data "openstack_identity_project_ids_v3" "not_tags" {
+      tags = ["%s"]
+      not_tags = ["internal"]
+    }
+  `, testAccOpenStackIdentityProjectIdsV3DataSource_projects(tag), tag, tag)
+}
@@ -0,0 +1,113 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/projects"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceIdentityProjectV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityProjectV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"domain_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"is_domain": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"parent_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityProjectV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	isDomain := d.Get("is_domain").(bool)
+	listOpts := projects.ListOpts{
+		DomainID: d.Get("domain_id").(string),
+		Enabled:  &enabled,
+		IsDomain: &isDomain,
+		Name:     d.Get("name").(string),
+		ParentID: d.Get("parent_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_identity_project_v3 list options: %#v", listOpts)
+
+	allPages, err := projects.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query projects: %s", err)
+	}
+
+	allProjects, err := projects.ExtractProjects(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve projects: %s", err)
+	}
+
+	if len(allProjects) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allProjects) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", allProjects)
+		return fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	project := allProjects[0]
+
+	log.Printf("[DEBUG] Retrieved OpenStack project %s: %#v", project.ID, project)
+	d.SetId(project.ID)
+
+	d.Set("description", project.Description)
+	d.Set("domain_id", project.DomainID)
+	d.Set("enabled", project.Enabled)
+	d.Set("is_domain", project.IsDomain)
+	d.Set("name", project.Name)
+	d.Set("parent_id", project.ParentID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
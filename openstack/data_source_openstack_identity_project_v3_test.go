@@ -0,0 +1,67 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackIdentityProjectV3DataSource_basic(t *testing.T) {
+	projectName := fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackIdentityProjectV3DataSource_basic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3DataSourceID("data.openstack_identity_project_v3.project_1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_project_v3.project_1", "id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_project_v3.project_1", "name", projectName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_project_v3.project_1", "description", "A project"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_project_v3.project_1", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find identity data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Identity data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+func testAccOpenStackIdentityProjectV3DataSource_basic(projectName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_project_v3" "project_1" {
+      name = "%s"
+      description = "A project"
+    }
+
+    data "openstack_identity_project_v3" "project_1" {
+      name = "${openstack_identity_project_v3.project_1.name}"
+    }
+  `, projectName)
+}
@@ -0,0 +1,33 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOpenStackIdentityRoleV3DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackIdentityRoleV3DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3DataSourceID("data.openstack_identity_role_v3.role_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_role_v3.role_1", "name", "admin"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackIdentityRoleV3DataSource_basic = `
+data "openstack_identity_role_v3" "role_1" {
+  name = "admin"
+}
+`
@@ -0,0 +1,130 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/users"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceIdentityUserV3() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIdentityUserV3Read,
+
+		Schema: map[string]*schema.Schema{
+			"default_project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"domain_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"idp_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"password_expires_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"protocol_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"unique_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceIdentityUserV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	listOpts := users.ListOpts{
+		DomainID:   d.Get("domain_id").(string),
+		Enabled:    &enabled,
+		IdPID:      d.Get("idp_id").(string),
+		Name:       d.Get("name").(string),
+		ProtocolID: d.Get("protocol_id").(string),
+		UniqueID:   d.Get("unique_id").(string),
+	}
+
+	log.Printf("[DEBUG] openstack_identity_user_v3 list options: %#v", listOpts)
+
+	allPages, err := users.List(identityClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to query users: %s", err)
+	}
+
+	allUsers, err := users.ExtractUsers(allPages)
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve users: %s", err)
+	}
+
+	if len(allUsers) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allUsers) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", allUsers)
+		return fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	user := allUsers[0]
+
+	log.Printf("[DEBUG] Retrieved OpenStack user %s: %#v", user.ID, user)
+	d.SetId(user.ID)
+
+	d.Set("default_project_id", user.DefaultProjectID)
+	d.Set("description", user.Description)
+	d.Set("domain_id", user.DomainID)
+	d.Set("enabled", user.Enabled)
+	d.Set("name", user.Name)
+	d.Set("region", GetRegion(d, config))
+
+	if !user.PasswordExpiresAt.IsZero() {
+		d.Set("password_expires_at", user.PasswordExpiresAt.Format(time.RFC3339))
+	}
+
+	return nil
+}
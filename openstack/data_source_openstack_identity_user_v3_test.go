@@ -0,0 +1,50 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOpenStackIdentityUserV3DataSource_basic(t *testing.T) {
+	userName := fmt.Sprintf("tf_test_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackIdentityUserV3DataSource_basic(userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3DataSourceID("data.openstack_identity_user_v3.user_1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_identity_user_v3.user_1", "id",
+						"openstack_identity_user_v3.user_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_user_v3.user_1", "name", userName),
+					resource.TestCheckResourceAttr(
+						"data.openstack_identity_user_v3.user_1", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOpenStackIdentityUserV3DataSource_basic(userName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_user_v3" "user_1" {
+      name = "%s"
+      password = "password123@!"
+      description = "A user"
+    }
+
+    data "openstack_identity_user_v3" "user_1" {
+      name = "${openstack_identity_user_v3.user_1.name}"
+    }
+  `, userName)
+}
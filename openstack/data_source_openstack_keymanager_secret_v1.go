@@ -0,0 +1,205 @@
+package openstack
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceKeyManagerSecretV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceKeyManagerSecretV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"secret_ref": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"secret_ref"},
+			},
+
+			"secret_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceKeyManagerSecretV1ValidSecretType,
+			},
+
+			"payload_content_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceKeyManagerSecretV1ValidPayloadContentType,
+			},
+
+			"payload_content_encoding": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: resourceKeyManagerSecretV1ValidPayloadContentEncoding,
+			},
+
+			"payload": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"bit_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"expiration": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"content_types": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+
+			"creator_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceKeyManagerSecretV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	var secret *SecretV1
+	if v, ok := d.GetOk("secret_ref"); ok {
+		secret, err = keyManagerV1SecretGet(kmClient, keyManagerV1RefID(v.(string))).Extract()
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve key manager secret %s: %s", v.(string), err)
+		}
+	} else {
+		listOpts := SecretV1ListOpts{
+			Name:       d.Get("name").(string),
+			SecretType: d.Get("secret_type").(string),
+		}
+
+		if listOpts.Name == "" {
+			return fmt.Errorf("One of secret_ref or name must be set")
+		}
+
+		log.Printf("[DEBUG] openstack_keymanager_secret_v1 list options: %#v", listOpts)
+
+		allSecrets, err := keyManagerV1SecretList(kmClient, listOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Unable to retrieve key manager secrets: %s", err)
+		}
+
+		if len(allSecrets) < 1 {
+			return fmt.Errorf("Your query returned no results. " +
+				"Please change your search criteria and try again.")
+		}
+
+		if len(allSecrets) > 1 {
+			log.Printf("[DEBUG] Multiple results found: %#v", allSecrets)
+			return fmt.Errorf("Your query returned more than one result. " +
+				"Please try a more specific search criteria.")
+		}
+
+		secret = &allSecrets[0]
+	}
+
+	id := keyManagerV1RefID(secret.SecretRef)
+
+	log.Printf("[DEBUG] Retrieved key manager secret %s: %+v", id, secret)
+	d.SetId(id)
+
+	d.Set("secret_ref", secret.SecretRef)
+	d.Set("name", secret.Name)
+	d.Set("secret_type", secret.SecretType)
+	d.Set("algorithm", secret.Algorithm)
+	d.Set("bit_length", secret.BitLength)
+	d.Set("mode", secret.Mode)
+	d.Set("expiration", secret.Expiration)
+	d.Set("status", secret.Status)
+	d.Set("content_types", secret.ContentTypes)
+	d.Set("creator_id", secret.CreatorID)
+	d.Set("created_at", secret.Created)
+	d.Set("updated_at", secret.Updated)
+	d.Set("region", GetRegion(d, config))
+
+	// A secret without content types has no payload.
+	if len(secret.ContentTypes) > 0 {
+		contentType := d.Get("payload_content_type").(string)
+		if contentType == "" {
+			contentType = secret.ContentTypes["default"]
+		}
+
+		payload, err := keyManagerV1SecretPayload(kmClient, id, contentType)
+		if err != nil {
+			return fmt.Errorf("Error retrieving payload of OpenStack key manager secret %s: %s", id, err)
+		}
+
+		if d.Get("payload_content_encoding").(string) == "base64" {
+			d.Set("payload", base64.StdEncoding.EncodeToString(payload))
+		} else {
+			d.Set("payload", string(payload))
+		}
+		d.Set("payload_content_type", contentType)
+	}
+
+	metadata, err := keyManagerV1SecretMetadataGet(kmClient, id).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving metadata of OpenStack key manager secret %s: %s", id, err)
+	}
+	d.Set("metadata", metadata)
+
+	return nil
+}
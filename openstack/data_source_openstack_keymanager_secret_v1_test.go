@@ -0,0 +1,52 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKeyManagerSecretV1DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckKeyManager(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerSecretV1DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_keymanager_secret_v1.secret_1", "id",
+						"openstack_keymanager_secret_v1.secret_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "payload", "secret payload"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_1", "metadata.purpose", "test"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_keymanager_secret_v1.secret_2", "payload", "secret payload"),
+				),
+			},
+		},
+	})
+}
+
+const testAccKeyManagerSecretV1DataSource_basic = `
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "datasource_secret_1"
+  secret_type = "passphrase"
+  payload = "secret payload"
+  payload_content_type = "text/plain"
+
+  metadata {
+    purpose = "test"
+  }
+}
+
+data "openstack_keymanager_secret_v1" "secret_1" {
+  name = "${openstack_keymanager_secret_v1.secret_1.name}"
+  secret_type = "passphrase"
+}
+
+data "openstack_keymanager_secret_v1" "secret_2" {
+  secret_ref = "${openstack_keymanager_secret_v1.secret_1.secret_ref}"
+}
+`
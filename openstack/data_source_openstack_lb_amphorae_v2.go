@@ -0,0 +1,158 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLBAmphoraeV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLBAmphoraeV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"loadbalancer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "STANDALONE" && value != "MASTER" && value != "BACKUP" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'STANDALONE', 'MASTER' and 'BACKUP' are supported values for 'role'"))
+					}
+					return
+				},
+			},
+			"ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"amphorae": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compute_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lb_network_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrrp_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vrrp_port_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_ip": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ha_port_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cert_expiration": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLBAmphoraeV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	if err := lbV2CheckOctavia(config, "openstack_lb_amphorae_v2"); err != nil {
+		return err
+	}
+
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := AmphoraV2ListOpts{
+		LoadBalancerID: d.Get("loadbalancer_id").(string),
+		Role:           d.Get("role").(string),
+	}
+
+	amphorae, err := lbV2AmphoraList(lbClient, listOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve amphorae of load balancer %s: %s", listOpts.LoadBalancerID, err)
+	}
+	sort.Sort(amphoraSort(amphorae))
+
+	ids := make([]string, len(amphorae))
+	flattenedAmphorae := make([]map[string]interface{}, len(amphorae))
+	for i, amphora := range amphorae {
+		ids[i] = amphora.ID
+		flattenedAmphorae[i] = map[string]interface{}{
+			"id":              amphora.ID,
+			"role":            amphora.Role,
+			"status":          amphora.Status,
+			"compute_id":      amphora.ComputeID,
+			"lb_network_ip":   amphora.LBNetworkIP,
+			"vrrp_ip":         amphora.VRRPIP,
+			"vrrp_port_id":    amphora.VRRPPortID,
+			"ha_ip":           amphora.HAIP,
+			"ha_port_id":      amphora.HAPortID,
+			"cert_expiration": amphora.CertExpiration,
+		}
+	}
+
+	log.Printf("[DEBUG] Retrieved amphorae of load balancer %s: %v", listOpts.LoadBalancerID, ids)
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+
+	d.Set("ids", ids)
+	d.Set("amphorae", flattenedAmphorae)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// amphoraSort orders amphorae by role and ID.
+type amphoraSort []AmphoraV2
+
+func (a amphoraSort) Len() int      { return len(a) }
+func (a amphoraSort) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a amphoraSort) Less(i, j int) bool {
+	if a[i].Role != a[j].Role {
+		return a[i].Role < a[j].Role
+	}
+	return a[i].ID < a[j].ID
+}
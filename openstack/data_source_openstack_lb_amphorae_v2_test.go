@@ -0,0 +1,41 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccLBV2AmphoraeDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckOctavia(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2AmphoraeDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.openstack_lb_amphorae_v2.amphorae", "ids.#", regexp.MustCompile("[1-9]\\d*")),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae", "amphorae.0.compute_id"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_amphorae_v2.amphorae", "amphorae.0.lb_network_ip"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2AmphoraeDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_lb_amphorae_v2" "amphorae" {
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+`, testAccLBV2PoolConfig)
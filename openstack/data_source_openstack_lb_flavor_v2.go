@@ -0,0 +1,104 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLBFlavorV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLBFlavorV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"flavor_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"flavor_id"},
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"flavor_profile_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceLBFlavorV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	if err := lbV2CheckOctavia(config, "openstack_lb_flavor_v2"); err != nil {
+		return err
+	}
+
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := FlavorV2ListOpts{
+		ID:   d.Get("flavor_id").(string),
+		Name: d.Get("name").(string),
+	}
+
+	if listOpts.ID == "" && listOpts.Name == "" {
+		return fmt.Errorf("One of flavor_id or name must be set")
+	}
+
+	log.Printf("[DEBUG] openstack_lb_flavor_v2 list options: %#v", listOpts)
+
+	allFlavors, err := lbV2FlavorList(lbClient, listOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve load balancer flavors: %s", err)
+	}
+
+	if len(allFlavors) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(allFlavors) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", allFlavors)
+		return fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	flavor := allFlavors[0]
+
+	log.Printf("[DEBUG] Retrieved load balancer flavor %s: %+v", flavor.ID, flavor)
+	d.SetId(flavor.ID)
+
+	d.Set("flavor_id", flavor.ID)
+	d.Set("name", flavor.Name)
+	d.Set("description", flavor.Description)
+	d.Set("flavor_profile_id", flavor.FlavorProfileID)
+	d.Set("enabled", flavor.Enabled)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccLBV2FlavorDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckLBFlavor(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2FlavorDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_lb_flavor_v2.flavor_1", "flavor_id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_flavor_v2.flavor_1", "name", OS_LB_FLAVOR_NAME),
+					resource.TestCheckResourceAttr(
+						"data.openstack_lb_flavor_v2.flavor_1", "enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+var testAccLBV2FlavorDataSource_basic = fmt.Sprintf(`
+data "openstack_lb_flavor_v2" "flavor_1" {
+  name = "%s"
+}
+`, OS_LB_FLAVOR_NAME)
@@ -0,0 +1,176 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetworkingAgentsV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingAgentsV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"agent_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"binary": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "alive" && value != "dead" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'alive' and 'dead' are supported values for 'state'"))
+					}
+					return
+				},
+			},
+			"ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"agents": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"agent_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"binary": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"host": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"topic": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"availability_zone": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"alive": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"admin_state_up": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingAgentsV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := AgentListOpts{
+		AgentType:        d.Get("agent_type").(string),
+		Binary:           d.Get("binary").(string),
+		Host:             d.Get("host").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+	}
+
+	allAgents, err := networkingV2AgentList(networkingClient, listOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve networking agents: %s", err)
+	}
+
+	// The liveness of agents is not a filter of the API.
+	state := d.Get("state").(string)
+	var agents []Agent
+	for _, agent := range allAgents {
+		if state == "alive" && !agent.Alive || state == "dead" && agent.Alive {
+			continue
+		}
+		agents = append(agents, agent)
+	}
+	sort.Sort(agentSort(agents))
+
+	ids := make([]string, len(agents))
+	flattenedAgents := make([]map[string]interface{}, len(agents))
+	for i, agent := range agents {
+		ids[i] = agent.ID
+		flattenedAgents[i] = map[string]interface{}{
+			"id":                agent.ID,
+			"agent_type":        agent.AgentType,
+			"binary":            agent.Binary,
+			"host":              agent.Host,
+			"topic":             agent.Topic,
+			"availability_zone": agent.AvailabilityZone,
+			"description":       agent.Description,
+			"alive":             agent.Alive,
+			"admin_state_up":    agent.AdminStateUp,
+		}
+	}
+
+	log.Printf("[DEBUG] Retrieved networking agents: %v", ids)
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+
+	d.Set("ids", ids)
+	d.Set("agents", flattenedAgents)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// agentSort orders agents by host, type and ID.
+type agentSort []Agent
+
+func (a agentSort) Len() int      { return len(a) }
+func (a agentSort) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a agentSort) Less(i, j int) bool {
+	if a[i].Host != a[j].Host {
+		return a[i].Host < a[j].Host
+	}
+	if a[i].AgentType != a[j].AgentType {
+		return a[i].AgentType < a[j].AgentType
+	}
+	return a[i].ID < a[j].ID
+}
@@ -0,0 +1,38 @@
+package openstack
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOpenStackNetworkingAgentsV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingAgentsV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.openstack_networking_agents_v2.agents", "ids.#", regexp.MustCompile("[1-9]\\d*")),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_agents_v2.agents", "agents.0.agent_type", "L3 agent"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_agents_v2.agents", "agents.0.alive", "true"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackNetworkingAgentsV2DataSource_basic = `
+data "openstack_networking_agents_v2" "agents" {
+  agent_type = "L3 agent"
+  state = "alive"
+}
+`
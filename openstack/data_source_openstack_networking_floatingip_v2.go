@@ -0,0 +1,145 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceNetworkingFloatingIPV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceNetworkingFloatingIPV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"pool": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"port_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"fixed_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "ACTIVE" && value != "DOWN" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'ACTIVE' and 'DOWN' are supported values for 'status'"))
+					}
+					return
+				},
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func dataSourceNetworkingFloatingIPV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	listOpts := FloatingIPListOpts{
+		FloatingIP: d.Get("address").(string),
+		PortID:     d.Get("port_id").(string),
+		FixedIP:    d.Get("fixed_ip").(string),
+		TenantID:   d.Get("tenant_id").(string),
+		Status:     d.Get("status").(string),
+	}
+
+	if v, ok := d.GetOk("pool"); ok {
+		poolID, err := getNetworkID(d, meta, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error retrieving ID for floating IP pool %s: %s", v, err)
+		}
+		if poolID == "" {
+			return fmt.Errorf("No network found with name: %s", v)
+		}
+		listOpts.FloatingNetworkID = poolID
+	}
+
+	if v, ok := d.GetOk("tags"); ok {
+		var tags []string
+		for _, tag := range v.(*schema.Set).List() {
+			tags = append(tags, tag.(string))
+		}
+		sort.Strings(tags)
+		listOpts.Tags = strings.Join(tags, ",")
+	}
+
+	pages, err := networkingV2FloatingIPList(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve floating IPs: %s", err)
+	}
+
+	allFloatingIPs, err := networkingV2ExtractFloatingIPsWithTags(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract floating IPs: %s", err)
+	}
+
+	if len(allFloatingIPs) < 1 {
+		return fmt.Errorf("No floating IP found matching %+v", listOpts)
+	}
+
+	if len(allFloatingIPs) > 1 {
+		return fmt.Errorf("More than one floating IP found matching %+v", listOpts)
+	}
+
+	floatingIP := allFloatingIPs[0]
+
+	log.Printf("[DEBUG] Retrieved floating IP %s: %+v", floatingIP.ID, floatingIP)
+	d.SetId(floatingIP.ID)
+
+	poolName, err := getNetworkName(d, meta, floatingIP.FloatingNetworkID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving floating IP pool name: %s", err)
+	}
+
+	d.Set("address", floatingIP.FloatingIP)
+	d.Set("pool", poolName)
+	d.Set("port_id", floatingIP.PortID)
+	d.Set("fixed_ip", floatingIP.FixedIP)
+	d.Set("tenant_id", floatingIP.TenantID)
+	d.Set("status", floatingIP.Status)
+	d.Set("tags", floatingIP.Tags)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
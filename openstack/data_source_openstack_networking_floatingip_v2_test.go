@@ -0,0 +1,91 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOpenStackNetworkingFloatingIPV2DataSource_address(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingFloatingIPV2DataSource_floatingIP,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingFloatingIPV2DataSource_address,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingFloatingIPV2DataSourceID("data.openstack_networking_floatingip_v2.fip_1"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_floatingip_v2.fip_1", "id",
+						"openstack_networking_floatingip_v2.fip_1", "id"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_floatingip_v2.fip_1", "pool", OS_POOL_NAME),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_floatingip_v2.fip_1", "port_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOpenStackNetworkingFloatingIPV2DataSource_status(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingFloatingIPV2DataSource_floatingIP,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingFloatingIPV2DataSource_status,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingFloatingIPV2DataSourceID("data.openstack_networking_floatingip_v2.fip_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_floatingip_v2.fip_1", "status", "DOWN"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingFloatingIPV2DataSourceID(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Can't find floating IP data source: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Floating IP data source ID not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccOpenStackNetworkingFloatingIPV2DataSource_floatingIP = `
+resource "openstack_networking_floatingip_v2" "fip_1" {
+}
+`
+
+var testAccOpenStackNetworkingFloatingIPV2DataSource_address = fmt.Sprintf(`
+%s
+
+data "openstack_networking_floatingip_v2" "fip_1" {
+  address = "${openstack_networking_floatingip_v2.fip_1.address}"
+}
+`, testAccOpenStackNetworkingFloatingIPV2DataSource_floatingIP)
+
+var testAccOpenStackNetworkingFloatingIPV2DataSource_status = fmt.Sprintf(`
+%s
+
+data "openstack_networking_floatingip_v2" "fip_1" {
+  address = "${openstack_networking_floatingip_v2.fip_1.address}"
+  status = "DOWN"
+}
+`, testAccOpenStackNetworkingFloatingIPV2DataSource_floatingIP)
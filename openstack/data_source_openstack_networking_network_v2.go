@@ -56,6 +56,14 @@ func dataSourceNetworkingNetworkV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"mtu": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"dns_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -115,10 +123,17 @@ func dataSourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{})
 	log.Printf("[DEBUG] Retrieved Network %s: %+v", network.ID, network)
 	d.SetId(network.ID)
 
+	extAttrs, err := networkingV2NetworkExtAttrs(networks.Get(networkingClient, network.ID))
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve network %s: %s", network.ID, err)
+	}
+
 	d.Set("name", network.Name)
 	d.Set("admin_state_up", strconv.FormatBool(network.AdminStateUp))
 	d.Set("shared", strconv.FormatBool(network.Shared))
 	d.Set("tenant_id", network.TenantID)
+	d.Set("mtu", extAttrs.MTU)
+	d.Set("dns_domain", extAttrs.DNSDomain)
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -24,6 +24,8 @@ func TestAccOpenStackNetworkingNetworkV2DataSource_basic(t *testing.T) {
 						"data.openstack_networking_network_v2.net", "name", "tf_test_network"),
 					resource.TestCheckResourceAttr(
 						"data.openstack_networking_network_v2.net", "admin_state_up", "true"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_networking_network_v2.net", "mtu"),
 				),
 			},
 		},
@@ -34,6 +34,10 @@ func dataSourceNetworkingSecGroupV2() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -41,6 +45,9 @@ func dataSourceNetworkingSecGroupV2() *schema.Resource {
 func dataSourceNetworkingSecGroupV2Read(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
 
 	listOpts := groups.ListOpts{
 		ID:       d.Get("secgroup_id").(string),
@@ -49,17 +56,24 @@ func dataSourceNetworkingSecGroupV2Read(d *schema.ResourceData, meta interface{}
 	}
 
 	pages, err := groups.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve security groups: %s", err)
+	}
+
 	allSecGroups, err := groups.ExtractGroups(pages)
 	if err != nil {
 		return fmt.Errorf("Unable to retrieve security groups: %s", err)
 	}
 
 	if len(allSecGroups) < 1 {
-		return fmt.Errorf("No Security Group found with name: %s", d.Get("name"))
+		return fmt.Errorf("No Security Group found matching %+v", listOpts)
 	}
 
+	// Every project has a security group named "default", so looking one up
+	// by name alone is ambiguous for admins.
 	if len(allSecGroups) > 1 {
-		return fmt.Errorf("More than one Security Group found with name: %s", d.Get("name"))
+		return fmt.Errorf("More than one Security Group found matching %+v, "+
+			"set tenant_id to select the one of a project", listOpts)
 	}
 
 	secGroup := allSecGroups[0]
@@ -22,6 +22,8 @@ func TestAccOpenStackNetworkingSecGroupV2DataSource_basic(t *testing.T) {
 					testAccCheckNetworkingSecGroupV2DataSourceID("data.openstack_networking_secgroup_v2.secgroup_1"),
 					resource.TestCheckResourceAttr(
 						"data.openstack_networking_secgroup_v2.secgroup_1", "name", "secgroup_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_secgroup_v2.secgroup_1", "description", "My neutron security group"),
 				),
 			},
 		},
@@ -48,6 +50,29 @@ func TestAccOpenStackNetworkingSecGroupV2DataSource_secGroupID(t *testing.T) {
 	})
 }
 
+func TestAccOpenStackNetworkingSecGroupV2DataSource_tenantID(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingSecGroupV2DataSource_group,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackNetworkingSecGroupV2DataSource_tenantID,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingSecGroupV2DataSourceID("data.openstack_networking_secgroup_v2.secgroup_1"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_networking_secgroup_v2.secgroup_1", "name", "default"),
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_networking_secgroup_v2.secgroup_1", "tenant_id",
+						"openstack_networking_secgroup_v2.secgroup_1", "tenant_id"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingSecGroupV2DataSourceID(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -85,3 +110,12 @@ data "openstack_networking_secgroup_v2" "secgroup_1" {
 	secgroup_id = "${openstack_networking_secgroup_v2.secgroup_1.id}"
 }
 `, testAccOpenStackNetworkingSecGroupV2DataSource_group)
+
+var testAccOpenStackNetworkingSecGroupV2DataSource_tenantID = fmt.Sprintf(`
+%s
+
+data "openstack_networking_secgroup_v2" "secgroup_1" {
+	name = "default"
+	tenant_id = "${openstack_networking_secgroup_v2.secgroup_1.tenant_id}"
+}
+`, testAccOpenStackNetworkingSecGroupV2DataSource_group)
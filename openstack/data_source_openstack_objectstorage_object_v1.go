@@ -0,0 +1,152 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceObjectStorageObjectV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectStorageObjectV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"container_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"fetch_content": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"max_content_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  1048576,
+			},
+			"content": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_disposition": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_encoding": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"content_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"delete_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"etag": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"object_manifest": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"static_large_object": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageObjectV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	cn := d.Get("container_name").(string)
+	name := d.Get("name").(string)
+
+	result := objects.Get(objectStorageClient, cn, name, nil)
+	object, err := result.Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve object %s/%s: %s", cn, name, err)
+	}
+
+	metadata, err := result.ExtractMetadata()
+	if err != nil {
+		return fmt.Errorf("Unable to extract metadata of object %s/%s: %s", cn, name, err)
+	}
+
+	log.Printf("[DEBUG] Retrieved object %s/%s: %+v", cn, name, object)
+	d.SetId(fmt.Sprintf("%s/%s", cn, name))
+
+	var content string
+	if d.Get("fetch_content").(bool) {
+		maxContentSize := d.Get("max_content_size").(int)
+		if object.ContentLength > int64(maxContentSize) {
+			return fmt.Errorf("Object %s/%s is %d bytes, which is larger than max_content_size %d",
+				cn, name, object.ContentLength, maxContentSize)
+		}
+
+		download := objects.Download(objectStorageClient, cn, name, nil)
+		body, err := download.ExtractContent()
+		if err != nil {
+			return fmt.Errorf("Unable to download object %s/%s: %s", cn, name, err)
+		}
+
+		if !utf8.Valid(body) {
+			return fmt.Errorf("Object %s/%s is not valid UTF-8 text", cn, name)
+		}
+		content = string(body)
+	}
+
+	d.Set("content", content)
+	d.Set("content_disposition", object.ContentDisposition)
+	d.Set("content_encoding", object.ContentEncoding)
+	d.Set("content_length", object.ContentLength)
+	d.Set("content_type", object.ContentType)
+	d.Set("etag", object.ETag)
+	d.Set("metadata", metadata)
+	d.Set("object_manifest", object.ObjectManifest)
+	d.Set("static_large_object", object.StaticLargeObject)
+	if object.DeleteAt.Unix() > 0 {
+		d.Set("delete_at", object.DeleteAt.Format(time.RFC3339))
+	}
+	if object.LastModified.Unix() > 0 {
+		d.Set("last_modified", object.LastModified.Format(time.RFC3339))
+	}
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOpenStackObjectStorageV1ObjectDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackObjectStorageV1ObjectDataSource_object,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackObjectStorageV1ObjectDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_objectstorage_object_v1.object_1", "etag",
+						"openstack_objectstorage_object_v1.object_1", "etag"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.object_1", "content_type", "application/json"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.object_1", "content_length", "16"),
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.object_1", "content", ""),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOpenStackObjectStorageV1ObjectDataSource_content,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.openstack_objectstorage_object_v1.object_1", "content", "{\"foo\" : \"bar\"}\n"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackObjectStorageV1ObjectDataSource_object = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+}
+
+resource "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  name = "test/default.json"
+  content_type = "application/json"
+  content = <<JSON
+{"foo" : "bar"}
+JSON
+}
+`
+
+var testAccOpenStackObjectStorageV1ObjectDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_object_v1.object_1.container_name}"
+  name = "${openstack_objectstorage_object_v1.object_1.name}"
+}
+`, testAccOpenStackObjectStorageV1ObjectDataSource_object)
+
+var testAccOpenStackObjectStorageV1ObjectDataSource_content = fmt.Sprintf(`
+%s
+
+data "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_object_v1.object_1.container_name}"
+  name = "${openstack_objectstorage_object_v1.object_1.name}"
+  fetch_content = true
+  max_content_size = 1024
+}
+`, testAccOpenStackObjectStorageV1ObjectDataSource_object)
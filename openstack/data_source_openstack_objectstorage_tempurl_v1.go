@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceObjectStorageTempURLV1() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceObjectStorageTempURLV1Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"container": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"object": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"method": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "GET",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					for _, method := range objectStorageV1TempURLMethods {
+						if value == method {
+							return
+						}
+					}
+					errors = append(errors, fmt.Errorf(
+						"Only %s are supported values for 'method'", strings.Join(objectStorageV1TempURLMethods, ", ")))
+					return
+				},
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"key": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"url": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"expires": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceObjectStorageTempURLV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	container := d.Get("container").(string)
+	object := d.Get("object").(string)
+	method := d.Get("method").(string)
+
+	// Without an explicit key, the key of the container is used, or else
+	// the key of the account.
+	key := d.Get("key").(string)
+	if key == "" {
+		for _, target := range []string{container, ""} {
+			keys, err := objectStorageV1TempURLKeysGet(objectStorageClient, target).Extract()
+			if err != nil {
+				return fmt.Errorf("Unable to retrieve temporary URL keys of %s: %s",
+					resourceObjectStorageTempURLKeyV1Target(target), err)
+			}
+			if keys.Key != "" {
+				key = keys.Key
+				break
+			}
+		}
+	}
+
+	if key == "" {
+		return fmt.Errorf("No temporary URL key found for container %s or the account", container)
+	}
+
+	expires := time.Now().Add(time.Duration(d.Get("ttl").(int)) * time.Second)
+
+	tempURL, err := objectStorageV1TempURL(objectStorageClient, container, object, method, expires.Unix(), key)
+	if err != nil {
+		return fmt.Errorf("Unable to create temporary URL of object %s/%s: %s", container, object, err)
+	}
+
+	log.Printf("[DEBUG] Created temporary %s URL of object %s/%s expiring at %s", method, container, object, expires)
+	d.SetId(fmt.Sprintf("%s/%s", container, object))
+
+	d.Set("url", tempURL)
+	d.Set("expires", expires.UTC().Format(time.RFC3339))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOpenStackObjectStorageV1TempURLDataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOpenStackObjectStorageV1TempURLDataSource_object,
+			},
+			resource.TestStep{
+				Config: testAccOpenStackObjectStorageV1TempURLDataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.openstack_objectstorage_tempurl_v1.url_1", "url",
+						regexp.MustCompile("/container_1/test/default.json\\?temp_url_expires=[0-9]+&temp_url_sig=[0-9a-f]{40}$")),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_objectstorage_tempurl_v1.url_1", "expires"),
+				),
+			},
+		},
+	})
+}
+
+const testAccOpenStackObjectStorageV1TempURLDataSource_object = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+}
+
+resource "openstack_objectstorage_tempurl_key_v1" "key_1" {
+  container = "${openstack_objectstorage_container_v1.container_1.name}"
+  key = "secret-1"
+}
+
+resource "openstack_objectstorage_object_v1" "object_1" {
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  name = "test/default.json"
+  content = "{}"
+}
+`
+
+var testAccOpenStackObjectStorageV1TempURLDataSource_basic = fmt.Sprintf(`
+%s
+
+data "openstack_objectstorage_tempurl_v1" "url_1" {
+  container = "${openstack_objectstorage_tempurl_key_v1.key_1.container}"
+  object = "${openstack_objectstorage_object_v1.object_1.name}"
+  ttl = 3600
+}
+`, testAccOpenStackObjectStorageV1TempURLDataSource_object)
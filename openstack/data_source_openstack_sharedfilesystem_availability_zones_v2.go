@@ -0,0 +1,59 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceSharedFilesystemAvailabilityZonesV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSharedFilesystemAvailabilityZonesV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"names": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSharedFilesystemAvailabilityZonesV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	zones, err := sharedfilesystemV2AvailabilityZoneList(sfsClient).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve shared file system availability zones: %s", err)
+	}
+
+	names := []string{}
+	for _, zone := range zones {
+		names = append(names, zone.Name)
+	}
+	sort.Strings(names)
+
+	log.Printf("[DEBUG] Retrieved shared file system availability zones: %v", names)
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(names, ","))))
+
+	d.Set("names", names)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSharedFilesystemAvailabilityZonesV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSharedFilesystem(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSharedFilesystemAvailabilityZonesV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.openstack_sharedfilesystem_availability_zones_v2.zones", "names.#", regexp.MustCompile("[1-9]\\d*")),
+				),
+			},
+		},
+	})
+}
+
+const testAccSharedFilesystemAvailabilityZonesV2DataSource_basic = `
+data "openstack_sharedfilesystem_availability_zones_v2" "zones" {}
+`
@@ -0,0 +1,128 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceSharedFilesystemShareTypeV2() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceSharedFilesystemShareTypeV2Read,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+
+			"share_type_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+
+			"name": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"share_type_id"},
+			},
+
+			"extra_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+
+			"is_public": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"driver_handles_share_servers": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceSharedFilesystemShareTypeV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	id := d.Get("share_type_id").(string)
+	name := d.Get("name").(string)
+	extraSpecs := d.Get("extra_specs").(map[string]interface{})
+
+	if id == "" && name == "" && len(extraSpecs) == 0 {
+		return fmt.Errorf("One of share_type_id, name or extra_specs must be set")
+	}
+
+	allShareTypes, err := sharedfilesystemV2ShareTypeList(sfsClient).Extract()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve share types: %s", err)
+	}
+
+	var shareTypes []ShareTypeV2
+	for _, shareType := range allShareTypes {
+		if id != "" && shareType.ID != id {
+			continue
+		}
+		if name != "" && shareType.Name != name {
+			continue
+		}
+		if !dataSourceSharedFilesystemShareTypeV2MatchesExtraSpecs(shareType, extraSpecs) {
+			continue
+		}
+		shareTypes = append(shareTypes, shareType)
+	}
+
+	if len(shareTypes) < 1 {
+		return fmt.Errorf("Your query returned no results. " +
+			"Please change your search criteria and try again.")
+	}
+
+	if len(shareTypes) > 1 {
+		log.Printf("[DEBUG] Multiple results found: %#v", shareTypes)
+		return fmt.Errorf("Your query returned more than one result. " +
+			"Please try a more specific search criteria.")
+	}
+
+	shareType := shareTypes[0]
+
+	log.Printf("[DEBUG] Retrieved share type %s: %+v", shareType.ID, shareType)
+	d.SetId(shareType.ID)
+
+	// driver_handles_share_servers is a required extra spec of every share
+	// type, so it is also exported as a bool.
+	d.Set("share_type_id", shareType.ID)
+	d.Set("name", shareType.Name)
+	d.Set("extra_specs", shareType.ExtraSpecs)
+	d.Set("is_public", shareType.IsPublic)
+	d.Set("driver_handles_share_servers", strings.EqualFold(shareType.RequiredExtraSpecs["driver_handles_share_servers"], "true"))
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// dataSourceSharedFilesystemShareTypeV2MatchesExtraSpecs returns true if a
+// share type has all the given extra specs. Values are compared case
+// insensitively, since Manila accepts e.g. both "True" and "true".
+func dataSourceSharedFilesystemShareTypeV2MatchesExtraSpecs(shareType ShareTypeV2, extraSpecs map[string]interface{}) bool {
+	for k, v := range extraSpecs {
+		value, ok := shareType.ExtraSpecs[k]
+		if !ok || !strings.EqualFold(value, v.(string)) {
+			return false
+		}
+	}
+	return true
+}
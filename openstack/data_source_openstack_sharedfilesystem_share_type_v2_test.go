@@ -0,0 +1,43 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSharedFilesystemShareTypeV2DataSource_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSharedFilesystem(t)
+		},
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSharedFilesystemShareTypeV2DataSource_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.openstack_sharedfilesystem_share_type_v2.share_type_1", "id",
+						"openstack_sharedfilesystem_share_v2.share_1", "share_type"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_sharedfilesystem_share_type_v2.share_type_1", "name"),
+					resource.TestCheckResourceAttrSet(
+						"data.openstack_sharedfilesystem_share_type_v2.share_type_1", "extra_specs.driver_handles_share_servers"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSharedFilesystemShareTypeV2DataSource_basic = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "share_1"
+  share_proto = "NFS"
+  size = 1
+}
+
+data "openstack_sharedfilesystem_share_type_v2" "share_type_1" {
+  share_type_id = "${openstack_sharedfilesystem_share_v2.share_1.share_type}"
+}
+`
@@ -0,0 +1,73 @@
+// This set of code handles the reverse DNS API of the DNS v2 service, which
+// manages the PTR records of floating IPs. PTR records are identified by the
+// region and the ID of the floating IP, joined by a colon.
+// Gophercloud does not support this API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// PtrRecord is the PTR record of a floating IP.
+type PtrRecord struct {
+	ID          string `json:"id"`
+	PtrDName    string `json:"ptrdname"`
+	Description string `json:"description"`
+	TTL         int    `json:"ttl"`
+	Address     string `json:"address"`
+	Status      string `json:"status"`
+	Action      string `json:"action"`
+}
+
+// PtrRecordSetOpts represents the attributes used when setting the PTR
+// record of a floating IP.
+type PtrRecordSetOpts struct {
+	PtrDName    string `json:"ptrdname" required:"true"`
+	Description string `json:"description,omitempty"`
+	TTL         int    `json:"ttl,omitempty"`
+}
+
+// ToPtrRecordSetMap casts a PtrRecordSetOpts struct to a map.
+func (opts PtrRecordSetOpts) ToPtrRecordSetMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// PtrRecordResult is the result of a get or set request.
+type PtrRecordResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a PtrRecordResult as a PtrRecord.
+func (r PtrRecordResult) Extract() (*PtrRecord, error) {
+	var s *PtrRecord
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func dnsV2PtrRecordGet(client *gophercloud.ServiceClient, id string) (r PtrRecordResult) {
+	_, r.Err = client.Get(client.ServiceURL("reverse", "floatingips", id), &r.Body, nil)
+	return
+}
+
+func dnsV2PtrRecordSet(client *gophercloud.ServiceClient, id string, opts PtrRecordSetOpts) (r PtrRecordResult) {
+	b, err := opts.ToPtrRecordSetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(client.ServiceURL("reverse", "floatingips", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// dnsV2PtrRecordUnset removes the PTR record of a floating IP.
+func dnsV2PtrRecordUnset(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"ptrdname": nil,
+	}
+	_, r.Err = client.Patch(client.ServiceURL("reverse", "floatingips", id), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
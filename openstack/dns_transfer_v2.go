@@ -0,0 +1,149 @@
+// This set of code handles zone transfers of the DNS v2 API, which hand a
+// zone over from one project to another. A transfer request is created in the
+// project which owns the zone and accepted in the receiving project with the
+// key of the request.
+// Gophercloud does not support zone transfers yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ZoneTransferRequest is a DNS zone transfer request.
+type ZoneTransferRequest struct {
+	ID              string `json:"id"`
+	ZoneID          string `json:"zone_id"`
+	ZoneName        string `json:"zone_name"`
+	ProjectID       string `json:"project_id"`
+	TargetProjectID string `json:"target_project_id"`
+	Description     string `json:"description"`
+	Key             string `json:"key"`
+	Status          string `json:"status"`
+}
+
+// ZoneTransferRequestCreateOpts represents the attributes used when creating
+// a new zone transfer request. If no target project is set, the transfer can
+// be accepted by any project which knows the key.
+type ZoneTransferRequestCreateOpts struct {
+	TargetProjectID string `json:"target_project_id,omitempty"`
+	Description     string `json:"description,omitempty"`
+}
+
+// ToZoneTransferRequestCreateMap casts a ZoneTransferRequestCreateOpts struct
+// to a map.
+func (opts ZoneTransferRequestCreateOpts) ToZoneTransferRequestCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ZoneTransferRequestUpdateOpts represents the attributes used when updating
+// an existing zone transfer request.
+type ZoneTransferRequestUpdateOpts struct {
+	TargetProjectID *string `json:"target_project_id,omitempty"`
+	Description     *string `json:"description,omitempty"`
+}
+
+// ToZoneTransferRequestUpdateMap casts a ZoneTransferRequestUpdateOpts struct
+// to a map.
+func (opts ZoneTransferRequestUpdateOpts) ToZoneTransferRequestUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ZoneTransferRequestResult is the result of a create, get or update
+// request.
+type ZoneTransferRequestResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ZoneTransferRequestResult as a ZoneTransferRequest.
+func (r ZoneTransferRequestResult) Extract() (*ZoneTransferRequest, error) {
+	var s *ZoneTransferRequest
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func dnsV2ZoneTransferRequestCreate(client *gophercloud.ServiceClient, zoneID string, opts ZoneTransferRequestCreateOpts) (r ZoneTransferRequestResult) {
+	b, err := opts.ToZoneTransferRequestCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("zones", zoneID, "tasks", "transfer_requests"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func dnsV2ZoneTransferRequestGet(client *gophercloud.ServiceClient, requestID string) (r ZoneTransferRequestResult) {
+	_, r.Err = client.Get(client.ServiceURL("zones", "tasks", "transfer_requests", requestID), &r.Body, nil)
+	return
+}
+
+func dnsV2ZoneTransferRequestUpdate(client *gophercloud.ServiceClient, requestID string, opts ZoneTransferRequestUpdateOpts) (r ZoneTransferRequestResult) {
+	b, err := opts.ToZoneTransferRequestUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(client.ServiceURL("zones", "tasks", "transfer_requests", requestID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func dnsV2ZoneTransferRequestDelete(client *gophercloud.ServiceClient, requestID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("zones", "tasks", "transfer_requests", requestID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ZoneTransferAccept is the acceptance of a DNS zone transfer request.
+type ZoneTransferAccept struct {
+	ID                    string `json:"id"`
+	ZoneID                string `json:"zone_id"`
+	ZoneTransferRequestID string `json:"zone_transfer_request_id"`
+	ProjectID             string `json:"project_id"`
+	Status                string `json:"status"`
+}
+
+// ZoneTransferAcceptCreateOpts represents the attributes used when accepting
+// a zone transfer request.
+type ZoneTransferAcceptCreateOpts struct {
+	ZoneTransferRequestID string `json:"zone_transfer_request_id" required:"true"`
+	Key                   string `json:"key" required:"true"`
+}
+
+// ToZoneTransferAcceptCreateMap casts a ZoneTransferAcceptCreateOpts struct
+// to a map.
+func (opts ZoneTransferAcceptCreateOpts) ToZoneTransferAcceptCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ZoneTransferAcceptResult is the result of a create or get request.
+type ZoneTransferAcceptResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ZoneTransferAcceptResult as a ZoneTransferAccept.
+func (r ZoneTransferAcceptResult) Extract() (*ZoneTransferAccept, error) {
+	var s *ZoneTransferAccept
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func dnsV2ZoneTransferAcceptCreate(client *gophercloud.ServiceClient, opts ZoneTransferAcceptCreateOpts) (r ZoneTransferAcceptResult) {
+	b, err := opts.ToZoneTransferAcceptCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("zones", "tasks", "transfer_accepts"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func dnsV2ZoneTransferAcceptGet(client *gophercloud.ServiceClient, acceptID string) (r ZoneTransferAcceptResult) {
+	_, r.Err = client.Get(client.ServiceURL("zones", "tasks", "transfer_accepts", acceptID), &r.Body, nil)
+	return
+}
@@ -0,0 +1,95 @@
+// This set of code handles TSIG keys of the DNS v2 API. TSIG keys sign the
+// zone transfers between Designate and secondary DNS servers. They are
+// scoped to a single zone or to a whole pool of DNS servers.
+// Gophercloud does not support TSIG keys yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// TSIGKey is a DNS TSIG key.
+type TSIGKey struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Algorithm  string `json:"algorithm"`
+	Secret     string `json:"secret"`
+	Scope      string `json:"scope"`
+	ResourceID string `json:"resource_id"`
+}
+
+// TSIGKeyCreateOpts represents the attributes used when creating a new TSIG
+// key.
+type TSIGKeyCreateOpts struct {
+	Name       string `json:"name" required:"true"`
+	Algorithm  string `json:"algorithm" required:"true"`
+	Secret     string `json:"secret" required:"true"`
+	Scope      string `json:"scope" required:"true"`
+	ResourceID string `json:"resource_id" required:"true"`
+}
+
+// ToTSIGKeyCreateMap casts a TSIGKeyCreateOpts struct to a map.
+func (opts TSIGKeyCreateOpts) ToTSIGKeyCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// TSIGKeyUpdateOpts represents the attributes used when updating an existing
+// TSIG key.
+type TSIGKeyUpdateOpts struct {
+	Name      string `json:"name,omitempty"`
+	Algorithm string `json:"algorithm,omitempty"`
+	Secret    string `json:"secret,omitempty"`
+}
+
+// ToTSIGKeyUpdateMap casts a TSIGKeyUpdateOpts struct to a map.
+func (opts TSIGKeyUpdateOpts) ToTSIGKeyUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// TSIGKeyResult is the result of a create, get or update request.
+type TSIGKeyResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a TSIGKeyResult as a TSIGKey.
+func (r TSIGKeyResult) Extract() (*TSIGKey, error) {
+	var s *TSIGKey
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func dnsV2TSIGKeyCreate(client *gophercloud.ServiceClient, opts TSIGKeyCreateOpts) (r TSIGKeyResult) {
+	b, err := opts.ToTSIGKeyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("tsigkeys"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func dnsV2TSIGKeyGet(client *gophercloud.ServiceClient, keyID string) (r TSIGKeyResult) {
+	_, r.Err = client.Get(client.ServiceURL("tsigkeys", keyID), &r.Body, nil)
+	return
+}
+
+func dnsV2TSIGKeyUpdate(client *gophercloud.ServiceClient, keyID string, opts TSIGKeyUpdateOpts) (r TSIGKeyResult) {
+	b, err := opts.ToTSIGKeyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(client.ServiceURL("tsigkeys", keyID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func dnsV2TSIGKeyDelete(client *gophercloud.ServiceClient, keyID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("tsigkeys", keyID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,195 @@
+// This set of code handles the services and endpoints of the Identity v3
+// API, which make up the service catalog. Every endpoint belongs to a
+// service and is published on one of the public, internal or admin
+// interfaces.
+// Gophercloud does not support managing services and endpoints yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// IdentityServiceV3 is a service of the Identity v3 service catalog.
+type IdentityServiceV3 struct {
+	ID          string `json:"id"`
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// IdentityServiceV3CreateOpts represents the attributes used when creating
+// a new service.
+type IdentityServiceV3CreateOpts struct {
+	Type        string `json:"type" required:"true"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+}
+
+// ToIdentityServiceV3CreateMap casts an IdentityServiceV3CreateOpts struct
+// to a map.
+func (opts IdentityServiceV3CreateOpts) ToIdentityServiceV3CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service")
+}
+
+// IdentityServiceV3UpdateOpts represents the attributes used when updating
+// an existing service.
+type IdentityServiceV3UpdateOpts struct {
+	Type        string  `json:"type,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// ToIdentityServiceV3UpdateMap casts an IdentityServiceV3UpdateOpts struct
+// to a map.
+func (opts IdentityServiceV3UpdateOpts) ToIdentityServiceV3UpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service")
+}
+
+// IdentityServiceV3Result is the result of a create, get or update request.
+type IdentityServiceV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityServiceV3Result as an IdentityServiceV3.
+func (r IdentityServiceV3Result) Extract() (*IdentityServiceV3, error) {
+	var s struct {
+		Service *IdentityServiceV3 `json:"service"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Service, err
+}
+
+func identityV3ServiceCreate(client *gophercloud.ServiceClient, opts IdentityServiceV3CreateOpts) (r IdentityServiceV3Result) {
+	b, err := opts.ToIdentityServiceV3CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("services"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func identityV3ServiceGet(client *gophercloud.ServiceClient, serviceID string) (r IdentityServiceV3Result) {
+	_, r.Err = client.Get(client.ServiceURL("services", serviceID), &r.Body, nil)
+	return
+}
+
+func identityV3ServiceUpdate(client *gophercloud.ServiceClient, serviceID string, opts IdentityServiceV3UpdateOpts) (r IdentityServiceV3Result) {
+	b, err := opts.ToIdentityServiceV3UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(client.ServiceURL("services", serviceID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func identityV3ServiceDelete(client *gophercloud.ServiceClient, serviceID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("services", serviceID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// IdentityEndpointV3 is an endpoint of the Identity v3 service catalog.
+type IdentityEndpointV3 struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	ServiceID string `json:"service_id"`
+	Interface string `json:"interface"`
+	URL       string `json:"url"`
+	RegionID  string `json:"region_id"`
+	Enabled   bool   `json:"enabled"`
+}
+
+// IdentityEndpointV3CreateOpts represents the attributes used when creating
+// a new endpoint.
+type IdentityEndpointV3CreateOpts struct {
+	Name      string `json:"name,omitempty"`
+	ServiceID string `json:"service_id" required:"true"`
+	Interface string `json:"interface" required:"true"`
+	URL       string `json:"url" required:"true"`
+	RegionID  string `json:"region_id,omitempty"`
+	Enabled   *bool  `json:"enabled,omitempty"`
+}
+
+// ToIdentityEndpointV3CreateMap casts an IdentityEndpointV3CreateOpts
+// struct to a map.
+func (opts IdentityEndpointV3CreateOpts) ToIdentityEndpointV3CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "endpoint")
+}
+
+// IdentityEndpointV3UpdateOpts represents the attributes used when updating
+// an existing endpoint.
+type IdentityEndpointV3UpdateOpts struct {
+	Name      *string `json:"name,omitempty"`
+	ServiceID string  `json:"service_id,omitempty"`
+	Interface string  `json:"interface,omitempty"`
+	URL       string  `json:"url,omitempty"`
+	RegionID  *string `json:"region_id,omitempty"`
+	Enabled   *bool   `json:"enabled,omitempty"`
+}
+
+// ToIdentityEndpointV3UpdateMap casts an IdentityEndpointV3UpdateOpts
+// struct to a map.
+func (opts IdentityEndpointV3UpdateOpts) ToIdentityEndpointV3UpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "endpoint")
+}
+
+// IdentityEndpointV3Result is the result of a create, get or update
+// request.
+type IdentityEndpointV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityEndpointV3Result as an IdentityEndpointV3.
+func (r IdentityEndpointV3Result) Extract() (*IdentityEndpointV3, error) {
+	var s struct {
+		Endpoint *IdentityEndpointV3 `json:"endpoint"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Endpoint, err
+}
+
+func identityV3EndpointCreate(client *gophercloud.ServiceClient, opts IdentityEndpointV3CreateOpts) (r IdentityEndpointV3Result) {
+	b, err := opts.ToIdentityEndpointV3CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("endpoints"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func identityV3EndpointGet(client *gophercloud.ServiceClient, endpointID string) (r IdentityEndpointV3Result) {
+	_, r.Err = client.Get(client.ServiceURL("endpoints", endpointID), &r.Body, nil)
+	return
+}
+
+func identityV3EndpointUpdate(client *gophercloud.ServiceClient, endpointID string, opts IdentityEndpointV3UpdateOpts) (r IdentityEndpointV3Result) {
+	b, err := opts.ToIdentityEndpointV3UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(client.ServiceURL("endpoints", endpointID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func identityV3EndpointDelete(client *gophercloud.ServiceClient, endpointID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("endpoints", endpointID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,99 @@
+// This set of code handles the groups and roles of the Identity v3 API.
+// Gophercloud does not support groups and roles yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// IdentityGroupV3 is a group of users.
+type IdentityGroupV3 struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	DomainID    string `json:"domain_id"`
+	Description string `json:"description"`
+}
+
+// IdentityGroupV3ListOpts represents the attributes used when listing
+// groups.
+type IdentityGroupV3ListOpts struct {
+	Name     string `q:"name"`
+	DomainID string `q:"domain_id"`
+}
+
+// ToIdentityGroupV3ListQuery formats an IdentityGroupV3ListOpts into a query
+// string.
+func (opts IdentityGroupV3ListOpts) ToIdentityGroupV3ListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// IdentityGroupV3ListResult is the result of a list request.
+type IdentityGroupV3ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityGroupV3ListResult as a list of
+// IdentityGroupV3.
+func (r IdentityGroupV3ListResult) Extract() ([]IdentityGroupV3, error) {
+	var s struct {
+		Groups []IdentityGroupV3 `json:"groups"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Groups, err
+}
+
+func identityV3GroupList(client *gophercloud.ServiceClient, opts IdentityGroupV3ListOpts) (r IdentityGroupV3ListResult) {
+	query, err := opts.ToIdentityGroupV3ListQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(client.ServiceURL("groups")+query, &r.Body, nil)
+	return
+}
+
+// IdentityRoleV3 is a role. Roles without a domain are global.
+type IdentityRoleV3 struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	DomainID string `json:"domain_id"`
+}
+
+// IdentityRoleV3ListOpts represents the attributes used when listing roles.
+type IdentityRoleV3ListOpts struct {
+	Name     string `q:"name"`
+	DomainID string `q:"domain_id"`
+}
+
+// ToIdentityRoleV3ListQuery formats an IdentityRoleV3ListOpts into a query
+// string.
+func (opts IdentityRoleV3ListOpts) ToIdentityRoleV3ListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// IdentityRoleV3ListResult is the result of a list request.
+type IdentityRoleV3ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityRoleV3ListResult as a list of
+// IdentityRoleV3.
+func (r IdentityRoleV3ListResult) Extract() ([]IdentityRoleV3, error) {
+	var s struct {
+		Roles []IdentityRoleV3 `json:"roles"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Roles, err
+}
+
+func identityV3RoleList(client *gophercloud.ServiceClient, opts IdentityRoleV3ListOpts) (r IdentityRoleV3ListResult) {
+	query, err := opts.ToIdentityRoleV3ListQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(client.ServiceURL("roles")+query, &r.Body, nil)
+	return
+}
@@ -0,0 +1,252 @@
+// This set of code handles the unified limits of the Identity v3 API.
+// Registered limits are the default limits of a resource of a service, and
+// project limits override them for a single project. Both are created in
+// batches, so the create requests and responses hold a list.
+// Gophercloud does not support unified limits yet.
+package openstack
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// IdentityRegisteredLimitV3 is the default limit of a resource of a service.
+type IdentityRegisteredLimitV3 struct {
+	ID           string `json:"id"`
+	ServiceID    string `json:"service_id"`
+	RegionID     string `json:"region_id"`
+	ResourceName string `json:"resource_name"`
+	DefaultLimit int    `json:"default_limit"`
+	Description  string `json:"description"`
+}
+
+// IdentityRegisteredLimitV3CreateOpts represents the attributes used when
+// creating a new registered limit.
+type IdentityRegisteredLimitV3CreateOpts struct {
+	ServiceID    string `json:"service_id" required:"true"`
+	RegionID     string `json:"region_id,omitempty"`
+	ResourceName string `json:"resource_name" required:"true"`
+	DefaultLimit int    `json:"default_limit"`
+	Description  string `json:"description,omitempty"`
+}
+
+// ToIdentityRegisteredLimitV3CreateMap casts an
+// IdentityRegisteredLimitV3CreateOpts struct to a map.
+func (opts IdentityRegisteredLimitV3CreateOpts) ToIdentityRegisteredLimitV3CreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"registered_limits": []map[string]interface{}{b},
+	}, nil
+}
+
+// IdentityRegisteredLimitV3UpdateOpts represents the attributes used when
+// updating an existing registered limit.
+type IdentityRegisteredLimitV3UpdateOpts struct {
+	DefaultLimit *int    `json:"default_limit,omitempty"`
+	Description  *string `json:"description,omitempty"`
+}
+
+// ToIdentityRegisteredLimitV3UpdateMap casts an
+// IdentityRegisteredLimitV3UpdateOpts struct to a map.
+func (opts IdentityRegisteredLimitV3UpdateOpts) ToIdentityRegisteredLimitV3UpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "registered_limit")
+}
+
+// IdentityRegisteredLimitV3CreateResult is the result of a create request.
+type IdentityRegisteredLimitV3CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityRegisteredLimitV3CreateResult as an
+// IdentityRegisteredLimitV3.
+func (r IdentityRegisteredLimitV3CreateResult) Extract() (*IdentityRegisteredLimitV3, error) {
+	var s struct {
+		RegisteredLimits []IdentityRegisteredLimitV3 `json:"registered_limits"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(s.RegisteredLimits) != 1 {
+		return nil, fmt.Errorf("Expected one registered limit, got %d", len(s.RegisteredLimits))
+	}
+
+	return &s.RegisteredLimits[0], nil
+}
+
+// IdentityRegisteredLimitV3Result is the result of a get or update request.
+type IdentityRegisteredLimitV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityRegisteredLimitV3Result as an
+// IdentityRegisteredLimitV3.
+func (r IdentityRegisteredLimitV3Result) Extract() (*IdentityRegisteredLimitV3, error) {
+	var s struct {
+		RegisteredLimit *IdentityRegisteredLimitV3 `json:"registered_limit"`
+	}
+	err := r.ExtractInto(&s)
+	return s.RegisteredLimit, err
+}
+
+func identityV3RegisteredLimitCreate(client *gophercloud.ServiceClient, opts IdentityRegisteredLimitV3CreateOpts) (r IdentityRegisteredLimitV3CreateResult) {
+	b, err := opts.ToIdentityRegisteredLimitV3CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("registered_limits"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func identityV3RegisteredLimitGet(client *gophercloud.ServiceClient, registeredLimitID string) (r IdentityRegisteredLimitV3Result) {
+	_, r.Err = client.Get(client.ServiceURL("registered_limits", registeredLimitID), &r.Body, nil)
+	return
+}
+
+func identityV3RegisteredLimitUpdate(client *gophercloud.ServiceClient, registeredLimitID string, opts IdentityRegisteredLimitV3UpdateOpts) (r IdentityRegisteredLimitV3Result) {
+	b, err := opts.ToIdentityRegisteredLimitV3UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(client.ServiceURL("registered_limits", registeredLimitID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func identityV3RegisteredLimitDelete(client *gophercloud.ServiceClient, registeredLimitID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("registered_limits", registeredLimitID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// IdentityLimitV3 is the limit of a resource of a service for a project.
+type IdentityLimitV3 struct {
+	ID            string `json:"id"`
+	ProjectID     string `json:"project_id"`
+	ServiceID     string `json:"service_id"`
+	RegionID      string `json:"region_id"`
+	ResourceName  string `json:"resource_name"`
+	ResourceLimit int    `json:"resource_limit"`
+	Description   string `json:"description"`
+}
+
+// IdentityLimitV3CreateOpts represents the attributes used when creating a
+// new project limit.
+type IdentityLimitV3CreateOpts struct {
+	ProjectID     string `json:"project_id" required:"true"`
+	ServiceID     string `json:"service_id" required:"true"`
+	RegionID      string `json:"region_id,omitempty"`
+	ResourceName  string `json:"resource_name" required:"true"`
+	ResourceLimit int    `json:"resource_limit"`
+	Description   string `json:"description,omitempty"`
+}
+
+// ToIdentityLimitV3CreateMap casts an IdentityLimitV3CreateOpts struct to a
+// map.
+func (opts IdentityLimitV3CreateOpts) ToIdentityLimitV3CreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"limits": []map[string]interface{}{b},
+	}, nil
+}
+
+// IdentityLimitV3UpdateOpts represents the attributes used when updating an
+// existing project limit.
+type IdentityLimitV3UpdateOpts struct {
+	ResourceLimit *int    `json:"resource_limit,omitempty"`
+	Description   *string `json:"description,omitempty"`
+}
+
+// ToIdentityLimitV3UpdateMap casts an IdentityLimitV3UpdateOpts struct to a
+// map.
+func (opts IdentityLimitV3UpdateOpts) ToIdentityLimitV3UpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "limit")
+}
+
+// IdentityLimitV3CreateResult is the result of a create request.
+type IdentityLimitV3CreateResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityLimitV3CreateResult as an IdentityLimitV3.
+func (r IdentityLimitV3CreateResult) Extract() (*IdentityLimitV3, error) {
+	var s struct {
+		Limits []IdentityLimitV3 `json:"limits"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(s.Limits) != 1 {
+		return nil, fmt.Errorf("Expected one limit, got %d", len(s.Limits))
+	}
+
+	return &s.Limits[0], nil
+}
+
+// IdentityLimitV3Result is the result of a get or update request.
+type IdentityLimitV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityLimitV3Result as an IdentityLimitV3.
+func (r IdentityLimitV3Result) Extract() (*IdentityLimitV3, error) {
+	var s struct {
+		Limit *IdentityLimitV3 `json:"limit"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Limit, err
+}
+
+func identityV3LimitCreate(client *gophercloud.ServiceClient, opts IdentityLimitV3CreateOpts) (r IdentityLimitV3CreateResult) {
+	b, err := opts.ToIdentityLimitV3CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("limits"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func identityV3LimitGet(client *gophercloud.ServiceClient, limitID string) (r IdentityLimitV3Result) {
+	_, r.Err = client.Get(client.ServiceURL("limits", limitID), &r.Body, nil)
+	return
+}
+
+func identityV3LimitUpdate(client *gophercloud.ServiceClient, limitID string, opts IdentityLimitV3UpdateOpts) (r IdentityLimitV3Result) {
+	b, err := opts.ToIdentityLimitV3UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Patch(client.ServiceURL("limits", limitID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func identityV3LimitDelete(client *gophercloud.ServiceClient, limitID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("limits", limitID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
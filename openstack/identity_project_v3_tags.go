@@ -0,0 +1,105 @@
+// This set of code handles the tags of an openstack_identity_project_v3
+// resource and the listing of projects by their tags.
+//
+// Project tags were added in the Queens release of the Identity service and
+// are not yet supported by Gophercloud, so the requests are built here.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// IdentityProjectV3TagsResult is the result of a project tags request.
+type IdentityProjectV3TagsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityProjectV3TagsResult as a list of tags.
+func (r IdentityProjectV3TagsResult) Extract() ([]string, error) {
+	var s struct {
+		Tags []string `json:"tags"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Tags, err
+}
+
+// identityV3ProjectTagsList retrieves the tags of a project.
+func identityV3ProjectTagsList(client *gophercloud.ServiceClient, projectID string) (r IdentityProjectV3TagsResult) {
+	_, r.Err = client.Get(client.ServiceURL("projects", projectID, "tags"), &r.Body, nil)
+	return
+}
+
+// identityV3ProjectTagsReplace replaces all tags of a project with the given
+// list of tags.
+func identityV3ProjectTagsReplace(client *gophercloud.ServiceClient, projectID string, tags []string) (r IdentityProjectV3TagsResult) {
+	b := map[string]interface{}{
+		"tags": tags,
+	}
+	_, r.Err = client.Put(client.ServiceURL("projects", projectID, "tags"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// IdentityProjectV3WithTags is a project together with its tags.
+type IdentityProjectV3WithTags struct {
+	ID       string   `json:"id"`
+	Name     string   `json:"name"`
+	DomainID string   `json:"domain_id"`
+	ParentID string   `json:"parent_id"`
+	Tags     []string `json:"tags"`
+}
+
+// IdentityProjectV3ListOpts represents the attributes used when listing
+// projects. Each of the tag filters is a comma-separated list of tags.
+type IdentityProjectV3ListOpts struct {
+	DomainID   string `q:"domain_id"`
+	ParentID   string `q:"parent_id"`
+	Tags       string `q:"tags"`
+	TagsAny    string `q:"tags-any"`
+	NotTags    string `q:"not-tags"`
+	NotTagsAny string `q:"not-tags-any"`
+}
+
+// ToIdentityProjectV3ListQuery formats an IdentityProjectV3ListOpts into a
+// query string.
+func (opts IdentityProjectV3ListOpts) ToIdentityProjectV3ListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// IdentityProjectV3ListResult is the result of a list request.
+type IdentityProjectV3ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityProjectV3ListResult as a list of
+// IdentityProjectV3WithTags.
+func (r IdentityProjectV3ListResult) Extract() ([]IdentityProjectV3WithTags, error) {
+	var s struct {
+		Projects []IdentityProjectV3WithTags `json:"projects"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Projects, err
+}
+
+func identityV3ProjectList(client *gophercloud.ServiceClient, opts IdentityProjectV3ListOpts) (r IdentityProjectV3ListResult) {
+	query, err := opts.ToIdentityProjectV3ListQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(client.ServiceURL("projects")+query, &r.Body, nil)
+	return
+}
+
+// resourceIdentityProjectV3Tags returns the configured tags of a project.
+func resourceIdentityProjectV3Tags(d *schema.ResourceData) []string {
+	rawTags := d.Get("tags").(*schema.Set).List()
+	tags := make([]string, len(rawTags))
+	for i, raw := range rawTags {
+		tags[i] = raw.(string)
+	}
+	return tags
+}
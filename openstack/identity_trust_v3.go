@@ -0,0 +1,98 @@
+// This set of code handles the trusts of the OS-TRUST extension of the
+// Identity v3 API. A trust delegates roles of the trustor on a project to the
+// trustee. Trusts can't be changed once they are created.
+// Gophercloud does not support managing trusts yet.
+package openstack
+
+import (
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// IdentityTrustV3Role is a role which is delegated by a trust.
+type IdentityTrustV3Role struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// IdentityTrustV3 is a trust between a trustor and a trustee.
+type IdentityTrustV3 struct {
+	ID                 string                `json:"id"`
+	TrustorUserID      string                `json:"trustor_user_id"`
+	TrusteeUserID      string                `json:"trustee_user_id"`
+	ProjectID          string                `json:"project_id"`
+	Impersonation      bool                  `json:"impersonation"`
+	AllowRedelegation  bool                  `json:"allow_redelegation"`
+	RedelegationCount  int                   `json:"redelegation_count"`
+	RemainingUses      *int                  `json:"remaining_uses"`
+	ExpiresAt          string                `json:"expires_at"`
+	Roles              []IdentityTrustV3Role `json:"roles"`
+	RedelegatedTrustID string                `json:"redelegated_trust_id"`
+}
+
+// IdentityTrustV3CreateOpts represents the attributes used when creating a
+// new trust.
+type IdentityTrustV3CreateOpts struct {
+	TrustorUserID     string                `json:"trustor_user_id" required:"true"`
+	TrusteeUserID     string                `json:"trustee_user_id" required:"true"`
+	ProjectID         string                `json:"project_id,omitempty"`
+	Impersonation     bool                  `json:"impersonation"`
+	AllowRedelegation bool                  `json:"allow_redelegation,omitempty"`
+	RemainingUses     *int                  `json:"remaining_uses,omitempty"`
+	ExpiresAt         *time.Time            `json:"-"`
+	Roles             []IdentityTrustV3Role `json:"roles,omitempty"`
+}
+
+// ToIdentityTrustV3CreateMap casts an IdentityTrustV3CreateOpts struct to a
+// map.
+func (opts IdentityTrustV3CreateOpts) ToIdentityTrustV3CreateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "trust")
+	if err != nil {
+		return nil, err
+	}
+
+	// Keystone only accepts expiration times in UTC with microseconds.
+	if opts.ExpiresAt != nil {
+		trust := b["trust"].(map[string]interface{})
+		trust["expires_at"] = opts.ExpiresAt.UTC().Format("2006-01-02T15:04:05.000000Z")
+	}
+
+	return b, nil
+}
+
+// IdentityTrustV3Result is the result of a create or get request.
+type IdentityTrustV3Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IdentityTrustV3Result as an IdentityTrustV3.
+func (r IdentityTrustV3Result) Extract() (*IdentityTrustV3, error) {
+	var s struct {
+		Trust *IdentityTrustV3 `json:"trust"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Trust, err
+}
+
+func identityV3TrustCreate(client *gophercloud.ServiceClient, opts IdentityTrustV3CreateOpts) (r IdentityTrustV3Result) {
+	b, err := opts.ToIdentityTrustV3CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("OS-TRUST", "trusts"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func identityV3TrustGet(client *gophercloud.ServiceClient, trustID string) (r IdentityTrustV3Result) {
+	_, r.Err = client.Get(client.ServiceURL("OS-TRUST", "trusts", trustID), &r.Body, nil)
+	return
+}
+
+func identityV3TrustDelete(client *gophercloud.ServiceClient, trustID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("OS-TRUST", "trusts", trustID), nil)
+	return
+}
@@ -0,0 +1,68 @@
+// This set of code handles the parts of the Image v2 API used by the
+// openstack_images_image_v2 resource which Gophercloud does not support yet:
+// the interoperable image import and updating arbitrary image attributes,
+// such as the protected flag and custom properties.
+package openstack
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ImageV2ImportMethod is the method which is used to import the data of an
+// image.
+type ImageV2ImportMethod struct {
+	Name string `json:"name"`
+	URI  string `json:"uri,omitempty"`
+}
+
+// ImageV2ImportOpts represents the attributes used when importing the data
+// of an image.
+type ImageV2ImportOpts struct {
+	Method ImageV2ImportMethod `json:"method"`
+}
+
+// ToImageV2ImportMap casts an ImageV2ImportOpts struct to a map.
+func (opts ImageV2ImportOpts) ToImageV2ImportMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// imagesImageV2Import starts the import of the data of an image. The import
+// runs asynchronously, so the status of the image has to be watched
+// afterwards.
+func imagesImageV2Import(client *gophercloud.ServiceClient, imageID string, opts ImageV2ImportOpts) (r gophercloud.ErrResult) {
+	b, err := opts.ToImageV2ImportMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("images", imageID, "import"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// ImageV2Patch is a single JSON patch operation on an attribute of an image.
+// It implements the images.Patch interface.
+type ImageV2Patch struct {
+	Op    string
+	Name  string
+	Value interface{}
+}
+
+// ToImagePatchMap assembles a request body based on ImageV2Patch.
+func (p ImageV2Patch) ToImagePatchMap() map[string]interface{} {
+	// The name is a JSON pointer, so "~" and "/" have to be escaped.
+	name := strings.Replace(p.Name, "~", "~0", -1)
+	name = strings.Replace(name, "/", "~1", -1)
+
+	m := map[string]interface{}{
+		"op":   p.Op,
+		"path": "/" + name,
+	}
+	if p.Op != "remove" {
+		m["value"] = p.Value
+	}
+	return m
+}
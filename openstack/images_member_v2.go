@@ -0,0 +1,66 @@
+// This set of code handles the members of an image of the Image v2 API.
+// The owner of an image with the "shared" visibility adds projects as its
+// members, and each member project then accepts or rejects the image.
+// Gophercloud does not support image members yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ImageMemberV2 is a project which an image is shared with.
+type ImageMemberV2 struct {
+	ImageID   string `json:"image_id"`
+	MemberID  string `json:"member_id"`
+	Status    string `json:"status"`
+	Schema    string `json:"schema"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// ImageMemberV2Result is the result of a create, get or update request.
+type ImageMemberV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an ImageMemberV2Result as an ImageMemberV2.
+func (r ImageMemberV2Result) Extract() (*ImageMemberV2, error) {
+	var s *ImageMemberV2
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func imagesV2MemberCreate(client *gophercloud.ServiceClient, imageID, memberID string) (r ImageMemberV2Result) {
+	b := map[string]interface{}{
+		"member": memberID,
+	}
+	_, r.Err = client.Post(client.ServiceURL("images", imageID, "members"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func imagesV2MemberGet(client *gophercloud.ServiceClient, imageID, memberID string) (r ImageMemberV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("images", imageID, "members", memberID), &r.Body, nil)
+	return
+}
+
+// imagesV2MemberUpdate sets the status of a member, which is one of
+// "pending", "accepted" or "rejected". Only the member itself can change
+// its status.
+func imagesV2MemberUpdate(client *gophercloud.ServiceClient, imageID, memberID, status string) (r ImageMemberV2Result) {
+	b := map[string]interface{}{
+		"status": status,
+	}
+	_, r.Err = client.Put(client.ServiceURL("images", imageID, "members", memberID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func imagesV2MemberDelete(client *gophercloud.ServiceClient, imageID, memberID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("images", imageID, "members", memberID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
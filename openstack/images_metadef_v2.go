@@ -0,0 +1,260 @@
+// This set of code handles the metadata definitions (metadefs) of the Image
+// v2 API. A metadef namespace groups objects and properties, which describe
+// the properties that can be set on images and other resources with a JSON
+// schema.
+// Gophercloud does not support metadata definitions yet.
+package openstack
+
+import (
+	"encoding/json"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// ImageMetadefNamespaceV2 is a metadata definition namespace.
+type ImageMetadefNamespaceV2 struct {
+	Namespace   string `json:"namespace"`
+	DisplayName string `json:"display_name"`
+	Description string `json:"description"`
+	Visibility  string `json:"visibility"`
+	Protected   bool   `json:"protected"`
+	Owner       string `json:"owner"`
+	Schema      string `json:"schema"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// ImageMetadefNamespaceV2Opts represents the attributes used when creating
+// or updating a metadata definition namespace. An update replaces all
+// attributes of the namespace.
+type ImageMetadefNamespaceV2Opts struct {
+	Namespace   string `json:"namespace" required:"true"`
+	DisplayName string `json:"display_name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Visibility  string `json:"visibility,omitempty"`
+	Protected   *bool  `json:"protected,omitempty"`
+}
+
+// ToImageMetadefNamespaceV2Map casts an ImageMetadefNamespaceV2Opts struct
+// to a map.
+func (opts ImageMetadefNamespaceV2Opts) ToImageMetadefNamespaceV2Map() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ImageMetadefNamespaceV2Result is the result of a create, get or update
+// request.
+type ImageMetadefNamespaceV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an ImageMetadefNamespaceV2Result as an
+// ImageMetadefNamespaceV2.
+func (r ImageMetadefNamespaceV2Result) Extract() (*ImageMetadefNamespaceV2, error) {
+	var s *ImageMetadefNamespaceV2
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func imagesV2MetadefNamespaceCreate(client *gophercloud.ServiceClient, opts ImageMetadefNamespaceV2Opts) (r ImageMetadefNamespaceV2Result) {
+	b, err := opts.ToImageMetadefNamespaceV2Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("metadefs", "namespaces"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func imagesV2MetadefNamespaceGet(client *gophercloud.ServiceClient, namespace string) (r ImageMetadefNamespaceV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("metadefs", "namespaces", namespace), &r.Body, nil)
+	return
+}
+
+func imagesV2MetadefNamespaceUpdate(client *gophercloud.ServiceClient, namespace string, opts ImageMetadefNamespaceV2Opts) (r ImageMetadefNamespaceV2Result) {
+	b, err := opts.ToImageMetadefNamespaceV2Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("metadefs", "namespaces", namespace), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// imagesV2MetadefNamespaceDelete deletes a namespace together with all of
+// its objects and properties. Protected namespaces can't be deleted.
+func imagesV2MetadefNamespaceDelete(client *gophercloud.ServiceClient, namespace string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("metadefs", "namespaces", namespace), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ImageMetadefObjectV2 is an object of a metadata definition namespace.
+// Properties holds the JSON schema of each property of the object, keyed
+// by the name of the property.
+type ImageMetadefObjectV2 struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+	Required    []string               `json:"required"`
+	Properties  map[string]interface{} `json:"properties"`
+	Schema      string                 `json:"schema"`
+	CreatedAt   string                 `json:"created_at"`
+	UpdatedAt   string                 `json:"updated_at"`
+}
+
+// ImageMetadefObjectV2Opts represents the attributes used when creating or
+// updating an object of a metadata definition namespace. An update replaces
+// all attributes of the object.
+type ImageMetadefObjectV2Opts struct {
+	Name        string                 `json:"name" required:"true"`
+	Description string                 `json:"description,omitempty"`
+	Required    []string               `json:"required,omitempty"`
+	Properties  map[string]interface{} `json:"properties,omitempty"`
+}
+
+// ToImageMetadefObjectV2Map casts an ImageMetadefObjectV2Opts struct to a
+// map.
+func (opts ImageMetadefObjectV2Opts) ToImageMetadefObjectV2Map() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ImageMetadefObjectV2Result is the result of a create, get or update
+// request.
+type ImageMetadefObjectV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an ImageMetadefObjectV2Result as an
+// ImageMetadefObjectV2.
+func (r ImageMetadefObjectV2Result) Extract() (*ImageMetadefObjectV2, error) {
+	var s *ImageMetadefObjectV2
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func imagesV2MetadefObjectCreate(client *gophercloud.ServiceClient, namespace string, opts ImageMetadefObjectV2Opts) (r ImageMetadefObjectV2Result) {
+	b, err := opts.ToImageMetadefObjectV2Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("metadefs", "namespaces", namespace, "objects"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func imagesV2MetadefObjectGet(client *gophercloud.ServiceClient, namespace, name string) (r ImageMetadefObjectV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("metadefs", "namespaces", namespace, "objects", name), &r.Body, nil)
+	return
+}
+
+func imagesV2MetadefObjectUpdate(client *gophercloud.ServiceClient, namespace, name string, opts ImageMetadefObjectV2Opts) (r ImageMetadefObjectV2Result) {
+	b, err := opts.ToImageMetadefObjectV2Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("metadefs", "namespaces", namespace, "objects", name), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func imagesV2MetadefObjectDelete(client *gophercloud.ServiceClient, namespace, name string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("metadefs", "namespaces", namespace, "objects", name), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ImageMetadefPropertyV2Result is the result of a create, get or update
+// request of a property of a metadata definition namespace.
+type ImageMetadefPropertyV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an ImageMetadefPropertyV2Result as the JSON schema of
+// the property. The name of the property is not part of the returned
+// schema.
+func (r ImageMetadefPropertyV2Result) Extract() (map[string]interface{}, error) {
+	var s map[string]interface{}
+	err := r.ExtractInto(&s)
+	if err != nil {
+		return nil, err
+	}
+	delete(s, "name")
+	return s, nil
+}
+
+// imagesV2MetadefPropertyCreate creates a property from its name and the
+// JSON schema which defines it, which has at least a title and a type.
+func imagesV2MetadefPropertyCreate(client *gophercloud.ServiceClient, namespace, name string, definition map[string]interface{}) (r ImageMetadefPropertyV2Result) {
+	b := imagesV2MetadefPropertyBody(name, definition)
+	_, r.Err = client.Post(client.ServiceURL("metadefs", "namespaces", namespace, "properties"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func imagesV2MetadefPropertyGet(client *gophercloud.ServiceClient, namespace, name string) (r ImageMetadefPropertyV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("metadefs", "namespaces", namespace, "properties", name), &r.Body, nil)
+	return
+}
+
+// imagesV2MetadefPropertyUpdate replaces the JSON schema of a property.
+func imagesV2MetadefPropertyUpdate(client *gophercloud.ServiceClient, namespace, name string, definition map[string]interface{}) (r ImageMetadefPropertyV2Result) {
+	b := imagesV2MetadefPropertyBody(name, definition)
+	_, r.Err = client.Put(client.ServiceURL("metadefs", "namespaces", namespace, "properties", name), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func imagesV2MetadefPropertyDelete(client *gophercloud.ServiceClient, namespace, name string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("metadefs", "namespaces", namespace, "properties", name), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+func imagesV2MetadefPropertyBody(name string, definition map[string]interface{}) map[string]interface{} {
+	b := make(map[string]interface{}, len(definition)+1)
+	for k, v := range definition {
+		b[k] = v
+	}
+	b["name"] = name
+	return b
+}
+
+// imagesV2MetadefDecodeJSON decodes a JSON object of a metadef resource,
+// which is empty if the object is not set.
+func imagesV2MetadefDecodeJSON(raw string) (map[string]interface{}, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	var v map[string]interface{}
+	if err := json.Unmarshal([]byte(raw), &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// imagesV2MetadefEncodeJSON encodes a JSON object of a metadef resource. An
+// empty object is encoded as an empty string.
+func imagesV2MetadefEncodeJSON(v map[string]interface{}) (string, error) {
+	if len(v) == 0 {
+		return "", nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
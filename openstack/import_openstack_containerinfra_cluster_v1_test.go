@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContainerInfraClusterV1_importBasic(t *testing.T) {
+	resourceName := "openstack_containerinfra_cluster_v1.cluster_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerInfraClusterV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerInfraClusterV1_basic(1),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContainerInfraClusterTemplateV1_importBasic(t *testing.T) {
+	resourceName := "openstack_containerinfra_clustertemplate_v1.clustertemplate_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerInfraClusterTemplateV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerInfraClusterTemplateV1_basic(),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccContainerInfraNodeGroupV1_importBasic(t *testing.T) {
+	resourceName := "openstack_containerinfra_nodegroup_v1.nodegroup_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerInfraNodeGroupV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerInfraNodeGroupV1_basic(1),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccFWGroupV2_importBasic(t *testing.T) {
+	resourceName := "openstack_fw_group_v2.group_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFWV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFWGroupV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFWGroupV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccFWRuleV2_importBasic(t *testing.T) {
+	resourceName := "openstack_fw_rule_v2.rule_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFWV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFWRuleV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFWRuleV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
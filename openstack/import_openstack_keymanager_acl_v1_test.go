@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKeyManagerACLV1_importBasic(t *testing.T) {
+	resourceName := "openstack_keymanager_acl_v1.acl_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerACLV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerACLV1_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccKeyManagerOrderV1_importBasic(t *testing.T) {
+	resourceName := "openstack_keymanager_order_v1.order_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerOrderV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerOrderV1_symmetric,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
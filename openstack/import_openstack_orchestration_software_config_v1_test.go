@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOrchestrationSoftwareConfigV1_importBasic(t *testing.T) {
+	resourceName := "openstack_orchestration_software_config_v1.config_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckOrchestration(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOrchestrationSoftwareConfigV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrchestrationSoftwareConfigV1_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
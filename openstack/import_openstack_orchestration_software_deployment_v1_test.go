@@ -0,0 +1,32 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccOrchestrationSoftwareDeploymentV1_importBasic(t *testing.T) {
+	resourceName := "openstack_orchestration_software_deployment_v1.deployment_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckOrchestration(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOrchestrationSoftwareDeploymentV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrchestrationSoftwareDeploymentV1_basic("Alice"),
+			},
+
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_completion"},
+			},
+		},
+	})
+}
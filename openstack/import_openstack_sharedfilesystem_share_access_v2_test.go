@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSharedFilesystemShareAccessV2_importBasic(t *testing.T) {
+	resourceName := "openstack_sharedfilesystem_share_access_v2.access_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSharedFilesystem(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedFilesystemShareAccessV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSharedFilesystemShareAccessV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
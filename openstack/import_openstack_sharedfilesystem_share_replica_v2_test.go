@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSharedFilesystemShareReplicaV2_importBasic(t *testing.T) {
+	resourceName := "openstack_sharedfilesystem_share_replica_v2.replica_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSharedFilesystemReplication(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedFilesystemShareReplicaV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSharedFilesystemShareReplicaV2_basic(),
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccSharedFilesystemShareV2_importBasic(t *testing.T) {
+	resourceName := "openstack_sharedfilesystem_share_v2.share_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSharedFilesystem(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedFilesystemShareV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSharedFilesystemShareV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccVPNaaSIPSecPolicyV2_importBasic(t *testing.T) {
+	resourceName := "openstack_vpnaas_ipsec_policy_v2.policy_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckVPN(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIPSecPolicyV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIPSecPolicyV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccVPNaaSServiceV2_importBasic(t *testing.T) {
+	resourceName := "openstack_vpnaas_service_v2.service_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckVPN(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPNServiceV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVPNServiceV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
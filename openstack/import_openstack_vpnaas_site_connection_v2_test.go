@@ -0,0 +1,28 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccVPNaaSSiteConnectionV2_importBasic(t *testing.T) {
+	resourceName := "openstack_vpnaas_site_connection_v2.conn_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckVPN(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSiteConnectionV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSiteConnectionV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
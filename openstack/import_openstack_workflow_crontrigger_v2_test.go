@@ -0,0 +1,32 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccWorkflowCronTriggerV2_importBasic(t *testing.T) {
+	resourceName := "openstack_workflow_crontrigger_v2.crontrigger_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWorkflow(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkflowCronTriggerV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccWorkflowCronTriggerV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"workflow_input", "workflow_params", "first_execution_time", "remaining_executions"},
+			},
+		},
+	})
+}
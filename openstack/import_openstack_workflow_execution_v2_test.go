@@ -0,0 +1,32 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccWorkflowExecutionV2_importBasic(t *testing.T) {
+	resourceName := "openstack_workflow_execution_v2.execution_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWorkflow(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkflowExecutionV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccWorkflowExecutionV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"input", "params", "wait_for_completion"},
+			},
+		},
+	})
+}
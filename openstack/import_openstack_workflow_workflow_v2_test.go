@@ -0,0 +1,31 @@
+package openstack
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccWorkflowWorkflowV2_importBasic(t *testing.T) {
+	resourceName := "openstack_workflow_workflow_v2.workflow_1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWorkflow(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkflowWorkflowV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccWorkflowWorkflowV2_basic,
+			},
+
+			resource.TestStep{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,75 @@
+// This set of code handles the ACLs of secrets and containers of the Key
+// Manager (Barbican) v1 API. Barbican only has ACLs for the "read"
+// operation. By default, all users of the owning project may read a secret;
+// an ACL can restrict this to a list of users, which may also belong to
+// other projects.
+// Gophercloud does not support the Key Manager API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// KeyManagerACLV1 is the ACL of a single operation on a secret or
+// container.
+type KeyManagerACLV1 struct {
+	ProjectAccess bool     `json:"project-access"`
+	Users         []string `json:"users"`
+	Created       string   `json:"created"`
+	Updated       string   `json:"updated"`
+}
+
+// KeyManagerACLV1SetOpts represents the attributes used when setting the
+// ACL of a secret or container. The ACL is replaced as a whole.
+type KeyManagerACLV1SetOpts struct {
+	ProjectAccess bool     `json:"project-access"`
+	Users         []string `json:"users"`
+}
+
+// ToKeyManagerACLV1SetMap casts a KeyManagerACLV1SetOpts struct to a map.
+func (opts KeyManagerACLV1SetOpts) ToKeyManagerACLV1SetMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "read")
+}
+
+// KeyManagerACLV1Result is the result of a get request.
+type KeyManagerACLV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a KeyManagerACLV1Result as the read ACL of a secret or
+// container.
+func (r KeyManagerACLV1Result) Extract() (*KeyManagerACLV1, error) {
+	var s struct {
+		Read *KeyManagerACLV1 `json:"read"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Read, err
+}
+
+// keyManagerV1ACLGet retrieves the ACL of a secret or container. The kind is
+// either "secrets" or "containers".
+func keyManagerV1ACLGet(client *gophercloud.ServiceClient, kind, id string) (r KeyManagerACLV1Result) {
+	_, r.Err = client.Get(client.ServiceURL(kind, id, "acl"), &r.Body, nil)
+	return
+}
+
+func keyManagerV1ACLSet(client *gophercloud.ServiceClient, kind, id string, opts KeyManagerACLV1SetOpts) (r gophercloud.ErrResult) {
+	b, err := opts.ToKeyManagerACLV1SetMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL(kind, id, "acl"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// keyManagerV1ACLDelete reverts the ACL of a secret or container to the
+// default, which grants read access to the owning project.
+func keyManagerV1ACLDelete(client *gophercloud.ServiceClient, kind, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL(kind, id, "acl"), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	return
+}
@@ -0,0 +1,89 @@
+// This set of code handles containers of the Key Manager (Barbican) v1 API.
+// A container groups secrets under well-known names, e.g. the certificate,
+// private key and intermediates consumed by TLS terminated load balancer
+// listeners.
+// Gophercloud does not support the Key Manager API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// KeyManagerContainerV1SecretRef is a named reference to a secret of a
+// container.
+type KeyManagerContainerV1SecretRef struct {
+	Name      string `json:"name"`
+	SecretRef string `json:"secret_ref"`
+}
+
+// KeyManagerContainerV1Consumer is a consumer of a container, e.g. a load
+// balancer.
+type KeyManagerContainerV1Consumer struct {
+	Name string `json:"name"`
+	URL  string `json:"URL"`
+}
+
+// KeyManagerContainerV1 is a Key Manager v1 container.
+type KeyManagerContainerV1 struct {
+	ContainerRef string                           `json:"container_ref"`
+	Name         string                           `json:"name"`
+	Type         string                           `json:"type"`
+	Status       string                           `json:"status"`
+	SecretRefs   []KeyManagerContainerV1SecretRef `json:"secret_refs"`
+	Consumers    []KeyManagerContainerV1Consumer  `json:"consumers"`
+	CreatorID    string                           `json:"creator_id"`
+	Created      string                           `json:"created"`
+	Updated      string                           `json:"updated"`
+}
+
+// KeyManagerContainerV1CreateOpts represents the attributes used when
+// creating a new Key Manager v1 container.
+type KeyManagerContainerV1CreateOpts struct {
+	Name       string                           `json:"name,omitempty"`
+	Type       string                           `json:"type" required:"true"`
+	SecretRefs []KeyManagerContainerV1SecretRef `json:"secret_refs,omitempty"`
+}
+
+// ToKeyManagerContainerV1CreateMap casts a KeyManagerContainerV1CreateOpts
+// struct to a map.
+func (opts KeyManagerContainerV1CreateOpts) ToKeyManagerContainerV1CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// KeyManagerContainerV1Result is the result of a create or get request. A
+// create request only returns the container_ref of the new container.
+type KeyManagerContainerV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a KeyManagerContainerV1Result as a
+// KeyManagerContainerV1.
+func (r KeyManagerContainerV1Result) Extract() (*KeyManagerContainerV1, error) {
+	var s *KeyManagerContainerV1
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func keyManagerV1ContainerCreate(client *gophercloud.ServiceClient, opts KeyManagerContainerV1CreateOpts) (r KeyManagerContainerV1Result) {
+	b, err := opts.ToKeyManagerContainerV1CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("containers"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func keyManagerV1ContainerGet(client *gophercloud.ServiceClient, containerID string) (r KeyManagerContainerV1Result) {
+	_, r.Err = client.Get(client.ServiceURL("containers", containerID), &r.Body, nil)
+	return
+}
+
+func keyManagerV1ContainerDelete(client *gophercloud.ServiceClient, containerID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("containers", containerID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,86 @@
+// This set of code handles orders of the Key Manager (Barbican) v1 API.
+// An order has Barbican generate a secret server-side: a symmetric key for
+// orders of type "key", or a key pair stored in an rsa container for orders
+// of type "asymmetric". Orders are processed asynchronously.
+// Gophercloud does not support the Key Manager API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// KeyManagerOrderV1Meta describes the secret generated by an order.
+type KeyManagerOrderV1Meta struct {
+	Name               string `json:"name,omitempty"`
+	Algorithm          string `json:"algorithm,omitempty"`
+	BitLength          int    `json:"bit_length,omitempty"`
+	Mode               string `json:"mode,omitempty"`
+	PayloadContentType string `json:"payload_content_type,omitempty"`
+	Expiration         string `json:"expiration,omitempty"`
+}
+
+// KeyManagerOrderV1 is a Key Manager v1 order.
+type KeyManagerOrderV1 struct {
+	OrderRef         string                `json:"order_ref"`
+	Type             string                `json:"type"`
+	Status           string                `json:"status"`
+	Meta             KeyManagerOrderV1Meta `json:"meta"`
+	SecretRef        string                `json:"secret_ref"`
+	ContainerRef     string                `json:"container_ref"`
+	SubStatus        string                `json:"sub_status"`
+	SubStatusMessage string                `json:"sub_status_message"`
+	ErrorReason      string                `json:"error_reason"`
+	CreatorID        string                `json:"creator_id"`
+	Created          string                `json:"created"`
+	Updated          string                `json:"updated"`
+}
+
+// KeyManagerOrderV1CreateOpts represents the attributes used when creating a
+// new Key Manager v1 order.
+type KeyManagerOrderV1CreateOpts struct {
+	Type string                `json:"type" required:"true"`
+	Meta KeyManagerOrderV1Meta `json:"meta"`
+}
+
+// ToKeyManagerOrderV1CreateMap casts a KeyManagerOrderV1CreateOpts struct to
+// a map.
+func (opts KeyManagerOrderV1CreateOpts) ToKeyManagerOrderV1CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// KeyManagerOrderV1Result is the result of a create or get request. A create
+// request only returns the order_ref of the new order.
+type KeyManagerOrderV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a KeyManagerOrderV1Result as a KeyManagerOrderV1.
+func (r KeyManagerOrderV1Result) Extract() (*KeyManagerOrderV1, error) {
+	var s *KeyManagerOrderV1
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func keyManagerV1OrderCreate(client *gophercloud.ServiceClient, opts KeyManagerOrderV1CreateOpts) (r KeyManagerOrderV1Result) {
+	b, err := opts.ToKeyManagerOrderV1CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("orders"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func keyManagerV1OrderGet(client *gophercloud.ServiceClient, orderID string) (r KeyManagerOrderV1Result) {
+	_, r.Err = client.Get(client.ServiceURL("orders", orderID), &r.Body, nil)
+	return
+}
+
+func keyManagerV1OrderDelete(client *gophercloud.ServiceClient, orderID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("orders", orderID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
@@ -0,0 +1,176 @@
+// This set of code handles secrets of the Key Manager (Barbican) v1 API.
+// Secrets are referenced by their secret_ref, the URL of the secret. The
+// payload of a secret is not part of its metadata and is retrieved
+// separately in one of its content types.
+// Gophercloud does not support the Key Manager API yet.
+package openstack
+
+import (
+	"io/ioutil"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// SecretV1 is a Key Manager v1 secret.
+type SecretV1 struct {
+	SecretRef    string            `json:"secret_ref"`
+	Name         string            `json:"name"`
+	Status       string            `json:"status"`
+	SecretType   string            `json:"secret_type"`
+	Algorithm    string            `json:"algorithm"`
+	BitLength    int               `json:"bit_length"`
+	Mode         string            `json:"mode"`
+	Expiration   string            `json:"expiration"`
+	ContentTypes map[string]string `json:"content_types"`
+	CreatorID    string            `json:"creator_id"`
+	Created      string            `json:"created"`
+	Updated      string            `json:"updated"`
+}
+
+// SecretV1CreateOpts represents the attributes used when creating a new Key
+// Manager v1 secret.
+type SecretV1CreateOpts struct {
+	Name                   string `json:"name,omitempty"`
+	SecretType             string `json:"secret_type,omitempty"`
+	Algorithm              string `json:"algorithm,omitempty"`
+	BitLength              int    `json:"bit_length,omitempty"`
+	Mode                   string `json:"mode,omitempty"`
+	Expiration             string `json:"expiration,omitempty"`
+	Payload                string `json:"payload,omitempty"`
+	PayloadContentType     string `json:"payload_content_type,omitempty"`
+	PayloadContentEncoding string `json:"payload_content_encoding,omitempty"`
+}
+
+// ToSecretV1CreateMap casts a SecretV1CreateOpts struct to a map.
+func (opts SecretV1CreateOpts) ToSecretV1CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// SecretV1Result is the result of a create or get request. A create request
+// only returns the secret_ref of the new secret.
+type SecretV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a SecretV1Result as a SecretV1.
+func (r SecretV1Result) Extract() (*SecretV1, error) {
+	var s *SecretV1
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// SecretV1ListOpts represents the attributes used when listing Key Manager
+// v1 secrets.
+type SecretV1ListOpts struct {
+	Name       string `q:"name"`
+	SecretType string `q:"secret_type"`
+}
+
+// ToSecretV1ListQuery formats a SecretV1ListOpts into a query string.
+func (opts SecretV1ListOpts) ToSecretV1ListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// SecretV1ListResult is the result of a list request.
+type SecretV1ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a SecretV1ListResult as a list of SecretV1.
+func (r SecretV1ListResult) Extract() ([]SecretV1, error) {
+	var s struct {
+		Secrets []SecretV1 `json:"secrets"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Secrets, err
+}
+
+// SecretV1MetadataResult is the result of a metadata get or update request.
+type SecretV1MetadataResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a SecretV1MetadataResult as the metadata of a secret.
+func (r SecretV1MetadataResult) Extract() (map[string]string, error) {
+	var s struct {
+		Metadata map[string]string `json:"metadata"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Metadata, err
+}
+
+func keyManagerV1SecretCreate(client *gophercloud.ServiceClient, opts SecretV1CreateOpts) (r SecretV1Result) {
+	b, err := opts.ToSecretV1CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("secrets"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func keyManagerV1SecretGet(client *gophercloud.ServiceClient, secretID string) (r SecretV1Result) {
+	_, r.Err = client.Get(client.ServiceURL("secrets", secretID), &r.Body, nil)
+	return
+}
+
+func keyManagerV1SecretList(client *gophercloud.ServiceClient, opts SecretV1ListOpts) (r SecretV1ListResult) {
+	query, err := opts.ToSecretV1ListQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(client.ServiceURL("secrets")+query, &r.Body, nil)
+	return
+}
+
+func keyManagerV1SecretDelete(client *gophercloud.ServiceClient, secretID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("secrets", secretID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// keyManagerV1SecretPayload retrieves the raw payload of a secret in the
+// given content type.
+func keyManagerV1SecretPayload(client *gophercloud.ServiceClient, secretID, contentType string) ([]byte, error) {
+	resp, err := client.Get(client.ServiceURL("secrets", secretID, "payload"), nil, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{
+			"Accept": contentType,
+		},
+		OkCodes: []int{200},
+	})
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+func keyManagerV1SecretMetadataGet(client *gophercloud.ServiceClient, secretID string) (r SecretV1MetadataResult) {
+	_, r.Err = client.Get(client.ServiceURL("secrets", secretID, "metadata"), &r.Body, nil)
+	return
+}
+
+// keyManagerV1SecretMetadataUpdate replaces all metadata of a secret.
+func keyManagerV1SecretMetadataUpdate(client *gophercloud.ServiceClient, secretID string, metadata map[string]string) (r SecretV1MetadataResult) {
+	b := map[string]interface{}{
+		"metadata": metadata,
+	}
+	_, r.Err = client.Put(client.ServiceURL("secrets", secretID, "metadata"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 201},
+	})
+	return
+}
+
+// keyManagerV1RefID returns the ID of a secret or container, which is the
+// last part of its secret_ref or container_ref.
+func keyManagerV1RefID(ref string) string {
+	parts := strings.Split(strings.TrimSuffix(ref, "/"), "/")
+	return parts[len(parts)-1]
+}
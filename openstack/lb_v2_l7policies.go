@@ -0,0 +1,223 @@
+// This set of code handles L7 policies and rules of the LBaaS v2 API, which
+// are used by the openstack_lb_l7policy_v2 and openstack_lb_l7rule_v2
+// resources. An L7 policy of a listener redirects or rejects the requests
+// which match all of its rules.
+// Gophercloud does not support L7 policies yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// L7PolicyV2 is an L7 policy of a listener.
+type L7PolicyV2 struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	ListenerID         string `json:"listener_id"`
+	Action             string `json:"action"`
+	Position           int    `json:"position"`
+	RedirectPoolID     string `json:"redirect_pool_id"`
+	RedirectURL        string `json:"redirect_url"`
+	TenantID           string `json:"tenant_id"`
+	AdminStateUp       bool   `json:"admin_state_up"`
+	ProvisioningStatus string `json:"provisioning_status"`
+}
+
+// L7PolicyV2CreateOpts represents the attributes used when creating an L7
+// policy.
+type L7PolicyV2CreateOpts struct {
+	Name           string `json:"name,omitempty"`
+	Description    string `json:"description,omitempty"`
+	ListenerID     string `json:"listener_id" required:"true"`
+	Action         string `json:"action" required:"true"`
+	Position       int    `json:"position,omitempty"`
+	RedirectPoolID string `json:"redirect_pool_id,omitempty"`
+	RedirectURL    string `json:"redirect_url,omitempty"`
+	TenantID       string `json:"tenant_id,omitempty"`
+	AdminStateUp   *bool  `json:"admin_state_up,omitempty"`
+}
+
+// ToL7PolicyV2CreateMap casts a L7PolicyV2CreateOpts struct to a map.
+func (opts L7PolicyV2CreateOpts) ToL7PolicyV2CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "l7policy")
+}
+
+// L7PolicyV2UpdateOpts represents the attributes used when updating an L7
+// policy. The redirect target which doesn't match the action is cleared.
+type L7PolicyV2UpdateOpts struct {
+	Name           *string `json:"name,omitempty"`
+	Description    *string `json:"description,omitempty"`
+	Action         string  `json:"action,omitempty"`
+	Position       int     `json:"position,omitempty"`
+	RedirectPoolID *string `json:"redirect_pool_id,omitempty"`
+	RedirectURL    *string `json:"redirect_url,omitempty"`
+	AdminStateUp   *bool   `json:"admin_state_up,omitempty"`
+}
+
+// ToL7PolicyV2UpdateMap casts a L7PolicyV2UpdateOpts struct to a map.
+func (opts L7PolicyV2UpdateOpts) ToL7PolicyV2UpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "l7policy")
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty redirect target is sent as null, which clears it.
+	l7policy := b["l7policy"].(map[string]interface{})
+	if opts.RedirectPoolID != nil && *opts.RedirectPoolID == "" {
+		l7policy["redirect_pool_id"] = nil
+	}
+	if opts.RedirectURL != nil && *opts.RedirectURL == "" {
+		l7policy["redirect_url"] = nil
+	}
+
+	return b, nil
+}
+
+// L7PolicyV2Result is the result of a create, get or update request.
+type L7PolicyV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a L7PolicyV2Result as a L7PolicyV2.
+func (r L7PolicyV2Result) Extract() (*L7PolicyV2, error) {
+	var s struct {
+		L7Policy *L7PolicyV2 `json:"l7policy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.L7Policy, err
+}
+
+func lbV2L7PolicyCreate(client *gophercloud.ServiceClient, opts L7PolicyV2CreateOpts) (r L7PolicyV2Result) {
+	b, err := opts.ToL7PolicyV2CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("lbaas", "l7policies"), b, &r.Body, nil)
+	return
+}
+
+func lbV2L7PolicyGet(client *gophercloud.ServiceClient, id string) (r L7PolicyV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("lbaas", "l7policies", id), &r.Body, nil)
+	return
+}
+
+func lbV2L7PolicyUpdate(client *gophercloud.ServiceClient, id string, opts L7PolicyV2UpdateOpts) (r L7PolicyV2Result) {
+	b, err := opts.ToL7PolicyV2UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("lbaas", "l7policies", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+func lbV2L7PolicyDelete(client *gophercloud.ServiceClient, id string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("lbaas", "l7policies", id), nil)
+	return
+}
+
+// L7RuleV2 is a rule of an L7 policy.
+type L7RuleV2 struct {
+	ID                 string `json:"id"`
+	Type               string `json:"type"`
+	CompareType        string `json:"compare_type"`
+	Key                string `json:"key"`
+	Value              string `json:"value"`
+	Invert             bool   `json:"invert"`
+	TenantID           string `json:"tenant_id"`
+	AdminStateUp       bool   `json:"admin_state_up"`
+	ProvisioningStatus string `json:"provisioning_status"`
+}
+
+// L7RuleV2CreateOpts represents the attributes used when creating a rule of
+// an L7 policy.
+type L7RuleV2CreateOpts struct {
+	Type         string `json:"type" required:"true"`
+	CompareType  string `json:"compare_type" required:"true"`
+	Key          string `json:"key,omitempty"`
+	Value        string `json:"value" required:"true"`
+	Invert       bool   `json:"invert,omitempty"`
+	TenantID     string `json:"tenant_id,omitempty"`
+	AdminStateUp *bool  `json:"admin_state_up,omitempty"`
+}
+
+// ToL7RuleV2CreateMap casts a L7RuleV2CreateOpts struct to a map.
+func (opts L7RuleV2CreateOpts) ToL7RuleV2CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "rule")
+}
+
+// L7RuleV2UpdateOpts represents the attributes used when updating a rule of
+// an L7 policy.
+type L7RuleV2UpdateOpts struct {
+	Type         string  `json:"type,omitempty"`
+	CompareType  string  `json:"compare_type,omitempty"`
+	Key          *string `json:"key,omitempty"`
+	Value        string  `json:"value,omitempty"`
+	Invert       *bool   `json:"invert,omitempty"`
+	AdminStateUp *bool   `json:"admin_state_up,omitempty"`
+}
+
+// ToL7RuleV2UpdateMap casts a L7RuleV2UpdateOpts struct to a map.
+func (opts L7RuleV2UpdateOpts) ToL7RuleV2UpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "rule")
+	if err != nil {
+		return nil, err
+	}
+
+	// An empty key is sent as null, which clears it.
+	if opts.Key != nil && *opts.Key == "" {
+		b["rule"].(map[string]interface{})["key"] = nil
+	}
+
+	return b, nil
+}
+
+// L7RuleV2Result is the result of a create, get or update request.
+type L7RuleV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a L7RuleV2Result as a L7RuleV2.
+func (r L7RuleV2Result) Extract() (*L7RuleV2, error) {
+	var s struct {
+		Rule *L7RuleV2 `json:"rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Rule, err
+}
+
+func lbV2L7RuleCreate(client *gophercloud.ServiceClient, policyID string, opts L7RuleV2CreateOpts) (r L7RuleV2Result) {
+	b, err := opts.ToL7RuleV2CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("lbaas", "l7policies", policyID, "rules"), b, &r.Body, nil)
+	return
+}
+
+func lbV2L7RuleGet(client *gophercloud.ServiceClient, policyID, ruleID string) (r L7RuleV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("lbaas", "l7policies", policyID, "rules", ruleID), &r.Body, nil)
+	return
+}
+
+func lbV2L7RuleUpdate(client *gophercloud.ServiceClient, policyID, ruleID string, opts L7RuleV2UpdateOpts) (r L7RuleV2Result) {
+	b, err := opts.ToL7RuleV2UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("lbaas", "l7policies", policyID, "rules", ruleID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+func lbV2L7RuleDelete(client *gophercloud.ServiceClient, policyID, ruleID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("lbaas", "l7policies", policyID, "rules", ruleID), nil)
+	return
+}
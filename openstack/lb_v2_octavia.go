@@ -0,0 +1,519 @@
+// This set of code handles the parts of the Octavia API which are not
+// available in Neutron LBaaS v2. Octavia is the LBaaS v2 API served by the
+// load-balancer endpoint. Unlike Neutron LBaaS v2, it reports the
+// provisioning status of listeners, pools, members and monitors, load
+// balancers can be created with a flavor and in an availability zone, flavors
+// and amphorae can be listed, and the TLS ciphers, versions, timeouts, allowed
+// CIDRs and inserted headers of listeners and the retries of monitors can be
+// set.
+// Gophercloud only supports the Neutron LBaaS v2 API, so the Octavia
+// attributes are added here.
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/listeners"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/loadbalancers"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/monitors"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+// LoadBalancerV2CreateOpts represents the attributes used when creating a
+// load balancer, including the ones which are only supported by Octavia.
+type LoadBalancerV2CreateOpts struct {
+	loadbalancers.CreateOptsBuilder
+
+	// FlavorID is the ID of the Octavia flavor of the load balancer.
+	FlavorID string
+
+	// AvailabilityZone is the Octavia availability zone the load balancer
+	// is created in.
+	AvailabilityZone string
+}
+
+// ToLoadBalancerCreateMap casts a LoadBalancerV2CreateOpts struct to a map.
+func (opts LoadBalancerV2CreateOpts) ToLoadBalancerCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOptsBuilder.ToLoadBalancerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	lb := b["loadbalancer"].(map[string]interface{})
+	if opts.FlavorID != "" {
+		lb["flavor_id"] = opts.FlavorID
+	}
+	if opts.AvailabilityZone != "" {
+		lb["availability_zone"] = opts.AvailabilityZone
+	}
+
+	return b, nil
+}
+
+// LoadBalancerV2Octavia holds the attributes of a load balancer which are
+// only returned by Octavia.
+type LoadBalancerV2Octavia struct {
+	FlavorID         string `json:"flavor_id"`
+	AvailabilityZone string `json:"availability_zone"`
+}
+
+// lbV2OctaviaLoadBalancer extracts the Octavia attributes of a load balancer
+// from the result of a loadbalancers.Get request.
+func lbV2OctaviaLoadBalancer(r loadbalancers.GetResult) (*LoadBalancerV2Octavia, error) {
+	var s struct {
+		LoadBalancer *LoadBalancerV2Octavia `json:"loadbalancer"`
+	}
+	err := r.ExtractInto(&s)
+	return s.LoadBalancer, err
+}
+
+// lbV2ProvisioningStatus extracts the provisioning status of a listener,
+// pool, member or monitor from the result of a get request. Neutron LBaaS v2
+// doesn't report the status of these, so ACTIVE is returned in that case,
+// as a successful get is the best that can be done.
+func lbV2ProvisioningStatus(r gophercloud.Result, label string) (string, error) {
+	var s map[string]struct {
+		ProvisioningStatus string `json:"provisioning_status"`
+	}
+	if err := r.ExtractInto(&s); err != nil {
+		return "", err
+	}
+
+	if status := s[label].ProvisioningStatus; status != "" {
+		return status, nil
+	}
+	return "ACTIVE", nil
+}
+
+// lbV2CheckOctavia returns an error if Octavia-only arguments are set while
+// the provider doesn't use the Octavia endpoint.
+func lbV2CheckOctavia(config *Config, args ...string) error {
+	if config.useOctavia || len(args) == 0 {
+		return nil
+	}
+	return fmt.Errorf("%s can only be used if use_octavia is set in the provider", strings.Join(args, ", "))
+}
+
+// ListenerV2CreateOpts represents the attributes used when creating a
+// listener, including the TLS settings which are only supported by Octavia.
+type ListenerV2CreateOpts struct {
+	listeners.CreateOptsBuilder
+
+	// TLSCiphers is the OpenSSL cipher string of a TERMINATED_HTTPS
+	// listener.
+	TLSCiphers string
+
+	// TLSVersions are the TLS protocol versions a TERMINATED_HTTPS listener
+	// accepts.
+	TLSVersions []string
+
+	// The timeouts of a listener are in milliseconds. Unset timeouts use the
+	// defaults of Octavia.
+	TimeoutClientData    *int
+	TimeoutMemberConnect *int
+	TimeoutMemberData    *int
+	TimeoutTCPInspect    *int
+
+	// AllowedCIDRs restricts the clients of a listener to these CIDRs.
+	AllowedCIDRs []string
+
+	// InsertHeaders are the headers, e.g. X-Forwarded-For, which are
+	// inserted into requests before they are sent to the members.
+	InsertHeaders map[string]string
+}
+
+// ToListenerCreateMap casts a ListenerV2CreateOpts struct to a map.
+func (opts ListenerV2CreateOpts) ToListenerCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOptsBuilder.ToListenerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	listener := b["listener"].(map[string]interface{})
+	if opts.TLSCiphers != "" {
+		listener["tls_ciphers"] = opts.TLSCiphers
+	}
+	if len(opts.TLSVersions) > 0 {
+		listener["tls_versions"] = opts.TLSVersions
+	}
+	if opts.TimeoutClientData != nil {
+		listener["timeout_client_data"] = *opts.TimeoutClientData
+	}
+	if opts.TimeoutMemberConnect != nil {
+		listener["timeout_member_connect"] = *opts.TimeoutMemberConnect
+	}
+	if opts.TimeoutMemberData != nil {
+		listener["timeout_member_data"] = *opts.TimeoutMemberData
+	}
+	if opts.TimeoutTCPInspect != nil {
+		listener["timeout_tcp_inspect"] = *opts.TimeoutTCPInspect
+	}
+	if len(opts.AllowedCIDRs) > 0 {
+		listener["allowed_cidrs"] = opts.AllowedCIDRs
+	}
+	if len(opts.InsertHeaders) > 0 {
+		listener["insert_headers"] = opts.InsertHeaders
+	}
+
+	return b, nil
+}
+
+// ListenerV2UpdateOpts represents the attributes used when updating a
+// listener. Unlike listeners.UpdateOpts, it allows the TLS container
+// references to be cleared.
+type ListenerV2UpdateOpts struct {
+	listeners.UpdateOpts
+
+	// DefaultTLSContainerRef is cleared if it points to an empty string.
+	DefaultTLSContainerRef *string
+
+	// SNIContainerRefs are cleared if they point to an empty list.
+	SNIContainerRefs *[]string
+
+	// The following attributes are only supported by Octavia. TLSCiphers
+	// and TLSVersions are reset to the defaults of Octavia if they point to
+	// an empty string or list, and AllowedCIDRs and InsertHeaders are
+	// cleared if they point to an empty list or map.
+	TLSCiphers           *string
+	TLSVersions          *[]string
+	TimeoutClientData    *int
+	TimeoutMemberConnect *int
+	TimeoutMemberData    *int
+	TimeoutTCPInspect    *int
+	AllowedCIDRs         *[]string
+	InsertHeaders        *map[string]string
+}
+
+// ToListenerUpdateMap casts a ListenerV2UpdateOpts struct to a map.
+func (opts ListenerV2UpdateOpts) ToListenerUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOpts.ToListenerUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	listener := b["listener"].(map[string]interface{})
+	if opts.DefaultTLSContainerRef != nil {
+		if *opts.DefaultTLSContainerRef == "" {
+			listener["default_tls_container_ref"] = nil
+		} else {
+			listener["default_tls_container_ref"] = *opts.DefaultTLSContainerRef
+		}
+	}
+	if opts.SNIContainerRefs != nil {
+		listener["sni_container_refs"] = *opts.SNIContainerRefs
+	}
+	if opts.TLSCiphers != nil {
+		if *opts.TLSCiphers == "" {
+			listener["tls_ciphers"] = nil
+		} else {
+			listener["tls_ciphers"] = *opts.TLSCiphers
+		}
+	}
+	if opts.TLSVersions != nil {
+		if len(*opts.TLSVersions) == 0 {
+			listener["tls_versions"] = nil
+		} else {
+			listener["tls_versions"] = *opts.TLSVersions
+		}
+	}
+	if opts.TimeoutClientData != nil {
+		listener["timeout_client_data"] = *opts.TimeoutClientData
+	}
+	if opts.TimeoutMemberConnect != nil {
+		listener["timeout_member_connect"] = *opts.TimeoutMemberConnect
+	}
+	if opts.TimeoutMemberData != nil {
+		listener["timeout_member_data"] = *opts.TimeoutMemberData
+	}
+	if opts.TimeoutTCPInspect != nil {
+		listener["timeout_tcp_inspect"] = *opts.TimeoutTCPInspect
+	}
+	if opts.AllowedCIDRs != nil {
+		listener["allowed_cidrs"] = *opts.AllowedCIDRs
+	}
+	if opts.InsertHeaders != nil {
+		listener["insert_headers"] = *opts.InsertHeaders
+	}
+
+	return b, nil
+}
+
+func lbV2ListenerUpdate(client *gophercloud.ServiceClient, id string, opts ListenerV2UpdateOpts) (r listeners.UpdateResult) {
+	b, err := opts.ToListenerUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("lbaas", "listeners", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// PoolV2UpdateOpts represents the attributes used when updating a pool.
+// Unlike pools.UpdateOpts, it allows the session persistence of a pool to be
+// changed.
+type PoolV2UpdateOpts struct {
+	pools.UpdateOpts
+
+	// Persistence replaces the session persistence of the pool if set.
+	Persistence *pools.SessionPersistence
+
+	// ClearPersistence disables the session persistence of the pool.
+	ClearPersistence bool
+}
+
+// ToPoolUpdateMap casts a PoolV2UpdateOpts struct to a map.
+func (opts PoolV2UpdateOpts) ToPoolUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOpts.ToPoolUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	pool := b["pool"].(map[string]interface{})
+	if opts.Persistence != nil {
+		persistence, err := gophercloud.BuildRequestBody(opts.Persistence, "")
+		if err != nil {
+			return nil, err
+		}
+		pool["session_persistence"] = persistence
+	} else if opts.ClearPersistence {
+		pool["session_persistence"] = nil
+	}
+
+	return b, nil
+}
+
+func lbV2PoolUpdate(client *gophercloud.ServiceClient, id string, opts PoolV2UpdateOpts) (r pools.UpdateResult) {
+	b, err := opts.ToPoolUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("lbaas", "pools", id), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200, 202},
+	})
+	return
+}
+
+// ListenerV2Octavia holds the attributes of a listener which are only
+// returned by Octavia.
+type ListenerV2Octavia struct {
+	TLSCiphers           string            `json:"tls_ciphers"`
+	TLSVersions          []string          `json:"tls_versions"`
+	TimeoutClientData    int               `json:"timeout_client_data"`
+	TimeoutMemberConnect int               `json:"timeout_member_connect"`
+	TimeoutMemberData    int               `json:"timeout_member_data"`
+	TimeoutTCPInspect    int               `json:"timeout_tcp_inspect"`
+	AllowedCIDRs         []string          `json:"allowed_cidrs"`
+	InsertHeaders        map[string]string `json:"insert_headers"`
+}
+
+// lbV2OctaviaListener extracts the Octavia attributes of a listener from the
+// result of a listeners.Get request.
+func lbV2OctaviaListener(r listeners.GetResult) (*ListenerV2Octavia, error) {
+	var s struct {
+		Listener *ListenerV2Octavia `json:"listener"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Listener, err
+}
+
+// MemberV2BatchUpdateOpts represents a member of a pool in a batch update of
+// its members. Existing members are matched by address and protocol port.
+type MemberV2BatchUpdateOpts struct {
+	Name         string `json:"name,omitempty"`
+	Address      string `json:"address" required:"true"`
+	ProtocolPort int    `json:"protocol_port" required:"true"`
+	Weight       *int   `json:"weight,omitempty"`
+	SubnetID     string `json:"subnet_id,omitempty"`
+	AdminStateUp *bool  `json:"admin_state_up,omitempty"`
+}
+
+// lbV2MembersBatchUpdate replaces all members of a pool with the given
+// members in a single request. Members which are not listed are deleted.
+func lbV2MembersBatchUpdate(client *gophercloud.ServiceClient, poolID string, opts []MemberV2BatchUpdateOpts) (r gophercloud.ErrResult) {
+	members := make([]interface{}, len(opts))
+	for i, opt := range opts {
+		b, err := gophercloud.BuildRequestBody(opt, "")
+		if err != nil {
+			r.Err = err
+			return
+		}
+		members[i] = b
+	}
+
+	b := map[string]interface{}{
+		"members": members,
+	}
+	_, r.Err = client.Put(client.ServiceURL("lbaas", "pools", poolID, "members"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// MonitorV2CreateOpts represents the attributes used when creating a
+// monitor, including the ones which are only supported by Octavia.
+type MonitorV2CreateOpts struct {
+	monitors.CreateOptsBuilder
+
+	// MaxRetriesDown is the number of failed checks after which a member is
+	// set to ERROR.
+	MaxRetriesDown int
+}
+
+// ToMonitorCreateMap casts a MonitorV2CreateOpts struct to a map.
+func (opts MonitorV2CreateOpts) ToMonitorCreateMap() (map[string]interface{}, error) {
+	b, err := opts.CreateOptsBuilder.ToMonitorCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.MaxRetriesDown != 0 {
+		b["healthmonitor"].(map[string]interface{})["max_retries_down"] = opts.MaxRetriesDown
+	}
+
+	return b, nil
+}
+
+// MonitorV2UpdateOpts represents the attributes used when updating a
+// monitor, including the ones which are only supported by Octavia.
+type MonitorV2UpdateOpts struct {
+	monitors.UpdateOptsBuilder
+
+	MaxRetriesDown int
+}
+
+// ToMonitorUpdateMap casts a MonitorV2UpdateOpts struct to a map.
+func (opts MonitorV2UpdateOpts) ToMonitorUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOptsBuilder.ToMonitorUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.MaxRetriesDown != 0 {
+		b["healthmonitor"].(map[string]interface{})["max_retries_down"] = opts.MaxRetriesDown
+	}
+
+	return b, nil
+}
+
+// MonitorV2Octavia holds the attributes of a monitor which are only returned
+// by Octavia.
+type MonitorV2Octavia struct {
+	MaxRetriesDown int `json:"max_retries_down"`
+}
+
+// lbV2OctaviaMonitor extracts the Octavia attributes of a monitor from the
+// result of a monitors.Get request.
+func lbV2OctaviaMonitor(r monitors.GetResult) (*MonitorV2Octavia, error) {
+	var s struct {
+		Monitor *MonitorV2Octavia `json:"healthmonitor"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Monitor, err
+}
+
+// FlavorV2 is an Octavia flavor. A flavor selects the topology, e.g.
+// SINGLE or ACTIVE_STANDBY, and other settings of the load balancers created
+// with it.
+type FlavorV2 struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	Description     string `json:"description"`
+	FlavorProfileID string `json:"flavor_profile_id"`
+	Enabled         bool   `json:"enabled"`
+}
+
+// FlavorV2ListOpts represents the attributes used when listing Octavia
+// flavors.
+type FlavorV2ListOpts struct {
+	ID   string `q:"id"`
+	Name string `q:"name"`
+}
+
+// ToFlavorV2ListQuery formats a FlavorV2ListOpts into a query string.
+func (opts FlavorV2ListOpts) ToFlavorV2ListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// FlavorV2ListResult is the result of a list request.
+type FlavorV2ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a FlavorV2ListResult as a list of FlavorV2.
+func (r FlavorV2ListResult) Extract() ([]FlavorV2, error) {
+	var s struct {
+		Flavors []FlavorV2 `json:"flavors"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Flavors, err
+}
+
+func lbV2FlavorList(client *gophercloud.ServiceClient, opts FlavorV2ListOpts) (r FlavorV2ListResult) {
+	query, err := opts.ToFlavorV2ListQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(client.ServiceURL("lbaas", "flavors")+query, &r.Body, nil)
+	return
+}
+
+// AmphoraV2 is an Octavia amphora, one of the instances which serve a load
+// balancer.
+type AmphoraV2 struct {
+	ID             string `json:"id"`
+	LoadBalancerID string `json:"loadbalancer_id"`
+	ComputeID      string `json:"compute_id"`
+	Role           string `json:"role"`
+	Status         string `json:"status"`
+	LBNetworkIP    string `json:"lb_network_ip"`
+	VRRPIP         string `json:"vrrp_ip"`
+	HAIP           string `json:"ha_ip"`
+	VRRPPortID     string `json:"vrrp_port_id"`
+	HAPortID       string `json:"ha_port_id"`
+	CertExpiration string `json:"cert_expiration"`
+}
+
+// AmphoraV2ListOpts represents the attributes used when listing Octavia
+// amphorae.
+type AmphoraV2ListOpts struct {
+	LoadBalancerID string `q:"loadbalancer_id"`
+	Role           string `q:"role"`
+}
+
+// ToAmphoraV2ListQuery formats an AmphoraV2ListOpts into a query string.
+func (opts AmphoraV2ListOpts) ToAmphoraV2ListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// AmphoraV2ListResult is the result of a list request.
+type AmphoraV2ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an AmphoraV2ListResult as a list of AmphoraV2.
+func (r AmphoraV2ListResult) Extract() ([]AmphoraV2, error) {
+	var s struct {
+		Amphorae []AmphoraV2 `json:"amphorae"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Amphorae, err
+}
+
+// lbV2AmphoraList lists amphorae. It is an admin only request.
+func lbV2AmphoraList(client *gophercloud.ServiceClient, opts AmphoraV2ListOpts) (r AmphoraV2ListResult) {
+	query, err := opts.ToAmphoraV2ListQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(client.ServiceURL("octavia", "amphorae")+query, &r.Body, nil)
+	return
+}
@@ -45,13 +45,18 @@ func waitForLBV2Listener(networkingClient *gophercloud.ServiceClient, id string,
 
 func resourceLBV2ListenerRefreshFunc(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		listener, err := listeners.Get(networkingClient, id).Extract()
+		r := listeners.Get(networkingClient, id)
+		listener, err := r.Extract()
 		if err != nil {
 			return nil, "", err
 		}
 
-		// The listener resource has no Status attribute, so a successful Get is the best we can do
-		return listener, "ACTIVE", nil
+		status, err := lbV2ProvisioningStatus(r.Result, "listener")
+		if err != nil {
+			return nil, "", err
+		}
+
+		return listener, status, nil
 	}
 }
 
@@ -124,13 +129,18 @@ func waitForLBV2Member(networkingClient *gophercloud.ServiceClient, poolID, memb
 
 func resourceLBV2MemberRefreshFunc(networkingClient *gophercloud.ServiceClient, poolID, memberID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		member, err := pools.GetMember(networkingClient, poolID, memberID).Extract()
+		r := pools.GetMember(networkingClient, poolID, memberID)
+		member, err := r.Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		status, err := lbV2ProvisioningStatus(r.Result, "member")
 		if err != nil {
 			return nil, "", err
 		}
 
-		// The member resource has no Status attribute, so a successful Get is the best we can do
-		return member, "ACTIVE", nil
+		return member, status, nil
 	}
 }
 
@@ -164,13 +174,18 @@ func waitForLBV2Monitor(networkingClient *gophercloud.ServiceClient, id string,
 
 func resourceLBV2MonitorRefreshFunc(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		monitor, err := monitors.Get(networkingClient, id).Extract()
+		r := monitors.Get(networkingClient, id)
+		monitor, err := r.Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		status, err := lbV2ProvisioningStatus(r.Result, "healthmonitor")
 		if err != nil {
 			return nil, "", err
 		}
 
-		// The monitor resource has no Status attribute, so a successful Get is the best we can do
-		return monitor, "ACTIVE", nil
+		return monitor, status, nil
 	}
 }
 
@@ -204,13 +219,18 @@ func waitForLBV2Pool(networkingClient *gophercloud.ServiceClient, id string, tar
 
 func resourceLBV2PoolRefreshFunc(networkingClient *gophercloud.ServiceClient, poolID string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
-		pool, err := pools.Get(networkingClient, poolID).Extract()
+		r := pools.Get(networkingClient, poolID)
+		pool, err := r.Extract()
 		if err != nil {
 			return nil, "", err
 		}
 
-		// The pool resource has no Status attribute, so a successful Get is the best we can do
-		return pool, "ACTIVE", nil
+		status, err := lbV2ProvisioningStatus(r.Result, "pool")
+		if err != nil {
+			return nil, "", err
+		}
+
+		return pool, status, nil
 	}
 }
 
@@ -249,3 +269,18 @@ func chooseLBV2Client(d *schema.ResourceData, config *Config) (*gophercloud.Serv
 	}
 	return config.networkingV2Client(GetRegion(d, config))
 }
+
+// lbV2ListenerLoadBalancerID returns the ID of the load balancer of a
+// listener.
+func lbV2ListenerLoadBalancerID(lbClient *gophercloud.ServiceClient, listenerID string) (string, error) {
+	listener, err := listeners.Get(lbClient, listenerID).Extract()
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving listener %s: %s", listenerID, err)
+	}
+
+	if len(listener.Loadbalancers) == 0 {
+		return "", fmt.Errorf("No Load Balancer on listener %s", listenerID)
+	}
+
+	return listener.Loadbalancers[0].ID, nil
+}
@@ -0,0 +1,142 @@
+// This set of code handles the address groups of the Networking service,
+// which can be used as the remote of security group rules.
+// Gophercloud does not support address groups yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/rules"
+)
+
+// AddressGroup is a Networking address group.
+type AddressGroup struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Addresses   []string `json:"addresses"`
+	ProjectID   string   `json:"project_id"`
+}
+
+// AddressGroupCreateOpts represents the attributes used when creating a new
+// address group.
+type AddressGroupCreateOpts struct {
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Addresses   []string `json:"addresses"`
+	ProjectID   string   `json:"project_id,omitempty"`
+}
+
+// ToAddressGroupCreateMap casts an AddressGroupCreateOpts struct to a map.
+func (opts AddressGroupCreateOpts) ToAddressGroupCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "address_group")
+}
+
+// AddressGroupUpdateOpts represents the attributes used when updating an
+// existing address group. The addresses of an address group are updated
+// with networkingV2AddressGroupAddAddresses and
+// networkingV2AddressGroupRemoveAddresses.
+type AddressGroupUpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToAddressGroupUpdateMap casts an AddressGroupUpdateOpts struct to a map.
+func (opts AddressGroupUpdateOpts) ToAddressGroupUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "address_group")
+}
+
+// AddressGroupResult is the result of a create, get, update, add addresses
+// or remove addresses request.
+type AddressGroupResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an AddressGroupResult as an AddressGroup.
+func (r AddressGroupResult) Extract() (*AddressGroup, error) {
+	var s struct {
+		AddressGroup *AddressGroup `json:"address_group"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AddressGroup, err
+}
+
+func networkingV2AddressGroupCreate(client *gophercloud.ServiceClient, opts AddressGroupCreateOpts) (r AddressGroupResult) {
+	b, err := opts.ToAddressGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("address-groups"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2AddressGroupGet(client *gophercloud.ServiceClient, addressGroupID string) (r AddressGroupResult) {
+	_, r.Err = client.Get(client.ServiceURL("address-groups", addressGroupID), &r.Body, nil)
+	return
+}
+
+func networkingV2AddressGroupUpdate(client *gophercloud.ServiceClient, addressGroupID string, opts AddressGroupUpdateOpts) (r AddressGroupResult) {
+	b, err := opts.ToAddressGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("address-groups", addressGroupID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2AddressGroupAddAddresses(client *gophercloud.ServiceClient, addressGroupID string, addresses []string) (r AddressGroupResult) {
+	b := map[string]interface{}{
+		"addresses": addresses,
+	}
+	_, r.Err = client.Put(client.ServiceURL("address-groups", addressGroupID, "add_addresses"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2AddressGroupRemoveAddresses(client *gophercloud.ServiceClient, addressGroupID string, addresses []string) (r AddressGroupResult) {
+	b := map[string]interface{}{
+		"addresses": addresses,
+	}
+	_, r.Err = client.Put(client.ServiceURL("address-groups", addressGroupID, "remove_addresses"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2AddressGroupDelete(client *gophercloud.ServiceClient, addressGroupID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("address-groups", addressGroupID), nil)
+	return
+}
+
+// SecGroupRuleCreateOpts represents the attributes used when creating a new
+// security group rule.
+type SecGroupRuleCreateOpts struct {
+	rules.CreateOpts
+	RemoteAddressGroupID string `json:"remote_address_group_id,omitempty"`
+}
+
+// ToSecGroupRuleCreateMap casts a CreateOpts struct to a map.
+// It overrides rules.ToSecGroupRuleCreateMap to add the RemoteAddressGroupID
+// field.
+func (opts SecGroupRuleCreateOpts) ToSecGroupRuleCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "security_group_rule")
+}
+
+// networkingV2SecGroupRuleRemoteAddressGroupID extracts the remote address
+// group ID of a security group rule, which is not exposed by Gophercloud's
+// SecGroupRule.
+func networkingV2SecGroupRuleRemoteAddressGroupID(r rules.GetResult) (string, error) {
+	var s struct {
+		SecGroupRule struct {
+			RemoteAddressGroupID string `json:"remote_address_group_id"`
+		} `json:"security_group_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.SecGroupRule.RemoteAddressGroupID, err
+}
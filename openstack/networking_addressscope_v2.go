@@ -0,0 +1,91 @@
+// This set of code handles the address scopes of the Networking service.
+// Gophercloud does not support address scopes yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// AddressScope is a Networking address scope.
+type AddressScope struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	IPVersion int    `json:"ip_version"`
+	Shared    bool   `json:"shared"`
+	TenantID  string `json:"tenant_id"`
+}
+
+// AddressScopeCreateOpts represents the attributes used when creating a new
+// address scope.
+type AddressScopeCreateOpts struct {
+	Name       string            `json:"name"`
+	IPVersion  int               `json:"ip_version"`
+	Shared     bool              `json:"shared,omitempty"`
+	TenantID   string            `json:"tenant_id,omitempty"`
+	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToAddressScopeCreateMap casts an AddressScopeCreateOpts struct to a map.
+func (opts AddressScopeCreateOpts) ToAddressScopeCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "address_scope")
+}
+
+// AddressScopeUpdateOpts represents the attributes used when updating an
+// existing address scope.
+type AddressScopeUpdateOpts struct {
+	Name   *string `json:"name,omitempty"`
+	Shared *bool   `json:"shared,omitempty"`
+}
+
+// ToAddressScopeUpdateMap casts an AddressScopeUpdateOpts struct to a map.
+func (opts AddressScopeUpdateOpts) ToAddressScopeUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "address_scope")
+}
+
+// AddressScopeResult is the result of a create, get or update request.
+type AddressScopeResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an AddressScopeResult as an AddressScope.
+func (r AddressScopeResult) Extract() (*AddressScope, error) {
+	var s struct {
+		AddressScope *AddressScope `json:"address_scope"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AddressScope, err
+}
+
+func networkingV2AddressScopeCreate(client *gophercloud.ServiceClient, opts AddressScopeCreateOpts) (r AddressScopeResult) {
+	b, err := opts.ToAddressScopeCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("address-scopes"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2AddressScopeGet(client *gophercloud.ServiceClient, addressScopeID string) (r AddressScopeResult) {
+	_, r.Err = client.Get(client.ServiceURL("address-scopes", addressScopeID), &r.Body, nil)
+	return
+}
+
+func networkingV2AddressScopeUpdate(client *gophercloud.ServiceClient, addressScopeID string, opts AddressScopeUpdateOpts) (r AddressScopeResult) {
+	b, err := opts.ToAddressScopeUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("address-scopes", addressScopeID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2AddressScopeDelete(client *gophercloud.ServiceClient, addressScopeID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("address-scopes", addressScopeID), nil)
+	return
+}
@@ -0,0 +1,79 @@
+// This set of code handles the agents of the Networking service, such as the
+// DHCP and L3 agents, and the scheduling of routers to L3 agents.
+// Gophercloud does not support agents yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// Agent is a Networking agent.
+type Agent struct {
+	ID               string `json:"id"`
+	AgentType        string `json:"agent_type"`
+	Binary           string `json:"binary"`
+	Host             string `json:"host"`
+	Topic            string `json:"topic"`
+	AvailabilityZone string `json:"availability_zone"`
+	Description      string `json:"description"`
+	Alive            bool   `json:"alive"`
+	AdminStateUp     bool   `json:"admin_state_up"`
+}
+
+// AgentListOpts represents the attributes used to filter a list of agents.
+type AgentListOpts struct {
+	AgentType        string `q:"agent_type"`
+	Binary           string `q:"binary"`
+	Host             string `q:"host"`
+	AvailabilityZone string `q:"availability_zone"`
+}
+
+// ToAgentListQuery formats an AgentListOpts into a query string.
+func (opts AgentListOpts) ToAgentListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// AgentListResult is the result of a list request.
+type AgentListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an AgentListResult as a list of Agents.
+func (r AgentListResult) Extract() ([]Agent, error) {
+	var s struct {
+		Agents []Agent `json:"agents"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Agents, err
+}
+
+func networkingV2AgentList(client *gophercloud.ServiceClient, opts AgentListOpts) (r AgentListResult) {
+	query, err := opts.ToAgentListQuery()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Get(client.ServiceURL("agents")+query, &r.Body, nil)
+	return
+}
+
+func networkingV2RouterL3AgentList(client *gophercloud.ServiceClient, routerID string) (r AgentListResult) {
+	_, r.Err = client.Get(client.ServiceURL("routers", routerID, "l3-agents"), &r.Body, nil)
+	return
+}
+
+func networkingV2L3AgentAddRouter(client *gophercloud.ServiceClient, agentID, routerID string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"router_id": routerID,
+	}
+	_, r.Err = client.Post(client.ServiceURL("agents", agentID, "l3-routers"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2L3AgentRemoveRouter(client *gophercloud.ServiceClient, agentID, routerID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("agents", agentID, "l3-routers", routerID), nil)
+	return
+}
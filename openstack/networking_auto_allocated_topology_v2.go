@@ -0,0 +1,43 @@
+// This set of code handles the auto-allocated topology of the Networking
+// service, which provides a project with a ready-made network, subnets and
+// router connected to the default external network.
+// Gophercloud does not support the auto-allocated topology yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// AutoAllocatedTopology is the auto-allocated topology of a project. Its ID
+// is the ID of the auto-allocated network.
+type AutoAllocatedTopology struct {
+	ID       string `json:"id"`
+	TenantID string `json:"tenant_id"`
+}
+
+// AutoAllocatedTopologyResult is the result of a get request.
+type AutoAllocatedTopologyResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an AutoAllocatedTopologyResult as an
+// AutoAllocatedTopology.
+func (r AutoAllocatedTopologyResult) Extract() (*AutoAllocatedTopology, error) {
+	var s struct {
+		AutoAllocatedTopology *AutoAllocatedTopology `json:"auto_allocated_topology"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AutoAllocatedTopology, err
+}
+
+// networkingV2AutoAllocatedTopologyGet returns the auto-allocated topology of
+// a project, which is allocated by the request if it doesn't exist yet.
+func networkingV2AutoAllocatedTopologyGet(client *gophercloud.ServiceClient, projectID string) (r AutoAllocatedTopologyResult) {
+	_, r.Err = client.Get(client.ServiceURL("auto-allocated-topology", projectID), &r.Body, nil)
+	return
+}
+
+func networkingV2AutoAllocatedTopologyDelete(client *gophercloud.ServiceClient, projectID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("auto-allocated-topology", projectID), nil)
+	return
+}
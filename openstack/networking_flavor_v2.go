@@ -0,0 +1,200 @@
+// This set of code handles the service flavors and service profiles of the
+// Networking service, which select the driver of an advanced service such as
+// LBaaS or VPNaaS per logical resource.
+// Gophercloud does not support service flavors yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// NetworkingFlavor is a Networking service flavor. It is unrelated to
+// Compute flavors.
+type NetworkingFlavor struct {
+	ID                string   `json:"id"`
+	Name              string   `json:"name"`
+	Description       string   `json:"description"`
+	ServiceType       string   `json:"service_type"`
+	Enabled           bool     `json:"enabled"`
+	ServiceProfileIDs []string `json:"service_profiles"`
+}
+
+// NetworkingFlavorCreateOpts represents the attributes used when creating a
+// new service flavor.
+type NetworkingFlavorCreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	ServiceType string `json:"service_type" required:"true"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+}
+
+// ToNetworkingFlavorCreateMap casts a NetworkingFlavorCreateOpts struct to a
+// map.
+func (opts NetworkingFlavorCreateOpts) ToNetworkingFlavorCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// NetworkingFlavorUpdateOpts represents the attributes used when updating an
+// existing service flavor.
+type NetworkingFlavorUpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// ToNetworkingFlavorUpdateMap casts a NetworkingFlavorUpdateOpts struct to a
+// map.
+func (opts NetworkingFlavorUpdateOpts) ToNetworkingFlavorUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "flavor")
+}
+
+// NetworkingFlavorResult is the result of a create, get or update request.
+type NetworkingFlavorResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a NetworkingFlavorResult as a NetworkingFlavor.
+func (r NetworkingFlavorResult) Extract() (*NetworkingFlavor, error) {
+	var s struct {
+		Flavor *NetworkingFlavor `json:"flavor"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Flavor, err
+}
+
+func networkingV2FlavorCreate(client *gophercloud.ServiceClient, opts NetworkingFlavorCreateOpts) (r NetworkingFlavorResult) {
+	b, err := opts.ToNetworkingFlavorCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("flavors"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2FlavorGet(client *gophercloud.ServiceClient, flavorID string) (r NetworkingFlavorResult) {
+	_, r.Err = client.Get(client.ServiceURL("flavors", flavorID), &r.Body, nil)
+	return
+}
+
+func networkingV2FlavorUpdate(client *gophercloud.ServiceClient, flavorID string, opts NetworkingFlavorUpdateOpts) (r NetworkingFlavorResult) {
+	b, err := opts.ToNetworkingFlavorUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("flavors", flavorID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2FlavorDelete(client *gophercloud.ServiceClient, flavorID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("flavors", flavorID), nil)
+	return
+}
+
+func networkingV2FlavorAssociateServiceProfile(client *gophercloud.ServiceClient, flavorID, profileID string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"service_profile": map[string]interface{}{
+			"id": profileID,
+		},
+	}
+	_, r.Err = client.Post(client.ServiceURL("flavors", flavorID, "service_profiles"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2FlavorDisassociateServiceProfile(client *gophercloud.ServiceClient, flavorID, profileID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("flavors", flavorID, "service_profiles", profileID), nil)
+	return
+}
+
+// ServiceProfile is a Networking service profile, which holds the driver of
+// a service flavor.
+type ServiceProfile struct {
+	ID          string `json:"id"`
+	Description string `json:"description"`
+	Driver      string `json:"driver"`
+	MetaInfo    string `json:"metainfo"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// ServiceProfileCreateOpts represents the attributes used when creating a
+// new service profile.
+type ServiceProfileCreateOpts struct {
+	Description string `json:"description,omitempty"`
+	Driver      string `json:"driver,omitempty"`
+	MetaInfo    string `json:"metainfo,omitempty"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+}
+
+// ToServiceProfileCreateMap casts a ServiceProfileCreateOpts struct to a map.
+func (opts ServiceProfileCreateOpts) ToServiceProfileCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service_profile")
+}
+
+// ServiceProfileUpdateOpts represents the attributes used when updating an
+// existing service profile.
+type ServiceProfileUpdateOpts struct {
+	Description *string `json:"description,omitempty"`
+	Driver      *string `json:"driver,omitempty"`
+	MetaInfo    *string `json:"metainfo,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+}
+
+// ToServiceProfileUpdateMap casts a ServiceProfileUpdateOpts struct to a map.
+func (opts ServiceProfileUpdateOpts) ToServiceProfileUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "service_profile")
+}
+
+// ServiceProfileResult is the result of a create, get or update request.
+type ServiceProfileResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ServiceProfileResult as a ServiceProfile.
+func (r ServiceProfileResult) Extract() (*ServiceProfile, error) {
+	var s struct {
+		ServiceProfile *ServiceProfile `json:"service_profile"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ServiceProfile, err
+}
+
+func networkingV2ServiceProfileCreate(client *gophercloud.ServiceClient, opts ServiceProfileCreateOpts) (r ServiceProfileResult) {
+	b, err := opts.ToServiceProfileCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("service_profiles"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2ServiceProfileGet(client *gophercloud.ServiceClient, profileID string) (r ServiceProfileResult) {
+	_, r.Err = client.Get(client.ServiceURL("service_profiles", profileID), &r.Body, nil)
+	return
+}
+
+func networkingV2ServiceProfileUpdate(client *gophercloud.ServiceClient, profileID string, opts ServiceProfileUpdateOpts) (r ServiceProfileResult) {
+	b, err := opts.ToServiceProfileUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("service_profiles", profileID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2ServiceProfileDelete(client *gophercloud.ServiceClient, profileID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("service_profiles", profileID), nil)
+	return
+}
@@ -0,0 +1,56 @@
+// This set of code handles the listing of floating IPs of the Networking
+// service with the filters that Gophercloud's floatingips.ListOpts lacks,
+// such as the status and the tags of the floating IPs.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+// FloatingIPListOpts represents the attributes used to filter a list of
+// floating IPs.
+type FloatingIPListOpts struct {
+	FloatingIP        string `q:"floating_ip_address"`
+	FloatingNetworkID string `q:"floating_network_id"`
+	PortID            string `q:"port_id"`
+	FixedIP           string `q:"fixed_ip_address"`
+	TenantID          string `q:"tenant_id"`
+	Status            string `q:"status"`
+	Tags              string `q:"tags"`
+}
+
+// ToFloatingIPListQuery formats a FloatingIPListOpts into a query string.
+func (opts FloatingIPListOpts) ToFloatingIPListQuery() (string, error) {
+	q, err := gophercloud.BuildQueryString(opts)
+	return q.String(), err
+}
+
+// FloatingIPWithTags is a floating IP along with its tags, which are not
+// exposed by Gophercloud's FloatingIP.
+type FloatingIPWithTags struct {
+	floatingips.FloatingIP
+	Tags []string `json:"tags"`
+}
+
+func networkingV2FloatingIPList(client *gophercloud.ServiceClient, opts FloatingIPListOpts) pagination.Pager {
+	query, err := opts.ToFloatingIPListQuery()
+	if err != nil {
+		return pagination.Pager{Err: err}
+	}
+	url := client.ServiceURL("floatingips") + query
+	return pagination.NewPager(client, url, func(r pagination.PageResult) pagination.Page {
+		return floatingips.FloatingIPPage{LinkedPageBase: pagination.LinkedPageBase{PageResult: r}}
+	})
+}
+
+// networkingV2ExtractFloatingIPsWithTags extracts the floating IPs of a page
+// returned by networkingV2FloatingIPList.
+func networkingV2ExtractFloatingIPsWithTags(page pagination.Page) ([]FloatingIPWithTags, error) {
+	var s struct {
+		FloatingIPs []FloatingIPWithTags `json:"floatingips"`
+	}
+	err := (page.(floatingips.FloatingIPPage)).ExtractInto(&s)
+	return s.FloatingIPs, err
+}
@@ -0,0 +1,369 @@
+// This set of code handles the FWaaS v2 extension of the Networking
+// service, which is used to manage firewall groups, firewall policies and
+// firewall rules. Gophercloud does not support this extension yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// FirewallGroup is a FWaaS v2 firewall group.
+type FirewallGroup struct {
+	ID                      string   `json:"id"`
+	Name                    string   `json:"name"`
+	Description             string   `json:"description"`
+	IngressFirewallPolicyID string   `json:"ingress_firewall_policy_id"`
+	EgressFirewallPolicyID  string   `json:"egress_firewall_policy_id"`
+	AdminStateUp            bool     `json:"admin_state_up"`
+	Ports                   []string `json:"ports"`
+	Shared                  bool     `json:"shared"`
+	Status                  string   `json:"status"`
+	TenantID                string   `json:"tenant_id"`
+}
+
+// FirewallGroupCreateOpts represents the attributes used when creating a
+// new firewall group.
+type FirewallGroupCreateOpts struct {
+	Name                    string            `json:"name,omitempty"`
+	Description             string            `json:"description,omitempty"`
+	IngressFirewallPolicyID string            `json:"ingress_firewall_policy_id,omitempty"`
+	EgressFirewallPolicyID  string            `json:"egress_firewall_policy_id,omitempty"`
+	AdminStateUp            *bool             `json:"admin_state_up,omitempty"`
+	Ports                   []string          `json:"ports,omitempty"`
+	Shared                  bool              `json:"shared,omitempty"`
+	TenantID                string            `json:"tenant_id,omitempty"`
+	ValueSpecs              map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToFirewallGroupCreateMap casts a FirewallGroupCreateOpts struct to a map.
+func (opts FirewallGroupCreateOpts) ToFirewallGroupCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "firewall_group")
+}
+
+// FirewallGroupUpdateOpts represents the attributes used when updating an
+// existing firewall group.
+type FirewallGroupUpdateOpts struct {
+	Name                    *string   `json:"name,omitempty"`
+	Description             *string   `json:"description,omitempty"`
+	IngressFirewallPolicyID *string   `json:"ingress_firewall_policy_id,omitempty"`
+	EgressFirewallPolicyID  *string   `json:"egress_firewall_policy_id,omitempty"`
+	AdminStateUp            *bool     `json:"admin_state_up,omitempty"`
+	Ports                   *[]string `json:"ports,omitempty"`
+	Shared                  *bool     `json:"shared,omitempty"`
+}
+
+// ToFirewallGroupUpdateMap casts a FirewallGroupUpdateOpts struct to a map.
+// An empty ingress or egress policy ID removes the policy from the firewall
+// group.
+func (opts FirewallGroupUpdateOpts) ToFirewallGroupUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "firewall_group")
+	if err != nil {
+		return nil, err
+	}
+
+	group := b["firewall_group"].(map[string]interface{})
+	if opts.IngressFirewallPolicyID != nil && *opts.IngressFirewallPolicyID == "" {
+		group["ingress_firewall_policy_id"] = nil
+	}
+	if opts.EgressFirewallPolicyID != nil && *opts.EgressFirewallPolicyID == "" {
+		group["egress_firewall_policy_id"] = nil
+	}
+
+	return b, nil
+}
+
+// FirewallGroupResult is the result of a create, get or update request.
+type FirewallGroupResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a FirewallGroupResult as a FirewallGroup.
+func (r FirewallGroupResult) Extract() (*FirewallGroup, error) {
+	var s struct {
+		FirewallGroup *FirewallGroup `json:"firewall_group"`
+	}
+	err := r.ExtractInto(&s)
+	return s.FirewallGroup, err
+}
+
+func networkingV2FirewallGroupCreate(client *gophercloud.ServiceClient, opts FirewallGroupCreateOpts) (r FirewallGroupResult) {
+	b, err := opts.ToFirewallGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("fwaas", "firewall_groups"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2FirewallGroupGet(client *gophercloud.ServiceClient, groupID string) (r FirewallGroupResult) {
+	_, r.Err = client.Get(client.ServiceURL("fwaas", "firewall_groups", groupID), &r.Body, nil)
+	return
+}
+
+func networkingV2FirewallGroupUpdate(client *gophercloud.ServiceClient, groupID string, opts FirewallGroupUpdateOpts) (r FirewallGroupResult) {
+	b, err := opts.ToFirewallGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("fwaas", "firewall_groups", groupID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2FirewallGroupDelete(client *gophercloud.ServiceClient, groupID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("fwaas", "firewall_groups", groupID), nil)
+	return
+}
+
+// FirewallPolicy is a FWaaS v2 firewall policy.
+type FirewallPolicy struct {
+	ID            string   `json:"id"`
+	Name          string   `json:"name"`
+	Description   string   `json:"description"`
+	FirewallRules []string `json:"firewall_rules"`
+	Audited       bool     `json:"audited"`
+	Shared        bool     `json:"shared"`
+	TenantID      string   `json:"tenant_id"`
+}
+
+// FirewallPolicyCreateOpts represents the attributes used when creating a
+// new firewall policy.
+type FirewallPolicyCreateOpts struct {
+	Name          string            `json:"name,omitempty"`
+	Description   string            `json:"description,omitempty"`
+	FirewallRules []string          `json:"firewall_rules,omitempty"`
+	Audited       bool              `json:"audited,omitempty"`
+	Shared        bool              `json:"shared,omitempty"`
+	TenantID      string            `json:"tenant_id,omitempty"`
+	ValueSpecs    map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToFirewallPolicyCreateMap casts a FirewallPolicyCreateOpts struct to a
+// map.
+func (opts FirewallPolicyCreateOpts) ToFirewallPolicyCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "firewall_policy")
+}
+
+// FirewallPolicyUpdateOpts represents the attributes used when updating an
+// existing firewall policy. FirewallRules replaces the ordered list of
+// rules of the policy.
+type FirewallPolicyUpdateOpts struct {
+	Name          *string   `json:"name,omitempty"`
+	Description   *string   `json:"description,omitempty"`
+	FirewallRules *[]string `json:"firewall_rules,omitempty"`
+	Audited       *bool     `json:"audited,omitempty"`
+	Shared        *bool     `json:"shared,omitempty"`
+}
+
+// ToFirewallPolicyUpdateMap casts a FirewallPolicyUpdateOpts struct to a
+// map.
+func (opts FirewallPolicyUpdateOpts) ToFirewallPolicyUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "firewall_policy")
+}
+
+// FirewallPolicyResult is the result of a create, get or update request.
+type FirewallPolicyResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a FirewallPolicyResult as a FirewallPolicy.
+func (r FirewallPolicyResult) Extract() (*FirewallPolicy, error) {
+	var s struct {
+		FirewallPolicy *FirewallPolicy `json:"firewall_policy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.FirewallPolicy, err
+}
+
+func networkingV2FirewallPolicyCreate(client *gophercloud.ServiceClient, opts FirewallPolicyCreateOpts) (r FirewallPolicyResult) {
+	b, err := opts.ToFirewallPolicyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("fwaas", "firewall_policies"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2FirewallPolicyGet(client *gophercloud.ServiceClient, policyID string) (r FirewallPolicyResult) {
+	_, r.Err = client.Get(client.ServiceURL("fwaas", "firewall_policies", policyID), &r.Body, nil)
+	return
+}
+
+func networkingV2FirewallPolicyUpdate(client *gophercloud.ServiceClient, policyID string, opts FirewallPolicyUpdateOpts) (r FirewallPolicyResult) {
+	b, err := opts.ToFirewallPolicyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("fwaas", "firewall_policies", policyID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2FirewallPolicyDelete(client *gophercloud.ServiceClient, policyID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("fwaas", "firewall_policies", policyID), nil)
+	return
+}
+
+// networkingV2FirewallPolicyRemoveRule removes a rule from a firewall
+// policy.
+func networkingV2FirewallPolicyRemoveRule(client *gophercloud.ServiceClient, policyID, ruleID string) (r FirewallPolicyResult) {
+	b := map[string]interface{}{
+		"firewall_rule_id": ruleID,
+	}
+	_, r.Err = client.Put(client.ServiceURL("fwaas", "firewall_policies", policyID, "remove_rule"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// FirewallRule is a FWaaS v2 firewall rule.
+type FirewallRule struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	Description          string `json:"description"`
+	Protocol             string `json:"protocol"`
+	Action               string `json:"action"`
+	IPVersion            int    `json:"ip_version"`
+	SourceIPAddress      string `json:"source_ip_address"`
+	DestinationIPAddress string `json:"destination_ip_address"`
+	SourcePort           string `json:"source_port"`
+	DestinationPort      string `json:"destination_port"`
+	Enabled              bool   `json:"enabled"`
+	Shared               bool   `json:"shared"`
+	FirewallPolicyID     string `json:"firewall_policy_id"`
+	TenantID             string `json:"tenant_id"`
+}
+
+// FirewallRuleCreateOpts represents the attributes used when creating a new
+// firewall rule. A Protocol of "any" matches all protocols.
+type FirewallRuleCreateOpts struct {
+	Name                 string            `json:"name,omitempty"`
+	Description          string            `json:"description,omitempty"`
+	Protocol             string            `json:"protocol"`
+	Action               string            `json:"action"`
+	IPVersion            int               `json:"ip_version,omitempty"`
+	SourceIPAddress      string            `json:"source_ip_address,omitempty"`
+	DestinationIPAddress string            `json:"destination_ip_address,omitempty"`
+	SourcePort           string            `json:"source_port,omitempty"`
+	DestinationPort      string            `json:"destination_port,omitempty"`
+	Enabled              *bool             `json:"enabled,omitempty"`
+	Shared               bool              `json:"shared,omitempty"`
+	TenantID             string            `json:"tenant_id,omitempty"`
+	ValueSpecs           map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToFirewallRuleCreateMap casts a FirewallRuleCreateOpts struct to a map.
+func (opts FirewallRuleCreateOpts) ToFirewallRuleCreateMap() (map[string]interface{}, error) {
+	b, err := BuildRequest(opts, "firewall_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.Protocol == "any" {
+		b["firewall_rule"].(map[string]interface{})["protocol"] = nil
+	}
+
+	return b, nil
+}
+
+// FirewallRuleUpdateOpts represents the attributes used when updating an
+// existing firewall rule. Empty addresses and ports are cleared, and a
+// Protocol of "any" matches all protocols.
+type FirewallRuleUpdateOpts struct {
+	Name                 *string `json:"name,omitempty"`
+	Description          *string `json:"description,omitempty"`
+	Protocol             *string `json:"protocol,omitempty"`
+	Action               *string `json:"action,omitempty"`
+	IPVersion            *int    `json:"ip_version,omitempty"`
+	SourceIPAddress      *string `json:"source_ip_address,omitempty"`
+	DestinationIPAddress *string `json:"destination_ip_address,omitempty"`
+	SourcePort           *string `json:"source_port,omitempty"`
+	DestinationPort      *string `json:"destination_port,omitempty"`
+	Enabled              *bool   `json:"enabled,omitempty"`
+	Shared               *bool   `json:"shared,omitempty"`
+}
+
+// ToFirewallRuleUpdateMap casts a FirewallRuleUpdateOpts struct to a map.
+func (opts FirewallRuleUpdateOpts) ToFirewallRuleUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "firewall_rule")
+	if err != nil {
+		return nil, err
+	}
+
+	rule := b["firewall_rule"].(map[string]interface{})
+	if opts.Protocol != nil && *opts.Protocol == "any" {
+		rule["protocol"] = nil
+	}
+
+	nullable := map[string]*string{
+		"source_ip_address":      opts.SourceIPAddress,
+		"destination_ip_address": opts.DestinationIPAddress,
+		"source_port":            opts.SourcePort,
+		"destination_port":       opts.DestinationPort,
+	}
+	for k, v := range nullable {
+		if v != nil && *v == "" {
+			rule[k] = nil
+		}
+	}
+
+	return b, nil
+}
+
+// FirewallRuleResult is the result of a create, get or update request.
+type FirewallRuleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a FirewallRuleResult as a FirewallRule.
+func (r FirewallRuleResult) Extract() (*FirewallRule, error) {
+	var s struct {
+		FirewallRule *FirewallRule `json:"firewall_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.FirewallRule, err
+}
+
+func networkingV2FirewallRuleCreate(client *gophercloud.ServiceClient, opts FirewallRuleCreateOpts) (r FirewallRuleResult) {
+	b, err := opts.ToFirewallRuleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("fwaas", "firewall_rules"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2FirewallRuleGet(client *gophercloud.ServiceClient, ruleID string) (r FirewallRuleResult) {
+	_, r.Err = client.Get(client.ServiceURL("fwaas", "firewall_rules", ruleID), &r.Body, nil)
+	return
+}
+
+func networkingV2FirewallRuleUpdate(client *gophercloud.ServiceClient, ruleID string, opts FirewallRuleUpdateOpts) (r FirewallRuleResult) {
+	b, err := opts.ToFirewallRuleUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("fwaas", "firewall_rules", ruleID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2FirewallRuleDelete(client *gophercloud.ServiceClient, ruleID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("fwaas", "firewall_rules", ruleID), nil)
+	return
+}
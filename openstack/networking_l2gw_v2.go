@@ -0,0 +1,162 @@
+// This set of code handles the L2 gateways of the networking-l2gw Networking
+// service extension, which bridge external VLANs into tenant networks.
+// Gophercloud does not support networking-l2gw.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// L2Gateway is a Networking L2 gateway.
+type L2Gateway struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name"`
+	Devices  []L2GatewayDevice `json:"devices"`
+	TenantID string            `json:"tenant_id"`
+}
+
+// L2GatewayDevice is a switch or gateway device of an L2 gateway.
+type L2GatewayDevice struct {
+	DeviceName string               `json:"device_name"`
+	Interfaces []L2GatewayInterface `json:"interfaces"`
+}
+
+// L2GatewayInterface is an interface of an L2 gateway device.
+type L2GatewayInterface struct {
+	Name           string `json:"name"`
+	SegmentationID []int  `json:"segmentation_id,omitempty"`
+}
+
+// L2GatewayCreateOpts represents the attributes used when creating a new
+// L2 gateway.
+type L2GatewayCreateOpts struct {
+	Name     string            `json:"name,omitempty"`
+	Devices  []L2GatewayDevice `json:"devices"`
+	TenantID string            `json:"tenant_id,omitempty"`
+}
+
+// ToL2GatewayCreateMap casts an L2GatewayCreateOpts struct to a map.
+func (opts L2GatewayCreateOpts) ToL2GatewayCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "l2_gateway")
+}
+
+// L2GatewayUpdateOpts represents the attributes used when updating an
+// existing L2 gateway.
+type L2GatewayUpdateOpts struct {
+	Name    *string            `json:"name,omitempty"`
+	Devices *[]L2GatewayDevice `json:"devices,omitempty"`
+}
+
+// ToL2GatewayUpdateMap casts an L2GatewayUpdateOpts struct to a map.
+func (opts L2GatewayUpdateOpts) ToL2GatewayUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "l2_gateway")
+}
+
+// L2GatewayResult is the result of a create, get or update request.
+type L2GatewayResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an L2GatewayResult as an L2Gateway.
+func (r L2GatewayResult) Extract() (*L2Gateway, error) {
+	var s struct {
+		L2Gateway *L2Gateway `json:"l2_gateway"`
+	}
+	err := r.ExtractInto(&s)
+	return s.L2Gateway, err
+}
+
+func networkingV2L2GatewayCreate(client *gophercloud.ServiceClient, opts L2GatewayCreateOpts) (r L2GatewayResult) {
+	b, err := opts.ToL2GatewayCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("l2-gateways"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2L2GatewayGet(client *gophercloud.ServiceClient, l2GatewayID string) (r L2GatewayResult) {
+	_, r.Err = client.Get(client.ServiceURL("l2-gateways", l2GatewayID), &r.Body, nil)
+	return
+}
+
+func networkingV2L2GatewayUpdate(client *gophercloud.ServiceClient, l2GatewayID string, opts L2GatewayUpdateOpts) (r L2GatewayResult) {
+	b, err := opts.ToL2GatewayUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("l2-gateways", l2GatewayID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2L2GatewayDelete(client *gophercloud.ServiceClient, l2GatewayID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("l2-gateways", l2GatewayID), nil)
+	return
+}
+
+// L2GatewayConnection is a connection between an L2 gateway and a
+// Networking network.
+type L2GatewayConnection struct {
+	ID             string `json:"id"`
+	L2GatewayID    string `json:"l2_gateway_id"`
+	NetworkID      string `json:"network_id"`
+	SegmentationID int    `json:"segmentation_id"`
+	TenantID       string `json:"tenant_id"`
+}
+
+// L2GatewayConnectionCreateOpts represents the attributes used when creating
+// a new L2 gateway connection.
+type L2GatewayConnectionCreateOpts struct {
+	L2GatewayID    string `json:"l2_gateway_id" required:"true"`
+	NetworkID      string `json:"network_id" required:"true"`
+	SegmentationID int    `json:"segmentation_id,omitempty"`
+	TenantID       string `json:"tenant_id,omitempty"`
+}
+
+// ToL2GatewayConnectionCreateMap casts an L2GatewayConnectionCreateOpts
+// struct to a map.
+func (opts L2GatewayConnectionCreateOpts) ToL2GatewayConnectionCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "l2_gateway_connection")
+}
+
+// L2GatewayConnectionResult is the result of a create or get request.
+type L2GatewayConnectionResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an L2GatewayConnectionResult as an L2GatewayConnection.
+func (r L2GatewayConnectionResult) Extract() (*L2GatewayConnection, error) {
+	var s struct {
+		L2GatewayConnection *L2GatewayConnection `json:"l2_gateway_connection"`
+	}
+	err := r.ExtractInto(&s)
+	return s.L2GatewayConnection, err
+}
+
+func networkingV2L2GatewayConnectionCreate(client *gophercloud.ServiceClient, opts L2GatewayConnectionCreateOpts) (r L2GatewayConnectionResult) {
+	b, err := opts.ToL2GatewayConnectionCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("l2-gateway-connections"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2L2GatewayConnectionGet(client *gophercloud.ServiceClient, connectionID string) (r L2GatewayConnectionResult) {
+	_, r.Err = client.Get(client.ServiceURL("l2-gateway-connections", connectionID), &r.Body, nil)
+	return
+}
+
+func networkingV2L2GatewayConnectionDelete(client *gophercloud.ServiceClient, connectionID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("l2-gateway-connections", connectionID), nil)
+	return
+}
@@ -0,0 +1,127 @@
+// This set of code handles the metering labels and rules of the Networking
+// service, which account the traffic of routers per tenant.
+// Gophercloud does not support metering yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// MeteringLabel is a Networking metering label.
+type MeteringLabel struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Shared      bool   `json:"shared"`
+	TenantID    string `json:"tenant_id"`
+}
+
+// MeteringLabelCreateOpts represents the attributes used when creating a new
+// metering label.
+type MeteringLabelCreateOpts struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Shared      bool   `json:"shared,omitempty"`
+	TenantID    string `json:"tenant_id,omitempty"`
+}
+
+// ToMeteringLabelCreateMap casts a MeteringLabelCreateOpts struct to a map.
+func (opts MeteringLabelCreateOpts) ToMeteringLabelCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "metering_label")
+}
+
+// MeteringLabelResult is the result of a create or get request.
+type MeteringLabelResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a MeteringLabelResult as a MeteringLabel.
+func (r MeteringLabelResult) Extract() (*MeteringLabel, error) {
+	var s struct {
+		MeteringLabel *MeteringLabel `json:"metering_label"`
+	}
+	err := r.ExtractInto(&s)
+	return s.MeteringLabel, err
+}
+
+func networkingV2MeteringLabelCreate(client *gophercloud.ServiceClient, opts MeteringLabelCreateOpts) (r MeteringLabelResult) {
+	b, err := opts.ToMeteringLabelCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("metering", "metering-labels"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2MeteringLabelGet(client *gophercloud.ServiceClient, labelID string) (r MeteringLabelResult) {
+	_, r.Err = client.Get(client.ServiceURL("metering", "metering-labels", labelID), &r.Body, nil)
+	return
+}
+
+func networkingV2MeteringLabelDelete(client *gophercloud.ServiceClient, labelID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("metering", "metering-labels", labelID), nil)
+	return
+}
+
+// MeteringLabelRule is a Networking metering label rule.
+type MeteringLabelRule struct {
+	ID              string `json:"id"`
+	MeteringLabelID string `json:"metering_label_id"`
+	Direction       string `json:"direction"`
+	RemoteIPPrefix  string `json:"remote_ip_prefix"`
+	Excluded        bool   `json:"excluded"`
+}
+
+// MeteringLabelRuleCreateOpts represents the attributes used when creating
+// a new metering label rule.
+type MeteringLabelRuleCreateOpts struct {
+	MeteringLabelID string `json:"metering_label_id" required:"true"`
+	Direction       string `json:"direction" required:"true"`
+	RemoteIPPrefix  string `json:"remote_ip_prefix" required:"true"`
+	Excluded        bool   `json:"excluded,omitempty"`
+}
+
+// ToMeteringLabelRuleCreateMap casts a MeteringLabelRuleCreateOpts struct to
+// a map.
+func (opts MeteringLabelRuleCreateOpts) ToMeteringLabelRuleCreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "metering_label_rule")
+}
+
+// MeteringLabelRuleResult is the result of a create or get request.
+type MeteringLabelRuleResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a MeteringLabelRuleResult as a MeteringLabelRule.
+func (r MeteringLabelRuleResult) Extract() (*MeteringLabelRule, error) {
+	var s struct {
+		MeteringLabelRule *MeteringLabelRule `json:"metering_label_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.MeteringLabelRule, err
+}
+
+func networkingV2MeteringLabelRuleCreate(client *gophercloud.ServiceClient, opts MeteringLabelRuleCreateOpts) (r MeteringLabelRuleResult) {
+	b, err := opts.ToMeteringLabelRuleCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("metering", "metering-label-rules"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2MeteringLabelRuleGet(client *gophercloud.ServiceClient, ruleID string) (r MeteringLabelRuleResult) {
+	_, r.Err = client.Get(client.ServiceURL("metering", "metering-label-rules", ruleID), &r.Body, nil)
+	return
+}
+
+func networkingV2MeteringLabelRuleDelete(client *gophercloud.ServiceClient, ruleID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("metering", "metering-label-rules", ruleID), nil)
+	return
+}
@@ -0,0 +1,244 @@
+// This set of code handles the QoS extension of the Networking service,
+// which is used to manage QoS policies and their bandwidth limit, DSCP
+// marking and minimum bandwidth rules. Gophercloud does not support this
+// extension yet.
+package openstack
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
+)
+
+// QoSPolicy is a Networking QoS policy.
+type QoSPolicy struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Shared      bool   `json:"shared"`
+	IsDefault   bool   `json:"is_default"`
+	TenantID    string `json:"tenant_id"`
+}
+
+// QoSPolicyCreateOpts represents the attributes used when creating a new
+// QoS policy.
+type QoSPolicyCreateOpts struct {
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Shared      bool              `json:"shared,omitempty"`
+	IsDefault   bool              `json:"is_default,omitempty"`
+	TenantID    string            `json:"tenant_id,omitempty"`
+	ValueSpecs  map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToQoSPolicyCreateMap casts a QoSPolicyCreateOpts struct to a map.
+func (opts QoSPolicyCreateOpts) ToQoSPolicyCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "policy")
+}
+
+// QoSPolicyUpdateOpts represents the attributes used when updating an
+// existing QoS policy.
+type QoSPolicyUpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Shared      *bool   `json:"shared,omitempty"`
+	IsDefault   *bool   `json:"is_default,omitempty"`
+}
+
+// ToQoSPolicyUpdateMap casts a QoSPolicyUpdateOpts struct to a map.
+func (opts QoSPolicyUpdateOpts) ToQoSPolicyUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "policy")
+}
+
+// QoSPolicyResult is the result of a create, get or update request.
+type QoSPolicyResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a QoSPolicyResult as a QoSPolicy.
+func (r QoSPolicyResult) Extract() (*QoSPolicy, error) {
+	var s struct {
+		Policy *QoSPolicy `json:"policy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Policy, err
+}
+
+func networkingV2QoSPolicyCreate(client *gophercloud.ServiceClient, opts QoSPolicyCreateOpts) (r QoSPolicyResult) {
+	b, err := opts.ToQoSPolicyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("qos", "policies"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2QoSPolicyGet(client *gophercloud.ServiceClient, policyID string) (r QoSPolicyResult) {
+	_, r.Err = client.Get(client.ServiceURL("qos", "policies", policyID), &r.Body, nil)
+	return
+}
+
+func networkingV2QoSPolicyUpdate(client *gophercloud.ServiceClient, policyID string, opts QoSPolicyUpdateOpts) (r QoSPolicyResult) {
+	b, err := opts.ToQoSPolicyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("qos", "policies", policyID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2QoSPolicyDelete(client *gophercloud.ServiceClient, policyID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("qos", "policies", policyID), nil)
+	return
+}
+
+// The rule types supported by the QoS rule resources. Each of them is
+// also the key of the rule in request and response bodies, and the
+// pluralized form is the URL path segment of the rule.
+const (
+	qosBandwidthLimitRule   = "bandwidth_limit_rule"
+	qosDSCPMarkingRule      = "dscp_marking_rule"
+	qosMinimumBandwidthRule = "minimum_bandwidth_rule"
+)
+
+// QoSBandwidthLimitRule is a QoS rule limiting the bandwidth of a port.
+type QoSBandwidthLimitRule struct {
+	ID           string `json:"id"`
+	MaxKBps      int    `json:"max_kbps"`
+	MaxBurstKBps int    `json:"max_burst_kbps"`
+	Direction    string `json:"direction"`
+}
+
+// QoSDSCPMarkingRule is a QoS rule marking the traffic of a port with a
+// DSCP value.
+type QoSDSCPMarkingRule struct {
+	ID       string `json:"id"`
+	DSCPMark int    `json:"dscp_mark"`
+}
+
+// QoSMinimumBandwidthRule is a QoS rule guaranteeing a minimum bandwidth
+// to a port.
+type QoSMinimumBandwidthRule struct {
+	ID        string `json:"id"`
+	MinKBps   int    `json:"min_kbps"`
+	Direction string `json:"direction"`
+}
+
+// QoSRuleResult is the result of a rule create, get or update request.
+type QoSRuleResult struct {
+	gophercloud.Result
+}
+
+// ExtractBandwidthLimitRule interprets a QoSRuleResult as a
+// QoSBandwidthLimitRule.
+func (r QoSRuleResult) ExtractBandwidthLimitRule() (*QoSBandwidthLimitRule, error) {
+	var s struct {
+		Rule *QoSBandwidthLimitRule `json:"bandwidth_limit_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Rule, err
+}
+
+// ExtractDSCPMarkingRule interprets a QoSRuleResult as a
+// QoSDSCPMarkingRule.
+func (r QoSRuleResult) ExtractDSCPMarkingRule() (*QoSDSCPMarkingRule, error) {
+	var s struct {
+		Rule *QoSDSCPMarkingRule `json:"dscp_marking_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Rule, err
+}
+
+// ExtractMinimumBandwidthRule interprets a QoSRuleResult as a
+// QoSMinimumBandwidthRule.
+func (r QoSRuleResult) ExtractMinimumBandwidthRule() (*QoSMinimumBandwidthRule, error) {
+	var s struct {
+		Rule *QoSMinimumBandwidthRule `json:"minimum_bandwidth_rule"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Rule, err
+}
+
+func networkingV2QoSRuleCreate(client *gophercloud.ServiceClient, policyID, ruleType string, rule map[string]interface{}) (r QoSRuleResult) {
+	b := map[string]interface{}{
+		ruleType: rule,
+	}
+	_, r.Err = client.Post(client.ServiceURL("qos", "policies", policyID, ruleType+"s"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2QoSRuleGet(client *gophercloud.ServiceClient, policyID, ruleType, ruleID string) (r QoSRuleResult) {
+	_, r.Err = client.Get(client.ServiceURL("qos", "policies", policyID, ruleType+"s", ruleID), &r.Body, nil)
+	return
+}
+
+func networkingV2QoSRuleUpdate(client *gophercloud.ServiceClient, policyID, ruleType, ruleID string, rule map[string]interface{}) (r QoSRuleResult) {
+	b := map[string]interface{}{
+		ruleType: rule,
+	}
+	_, r.Err = client.Put(client.ServiceURL("qos", "policies", policyID, ruleType+"s", ruleID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2QoSRuleDelete(client *gophercloud.ServiceClient, policyID, ruleType, ruleID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("qos", "policies", policyID, ruleType+"s", ruleID), nil)
+	return
+}
+
+// parseNetworkingQoSRuleID splits the ID of a QoS rule resource, which has
+// the form <policy id>/<rule id>.
+func parseNetworkingQoSRuleID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine QoS rule ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
+
+// validateNetworkingQoSRuleDirection validates the direction of a QoS rule.
+func validateNetworkingQoSRuleDirection(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value != "egress" && value != "ingress" {
+		errors = append(errors, fmt.Errorf(
+			"Only 'egress' and 'ingress' are supported values for '%s'", k))
+	}
+	return
+}
+
+// networkingV2NetworkQoSPolicyID extracts the QoS policy ID of a network,
+// which is not exposed by Gophercloud's Network.
+func networkingV2NetworkQoSPolicyID(r networks.GetResult) (string, error) {
+	var s struct {
+		Network struct {
+			QoSPolicyID string `json:"qos_policy_id"`
+		} `json:"network"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Network.QoSPolicyID, err
+}
+
+// networkingV2PortQoSPolicyID extracts the QoS policy ID of a port, which
+// is not exposed by Gophercloud's Port.
+func networkingV2PortQoSPolicyID(r ports.GetResult) (string, error) {
+	var s struct {
+		Port struct {
+			QoSPolicyID string `json:"qos_policy_id"`
+		} `json:"port"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Port.QoSPolicyID, err
+}
@@ -0,0 +1,157 @@
+// This set of code handles the subnet pools of the Networking service.
+// Gophercloud does not support subnet pools yet.
+package openstack
+
+import (
+	"strconv"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// SubnetPool is a Networking subnet pool.
+type SubnetPool struct {
+	ID               string   `json:"id"`
+	Name             string   `json:"name"`
+	Description      string   `json:"description"`
+	Prefixes         []string `json:"prefixes"`
+	DefaultPrefixLen int      `json:"-"`
+	MinPrefixLen     int      `json:"-"`
+	MaxPrefixLen     int      `json:"-"`
+	DefaultQuota     int      `json:"default_quota"`
+	AddressScopeID   string   `json:"address_scope_id"`
+	IPVersion        int      `json:"ip_version"`
+	Shared           bool     `json:"shared"`
+	IsDefault        bool     `json:"is_default"`
+	TenantID         string   `json:"tenant_id"`
+}
+
+// SubnetPoolCreateOpts represents the attributes used when creating a new
+// subnet pool.
+type SubnetPoolCreateOpts struct {
+	Name             string            `json:"name"`
+	Description      string            `json:"description,omitempty"`
+	Prefixes         []string          `json:"prefixes"`
+	DefaultPrefixLen int               `json:"default_prefixlen,omitempty"`
+	MinPrefixLen     int               `json:"min_prefixlen,omitempty"`
+	MaxPrefixLen     int               `json:"max_prefixlen,omitempty"`
+	DefaultQuota     int               `json:"default_quota,omitempty"`
+	AddressScopeID   string            `json:"address_scope_id,omitempty"`
+	Shared           bool              `json:"shared,omitempty"`
+	IsDefault        bool              `json:"is_default,omitempty"`
+	TenantID         string            `json:"tenant_id,omitempty"`
+	ValueSpecs       map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToSubnetPoolCreateMap casts a SubnetPoolCreateOpts struct to a map.
+func (opts SubnetPoolCreateOpts) ToSubnetPoolCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "subnetpool")
+}
+
+// SubnetPoolUpdateOpts represents the attributes used when updating an
+// existing subnet pool.
+type SubnetPoolUpdateOpts struct {
+	Name             *string  `json:"name,omitempty"`
+	Description      *string  `json:"description,omitempty"`
+	Prefixes         []string `json:"prefixes,omitempty"`
+	DefaultPrefixLen *int     `json:"default_prefixlen,omitempty"`
+	MinPrefixLen     *int     `json:"min_prefixlen,omitempty"`
+	MaxPrefixLen     *int     `json:"max_prefixlen,omitempty"`
+	DefaultQuota     *int     `json:"default_quota,omitempty"`
+	AddressScopeID   *string  `json:"address_scope_id,omitempty"`
+	IsDefault        *bool    `json:"is_default,omitempty"`
+}
+
+// ToSubnetPoolUpdateMap casts a SubnetPoolUpdateOpts struct to a map.
+// An empty AddressScopeID removes the subnet pool from its address scope.
+func (opts SubnetPoolUpdateOpts) ToSubnetPoolUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "subnetpool")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.AddressScopeID != nil && *opts.AddressScopeID == "" {
+		b["subnetpool"].(map[string]interface{})["address_scope_id"] = nil
+	}
+
+	return b, nil
+}
+
+// SubnetPoolResult is the result of a create, get or update request.
+type SubnetPoolResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a SubnetPoolResult as a SubnetPool.
+func (r SubnetPoolResult) Extract() (*SubnetPool, error) {
+	// The prefix lengths are returned as strings by some releases of the
+	// Networking service and as integers by others.
+	var s struct {
+		SubnetPool *struct {
+			SubnetPool
+			DefaultPrefixLen interface{} `json:"default_prefixlen"`
+			MinPrefixLen     interface{} `json:"min_prefixlen"`
+			MaxPrefixLen     interface{} `json:"max_prefixlen"`
+		} `json:"subnetpool"`
+	}
+	err := r.ExtractInto(&s)
+	if err != nil || s.SubnetPool == nil {
+		return nil, err
+	}
+
+	pool := s.SubnetPool.SubnetPool
+	pool.DefaultPrefixLen = subnetPoolPrefixLen(s.SubnetPool.DefaultPrefixLen)
+	pool.MinPrefixLen = subnetPoolPrefixLen(s.SubnetPool.MinPrefixLen)
+	pool.MaxPrefixLen = subnetPoolPrefixLen(s.SubnetPool.MaxPrefixLen)
+
+	return &pool, nil
+}
+
+func networkingV2SubnetPoolCreate(client *gophercloud.ServiceClient, opts SubnetPoolCreateOpts) (r SubnetPoolResult) {
+	b, err := opts.ToSubnetPoolCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("subnetpools"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2SubnetPoolGet(client *gophercloud.ServiceClient, subnetPoolID string) (r SubnetPoolResult) {
+	_, r.Err = client.Get(client.ServiceURL("subnetpools", subnetPoolID), &r.Body, nil)
+	return
+}
+
+func networkingV2SubnetPoolUpdate(client *gophercloud.ServiceClient, subnetPoolID string, opts SubnetPoolUpdateOpts) (r SubnetPoolResult) {
+	b, err := opts.ToSubnetPoolUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("subnetpools", subnetPoolID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2SubnetPoolDelete(client *gophercloud.ServiceClient, subnetPoolID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("subnetpools", subnetPoolID), nil)
+	return
+}
+
+// subnetPoolPrefixLen converts a prefix length which was returned either as
+// a number or as a string into an int.
+func subnetPoolPrefixLen(v interface{}) int {
+	switch prefixLen := v.(type) {
+	case float64:
+		return int(prefixLen)
+	case string:
+		i, err := strconv.Atoi(prefixLen)
+		if err == nil {
+			return i
+		}
+	}
+
+	return 0
+}
@@ -0,0 +1,577 @@
+// This set of code handles the VPNaaS extension of the Networking service,
+// which is used to manage IKE policies, IPsec policies, VPN services,
+// endpoint groups and IPsec site connections. Gophercloud does not support
+// this extension yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// VPNLifetime is the security association lifetime of an IKE or IPsec
+// policy.
+type VPNLifetime struct {
+	Units string `json:"units,omitempty"`
+	Value int    `json:"value,omitempty"`
+}
+
+// VPNDPD is the dead peer detection protocol settings of an IPsec site
+// connection.
+type VPNDPD struct {
+	Action   string `json:"action,omitempty"`
+	Interval int    `json:"interval,omitempty"`
+	Timeout  int    `json:"timeout,omitempty"`
+}
+
+// IKEPolicy is a VPNaaS IKE policy.
+type IKEPolicy struct {
+	ID                    string      `json:"id"`
+	Name                  string      `json:"name"`
+	Description           string      `json:"description"`
+	AuthAlgorithm         string      `json:"auth_algorithm"`
+	EncryptionAlgorithm   string      `json:"encryption_algorithm"`
+	IKEVersion            string      `json:"ike_version"`
+	Lifetime              VPNLifetime `json:"lifetime"`
+	PFS                   string      `json:"pfs"`
+	Phase1NegotiationMode string      `json:"phase1_negotiation_mode"`
+	TenantID              string      `json:"tenant_id"`
+}
+
+// IKEPolicyCreateOpts represents the attributes used when creating a new
+// IKE policy.
+type IKEPolicyCreateOpts struct {
+	Name                  string            `json:"name,omitempty"`
+	Description           string            `json:"description,omitempty"`
+	AuthAlgorithm         string            `json:"auth_algorithm,omitempty"`
+	EncryptionAlgorithm   string            `json:"encryption_algorithm,omitempty"`
+	IKEVersion            string            `json:"ike_version,omitempty"`
+	Lifetime              *VPNLifetime      `json:"lifetime,omitempty"`
+	PFS                   string            `json:"pfs,omitempty"`
+	Phase1NegotiationMode string            `json:"phase1_negotiation_mode,omitempty"`
+	TenantID              string            `json:"tenant_id,omitempty"`
+	ValueSpecs            map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToIKEPolicyCreateMap casts an IKEPolicyCreateOpts struct to a map.
+func (opts IKEPolicyCreateOpts) ToIKEPolicyCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "ikepolicy")
+}
+
+// IKEPolicyUpdateOpts represents the attributes used when updating an
+// existing IKE policy.
+type IKEPolicyUpdateOpts struct {
+	Name                  *string      `json:"name,omitempty"`
+	Description           *string      `json:"description,omitempty"`
+	AuthAlgorithm         *string      `json:"auth_algorithm,omitempty"`
+	EncryptionAlgorithm   *string      `json:"encryption_algorithm,omitempty"`
+	IKEVersion            *string      `json:"ike_version,omitempty"`
+	Lifetime              *VPNLifetime `json:"lifetime,omitempty"`
+	PFS                   *string      `json:"pfs,omitempty"`
+	Phase1NegotiationMode *string      `json:"phase1_negotiation_mode,omitempty"`
+}
+
+// ToIKEPolicyUpdateMap casts an IKEPolicyUpdateOpts struct to a map.
+func (opts IKEPolicyUpdateOpts) ToIKEPolicyUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "ikepolicy")
+}
+
+// IKEPolicyResult is the result of a create, get or update request.
+type IKEPolicyResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IKEPolicyResult as an IKEPolicy.
+func (r IKEPolicyResult) Extract() (*IKEPolicy, error) {
+	var s struct {
+		IKEPolicy *IKEPolicy `json:"ikepolicy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.IKEPolicy, err
+}
+
+func networkingV2IKEPolicyCreate(client *gophercloud.ServiceClient, opts IKEPolicyCreateOpts) (r IKEPolicyResult) {
+	b, err := opts.ToIKEPolicyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("vpn", "ikepolicies"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2IKEPolicyGet(client *gophercloud.ServiceClient, policyID string) (r IKEPolicyResult) {
+	_, r.Err = client.Get(client.ServiceURL("vpn", "ikepolicies", policyID), &r.Body, nil)
+	return
+}
+
+func networkingV2IKEPolicyUpdate(client *gophercloud.ServiceClient, policyID string, opts IKEPolicyUpdateOpts) (r IKEPolicyResult) {
+	b, err := opts.ToIKEPolicyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("vpn", "ikepolicies", policyID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2IKEPolicyDelete(client *gophercloud.ServiceClient, policyID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("vpn", "ikepolicies", policyID), nil)
+	return
+}
+
+// IPSecPolicy is a VPNaaS IPsec policy.
+type IPSecPolicy struct {
+	ID                  string      `json:"id"`
+	Name                string      `json:"name"`
+	Description         string      `json:"description"`
+	AuthAlgorithm       string      `json:"auth_algorithm"`
+	EncapsulationMode   string      `json:"encapsulation_mode"`
+	EncryptionAlgorithm string      `json:"encryption_algorithm"`
+	Lifetime            VPNLifetime `json:"lifetime"`
+	PFS                 string      `json:"pfs"`
+	TransformProtocol   string      `json:"transform_protocol"`
+	TenantID            string      `json:"tenant_id"`
+}
+
+// IPSecPolicyCreateOpts represents the attributes used when creating a new
+// IPsec policy.
+type IPSecPolicyCreateOpts struct {
+	Name                string            `json:"name,omitempty"`
+	Description         string            `json:"description,omitempty"`
+	AuthAlgorithm       string            `json:"auth_algorithm,omitempty"`
+	EncapsulationMode   string            `json:"encapsulation_mode,omitempty"`
+	EncryptionAlgorithm string            `json:"encryption_algorithm,omitempty"`
+	Lifetime            *VPNLifetime      `json:"lifetime,omitempty"`
+	PFS                 string            `json:"pfs,omitempty"`
+	TransformProtocol   string            `json:"transform_protocol,omitempty"`
+	TenantID            string            `json:"tenant_id,omitempty"`
+	ValueSpecs          map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToIPSecPolicyCreateMap casts an IPSecPolicyCreateOpts struct to a map.
+func (opts IPSecPolicyCreateOpts) ToIPSecPolicyCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "ipsecpolicy")
+}
+
+// IPSecPolicyUpdateOpts represents the attributes used when updating an
+// existing IPsec policy.
+type IPSecPolicyUpdateOpts struct {
+	Name                *string      `json:"name,omitempty"`
+	Description         *string      `json:"description,omitempty"`
+	AuthAlgorithm       *string      `json:"auth_algorithm,omitempty"`
+	EncapsulationMode   *string      `json:"encapsulation_mode,omitempty"`
+	EncryptionAlgorithm *string      `json:"encryption_algorithm,omitempty"`
+	Lifetime            *VPNLifetime `json:"lifetime,omitempty"`
+	PFS                 *string      `json:"pfs,omitempty"`
+	TransformProtocol   *string      `json:"transform_protocol,omitempty"`
+}
+
+// ToIPSecPolicyUpdateMap casts an IPSecPolicyUpdateOpts struct to a map.
+func (opts IPSecPolicyUpdateOpts) ToIPSecPolicyUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "ipsecpolicy")
+}
+
+// IPSecPolicyResult is the result of a create, get or update request.
+type IPSecPolicyResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an IPSecPolicyResult as an IPSecPolicy.
+func (r IPSecPolicyResult) Extract() (*IPSecPolicy, error) {
+	var s struct {
+		IPSecPolicy *IPSecPolicy `json:"ipsecpolicy"`
+	}
+	err := r.ExtractInto(&s)
+	return s.IPSecPolicy, err
+}
+
+func networkingV2IPSecPolicyCreate(client *gophercloud.ServiceClient, opts IPSecPolicyCreateOpts) (r IPSecPolicyResult) {
+	b, err := opts.ToIPSecPolicyCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("vpn", "ipsecpolicies"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2IPSecPolicyGet(client *gophercloud.ServiceClient, policyID string) (r IPSecPolicyResult) {
+	_, r.Err = client.Get(client.ServiceURL("vpn", "ipsecpolicies", policyID), &r.Body, nil)
+	return
+}
+
+func networkingV2IPSecPolicyUpdate(client *gophercloud.ServiceClient, policyID string, opts IPSecPolicyUpdateOpts) (r IPSecPolicyResult) {
+	b, err := opts.ToIPSecPolicyUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("vpn", "ipsecpolicies", policyID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2IPSecPolicyDelete(client *gophercloud.ServiceClient, policyID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("vpn", "ipsecpolicies", policyID), nil)
+	return
+}
+
+// VPNService is a VPNaaS VPN service.
+type VPNService struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	AdminStateUp bool   `json:"admin_state_up"`
+	SubnetID     string `json:"subnet_id"`
+	RouterID     string `json:"router_id"`
+	ExternalV4IP string `json:"external_v4_ip"`
+	ExternalV6IP string `json:"external_v6_ip"`
+	Status       string `json:"status"`
+	TenantID     string `json:"tenant_id"`
+}
+
+// VPNServiceCreateOpts represents the attributes used when creating a new
+// VPN service.
+type VPNServiceCreateOpts struct {
+	Name         string            `json:"name,omitempty"`
+	Description  string            `json:"description,omitempty"`
+	AdminStateUp *bool             `json:"admin_state_up,omitempty"`
+	SubnetID     string            `json:"subnet_id,omitempty"`
+	RouterID     string            `json:"router_id"`
+	TenantID     string            `json:"tenant_id,omitempty"`
+	ValueSpecs   map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToVPNServiceCreateMap casts a VPNServiceCreateOpts struct to a map.
+func (opts VPNServiceCreateOpts) ToVPNServiceCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "vpnservice")
+}
+
+// VPNServiceUpdateOpts represents the attributes used when updating an
+// existing VPN service.
+type VPNServiceUpdateOpts struct {
+	Name         *string `json:"name,omitempty"`
+	Description  *string `json:"description,omitempty"`
+	AdminStateUp *bool   `json:"admin_state_up,omitempty"`
+}
+
+// ToVPNServiceUpdateMap casts a VPNServiceUpdateOpts struct to a map.
+func (opts VPNServiceUpdateOpts) ToVPNServiceUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "vpnservice")
+}
+
+// VPNServiceResult is the result of a create, get or update request.
+type VPNServiceResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a VPNServiceResult as a VPNService.
+func (r VPNServiceResult) Extract() (*VPNService, error) {
+	var s struct {
+		VPNService *VPNService `json:"vpnservice"`
+	}
+	err := r.ExtractInto(&s)
+	return s.VPNService, err
+}
+
+func networkingV2VPNServiceCreate(client *gophercloud.ServiceClient, opts VPNServiceCreateOpts) (r VPNServiceResult) {
+	b, err := opts.ToVPNServiceCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("vpn", "vpnservices"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2VPNServiceGet(client *gophercloud.ServiceClient, serviceID string) (r VPNServiceResult) {
+	_, r.Err = client.Get(client.ServiceURL("vpn", "vpnservices", serviceID), &r.Body, nil)
+	return
+}
+
+func networkingV2VPNServiceUpdate(client *gophercloud.ServiceClient, serviceID string, opts VPNServiceUpdateOpts) (r VPNServiceResult) {
+	b, err := opts.ToVPNServiceUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("vpn", "vpnservices", serviceID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2VPNServiceDelete(client *gophercloud.ServiceClient, serviceID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("vpn", "vpnservices", serviceID), nil)
+	return
+}
+
+// EndpointGroup is a VPNaaS endpoint group.
+type EndpointGroup struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Type        string   `json:"type"`
+	Endpoints   []string `json:"endpoints"`
+	TenantID    string   `json:"tenant_id"`
+}
+
+// EndpointGroupCreateOpts represents the attributes used when creating a
+// new endpoint group.
+type EndpointGroupCreateOpts struct {
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Type        string            `json:"type"`
+	Endpoints   []string          `json:"endpoints"`
+	TenantID    string            `json:"tenant_id,omitempty"`
+	ValueSpecs  map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToEndpointGroupCreateMap casts an EndpointGroupCreateOpts struct to a map.
+func (opts EndpointGroupCreateOpts) ToEndpointGroupCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "endpoint_group")
+}
+
+// EndpointGroupUpdateOpts represents the attributes used when updating an
+// existing endpoint group. The type and endpoints of an endpoint group
+// cannot be updated.
+type EndpointGroupUpdateOpts struct {
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+}
+
+// ToEndpointGroupUpdateMap casts an EndpointGroupUpdateOpts struct to a map.
+func (opts EndpointGroupUpdateOpts) ToEndpointGroupUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "endpoint_group")
+}
+
+// EndpointGroupResult is the result of a create, get or update request.
+type EndpointGroupResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an EndpointGroupResult as an EndpointGroup.
+func (r EndpointGroupResult) Extract() (*EndpointGroup, error) {
+	var s struct {
+		EndpointGroup *EndpointGroup `json:"endpoint_group"`
+	}
+	err := r.ExtractInto(&s)
+	return s.EndpointGroup, err
+}
+
+func networkingV2EndpointGroupCreate(client *gophercloud.ServiceClient, opts EndpointGroupCreateOpts) (r EndpointGroupResult) {
+	b, err := opts.ToEndpointGroupCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("vpn", "endpoint-groups"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2EndpointGroupGet(client *gophercloud.ServiceClient, groupID string) (r EndpointGroupResult) {
+	_, r.Err = client.Get(client.ServiceURL("vpn", "endpoint-groups", groupID), &r.Body, nil)
+	return
+}
+
+func networkingV2EndpointGroupUpdate(client *gophercloud.ServiceClient, groupID string, opts EndpointGroupUpdateOpts) (r EndpointGroupResult) {
+	b, err := opts.ToEndpointGroupUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("vpn", "endpoint-groups", groupID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2EndpointGroupDelete(client *gophercloud.ServiceClient, groupID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("vpn", "endpoint-groups", groupID), nil)
+	return
+}
+
+// SiteConnection is a VPNaaS IPsec site connection.
+type SiteConnection struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	Description    string   `json:"description"`
+	IKEPolicyID    string   `json:"ikepolicy_id"`
+	IPSecPolicyID  string   `json:"ipsecpolicy_id"`
+	VPNServiceID   string   `json:"vpnservice_id"`
+	LocalEPGroupID string   `json:"local_ep_group_id"`
+	PeerEPGroupID  string   `json:"peer_ep_group_id"`
+	LocalID        string   `json:"local_id"`
+	PeerAddress    string   `json:"peer_address"`
+	PeerID         string   `json:"peer_id"`
+	PeerCIDRs      []string `json:"peer_cidrs"`
+	PSK            string   `json:"psk"`
+	MTU            int      `json:"mtu"`
+	Initiator      string   `json:"initiator"`
+	AdminStateUp   bool     `json:"admin_state_up"`
+	DPD            VPNDPD   `json:"dpd"`
+	Status         string   `json:"status"`
+	TenantID       string   `json:"tenant_id"`
+}
+
+// SiteConnectionCreateOpts represents the attributes used when creating a
+// new IPsec site connection.
+type SiteConnectionCreateOpts struct {
+	Name           string            `json:"name,omitempty"`
+	Description    string            `json:"description,omitempty"`
+	IKEPolicyID    string            `json:"ikepolicy_id"`
+	IPSecPolicyID  string            `json:"ipsecpolicy_id"`
+	VPNServiceID   string            `json:"vpnservice_id"`
+	LocalEPGroupID string            `json:"local_ep_group_id,omitempty"`
+	PeerEPGroupID  string            `json:"peer_ep_group_id,omitempty"`
+	LocalID        string            `json:"local_id,omitempty"`
+	PeerAddress    string            `json:"peer_address"`
+	PeerID         string            `json:"peer_id"`
+	PeerCIDRs      []string          `json:"peer_cidrs,omitempty"`
+	PSK            string            `json:"psk"`
+	MTU            int               `json:"mtu,omitempty"`
+	Initiator      string            `json:"initiator,omitempty"`
+	AdminStateUp   *bool             `json:"admin_state_up,omitempty"`
+	DPD            *VPNDPD           `json:"dpd,omitempty"`
+	TenantID       string            `json:"tenant_id,omitempty"`
+	ValueSpecs     map[string]string `json:"value_specs,omitempty"`
+}
+
+// ToSiteConnectionCreateMap casts a SiteConnectionCreateOpts struct to a
+// map.
+func (opts SiteConnectionCreateOpts) ToSiteConnectionCreateMap() (map[string]interface{}, error) {
+	return BuildRequest(opts, "ipsec_site_connection")
+}
+
+// SiteConnectionUpdateOpts represents the attributes used when updating an
+// existing IPsec site connection.
+type SiteConnectionUpdateOpts struct {
+	Name           *string  `json:"name,omitempty"`
+	Description    *string  `json:"description,omitempty"`
+	LocalEPGroupID *string  `json:"local_ep_group_id,omitempty"`
+	PeerEPGroupID  *string  `json:"peer_ep_group_id,omitempty"`
+	LocalID        *string  `json:"local_id,omitempty"`
+	PeerAddress    *string  `json:"peer_address,omitempty"`
+	PeerID         *string  `json:"peer_id,omitempty"`
+	PeerCIDRs      []string `json:"peer_cidrs,omitempty"`
+	PSK            *string  `json:"psk,omitempty"`
+	MTU            *int     `json:"mtu,omitempty"`
+	Initiator      *string  `json:"initiator,omitempty"`
+	AdminStateUp   *bool    `json:"admin_state_up,omitempty"`
+	DPD            *VPNDPD  `json:"dpd,omitempty"`
+}
+
+// ToSiteConnectionUpdateMap casts a SiteConnectionUpdateOpts struct to a
+// map.
+func (opts SiteConnectionUpdateOpts) ToSiteConnectionUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "ipsec_site_connection")
+}
+
+// SiteConnectionResult is the result of a create, get or update request.
+type SiteConnectionResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a SiteConnectionResult as a SiteConnection.
+func (r SiteConnectionResult) Extract() (*SiteConnection, error) {
+	var s struct {
+		SiteConnection *SiteConnection `json:"ipsec_site_connection"`
+	}
+	err := r.ExtractInto(&s)
+	return s.SiteConnection, err
+}
+
+func networkingV2SiteConnectionCreate(client *gophercloud.ServiceClient, opts SiteConnectionCreateOpts) (r SiteConnectionResult) {
+	b, err := opts.ToSiteConnectionCreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("vpn", "ipsec-site-connections"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func networkingV2SiteConnectionGet(client *gophercloud.ServiceClient, connectionID string) (r SiteConnectionResult) {
+	_, r.Err = client.Get(client.ServiceURL("vpn", "ipsec-site-connections", connectionID), &r.Body, nil)
+	return
+}
+
+func networkingV2SiteConnectionUpdate(client *gophercloud.ServiceClient, connectionID string, opts SiteConnectionUpdateOpts) (r SiteConnectionResult) {
+	b, err := opts.ToSiteConnectionUpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("vpn", "ipsec-site-connections", connectionID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func networkingV2SiteConnectionDelete(client *gophercloud.ServiceClient, connectionID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("vpn", "ipsec-site-connections", connectionID), nil)
+	return
+}
+
+// vpnaasLifetimeSchema returns the schema of the lifetime of an IKE or
+// IPsec policy.
+func vpnaasLifetimeSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"units": &schema.Schema{
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  "seconds",
+				},
+				"value": &schema.Schema{
+					Type:     schema.TypeInt,
+					Optional: true,
+					Default:  3600,
+				},
+			},
+		},
+	}
+}
+
+// expandVPNaaSLifetime returns the lifetime configured in d, or nil if no
+// lifetime was configured.
+func expandVPNaaSLifetime(d *schema.ResourceData) *VPNLifetime {
+	rawLifetime := d.Get("lifetime").(*schema.Set).List()
+	if len(rawLifetime) == 0 {
+		return nil
+	}
+
+	lifetime := rawLifetime[0].(map[string]interface{})
+	return &VPNLifetime{
+		Units: lifetime["units"].(string),
+		Value: lifetime["value"].(int),
+	}
+}
+
+func flattenVPNaaSLifetime(lifetime VPNLifetime) []interface{} {
+	return []interface{}{
+		map[string]interface{}{
+			"units": lifetime.Units,
+			"value": lifetime.Value,
+		},
+	}
+}
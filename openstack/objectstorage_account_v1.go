@@ -0,0 +1,56 @@
+// This set of code handles the account headers which are used by the
+// openstack_objectstorage_account_v1 resource but which can't be set with
+// the account options of Gophercloud: the removal of metadata.
+// Gophercloud does not support these headers yet.
+package openstack
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+)
+
+const (
+	// objectStorageV1AccountMetaPrefix is the prefix of account metadata
+	// headers.
+	objectStorageV1AccountMetaPrefix = "X-Account-Meta-"
+
+	// objectStorageV1AccountRemoveMetaPrefix is the prefix of headers which
+	// remove account metadata.
+	objectStorageV1AccountRemoveMetaPrefix = "X-Remove-Account-Meta-"
+
+	// objectStorageV1AccountQuotaBytesKey is the metadata key of the quota
+	// of the account, in bytes. It is enforced by the account_quotas
+	// middleware and can only be set by reseller admins.
+	objectStorageV1AccountQuotaBytesKey = "Quota-Bytes"
+)
+
+// AccountV1UpdateOpts extends the account update options with additional
+// headers.
+type AccountV1UpdateOpts struct {
+	accounts.UpdateOptsBuilder
+	Headers map[string]string
+}
+
+// ToAccountUpdateMap adds the additional headers to the base account update
+// headers.
+func (opts AccountV1UpdateOpts) ToAccountUpdateMap() (map[string]string, error) {
+	h, err := opts.UpdateOptsBuilder.ToAccountUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range opts.Headers {
+		h[k] = v
+	}
+
+	return h, nil
+}
+
+// objectStorageV1AccountName returns the name of the account of an Object
+// Storage client, which is the last element of its endpoint.
+func objectStorageV1AccountName(client *gophercloud.ServiceClient) string {
+	parts := strings.Split(strings.TrimSuffix(client.Endpoint, "/"), "/")
+	return parts[len(parts)-1]
+}
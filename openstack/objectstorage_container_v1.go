@@ -0,0 +1,142 @@
+// This set of code handles the container headers which are used by the
+// openstack_objectstorage_container_v1 resource but which can't be set with
+// the container options of Gophercloud: history based object versioning,
+// the removal of headers and metadata, reading back headers, and comparing
+// access control lists (ACLs).
+// Gophercloud does not support these headers yet.
+package openstack
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const (
+	// objectStorageV1ContainerMetaPrefix is the prefix of container
+	// metadata headers.
+	objectStorageV1ContainerMetaPrefix = "X-Container-Meta-"
+
+	// objectStorageV1ContainerRemoveMetaPrefix is the prefix of headers
+	// which remove container metadata.
+	objectStorageV1ContainerRemoveMetaPrefix = "X-Remove-Container-Meta-"
+
+	// objectStorageV1ContainerWebMetaPrefix is the prefix of the metadata
+	// keys used by the static website middleware.
+	objectStorageV1ContainerWebMetaPrefix = "Web-"
+
+	// objectStorageV1TempURLKeyMetaPrefix is the prefix of the metadata keys
+	// which hold the temporary URL keys of an account or a container.
+	objectStorageV1TempURLKeyMetaPrefix = "Temp-Url-Key"
+)
+
+// objectStorageV1VersioningHeaders maps the versioning modes of a
+// container to the header which holds the location of the old versions of
+// its objects.
+var objectStorageV1VersioningHeaders = map[string]string{
+	"versions": "X-Versions-Location",
+	"history":  "X-History-Location",
+}
+
+// ContainerV1CreateOpts extends the container create options with
+// additional headers.
+type ContainerV1CreateOpts struct {
+	containers.CreateOptsBuilder
+	Headers map[string]string
+}
+
+// ToContainerCreateMap adds the additional headers to the base container
+// creation headers.
+func (opts ContainerV1CreateOpts) ToContainerCreateMap() (map[string]string, error) {
+	h, err := opts.CreateOptsBuilder.ToContainerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range opts.Headers {
+		h[k] = v
+	}
+
+	return h, nil
+}
+
+// ContainerV1UpdateOpts extends the container update options with
+// additional headers. Unlike the base options, headers with an empty value
+// are sent, which clears them.
+type ContainerV1UpdateOpts struct {
+	containers.UpdateOptsBuilder
+	Headers map[string]string
+}
+
+// ToContainerUpdateMap adds the additional headers to the base container
+// update headers.
+func (opts ContainerV1UpdateOpts) ToContainerUpdateMap() (map[string]string, error) {
+	h, err := opts.UpdateOptsBuilder.ToContainerUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range opts.Headers {
+		h[k] = v
+	}
+
+	return h, nil
+}
+
+// objectStorageV1Metadata returns the metadata of an account or a container
+// from its headers. Header names are canonicalized by Swift, so the case of
+// the given keys is restored where they match case-insensitively. Metadata
+// whose key starts with one of the excluded prefixes is left out, as it is
+// managed by other arguments.
+func objectStorageV1Metadata(header http.Header, prefix string, keys map[string]interface{}, excluded ...string) map[string]string {
+	metadata := make(map[string]string)
+	for k, v := range header {
+		if !strings.HasPrefix(k, prefix) || len(v) == 0 {
+			continue
+		}
+
+		key := strings.TrimPrefix(k, prefix)
+
+		var isExcluded bool
+		for _, excludedPrefix := range excluded {
+			if strings.HasPrefix(key, excludedPrefix) {
+				isExcluded = true
+				break
+			}
+		}
+		if isExcluded {
+			continue
+		}
+
+		for configuredKey := range keys {
+			if strings.EqualFold(configuredKey, key) {
+				key = configuredKey
+				break
+			}
+		}
+
+		metadata[key] = v[0]
+	}
+
+	return metadata
+}
+
+// objectStorageV1NormalizeACL returns a container ACL in the form Swift
+// stores it, without whitespace and empty elements.
+func objectStorageV1NormalizeACL(acl string) string {
+	var elements []string
+	for _, element := range strings.Split(acl, ",") {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+	return strings.Join(elements, ",")
+}
+
+// suppressEquivalentObjectStorageACLDiffs suppresses the diff of container
+// ACLs which only differ in whitespace.
+func suppressEquivalentObjectStorageACLDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return objectStorageV1NormalizeACL(old) == objectStorageV1NormalizeACL(new)
+}
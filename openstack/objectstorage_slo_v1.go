@@ -0,0 +1,201 @@
+// This set of code handles Static Large Objects (SLO) of Object Storage.
+// Swift rejects uploads larger than 5 GB, so larger files are uploaded as
+// segments into a separate container, and the object itself is a manifest
+// which lists the segments in order.
+// Gophercloud does not support uploading SLOs yet, so the requests are
+// built here.
+package openstack
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/objects"
+)
+
+const (
+	// objectStorageV1SLODefaultThresholdMB is the size in MB above which a
+	// file is uploaded as a Static Large Object by default. It is the
+	// largest object Object Storage accepts by default.
+	objectStorageV1SLODefaultThresholdMB = 5120
+
+	// objectStorageV1SLODefaultSegmentSizeMB is the default size in MB of
+	// the segments of a Static Large Object.
+	objectStorageV1SLODefaultSegmentSizeMB = 1024
+)
+
+// SLOSegment is a segment of a Static Large Object, as listed in its
+// manifest when it is uploaded.
+type SLOSegment struct {
+	Path      string `json:"path"`
+	ETag      string `json:"etag"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+// objectStorageV1SLOSegmentContainer returns the default name of the
+// container which holds the segments of the objects of a container.
+func objectStorageV1SLOSegmentContainer(container string) string {
+	return container + "_segments"
+}
+
+// objectStorageV1SLOUploadSegments uploads a file as segments of at most
+// segmentSize bytes into segmentContainer, which is created if it doesn't
+// exist. The segments are named after the object and the upload time, so
+// that the segments of a previous upload are not overwritten.
+func objectStorageV1SLOUploadSegments(client *gophercloud.ServiceClient, file *os.File, size int64, segmentContainer, name string, segmentSize int64) ([]SLOSegment, error) {
+	if segmentSize <= 0 {
+		return nil, fmt.Errorf("Invalid segment size: %d", segmentSize)
+	}
+
+	_, err := containers.Create(client, segmentContainer, nil).Extract()
+	if err != nil {
+		return nil, fmt.Errorf("Error creating segment container %s: %s", segmentContainer, err)
+	}
+
+	prefix := fmt.Sprintf("%s/slo/%d/%d/%d", name, time.Now().Unix(), size, segmentSize)
+
+	var segments []SLOSegment
+	for i, offset := 0, int64(0); offset < size; i, offset = i+1, offset+segmentSize {
+		length := segmentSize
+		if offset+length > size {
+			length = size - offset
+		}
+
+		// The checksum is computed first so that Swift verifies the
+		// uploaded segment.
+		hash := md5.New()
+		if _, err := io.Copy(hash, io.NewSectionReader(file, offset, length)); err != nil {
+			return nil, fmt.Errorf("Error reading segment %d of %s: %s", i, file.Name(), err)
+		}
+		etag := fmt.Sprintf("%x", hash.Sum(nil))
+
+		segmentName := fmt.Sprintf("%s/%08d", prefix, i)
+		_, err := client.Request("PUT", client.ServiceURL(segmentContainer, segmentName), &gophercloud.RequestOpts{
+			RawBody: io.NewSectionReader(file, offset, length),
+			MoreHeaders: map[string]string{
+				"ETag": etag,
+			},
+			OkCodes: []int{201},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error uploading segment %s/%s: %s", segmentContainer, segmentName, err)
+		}
+
+		segments = append(segments, SLOSegment{
+			Path:      fmt.Sprintf("/%s/%s", segmentContainer, segmentName),
+			ETag:      etag,
+			SizeBytes: length,
+		})
+	}
+
+	return segments, nil
+}
+
+// objectStorageV1SLOManifestPut creates or replaces an object with the
+// manifest of a Static Large Object. The headers of the object are taken
+// from opts, apart from its content and checksum.
+func objectStorageV1SLOManifestPut(client *gophercloud.ServiceClient, container, name string, opts *objects.CreateOpts, segments []SLOSegment) (r objects.CreateResult) {
+	h, err := gophercloud.BuildHeaders(opts)
+	if err != nil {
+		r.Err = err
+		return
+	}
+	for k, v := range opts.Metadata {
+		h["X-Object-Meta-"+k] = v
+	}
+
+	// The checksum of a manifest is the checksum of the checksums of its
+	// segments, not of the manifest itself.
+	delete(h, "ETag")
+	delete(h, "Content-Length")
+	delete(h, "Transfer-Encoding")
+
+	b, err := json.Marshal(segments)
+	if err != nil {
+		r.Err = err
+		return
+	}
+
+	resp, err := client.Request("PUT", client.ServiceURL(container, name)+"?multipart-manifest=put", &gophercloud.RequestOpts{
+		RawBody:     bytes.NewReader(b),
+		MoreHeaders: h,
+		OkCodes:     []int{201},
+	})
+	if resp != nil {
+		r.Header = resp.Header
+	}
+	r.Err = err
+	return
+}
+
+// objectStorageV1SLOManifestETag returns the etag of a Static Large Object
+// with the given segments, which is the checksum of the checksums of its
+// segments.
+func objectStorageV1SLOManifestETag(segments []SLOSegment) string {
+	hash := md5.New()
+	for _, segment := range segments {
+		io.WriteString(hash, segment.ETag)
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil))
+}
+
+// objectStorageV1SLOSegmentPaths returns the paths of the segments of an
+// object, in the form /container/object, or nil if the object is not a
+// Static Large Object or doesn't exist.
+func objectStorageV1SLOSegmentPaths(client *gophercloud.ServiceClient, container, name string) ([]string, error) {
+	object, err := objects.Get(client, container, name, nil).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if !object.StaticLargeObject {
+		return nil, nil
+	}
+
+	var segments []struct {
+		Name string `json:"name"`
+	}
+	_, err = client.Get(client.ServiceURL(container, name)+"?multipart-manifest=get", &segments, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, len(segments))
+	for i, segment := range segments {
+		paths[i] = segment.Name
+	}
+
+	return paths, nil
+}
+
+// objectStorageV1SLOSegmentsDelete deletes the given segments, which are
+// in the form /container/object. Segments which don't exist are ignored.
+func objectStorageV1SLOSegmentsDelete(client *gophercloud.ServiceClient, paths []string) error {
+	for _, path := range paths {
+		parts := strings.SplitN(strings.TrimPrefix(path, "/"), "/", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("Invalid segment path: %s", path)
+		}
+
+		_, err := objects.Delete(client, parts[0], parts[1], nil).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+			return fmt.Errorf("Error deleting segment %s: %s", path, err)
+		}
+	}
+
+	return nil
+}
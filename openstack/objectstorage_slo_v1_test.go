@@ -0,0 +1,127 @@
+package openstack
+
+import (
+	"crypto/md5"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func TestObjectStorageV1SLOUploadSegments(t *testing.T) {
+	content := []byte("0123456789")
+
+	file, err := ioutil.TempFile("", "slo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	if _, err := file.Write(content); err != nil {
+		t.Fatal(err)
+	}
+
+	var uploaded [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("Unexpected %s request to %s", r.Method, r.URL.Path)
+		}
+
+		// The first request creates the segment container.
+		if r.URL.Path == "/container_segments" {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("Error reading %s: %s", r.URL.Path, err)
+		}
+		if etag := fmt.Sprintf("%x", md5.Sum(body)); r.Header.Get("ETag") != etag {
+			t.Errorf("Expected ETag %s for %s, got %s", etag, r.URL.Path, r.Header.Get("ETag"))
+		}
+		uploaded = append(uploaded, body)
+
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	client := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       server.URL + "/",
+	}
+
+	segments, err := objectStorageV1SLOUploadSegments(client, file, int64(len(content)), "container_segments", "object", 4)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := [][]byte{[]byte("0123"), []byte("4567"), []byte("89")}
+	if !reflect.DeepEqual(uploaded, expected) {
+		t.Fatalf("Expected segments %q to be uploaded, got %q", expected, uploaded)
+	}
+
+	if len(segments) != len(expected) {
+		t.Fatalf("Expected %d segments, got %d", len(expected), len(segments))
+	}
+
+	for i, segment := range segments {
+		if segment.SizeBytes != int64(len(expected[i])) {
+			t.Fatalf("Expected segment %d to be %d bytes, got %d", i, len(expected[i]), segment.SizeBytes)
+		}
+		if etag := fmt.Sprintf("%x", md5.Sum(expected[i])); segment.ETag != etag {
+			t.Fatalf("Expected segment %d to have ETag %s, got %s", i, etag, segment.ETag)
+		}
+	}
+}
+
+func TestObjectStorageV1SLOUploadSegments_invalidSize(t *testing.T) {
+	client := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       "http://localhost/",
+	}
+
+	_, err := objectStorageV1SLOUploadSegments(client, nil, 10, "container_segments", "object", 0)
+	if err == nil {
+		t.Fatal("Expected an error for a segment size of 0")
+	}
+}
+
+func TestObjectStorageV1SLOManifestETag(t *testing.T) {
+	segments := []SLOSegment{
+		{ETag: fmt.Sprintf("%x", md5.Sum([]byte("0123")))},
+		{ETag: fmt.Sprintf("%x", md5.Sum([]byte("4567")))},
+	}
+
+	expected := fmt.Sprintf("%x", md5.Sum([]byte(segments[0].ETag+segments[1].ETag)))
+	if actual := objectStorageV1SLOManifestETag(segments); actual != expected {
+		t.Fatalf("Expected ETag %s, got %s", expected, actual)
+	}
+}
+
+func TestObjectStorageV1SLOSegmentPaths_notFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := &gophercloud.ServiceClient{
+		ProviderClient: &gophercloud.ProviderClient{},
+		Endpoint:       server.URL + "/",
+	}
+
+	paths, err := objectStorageV1SLOSegmentPaths(client, "container", "object")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if paths != nil {
+		t.Fatalf("Expected no segments, got %v", paths)
+	}
+}
@@ -0,0 +1,121 @@
+// This set of code handles the temporary URLs of Object Storage, which
+// grant time limited access to an object without authentication. A
+// temporary URL is signed with a key which is stored either in the metadata
+// of the account or of the container. Two keys can be set so that keys can
+// be rotated without invalidating existing URLs.
+// Gophercloud only supports signing URLs for GET and POST requests with the
+// first account key, so the requests are built here.
+package openstack
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// objectStorageV1TempURLMethods are the HTTP methods which temporary URLs
+// can be signed for.
+var objectStorageV1TempURLMethods = []string{"GET", "HEAD", "PUT", "POST", "DELETE"}
+
+// TempURLKeys are the keys used to sign temporary URLs.
+type TempURLKeys struct {
+	Key  string
+	Key2 string
+}
+
+// TempURLKeysResult is the result of a get request.
+type TempURLKeysResult struct {
+	gophercloud.HeaderResult
+	container string
+}
+
+// Extract interprets a TempURLKeysResult as TempURLKeys.
+func (r TempURLKeysResult) Extract() (*TempURLKeys, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	keyHeader, key2Header := objectStorageV1TempURLKeyHeaders(r.container)
+	keys := &TempURLKeys{
+		Key:  r.Header.Get(keyHeader),
+		Key2: r.Header.Get(key2Header),
+	}
+
+	return keys, nil
+}
+
+// objectStorageV1TempURLKeyHeaders returns the names of the headers which
+// hold the first and second temporary URL key. The keys of the account are
+// used if container is empty.
+func objectStorageV1TempURLKeyHeaders(container string) (string, string) {
+	if container == "" {
+		return "X-Account-Meta-Temp-Url-Key", "X-Account-Meta-Temp-Url-Key-2"
+	}
+	return "X-Container-Meta-Temp-Url-Key", "X-Container-Meta-Temp-Url-Key-2"
+}
+
+// objectStorageV1TempURLKeysURL returns the URL of the account or the
+// container which holds the temporary URL keys.
+func objectStorageV1TempURLKeysURL(client *gophercloud.ServiceClient, container string) string {
+	if container == "" {
+		return client.Endpoint
+	}
+	return client.ServiceURL(container)
+}
+
+func objectStorageV1TempURLKeysGet(client *gophercloud.ServiceClient, container string) (r TempURLKeysResult) {
+	r.container = container
+	resp, err := client.Request("HEAD", objectStorageV1TempURLKeysURL(client, container), &gophercloud.RequestOpts{
+		OkCodes: []int{200, 204},
+	})
+	if resp != nil {
+		r.Header = resp.Header
+	}
+	r.Err = err
+	return
+}
+
+// objectStorageV1TempURLKeysUpdate sets the temporary URL keys of the
+// account or the container. Empty keys are removed.
+func objectStorageV1TempURLKeysUpdate(client *gophercloud.ServiceClient, container string, keys TempURLKeys) (r gophercloud.ErrResult) {
+	keyHeader, key2Header := objectStorageV1TempURLKeyHeaders(container)
+	h := make(map[string]string)
+	for k, v := range map[string]string{keyHeader: keys.Key, key2Header: keys.Key2} {
+		if v == "" {
+			h[strings.Replace(k, "X-", "X-Remove-", 1)] = "x"
+		} else {
+			h[k] = v
+		}
+	}
+
+	_, r.Err = client.Request("POST", objectStorageV1TempURLKeysURL(client, container), &gophercloud.RequestOpts{
+		MoreHeaders: h,
+		OkCodes:     []int{201, 202, 204},
+	})
+	return
+}
+
+// objectStorageV1TempURL returns a temporary URL of an object, signed with
+// the given key, which allows the given HTTP method until expires.
+func objectStorageV1TempURL(client *gophercloud.ServiceClient, container, object, method string, expires int64, key string) (string, error) {
+	objectURL, err := url.Parse(client.ServiceURL(container, object))
+	if err != nil {
+		return "", fmt.Errorf("Unable to parse object URL: %s", err)
+	}
+
+	// The signature covers the unescaped path of the object.
+	body := fmt.Sprintf("%s\n%d\n%s", method, expires, objectURL.Path)
+	hash := hmac.New(sha1.New, []byte(key))
+	hash.Write([]byte(body))
+
+	query := url.Values{}
+	query.Set("temp_url_sig", fmt.Sprintf("%x", hash.Sum(nil)))
+	query.Set("temp_url_expires", fmt.Sprintf("%d", expires))
+	objectURL.RawQuery = query.Encode()
+
+	return objectURL.String(), nil
+}
@@ -0,0 +1,194 @@
+// This set of code handles software configs and software deployments of the
+// Orchestration (Heat) v1 API. A software config holds configuration which
+// is delivered to servers by a software deployment. The agents running on
+// the server poll the metadata of the server, apply the configuration and
+// signal the outputs of the deployment back to Heat.
+// Gophercloud does not support software configs and deployments yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// SoftwareConfigV1Input is an input of a software config.
+type SoftwareConfigV1Input struct {
+	Name        string      `json:"name"`
+	Type        string      `json:"type,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Default     interface{} `json:"default,omitempty"`
+}
+
+// SoftwareConfigV1Output is an output of a software config.
+type SoftwareConfigV1Output struct {
+	Name        string `json:"name"`
+	Type        string `json:"type,omitempty"`
+	Description string `json:"description,omitempty"`
+	ErrorOutput bool   `json:"error_output,omitempty"`
+}
+
+// SoftwareConfigV1 is an Orchestration v1 software config.
+type SoftwareConfigV1 struct {
+	ID           string                   `json:"id"`
+	Name         string                   `json:"name"`
+	Group        string                   `json:"group"`
+	Config       string                   `json:"config"`
+	Inputs       []SoftwareConfigV1Input  `json:"inputs"`
+	Outputs      []SoftwareConfigV1Output `json:"outputs"`
+	Options      map[string]interface{}   `json:"options"`
+	CreationTime string                   `json:"creation_time"`
+}
+
+// SoftwareConfigV1CreateOpts represents the attributes used when creating a
+// new Orchestration v1 software config. Software configs are immutable.
+type SoftwareConfigV1CreateOpts struct {
+	Name    string                   `json:"name,omitempty"`
+	Group   string                   `json:"group,omitempty"`
+	Config  string                   `json:"config,omitempty"`
+	Inputs  []SoftwareConfigV1Input  `json:"inputs,omitempty"`
+	Outputs []SoftwareConfigV1Output `json:"outputs,omitempty"`
+	Options map[string]interface{}   `json:"options,omitempty"`
+}
+
+// ToSoftwareConfigV1CreateMap casts a SoftwareConfigV1CreateOpts struct to a
+// map.
+func (opts SoftwareConfigV1CreateOpts) ToSoftwareConfigV1CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// SoftwareConfigV1Result is the result of a create or get request.
+type SoftwareConfigV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a SoftwareConfigV1Result as a SoftwareConfigV1.
+func (r SoftwareConfigV1Result) Extract() (*SoftwareConfigV1, error) {
+	var s struct {
+		SoftwareConfig *SoftwareConfigV1 `json:"software_config"`
+	}
+	err := r.ExtractInto(&s)
+	return s.SoftwareConfig, err
+}
+
+func orchestrationV1SoftwareConfigCreate(client *gophercloud.ServiceClient, opts SoftwareConfigV1CreateOpts) (r SoftwareConfigV1Result) {
+	b, err := opts.ToSoftwareConfigV1CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("software_configs"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func orchestrationV1SoftwareConfigGet(client *gophercloud.ServiceClient, configID string) (r SoftwareConfigV1Result) {
+	_, r.Err = client.Get(client.ServiceURL("software_configs", configID), &r.Body, nil)
+	return
+}
+
+func orchestrationV1SoftwareConfigDelete(client *gophercloud.ServiceClient, configID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("software_configs", configID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// SoftwareDeploymentV1 is an Orchestration v1 software deployment, which
+// applies a software config to a server.
+type SoftwareDeploymentV1 struct {
+	ID           string                 `json:"id"`
+	ConfigID     string                 `json:"config_id"`
+	ServerID     string                 `json:"server_id"`
+	Action       string                 `json:"action"`
+	Status       string                 `json:"status"`
+	StatusReason string                 `json:"status_reason"`
+	InputValues  map[string]interface{} `json:"input_values"`
+	OutputValues map[string]interface{} `json:"output_values"`
+	CreationTime string                 `json:"creation_time"`
+	UpdatedTime  string                 `json:"updated_time"`
+}
+
+// SoftwareDeploymentV1CreateOpts represents the attributes used when
+// creating a new Orchestration v1 software deployment.
+type SoftwareDeploymentV1CreateOpts struct {
+	ConfigID     string                 `json:"config_id" required:"true"`
+	ServerID     string                 `json:"server_id" required:"true"`
+	Action       string                 `json:"action,omitempty"`
+	Status       string                 `json:"status,omitempty"`
+	StatusReason string                 `json:"status_reason,omitempty"`
+	InputValues  map[string]interface{} `json:"input_values,omitempty"`
+}
+
+// ToSoftwareDeploymentV1CreateMap casts a SoftwareDeploymentV1CreateOpts
+// struct to a map.
+func (opts SoftwareDeploymentV1CreateOpts) ToSoftwareDeploymentV1CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// SoftwareDeploymentV1UpdateOpts represents the attributes used when
+// updating an existing Orchestration v1 software deployment.
+type SoftwareDeploymentV1UpdateOpts struct {
+	ConfigID     string                 `json:"config_id,omitempty"`
+	Action       string                 `json:"action,omitempty"`
+	Status       string                 `json:"status,omitempty"`
+	StatusReason string                 `json:"status_reason,omitempty"`
+	InputValues  map[string]interface{} `json:"input_values,omitempty"`
+}
+
+// ToSoftwareDeploymentV1UpdateMap casts a SoftwareDeploymentV1UpdateOpts
+// struct to a map.
+func (opts SoftwareDeploymentV1UpdateOpts) ToSoftwareDeploymentV1UpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// SoftwareDeploymentV1Result is the result of a create, get or update
+// request.
+type SoftwareDeploymentV1Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a SoftwareDeploymentV1Result as a
+// SoftwareDeploymentV1.
+func (r SoftwareDeploymentV1Result) Extract() (*SoftwareDeploymentV1, error) {
+	var s struct {
+		SoftwareDeployment *SoftwareDeploymentV1 `json:"software_deployment"`
+	}
+	err := r.ExtractInto(&s)
+	return s.SoftwareDeployment, err
+}
+
+func orchestrationV1SoftwareDeploymentCreate(client *gophercloud.ServiceClient, opts SoftwareDeploymentV1CreateOpts) (r SoftwareDeploymentV1Result) {
+	b, err := opts.ToSoftwareDeploymentV1CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("software_deployments"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func orchestrationV1SoftwareDeploymentGet(client *gophercloud.ServiceClient, deploymentID string) (r SoftwareDeploymentV1Result) {
+	_, r.Err = client.Get(client.ServiceURL("software_deployments", deploymentID), &r.Body, nil)
+	return
+}
+
+func orchestrationV1SoftwareDeploymentUpdate(client *gophercloud.ServiceClient, deploymentID string, opts SoftwareDeploymentV1UpdateOpts) (r SoftwareDeploymentV1Result) {
+	b, err := opts.ToSoftwareDeploymentV1UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("software_deployments", deploymentID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func orchestrationV1SoftwareDeploymentDelete(client *gophercloud.ServiceClient, deploymentID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("software_deployments", deploymentID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
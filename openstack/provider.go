@@ -11,7 +11,7 @@ var osMutexKV = mutexkv.NewMutexKV()
 
 // Provider returns a schema.Provider for OpenStack.
 func Provider() terraform.ResourceProvider {
-	return &schema.Provider{
+	return &diffProvider{Provider: &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"auth_url": &schema.Schema{
 				Type:        schema.TypeString,
@@ -153,61 +153,161 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("OS_CLOUD", ""),
 				Description: descriptions["cloud"],
 			},
+
+			"identity_api_version": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("OS_IDENTITY_API_VERSION", ""),
+				Description: descriptions["identity_api_version"],
+			},
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"openstack_dns_zone_v2":            dataSourceDNSZoneV2(),
-			"openstack_images_image_v2":        dataSourceImagesImageV2(),
-			"openstack_networking_network_v2":  dataSourceNetworkingNetworkV2(),
-			"openstack_networking_subnet_v2":   dataSourceNetworkingSubnetV2(),
-			"openstack_networking_secgroup_v2": dataSourceNetworkingSecGroupV2(),
+			"openstack_blockstorage_snapshot_v3":               dataSourceBlockStorageSnapshotV3(),
+			"openstack_blockstorage_volume_v3":                 dataSourceBlockStorageVolumeV3(),
+			"openstack_compute_availability_zones_v2":          dataSourceComputeAvailabilityZonesV2(),
+			"openstack_compute_flavor_v2":                      dataSourceComputeFlavorV2(),
+			"openstack_compute_instance_v2":                    dataSourceComputeInstanceV2(),
+			"openstack_compute_instance_ids_v2":                dataSourceComputeInstanceIdsV2(),
+			"openstack_compute_instance_password_v2":           dataSourceComputeInstancePasswordV2(),
+			"openstack_compute_keypair_v2":                     dataSourceComputeKeypairV2(),
+			"openstack_containerinfra_cluster_v1":              dataSourceContainerInfraClusterV1(),
+			"openstack_dns_zone_v2":                            dataSourceDNSZoneV2(),
+			"openstack_identity_group_v3":                      dataSourceIdentityGroupV3(),
+			"openstack_identity_project_ids_v3":                dataSourceIdentityProjectIdsV3(),
+			"openstack_identity_project_v3":                    dataSourceIdentityProjectV3(),
+			"openstack_identity_role_v3":                       dataSourceIdentityRoleV3(),
+			"openstack_identity_user_v3":                       dataSourceIdentityUserV3(),
+			"openstack_images_image_v2":                        dataSourceImagesImageV2(),
+			"openstack_keymanager_secret_v1":                   dataSourceKeyManagerSecretV1(),
+			"openstack_lb_amphorae_v2":                         dataSourceLBAmphoraeV2(),
+			"openstack_lb_flavor_v2":                           dataSourceLBFlavorV2(),
+			"openstack_networking_agents_v2":                   dataSourceNetworkingAgentsV2(),
+			"openstack_networking_floatingip_v2":               dataSourceNetworkingFloatingIPV2(),
+			"openstack_networking_network_v2":                  dataSourceNetworkingNetworkV2(),
+			"openstack_networking_subnet_v2":                   dataSourceNetworkingSubnetV2(),
+			"openstack_networking_secgroup_v2":                 dataSourceNetworkingSecGroupV2(),
+			"openstack_objectstorage_object_v1":                dataSourceObjectStorageObjectV1(),
+			"openstack_objectstorage_tempurl_v1":               dataSourceObjectStorageTempURLV1(),
+			"openstack_sharedfilesystem_availability_zones_v2": dataSourceSharedFilesystemAvailabilityZonesV2(),
+			"openstack_sharedfilesystem_share_type_v2":         dataSourceSharedFilesystemShareTypeV2(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
-			"openstack_blockstorage_volume_v1":          resourceBlockStorageVolumeV1(),
-			"openstack_blockstorage_volume_v2":          resourceBlockStorageVolumeV2(),
-			"openstack_blockstorage_volume_attach_v2":   resourceBlockStorageVolumeAttachV2(),
-			"openstack_compute_flavor_v2":               resourceComputeFlavorV2(),
-			"openstack_compute_instance_v2":             resourceComputeInstanceV2(),
-			"openstack_compute_keypair_v2":              resourceComputeKeypairV2(),
-			"openstack_compute_secgroup_v2":             resourceComputeSecGroupV2(),
-			"openstack_compute_servergroup_v2":          resourceComputeServerGroupV2(),
-			"openstack_compute_floatingip_v2":           resourceComputeFloatingIPV2(),
-			"openstack_compute_floatingip_associate_v2": resourceComputeFloatingIPAssociateV2(),
-			"openstack_compute_volume_attach_v2":        resourceComputeVolumeAttachV2(),
-			"openstack_db_instance_v1":                  resourceDatabaseInstanceV1(),
-			"openstack_dns_recordset_v2":                resourceDNSRecordSetV2(),
-			"openstack_dns_zone_v2":                     resourceDNSZoneV2(),
-			"openstack_fw_firewall_v1":                  resourceFWFirewallV1(),
-			"openstack_fw_policy_v1":                    resourceFWPolicyV1(),
-			"openstack_fw_rule_v1":                      resourceFWRuleV1(),
-			"openstack_identity_project_v3":             resourceIdentityProjectV3(),
-			"openstack_identity_user_v3":                resourceIdentityUserV3(),
-			"openstack_images_image_v2":                 resourceImagesImageV2(),
-			"openstack_lb_member_v1":                    resourceLBMemberV1(),
-			"openstack_lb_monitor_v1":                   resourceLBMonitorV1(),
-			"openstack_lb_pool_v1":                      resourceLBPoolV1(),
-			"openstack_lb_vip_v1":                       resourceLBVipV1(),
-			"openstack_lb_loadbalancer_v2":              resourceLoadBalancerV2(),
-			"openstack_lb_listener_v2":                  resourceListenerV2(),
-			"openstack_lb_pool_v2":                      resourcePoolV2(),
-			"openstack_lb_member_v2":                    resourceMemberV2(),
-			"openstack_lb_monitor_v2":                   resourceMonitorV2(),
-			"openstack_networking_network_v2":           resourceNetworkingNetworkV2(),
-			"openstack_networking_subnet_v2":            resourceNetworkingSubnetV2(),
-			"openstack_networking_floatingip_v2":        resourceNetworkingFloatingIPV2(),
-			"openstack_networking_port_v2":              resourceNetworkingPortV2(),
-			"openstack_networking_router_v2":            resourceNetworkingRouterV2(),
-			"openstack_networking_router_interface_v2":  resourceNetworkingRouterInterfaceV2(),
-			"openstack_networking_router_route_v2":      resourceNetworkingRouterRouteV2(),
-			"openstack_networking_secgroup_v2":          resourceNetworkingSecGroupV2(),
-			"openstack_networking_secgroup_rule_v2":     resourceNetworkingSecGroupRuleV2(),
-			"openstack_objectstorage_container_v1":      resourceObjectStorageContainerV1(),
-			"openstack_objectstorage_object_v1":         resourceObjectStorageObjectV1(),
+			"openstack_blockstorage_qos_v3":                       resourceBlockStorageQoSV3(),
+			"openstack_blockstorage_qos_association_v3":           resourceBlockStorageQoSAssociationV3(),
+			"openstack_blockstorage_quotaset_v3":                  resourceBlockStorageQuotaSetV3(),
+			"openstack_blockstorage_snapshot_v3":                  resourceBlockStorageSnapshotV3(),
+			"openstack_blockstorage_volume_v1":                    resourceBlockStorageVolumeV1(),
+			"openstack_blockstorage_volume_v2":                    resourceBlockStorageVolumeV2(),
+			"openstack_blockstorage_volume_v3":                    resourceBlockStorageVolumeV3(),
+			"openstack_blockstorage_volume_type_v3":               resourceBlockStorageVolumeTypeV3(),
+			"openstack_blockstorage_volume_transfer_v3":           resourceBlockStorageVolumeTransferV3(),
+			"openstack_blockstorage_volume_transfer_accept_v3":    resourceBlockStorageVolumeTransferAcceptV3(),
+			"openstack_blockstorage_volume_attach_v2":             resourceBlockStorageVolumeAttachV2(),
+			"openstack_compute_flavor_v2":                         resourceComputeFlavorV2(),
+			"openstack_compute_instance_v2":                       resourceComputeInstanceV2(),
+			"openstack_compute_interface_attach_v2":               resourceComputeInterfaceAttachV2(),
+			"openstack_compute_keypair_v2":                        resourceComputeKeypairV2(),
+			"openstack_compute_quotaset_v2":                       resourceComputeQuotaSetV2(),
+			"openstack_compute_secgroup_v2":                       resourceComputeSecGroupV2(),
+			"openstack_compute_servergroup_v2":                    resourceComputeServerGroupV2(),
+			"openstack_compute_floatingip_v2":                     resourceComputeFloatingIPV2(),
+			"openstack_compute_floatingip_associate_v2":           resourceComputeFloatingIPAssociateV2(),
+			"openstack_compute_volume_attach_v2":                  resourceComputeVolumeAttachV2(),
+			"openstack_containerinfra_cluster_v1":                 resourceContainerInfraClusterV1(),
+			"openstack_containerinfra_clustertemplate_v1":         resourceContainerInfraClusterTemplateV1(),
+			"openstack_containerinfra_nodegroup_v1":               resourceContainerInfraNodeGroupV1(),
+			"openstack_db_instance_v1":                            resourceDatabaseInstanceV1(),
+			"openstack_dns_ptrrecord_v2":                          resourceDNSPtrRecordV2(),
+			"openstack_dns_recordset_v2":                          resourceDNSRecordSetV2(),
+			"openstack_dns_transfer_accept_v2":                    resourceDNSTransferAcceptV2(),
+			"openstack_dns_transfer_request_v2":                   resourceDNSTransferRequestV2(),
+			"openstack_dns_tsigkey_v2":                            resourceDNSTSIGKeyV2(),
+			"openstack_dns_zone_v2":                               resourceDNSZoneV2(),
+			"openstack_fw_firewall_v1":                            resourceFWFirewallV1(),
+			"openstack_fw_policy_v1":                              resourceFWPolicyV1(),
+			"openstack_fw_rule_v1":                                resourceFWRuleV1(),
+			"openstack_fw_group_v2":                               resourceFWGroupV2(),
+			"openstack_fw_policy_v2":                              resourceFWPolicyV2(),
+			"openstack_fw_rule_v2":                                resourceFWRuleV2(),
+			"openstack_identity_endpoint_v3":                      resourceIdentityEndpointV3(),
+			"openstack_identity_limit_v3":                         resourceIdentityLimitV3(),
+			"openstack_identity_project_v3":                       resourceIdentityProjectV3(),
+			"openstack_identity_registered_limit_v3":              resourceIdentityRegisteredLimitV3(),
+			"openstack_identity_service_v3":                       resourceIdentityServiceV3(),
+			"openstack_identity_trust_v3":                         resourceIdentityTrustV3(),
+			"openstack_identity_user_v3":                          resourceIdentityUserV3(),
+			"openstack_images_image_member_accept_v2":             resourceImagesImageMemberAcceptV2(),
+			"openstack_images_image_member_v2":                    resourceImagesImageMemberV2(),
+			"openstack_images_image_v2":                           resourceImagesImageV2(),
+			"openstack_images_metadef_namespace_v2":               resourceImagesMetadefNamespaceV2(),
+			"openstack_images_metadef_object_v2":                  resourceImagesMetadefObjectV2(),
+			"openstack_images_metadef_property_v2":                resourceImagesMetadefPropertyV2(),
+			"openstack_keymanager_acl_v1":                         resourceKeyManagerACLV1(),
+			"openstack_keymanager_container_v1":                   resourceKeyManagerContainerV1(),
+			"openstack_keymanager_order_v1":                       resourceKeyManagerOrderV1(),
+			"openstack_keymanager_secret_v1":                      resourceKeyManagerSecretV1(),
+			"openstack_lb_member_v1":                              resourceLBMemberV1(),
+			"openstack_lb_monitor_v1":                             resourceLBMonitorV1(),
+			"openstack_lb_pool_v1":                                resourceLBPoolV1(),
+			"openstack_lb_vip_v1":                                 resourceLBVipV1(),
+			"openstack_lb_loadbalancer_v2":                        resourceLoadBalancerV2(),
+			"openstack_lb_listener_v2":                            resourceListenerV2(),
+			"openstack_lb_pool_v2":                                resourcePoolV2(),
+			"openstack_lb_member_v2":                              resourceMemberV2(),
+			"openstack_lb_members_v2":                             resourceMembersV2(),
+			"openstack_lb_monitor_v2":                             resourceMonitorV2(),
+			"openstack_lb_l7policy_v2":                            resourceL7PolicyV2(),
+			"openstack_lb_l7rule_v2":                              resourceL7RuleV2(),
+			"openstack_networking_address_group_v2":               resourceNetworkingAddressGroupV2(),
+			"openstack_networking_addressscope_v2":                resourceNetworkingAddressScopeV2(),
+			"openstack_networking_network_v2":                     resourceNetworkingNetworkV2(),
+			"openstack_networking_subnet_v2":                      resourceNetworkingSubnetV2(),
+			"openstack_networking_auto_allocated_topology_v2":     resourceNetworkingAutoAllocatedTopologyV2(),
+			"openstack_networking_floatingip_v2":                  resourceNetworkingFloatingIPV2(),
+			"openstack_networking_floatingip_associate_v2":        resourceNetworkingFloatingIPAssociateV2(),
+			"openstack_networking_flavor_v2":                      resourceNetworkingFlavorV2(),
+			"openstack_networking_l2gateway_v2":                   resourceNetworkingL2GatewayV2(),
+			"openstack_networking_l2gateway_connection_v2":        resourceNetworkingL2GatewayConnectionV2(),
+			"openstack_networking_metering_label_v2":              resourceNetworkingMeteringLabelV2(),
+			"openstack_networking_metering_label_rule_v2":         resourceNetworkingMeteringLabelRuleV2(),
+			"openstack_networking_port_v2":                        resourceNetworkingPortV2(),
+			"openstack_networking_router_v2":                      resourceNetworkingRouterV2(),
+			"openstack_networking_router_interface_v2":            resourceNetworkingRouterInterfaceV2(),
+			"openstack_networking_router_l3_agent_v2":             resourceNetworkingRouterL3AgentV2(),
+			"openstack_networking_router_route_v2":                resourceNetworkingRouterRouteV2(),
+			"openstack_networking_secgroup_v2":                    resourceNetworkingSecGroupV2(),
+			"openstack_networking_secgroup_rule_v2":               resourceNetworkingSecGroupRuleV2(),
+			"openstack_networking_service_profile_v2":             resourceNetworkingServiceProfileV2(),
+			"openstack_networking_subnetpool_v2":                  resourceNetworkingSubnetPoolV2(),
+			"openstack_networking_qos_policy_v2":                  resourceNetworkingQoSPolicyV2(),
+			"openstack_networking_qos_bandwidth_limit_rule_v2":    resourceNetworkingQoSBandwidthLimitRuleV2(),
+			"openstack_networking_qos_dscp_marking_rule_v2":       resourceNetworkingQoSDSCPMarkingRuleV2(),
+			"openstack_networking_qos_minimum_bandwidth_rule_v2":  resourceNetworkingQoSMinimumBandwidthRuleV2(),
+			"openstack_objectstorage_account_v1":                  resourceObjectStorageAccountV1(),
+			"openstack_objectstorage_container_v1":                resourceObjectStorageContainerV1(),
+			"openstack_objectstorage_object_v1":                   resourceObjectStorageObjectV1(),
+			"openstack_objectstorage_tempurl_key_v1":              resourceObjectStorageTempURLKeyV1(),
+			"openstack_orchestration_software_config_v1":          resourceOrchestrationSoftwareConfigV1(),
+			"openstack_orchestration_software_deployment_v1":      resourceOrchestrationSoftwareDeploymentV1(),
+			"openstack_sharedfilesystem_share_access_v2":          resourceSharedFilesystemShareAccessV2(),
+			"openstack_sharedfilesystem_share_replica_promote_v2": resourceSharedFilesystemShareReplicaPromoteV2(),
+			"openstack_sharedfilesystem_share_replica_v2":         resourceSharedFilesystemShareReplicaV2(),
+			"openstack_sharedfilesystem_share_v2":                 resourceSharedFilesystemShareV2(),
+			"openstack_sharedfilesystem_snapshot_v2":              resourceSharedFilesystemSnapshotV2(),
+			"openstack_vpnaas_ike_policy_v2":                      resourceIKEPolicyV2(),
+			"openstack_vpnaas_ipsec_policy_v2":                    resourceIPSecPolicyV2(),
+			"openstack_vpnaas_service_v2":                         resourceVPNServiceV2(),
+			"openstack_vpnaas_endpoint_group_v2":                  resourceEndpointGroupV2(),
+			"openstack_vpnaas_site_connection_v2":                 resourceSiteConnectionV2(),
+			"openstack_workflow_crontrigger_v2":                   resourceWorkflowCronTriggerV2(),
+			"openstack_workflow_execution_v2":                     resourceWorkflowExecutionV2(),
+			"openstack_workflow_workflow_v2":                      resourceWorkflowWorkflowV2(),
 		},
 
 		ConfigureFunc: configureProvider,
-	}
+	}}
 }
 
 var descriptions map[string]string
@@ -253,29 +353,33 @@ func init() {
 			"service (Octavia) instead of the Networking service (Neutron).",
 
 		"cloud": "An entry in a `clouds.yaml` file to use.",
+
+		"identity_api_version": "The Identity API version to use (`2`, `2.0`, `3` or `3.0`).\n" +
+			"If omitted, the version is automatically discovered.",
 	}
 }
 
 func configureProvider(d *schema.ResourceData) (interface{}, error) {
 	config := Config{
-		CACertFile:       d.Get("cacert_file").(string),
-		ClientCertFile:   d.Get("cert").(string),
-		ClientKeyFile:    d.Get("key").(string),
-		Cloud:            d.Get("cloud").(string),
-		DomainID:         d.Get("domain_id").(string),
-		DomainName:       d.Get("domain_name").(string),
-		EndpointType:     d.Get("endpoint_type").(string),
-		IdentityEndpoint: d.Get("auth_url").(string),
-		Insecure:         d.Get("insecure").(bool),
-		Password:         d.Get("password").(string),
-		Region:           d.Get("region").(string),
-		Swauth:           d.Get("swauth").(bool),
-		Token:            d.Get("token").(string),
-		TenantID:         d.Get("tenant_id").(string),
-		TenantName:       d.Get("tenant_name").(string),
-		Username:         d.Get("user_name").(string),
-		UserID:           d.Get("user_id").(string),
-		useOctavia:       d.Get("use_octavia").(bool),
+		CACertFile:         d.Get("cacert_file").(string),
+		ClientCertFile:     d.Get("cert").(string),
+		ClientKeyFile:      d.Get("key").(string),
+		Cloud:              d.Get("cloud").(string),
+		DomainID:           d.Get("domain_id").(string),
+		DomainName:         d.Get("domain_name").(string),
+		EndpointType:       d.Get("endpoint_type").(string),
+		IdentityAPIVersion: d.Get("identity_api_version").(string),
+		IdentityEndpoint:   d.Get("auth_url").(string),
+		Insecure:           d.Get("insecure").(bool),
+		Password:           d.Get("password").(string),
+		Region:             d.Get("region").(string),
+		Swauth:             d.Get("swauth").(bool),
+		Token:              d.Get("token").(string),
+		TenantID:           d.Get("tenant_id").(string),
+		TenantName:         d.Get("tenant_name").(string),
+		Username:           d.Get("user_name").(string),
+		UserID:             d.Get("user_id").(string),
+		useOctavia:         d.Get("use_octavia").(bool),
 	}
 
 	if err := config.LoadAndValidate(); err != nil {
@@ -0,0 +1,61 @@
+// This set of code lets resources compute their own diff.
+//
+// The vendored version of helper/schema has no CustomizeDiff, so a
+// resource can't change its plan, for example to update an argument in
+// place which otherwise forces a new resource, or to reject a change before
+// it is applied. Resources which need to do so are listed in
+// resourceDiffFuncs.
+package openstack
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// resourceDiffFunc computes the diff of a resource in place of
+// schema.Resource.Diff.
+type resourceDiffFunc func(*schema.Resource, *terraform.InstanceState, *terraform.ResourceConfig) (*terraform.InstanceDiff, error)
+
+// resourceDiffFuncs holds the resources which compute their own diff.
+var resourceDiffFuncs = map[string]resourceDiffFunc{
+	"openstack_blockstorage_volume_v3": resourceBlockStorageVolumeV3Diff,
+	"openstack_compute_instance_v2":    resourceComputeInstanceV2Diff,
+}
+
+// diffProvider is a schema.Provider which computes the diff of the
+// resources in resourceDiffFuncs with their resourceDiffFunc.
+type diffProvider struct {
+	*schema.Provider
+}
+
+// Diff implementation of terraform.ResourceProvider interface.
+func (p *diffProvider) Diff(
+	info *terraform.InstanceInfo,
+	s *terraform.InstanceState,
+	c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+	r, ok := p.ResourcesMap[info.Type]
+	diffFunc, hasDiffFunc := resourceDiffFuncs[info.Type]
+	if !ok || !hasDiffFunc {
+		return p.Provider.Diff(info, s, c)
+	}
+
+	return diffFunc(r, s, c)
+}
+
+// resourceWithoutForceNew returns a copy of r in which the given arguments
+// don't force a new resource.
+func resourceWithoutForceNew(r *schema.Resource, keys ...string) *schema.Resource {
+	copied := *r
+	copied.Schema = make(map[string]*schema.Schema, len(r.Schema))
+	for k, v := range r.Schema {
+		copied.Schema[k] = v
+	}
+
+	for _, k := range keys {
+		s := *r.Schema[k]
+		s.ForceNew = false
+		copied.Schema[k] = &s
+	}
+
+	return &copied
+}
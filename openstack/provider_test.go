@@ -13,29 +13,44 @@ import (
 )
 
 var (
-	OS_DB_ENVIRONMENT         = os.Getenv("OS_DB_ENVIRONMENT")
-	OS_DB_DATASTORE_VERSION   = os.Getenv("OS_DB_DATASTORE_VERSION")
-	OS_DB_DATASTORE_TYPE      = os.Getenv("OS_DB_DATASTORE_TYPE")
-	OS_DEPRECATED_ENVIRONMENT = os.Getenv("OS_DEPRECATED_ENVIRONMENT")
-	OS_DNS_ENVIRONMENT        = os.Getenv("OS_DNS_ENVIRONMENT")
-	OS_EXTGW_ID               = os.Getenv("OS_EXTGW_ID")
-	OS_FLAVOR_ID              = os.Getenv("OS_FLAVOR_ID")
-	OS_FLAVOR_NAME            = os.Getenv("OS_FLAVOR_NAME")
-	OS_IMAGE_ID               = os.Getenv("OS_IMAGE_ID")
-	OS_IMAGE_NAME             = os.Getenv("OS_IMAGE_NAME")
-	OS_NETWORK_ID             = os.Getenv("OS_NETWORK_ID")
-	OS_POOL_NAME              = os.Getenv("OS_POOL_NAME")
-	OS_REGION_NAME            = os.Getenv("OS_REGION_NAME")
-	OS_SWIFT_ENVIRONMENT      = os.Getenv("OS_SWIFT_ENVIRONMENT")
+	OS_CONTAINER_INFRA_ENVIRONMENT             = os.Getenv("OS_CONTAINER_INFRA_ENVIRONMENT")
+	OS_DB_ENVIRONMENT                          = os.Getenv("OS_DB_ENVIRONMENT")
+	OS_DB_DATASTORE_VERSION                    = os.Getenv("OS_DB_DATASTORE_VERSION")
+	OS_DB_DATASTORE_TYPE                       = os.Getenv("OS_DB_DATASTORE_TYPE")
+	OS_DEPRECATED_ENVIRONMENT                  = os.Getenv("OS_DEPRECATED_ENVIRONMENT")
+	OS_DNS_ENVIRONMENT                         = os.Getenv("OS_DNS_ENVIRONMENT")
+	OS_EXTGW_ID                                = os.Getenv("OS_EXTGW_ID")
+	OS_FLAVOR_ID                               = os.Getenv("OS_FLAVOR_ID")
+	OS_FLAVOR_NAME                             = os.Getenv("OS_FLAVOR_NAME")
+	OS_FW_V2_ENVIRONMENT                       = os.Getenv("OS_FW_V2_ENVIRONMENT")
+	OS_IMAGE_ID                                = os.Getenv("OS_IMAGE_ID")
+	OS_IMAGE_NAME                              = os.Getenv("OS_IMAGE_NAME")
+	OS_KEYMANAGER_ENVIRONMENT                  = os.Getenv("OS_KEYMANAGER_ENVIRONMENT")
+	OS_L2GW_ENVIRONMENT                        = os.Getenv("OS_L2GW_ENVIRONMENT")
+	OS_LB_FLAVOR_NAME                          = os.Getenv("OS_LB_FLAVOR_NAME")
+	OS_MAGNUM_FLAVOR                           = os.Getenv("OS_MAGNUM_FLAVOR")
+	OS_MAGNUM_IMAGE                            = os.Getenv("OS_MAGNUM_IMAGE")
+	OS_NETWORK_ID                              = os.Getenv("OS_NETWORK_ID")
+	OS_ORCHESTRATION_ENVIRONMENT               = os.Getenv("OS_ORCHESTRATION_ENVIRONMENT")
+	OS_POOL_NAME                               = os.Getenv("OS_POOL_NAME")
+	OS_REGION_NAME                             = os.Getenv("OS_REGION_NAME")
+	OS_SHAREDFILESYSTEM_ENVIRONMENT            = os.Getenv("OS_SHAREDFILESYSTEM_ENVIRONMENT")
+	OS_SHAREDFILESYSTEM_REPLICA_AZ             = os.Getenv("OS_SHAREDFILESYSTEM_REPLICA_AZ")
+	OS_SHAREDFILESYSTEM_REPLICATION_SHARE_TYPE = os.Getenv("OS_SHAREDFILESYSTEM_REPLICATION_SHARE_TYPE")
+	OS_SWIFT_ENVIRONMENT                       = os.Getenv("OS_SWIFT_ENVIRONMENT")
+	OS_USE_OCTAVIA                             = os.Getenv("OS_USE_OCTAVIA")
+	OS_VPN_ENVIRONMENT                         = os.Getenv("OS_VPN_ENVIRONMENT")
+	OS_WORKFLOW_ENVIRONMENT                    = os.Getenv("OS_WORKFLOW_ENVIRONMENT")
 )
 
 var testAccProviders map[string]terraform.ResourceProvider
 var testAccProvider *schema.Provider
 
 func init() {
-	testAccProvider = Provider().(*schema.Provider)
+	provider := Provider().(*diffProvider)
+	testAccProvider = provider.Provider
 	testAccProviders = map[string]terraform.ResourceProvider{
-		"openstack": testAccProvider,
+		"openstack": provider,
 	}
 }
 
@@ -112,6 +127,106 @@ func testAccPreCheckDatabase(t *testing.T) {
 	}
 }
 
+func testAccPreCheckKeyManager(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_KEYMANAGER_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Barbican Key Manager tests")
+	}
+}
+
+func testAccPreCheckOrchestration(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_ORCHESTRATION_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Heat Orchestration tests")
+	}
+}
+
+func testAccPreCheckContainerInfra(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_CONTAINER_INFRA_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Magnum Container Infra tests")
+	}
+
+	if OS_MAGNUM_IMAGE == "" || OS_MAGNUM_FLAVOR == "" {
+		t.Fatal("OS_MAGNUM_IMAGE and OS_MAGNUM_FLAVOR must be set for Magnum Container Infra tests")
+	}
+}
+
+func testAccPreCheckWorkflow(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_WORKFLOW_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Mistral Workflow tests")
+	}
+}
+
+func testAccPreCheckSharedFilesystem(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_SHAREDFILESYSTEM_ENVIRONMENT == "" {
+		t.Skip("This environment does not support Manila Shared File Systems tests")
+	}
+}
+
+func testAccPreCheckSharedFilesystemReplication(t *testing.T) {
+	testAccPreCheckSharedFilesystem(t)
+
+	if OS_SHAREDFILESYSTEM_REPLICA_AZ == "" || OS_SHAREDFILESYSTEM_REPLICATION_SHARE_TYPE == "" {
+		t.Skip("OS_SHAREDFILESYSTEM_REPLICA_AZ and OS_SHAREDFILESYSTEM_REPLICATION_SHARE_TYPE must be set for Manila share replication tests")
+	}
+}
+
+func testAccPreCheckFWV2(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_FW_V2_ENVIRONMENT == "" {
+		t.Skip("This environment does not support FWaaS v2 tests")
+	}
+}
+
+func testAccPreCheckL2GW(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_L2GW_ENVIRONMENT == "" {
+		t.Skip("This environment does not support L2 gateway tests")
+	}
+}
+
+func testAccPreCheckVPN(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_VPN_ENVIRONMENT == "" {
+		t.Skip("This environment does not support VPN tests")
+	}
+}
+
+func testAccPreCheckOctavia(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_USE_OCTAVIA == "" {
+		t.Skip("This environment does not support Octavia tests")
+	}
+}
+
+func testAccPreCheckNonOctavia(t *testing.T) {
+	testAccPreCheckRequiredEnvVars(t)
+
+	if OS_USE_OCTAVIA != "" {
+		t.Skip("This environment only supports Octavia tests")
+	}
+}
+
+func testAccPreCheckLBFlavor(t *testing.T) {
+	testAccPreCheckOctavia(t)
+
+	if OS_LB_FLAVOR_NAME == "" {
+		t.Skip("OS_LB_FLAVOR_NAME must be set for load balancer flavor tests")
+	}
+}
+
 func testAccPreCheckAdminOnly(t *testing.T) {
 	v := os.Getenv("OS_USERNAME")
 	if v != "admin" {
@@ -120,7 +235,7 @@ func testAccPreCheckAdminOnly(t *testing.T) {
 }
 
 func TestProvider(t *testing.T) {
-	if err := Provider().(*schema.Provider).InternalValidate(); err != nil {
+	if err := Provider().(*diffProvider).InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
 	}
 }
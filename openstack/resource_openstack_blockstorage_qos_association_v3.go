@@ -0,0 +1,132 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageQoSAssociationV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageQoSAssociationV3Create,
+		Read:   resourceBlockStorageQoSAssociationV3Read,
+		Delete: resourceBlockStorageQoSAssociationV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"qos_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volume_type_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageQoSAssociationV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	qosID := d.Get("qos_id").(string)
+	volumeTypeID := d.Get("volume_type_id").(string)
+
+	log.Printf("[DEBUG] Associating QoS spec %s with volume type %s", qosID, volumeTypeID)
+	err = blockStorageV3QoSAssociate(blockStorageClient, qosID, volumeTypeID).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error associating OpenStack QoS spec %s with volume type %s: %s", qosID, volumeTypeID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", qosID, volumeTypeID))
+
+	return resourceBlockStorageQoSAssociationV3Read(d, meta)
+}
+
+func resourceBlockStorageQoSAssociationV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	qosID, volumeTypeID, err := parseBlockStorageQoSAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	associations, err := blockStorageV3QoSAssociations(blockStorageClient, qosID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "QoS association")
+	}
+
+	var found bool
+	for _, association := range associations {
+		if association.AssociationType == "volume_type" && association.ID == volumeTypeID {
+			found = true
+			break
+		}
+	}
+
+	// The QoS spec was disassociated outside of Terraform.
+	if !found {
+		log.Printf("[DEBUG] QoS spec %s is not associated with volume type %s", qosID, volumeTypeID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("qos_id", qosID)
+	d.Set("volume_type_id", volumeTypeID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageQoSAssociationV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	qosID, volumeTypeID, err := parseBlockStorageQoSAssociationID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Disassociating QoS spec %s from volume type %s", qosID, volumeTypeID)
+	err = blockStorageV3QoSDisassociate(blockStorageClient, qosID, volumeTypeID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error disassociating OpenStack QoS spec from volume type")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseBlockStorageQoSAssociationID splits the ID of a QoS association
+// resource, which has the form <qos id>/<volume type id>.
+func parseBlockStorageQoSAssociationID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine QoS association ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,163 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageQoSV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageQoSV3Create,
+		Read:   resourceBlockStorageQoSV3Read,
+		Update: resourceBlockStorageQoSV3Update,
+		Delete: resourceBlockStorageQoSV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"consumer": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "back-end",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "front-end" && value != "back-end" && value != "both" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'front-end', 'back-end' and 'both' are supported values for 'consumer'"))
+					}
+					return
+				},
+			},
+			"specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageQoSV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	createOpts := QoSCreateOpts{
+		Name:     d.Get("name").(string),
+		Consumer: d.Get("consumer").(string),
+		Specs:    resourceVolumeTypeV3ExtraSpecs(d.Get("specs").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	qos, err := blockStorageV3QoSCreate(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack QoS spec: %s", err)
+	}
+
+	log.Printf("[INFO] QoS spec ID: %s", qos.ID)
+
+	d.SetId(qos.ID)
+
+	return resourceBlockStorageQoSV3Read(d, meta)
+}
+
+func resourceBlockStorageQoSV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	qos, err := blockStorageV3QoSGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "QoS spec")
+	}
+
+	log.Printf("[DEBUG] Retrieved QoS spec %s: %+v", d.Id(), qos)
+
+	d.Set("name", qos.Name)
+	d.Set("consumer", qos.Consumer)
+	d.Set("specs", qos.Specs)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageQoSV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	keys := make(map[string]string)
+	if d.HasChange("consumer") {
+		keys["consumer"] = d.Get("consumer").(string)
+	}
+
+	if d.HasChange("specs") {
+		o, n := d.GetChange("specs")
+		oldSpecs := resourceVolumeTypeV3ExtraSpecs(o.(map[string]interface{}))
+		newSpecs := resourceVolumeTypeV3ExtraSpecs(n.(map[string]interface{}))
+
+		var removedKeys []string
+		for key := range oldSpecs {
+			if _, ok := newSpecs[key]; !ok {
+				removedKeys = append(removedKeys, key)
+			}
+		}
+
+		if len(removedKeys) > 0 {
+			log.Printf("[DEBUG] Deleting keys %v of QoS spec %s", removedKeys, d.Id())
+			err = blockStorageV3QoSDeleteKeys(blockStorageClient, d.Id(), removedKeys).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error deleting keys of OpenStack QoS spec: %s", err)
+			}
+		}
+
+		for key, value := range newSpecs {
+			keys[key] = value
+		}
+	}
+
+	if len(keys) > 0 {
+		log.Printf("[DEBUG] Setting keys of QoS spec %s: %+v", d.Id(), keys)
+		err = blockStorageV3QoSSetKeys(blockStorageClient, d.Id(), keys).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack QoS spec: %s", err)
+		}
+	}
+
+	return resourceBlockStorageQoSV3Read(d, meta)
+}
+
+func resourceBlockStorageQoSV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	err = blockStorageV3QoSDelete(blockStorageClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack QoS spec")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,205 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBlockStorageV3QoS_basic(t *testing.T) {
+	var qos QoS
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3QoSDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3QoS_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QoSExists("openstack_blockstorage_qos_v3.qos_1", &qos),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "name", "qos_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "consumer", "front-end"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.read_iops_sec", "20000"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3QoS_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QoSExists("openstack_blockstorage_qos_v3.qos_1", &qos),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "consumer", "both"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_qos_v3.qos_1", "specs.total_iops_sec", "30000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3QoSAssociation_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3QoSDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3QoSAssociation_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QoSAssociationExists("openstack_blockstorage_qos_association_v3.qos_association_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_qos_association_v3.qos_association_1", "qos_id",
+						"openstack_blockstorage_qos_v3.qos_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_qos_association_v3.qos_association_1", "volume_type_id",
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3QoSDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_qos_v3" {
+			continue
+		}
+
+		_, err := blockStorageV3QoSGet(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("QoS spec still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3QoSExists(n string, qos *QoS) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := blockStorageV3QoSGet(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("QoS spec not found")
+		}
+
+		*qos = *found
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageV3QoSAssociationExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		qosID, volumeTypeID, err := parseBlockStorageQoSAssociationID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		associations, err := blockStorageV3QoSAssociations(blockStorageClient, qosID).Extract()
+		if err != nil {
+			return err
+		}
+
+		for _, association := range associations {
+			if association.ID == volumeTypeID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("QoS association not found")
+	}
+}
+
+const testAccBlockStorageV3QoS_basic = `
+resource "openstack_blockstorage_qos_v3" "qos_1" {
+  name = "qos_1"
+  consumer = "front-end"
+  specs {
+    read_iops_sec = "20000"
+    write_iops_sec = "10000"
+  }
+}
+`
+
+const testAccBlockStorageV3QoS_update = `
+resource "openstack_blockstorage_qos_v3" "qos_1" {
+  name = "qos_1"
+  consumer = "both"
+  specs {
+    total_iops_sec = "30000"
+  }
+}
+`
+
+const testAccBlockStorageV3QoSAssociation_basic = `
+resource "openstack_blockstorage_qos_v3" "qos_1" {
+  name = "qos_1"
+  consumer = "front-end"
+  specs {
+    total_iops_sec = "30000"
+  }
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_blockstorage_qos_association_v3" "qos_association_1" {
+  qos_id = "${openstack_blockstorage_qos_v3.qos_1.id}"
+  volume_type_id = "${openstack_blockstorage_volume_type_v3.volume_type_1.id}"
+}
+`
@@ -0,0 +1,262 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageQuotaSetV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageQuotaSetV3Create,
+		Read:   resourceBlockStorageQuotaSetV3Read,
+		Update: resourceBlockStorageQuotaSetV3Update,
+		Delete: resourceBlockStorageQuotaSetV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"volumes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"snapshots": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"gigabytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"per_volume_gigabytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"backups": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"backup_gigabytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"groups": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"volume_type_quota": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageQuotaSetV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	volumeTypeQuotas, err := resourceBlockStorageQuotaSetV3VolumeTypeQuotas(d.Get("volume_type_quota").(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	// The ID is set first, so the quotas which are set to 0 can be told
+	// apart from the ones which are not set.
+	d.SetId(projectID)
+
+	quotas, err := configuredInts(d, "volumes", "snapshots", "gigabytes",
+		"per_volume_gigabytes", "backups", "backup_gigabytes", "groups")
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	updateOpts := BlockStorageQuotaSetUpdateOpts{
+		VolumeTypeQuotas: volumeTypeQuotas,
+	}
+	if v, ok := quotas["volumes"]; ok {
+		updateOpts.Volumes = &v
+	}
+	if v, ok := quotas["snapshots"]; ok {
+		updateOpts.Snapshots = &v
+	}
+	if v, ok := quotas["gigabytes"]; ok {
+		updateOpts.Gigabytes = &v
+	}
+	if v, ok := quotas["per_volume_gigabytes"]; ok {
+		updateOpts.PerVolumeGigabytes = &v
+	}
+	if v, ok := quotas["backups"]; ok {
+		updateOpts.Backups = &v
+	}
+	if v, ok := quotas["backup_gigabytes"]; ok {
+		updateOpts.BackupGigabytes = &v
+	}
+	if v, ok := quotas["groups"]; ok {
+		updateOpts.Groups = &v
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	_, err = blockStorageV3QuotaSetUpdate(blockStorageClient, projectID, updateOpts).Extract()
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("Error setting OpenStack block storage quotas of project %s: %s", projectID, err)
+	}
+
+	return resourceBlockStorageQuotaSetV3Read(d, meta)
+}
+
+func resourceBlockStorageQuotaSetV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	q, err := blockStorageV3QuotaSetGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "block storage quotas")
+	}
+
+	log.Printf("[DEBUG] Retrieved block storage quotas of project %s: %+v", d.Id(), q)
+
+	d.Set("project_id", d.Id())
+	d.Set("volumes", q.Volumes)
+	d.Set("snapshots", q.Snapshots)
+	d.Set("gigabytes", q.Gigabytes)
+	d.Set("per_volume_gigabytes", q.PerVolumeGigabytes)
+	d.Set("backups", q.Backups)
+	d.Set("backup_gigabytes", q.BackupGigabytes)
+	d.Set("groups", q.Groups)
+	d.Set("region", GetRegion(d, config))
+
+	// Block Storage returns the quotas of every volume type, so only the
+	// configured ones are stored.
+	volumeTypeQuotas := make(map[string]string)
+	for key := range d.Get("volume_type_quota").(map[string]interface{}) {
+		if v, ok := q.VolumeTypeQuotas[key]; ok {
+			volumeTypeQuotas[key] = strconv.Itoa(v)
+		}
+	}
+	d.Set("volume_type_quota", volumeTypeQuotas)
+
+	return nil
+}
+
+func resourceBlockStorageQuotaSetV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	var updateOpts BlockStorageQuotaSetUpdateOpts
+	if d.HasChange("volumes") {
+		volumes := d.Get("volumes").(int)
+		updateOpts.Volumes = &volumes
+	}
+	if d.HasChange("snapshots") {
+		snapshots := d.Get("snapshots").(int)
+		updateOpts.Snapshots = &snapshots
+	}
+	if d.HasChange("gigabytes") {
+		gigabytes := d.Get("gigabytes").(int)
+		updateOpts.Gigabytes = &gigabytes
+	}
+	if d.HasChange("per_volume_gigabytes") {
+		perVolumeGigabytes := d.Get("per_volume_gigabytes").(int)
+		updateOpts.PerVolumeGigabytes = &perVolumeGigabytes
+	}
+	if d.HasChange("backups") {
+		backups := d.Get("backups").(int)
+		updateOpts.Backups = &backups
+	}
+	if d.HasChange("backup_gigabytes") {
+		backupGigabytes := d.Get("backup_gigabytes").(int)
+		updateOpts.BackupGigabytes = &backupGigabytes
+	}
+	if d.HasChange("groups") {
+		groups := d.Get("groups").(int)
+		updateOpts.Groups = &groups
+	}
+	if d.HasChange("volume_type_quota") {
+		o, n := d.GetChange("volume_type_quota")
+		volumeTypeQuotas, err := resourceBlockStorageQuotaSetV3VolumeTypeQuotas(n.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+
+		// Removed volume type quotas are reverted to unlimited, which is
+		// their default.
+		for key := range o.(map[string]interface{}) {
+			if _, ok := volumeTypeQuotas[key]; !ok {
+				volumeTypeQuotas[key] = -1
+			}
+		}
+
+		updateOpts.VolumeTypeQuotas = volumeTypeQuotas
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	_, err = blockStorageV3QuotaSetUpdate(blockStorageClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack block storage quotas of project %s: %s", d.Id(), err)
+	}
+
+	return resourceBlockStorageQuotaSetV3Read(d, meta)
+}
+
+func resourceBlockStorageQuotaSetV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Reverting block storage quotas of project %s to their defaults", d.Id())
+	err = blockStorageV3QuotaSetDelete(blockStorageClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "block storage quotas")
+	}
+
+	return nil
+}
+
+func resourceBlockStorageQuotaSetV3VolumeTypeQuotas(rawQuotas map[string]interface{}) (map[string]int, error) {
+	quotas := make(map[string]int)
+	for key, raw := range rawQuotas {
+		v, err := strconv.Atoi(raw.(string))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid value for volume type quota %s: %s", key, raw)
+		}
+		quotas[key] = v
+	}
+	return quotas, nil
+}
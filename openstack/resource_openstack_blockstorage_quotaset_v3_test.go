@@ -0,0 +1,137 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBlockStorageV3QuotaSet_basic(t *testing.T) {
+	var quotaSet BlockStorageQuotaSet
+	var projectName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3QuotaSet_basic(projectName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QuotaSetExists("openstack_blockstorage_quotaset_v3.quotaset_1", &quotaSet),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "volumes", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "snapshots", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "gigabytes", "100"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "volume_type_quota.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "volume_type_quota.volumes_volume_type_1", "3"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3QuotaSet_basic(projectName, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QuotaSetExists("openstack_blockstorage_quotaset_v3.quotaset_1", &quotaSet),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "volumes", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3QuotaSet_zero(t *testing.T) {
+	var quotaSet BlockStorageQuotaSet
+	var projectName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3QuotaSet_basic(projectName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3QuotaSetExists("openstack_blockstorage_quotaset_v3.quotaset_1", &quotaSet),
+					testAccCheckBlockStorageV3QuotaSetVolumes(&quotaSet, 0),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_quotaset_v3.quotaset_1", "volumes", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3QuotaSetExists(n string, quotaSet *BlockStorageQuotaSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := blockStorageV3QuotaSetGet(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		*quotaSet = *found
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageV3QuotaSetVolumes(quotaSet *BlockStorageQuotaSet, volumes int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if quotaSet.Volumes != volumes {
+			return fmt.Errorf("Expected a volumes quota of %d, got %d", volumes, quotaSet.Volumes)
+		}
+
+		return nil
+	}
+}
+
+func testAccBlockStorageV3QuotaSet_basic(projectName string, volumes int) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_blockstorage_quotaset_v3" "quotaset_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  volumes = %d
+  snapshots = 10
+  gigabytes = 100
+  volume_type_quota {
+    volumes_volume_type_1 = 3
+  }
+
+  depends_on = ["openstack_blockstorage_volume_type_v3.volume_type_1"]
+}
+`, projectName, volumes)
+}
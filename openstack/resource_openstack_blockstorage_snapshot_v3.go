@@ -0,0 +1,312 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageSnapshotV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageSnapshotV3Create,
+		Read:   resourceBlockStorageSnapshotV3Read,
+		Update: resourceBlockStorageSnapshotV3Update,
+		Delete: resourceBlockStorageSnapshotV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"force": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageSnapshotV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	createOpts := SnapshotV3CreateOpts{
+		VolumeID:    d.Get("volume_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Force:       d.Get("force").(bool),
+		Metadata:    resourceVolumeMetadataV2(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	s, err := blockStorageV3SnapshotCreate(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack volume snapshot: %s", err)
+	}
+	log.Printf("[INFO] Snapshot ID: %s", s.ID)
+
+	// Store the ID now
+	d.SetId(s.ID)
+
+	// Wait for the snapshot to become available.
+	log.Printf("[DEBUG] Waiting for snapshot (%s) to become available", s.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    SnapshotV3StateRefreshFunc(blockStorageClient, s.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for snapshot (%s) to become ready: %s",
+			s.ID, err)
+	}
+
+	return resourceBlockStorageSnapshotV3Read(d, meta)
+}
+
+func resourceBlockStorageSnapshotV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	s, err := blockStorageV3SnapshotGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "snapshot")
+	}
+
+	log.Printf("[DEBUG] Retrieved snapshot %s: %+v", d.Id(), s)
+
+	d.Set("volume_id", s.VolumeID)
+	d.Set("name", s.Name)
+	d.Set("description", s.Description)
+	d.Set("metadata", s.Metadata)
+	d.Set("size", s.Size)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageSnapshotV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") {
+		var updateOpts SnapshotV3UpdateOpts
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			updateOpts.Name = &name
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+
+		log.Printf("[DEBUG] Updating snapshot %s with options: %+v", d.Id(), updateOpts)
+
+		_, err = blockStorageV3SnapshotUpdate(blockStorageClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack volume snapshot: %s", err)
+		}
+	}
+
+	if d.HasChange("metadata") {
+		metadata := resourceVolumeMetadataV2(d)
+
+		log.Printf("[DEBUG] Replacing metadata of snapshot %s with %+v", d.Id(), metadata)
+
+		err = blockStorageV3SnapshotMetadataReplace(blockStorageClient, d.Id(), metadata).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error updating metadata of OpenStack volume snapshot: %s", err)
+		}
+	}
+
+	return resourceBlockStorageSnapshotV3Read(d, meta)
+}
+
+func resourceBlockStorageSnapshotV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	timeout := d.Timeout(schema.TimeoutDelete)
+
+	s, err := blockStorageV3SnapshotGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "snapshot")
+	}
+
+	// A snapshot can't be deleted while it is being created.
+	if s.Status == "creating" {
+		log.Printf("[DEBUG] Waiting for snapshot (%s) to become available", d.Id())
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"creating"},
+			Target:     []string{"available"},
+			Refresh:    SnapshotV3StateRefreshFunc(blockStorageClient, d.Id()),
+			Timeout:    timeout,
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for snapshot (%s) to become available: %s",
+				d.Id(), err)
+		}
+	}
+
+	// Volumes created from the snapshot must be deleted before the snapshot
+	// itself. They may still be deleting when the snapshot is destroyed in
+	// the same run, so wait for them to go away.
+	log.Printf("[DEBUG] Waiting for volumes created from snapshot (%s) to delete", d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"in-use"},
+		Target:     []string{"free"},
+		Refresh:    SnapshotV3DependentVolumesRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for volumes created from snapshot (%s) to delete: %s",
+			d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Attempting to delete snapshot %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = blockStorageV3SnapshotDelete(blockStorageClient, d.Id()).ExtractErr()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return nil
+			}
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting OpenStack volume snapshot: %s", err)
+	}
+
+	// Wait for the snapshot to delete before moving on.
+	log.Printf("[DEBUG] Waiting for snapshot (%s) to delete", d.Id())
+
+	stateConf = &resource.StateChangeConf{
+		Pending:    []string{"deleting", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    SnapshotV3StateRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for snapshot (%s) to delete: %s",
+			d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// SnapshotV3StateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// an OpenStack Block Storage v3 volume snapshot.
+func SnapshotV3StateRefreshFunc(client *gophercloud.ServiceClient, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		s, err := blockStorageV3SnapshotGet(client, snapshotID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return s, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if s.Status == "error" || s.Status == "error_deleting" {
+			return s, s.Status, fmt.Errorf("The volume snapshot is in %s state. "+
+				"Please check with your cloud admin or check the Block Storage "+
+				"API logs to see why this error occurred.", s.Status)
+		}
+
+		return s, s.Status, nil
+	}
+}
+
+// SnapshotV3DependentVolumesRefreshFunc returns a resource.StateRefreshFunc
+// that is used to watch the volumes created from an OpenStack Block Storage
+// v3 volume snapshot. The state is "in-use" while any of those volumes
+// exist and "free" otherwise.
+func SnapshotV3DependentVolumesRefreshFunc(client *gophercloud.ServiceClient, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		volumeIDs, err := blockStorageV3SnapshotDependentVolumes(client, snapshotID)
+		if err != nil {
+			return nil, "", err
+		}
+
+		if len(volumeIDs) > 0 {
+			log.Printf("[DEBUG] Snapshot %s is used by volumes: %s", snapshotID, strings.Join(volumeIDs, ", "))
+			return volumeIDs, "in-use", nil
+		}
+
+		return volumeIDs, "free", nil
+	}
+}
@@ -0,0 +1,169 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBlockStorageV3Snapshot_basic(t *testing.T) {
+	var snapshot SnapshotV3
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3SnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3Snapshot_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "name", "snapshot_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "metadata.foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "size", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3Snapshot_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "name", "snapshot_1-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "metadata.foo", "baz"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Snapshot_dependentVolume(t *testing.T) {
+	var snapshot SnapshotV3
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3SnapshotDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3Snapshot_dependentVolume,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3SnapshotExists("openstack_blockstorage_snapshot_v3.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_v3.volume_2", "snapshot_id",
+						"openstack_blockstorage_snapshot_v3.snapshot_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3SnapshotDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_snapshot_v3" {
+			continue
+		}
+
+		_, err := blockStorageV3SnapshotGet(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Snapshot still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3SnapshotExists(n string, snapshot *SnapshotV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := blockStorageV3SnapshotGet(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Snapshot not found")
+		}
+
+		*snapshot = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3Snapshot_basic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "snapshot_1"
+  description = "first test snapshot"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  force = true
+  metadata {
+    foo = "bar"
+  }
+}
+`
+
+const testAccBlockStorageV3Snapshot_update = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "snapshot_1-updated"
+  description = "first test snapshot"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+  force = true
+  metadata {
+    foo = "baz"
+  }
+}
+`
+
+const testAccBlockStorageV3Snapshot_dependentVolume = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_snapshot_v3" "snapshot_1" {
+  name = "snapshot_1"
+  volume_id = "${openstack_blockstorage_volume_v3.volume_1.id}"
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_2" {
+  name = "volume_2"
+  size = 1
+  snapshot_id = "${openstack_blockstorage_snapshot_v3.snapshot_1.id}"
+}
+`
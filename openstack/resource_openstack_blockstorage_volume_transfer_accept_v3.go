@@ -0,0 +1,95 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageVolumeTransferAcceptV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTransferAcceptV3Create,
+		Read:   resourceBlockStorageVolumeTransferAcceptV3Read,
+		Delete: resourceBlockStorageVolumeTransferAcceptV3Delete,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"transfer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"auth_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTransferAcceptV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	transferID := d.Get("transfer_id").(string)
+	acceptOpts := VolumeTransferAcceptOpts{
+		AuthKey: d.Get("auth_key").(string),
+	}
+
+	log.Printf("[DEBUG] Accepting volume transfer %s", transferID)
+	transfer, err := blockStorageV3VolumeTransferAccept(blockStorageClient, transferID, acceptOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error accepting OpenStack volume transfer %s: %s", transferID, err)
+	}
+
+	log.Printf("[INFO] Accepted volume transfer %s of volume %s", transferID, transfer.VolumeID)
+
+	// The transfer no longer exists once it has been accepted, so the
+	// resource is identified by the transferred volume.
+	d.SetId(transfer.VolumeID)
+
+	return resourceBlockStorageVolumeTransferAcceptV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTransferAcceptV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	v, err := blockStorageV3VolumeGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "volume transfer accept")
+	}
+
+	d.Set("volume_id", v.ID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// resourceBlockStorageVolumeTransferAcceptV3Delete only removes the
+// resource from the state. An accepted transfer can't be undone, and the
+// transferred volume is left in place.
+func resourceBlockStorageVolumeTransferAcceptV3Delete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing volume transfer accept %s from the state", d.Id())
+
+	d.SetId("")
+	return nil
+}
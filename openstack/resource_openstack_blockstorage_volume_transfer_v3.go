@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageVolumeTransferV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTransferV3Create,
+		Read:   resourceBlockStorageVolumeTransferV3Read,
+		Delete: resourceBlockStorageVolumeTransferV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"volume_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"auth_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTransferV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	createOpts := VolumeTransferCreateOpts{
+		VolumeID: d.Get("volume_id").(string),
+		Name:     d.Get("name").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	transfer, err := blockStorageV3VolumeTransferCreate(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack volume transfer: %s", err)
+	}
+
+	log.Printf("[INFO] Volume transfer ID: %s", transfer.ID)
+
+	d.SetId(transfer.ID)
+
+	// The authorization key is only returned when the transfer is created.
+	d.Set("auth_key", transfer.AuthKey)
+
+	return resourceBlockStorageVolumeTransferV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTransferV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	transfer, err := blockStorageV3VolumeTransferGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return fmt.Errorf("Error retrieving OpenStack volume transfer %s: %s", d.Id(), err)
+		}
+
+		// A transfer disappears once it has been accepted, and the volume
+		// then belongs to another project. The transfer is kept in the
+		// state in that case, so that it is not created again.
+		if volumeID := d.Get("volume_id").(string); volumeID != "" {
+			_, volumeErr := blockStorageV3VolumeGet(blockStorageClient, volumeID).Extract()
+			if _, ok := volumeErr.(gophercloud.ErrDefault404); ok {
+				log.Printf("[DEBUG] Volume transfer %s was accepted", d.Id())
+				return nil
+			}
+		}
+
+		return CheckDeleted(d, err, "volume transfer")
+	}
+
+	log.Printf("[DEBUG] Retrieved volume transfer %s: %+v", d.Id(), transfer)
+
+	d.Set("volume_id", transfer.VolumeID)
+	d.Set("name", transfer.Name)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTransferV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	err = blockStorageV3VolumeTransferDelete(blockStorageClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack volume transfer")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,302 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceBlockStorageVolumeTypeV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeTypeV3Create,
+		Read:   resourceBlockStorageVolumeTypeV3Read,
+		Update: resourceBlockStorageVolumeTypeV3Update,
+		Delete: resourceBlockStorageVolumeTypeV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"is_public": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"extra_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"encryption": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"provider": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"cipher": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"key_size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"control_location": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "front-end",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(string)
+								if value != "front-end" && value != "back-end" {
+									errors = append(errors, fmt.Errorf(
+										"Only 'front-end' and 'back-end' are supported values for 'control_location'"))
+								}
+								return
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeTypeV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	isPublic := d.Get("is_public").(bool)
+	createOpts := VolumeTypeCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		IsPublic:    &isPublic,
+		ExtraSpecs:  resourceVolumeTypeV3ExtraSpecs(d.Get("extra_specs").(map[string]interface{})),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	volumeType, err := blockStorageV3VolumeTypeCreate(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack volume type: %s", err)
+	}
+
+	log.Printf("[INFO] Volume type ID: %s", volumeType.ID)
+
+	d.SetId(volumeType.ID)
+
+	if encryptionOpts := resourceVolumeTypeV3Encryption(d); encryptionOpts != nil {
+		log.Printf("[DEBUG] Encryption Options: %#v", encryptionOpts)
+		_, err = blockStorageV3VolumeTypeEncryptionCreate(blockStorageClient, d.Id(), *encryptionOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error creating encryption of OpenStack volume type %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceBlockStorageVolumeTypeV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTypeV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	volumeType, err := blockStorageV3VolumeTypeGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "volume type")
+	}
+
+	log.Printf("[DEBUG] Retrieved volume type %s: %+v", d.Id(), volumeType)
+
+	d.Set("name", volumeType.Name)
+	d.Set("description", volumeType.Description)
+	d.Set("is_public", volumeType.IsPublic)
+	d.Set("extra_specs", volumeType.ExtraSpecs)
+	d.Set("region", GetRegion(d, config))
+
+	encryption, err := blockStorageV3VolumeTypeEncryptionGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving encryption of OpenStack volume type %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved encryption of volume type %s: %+v", d.Id(), encryption)
+
+	var encryptionList []map[string]interface{}
+	if encryption.EncryptionID != "" {
+		encryptionList = append(encryptionList, map[string]interface{}{
+			"provider":         encryption.Provider,
+			"cipher":           encryption.Cipher,
+			"key_size":         encryption.KeySize,
+			"control_location": encryption.ControlLocation,
+		})
+	}
+	d.Set("encryption", encryptionList)
+
+	return nil
+}
+
+func resourceBlockStorageVolumeTypeV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("is_public") {
+		var updateOpts VolumeTypeUpdateOpts
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			updateOpts.Name = &name
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChange("is_public") {
+			isPublic := d.Get("is_public").(bool)
+			updateOpts.IsPublic = &isPublic
+		}
+
+		log.Printf("[DEBUG] Updating volume type %s with options: %+v", d.Id(), updateOpts)
+
+		_, err = blockStorageV3VolumeTypeUpdate(blockStorageClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack volume type: %s", err)
+		}
+	}
+
+	if d.HasChange("extra_specs") {
+		o, n := d.GetChange("extra_specs")
+		oldSpecs := resourceVolumeTypeV3ExtraSpecs(o.(map[string]interface{}))
+		newSpecs := resourceVolumeTypeV3ExtraSpecs(n.(map[string]interface{}))
+
+		for key := range oldSpecs {
+			if _, ok := newSpecs[key]; ok {
+				continue
+			}
+
+			log.Printf("[DEBUG] Deleting extra spec %s of volume type %s", key, d.Id())
+			err = blockStorageV3VolumeTypeExtraSpecDelete(blockStorageClient, d.Id(), key).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error deleting extra spec %s of OpenStack volume type: %s", key, err)
+			}
+		}
+
+		if len(newSpecs) > 0 {
+			log.Printf("[DEBUG] Setting extra specs of volume type %s: %+v", d.Id(), newSpecs)
+			err = blockStorageV3VolumeTypeExtraSpecsCreate(blockStorageClient, d.Id(), newSpecs).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error setting extra specs of OpenStack volume type: %s", err)
+			}
+		}
+	}
+
+	if d.HasChange("encryption") {
+		encryption, err := blockStorageV3VolumeTypeEncryptionGet(blockStorageClient, d.Id()).Extract()
+		if err != nil {
+			return fmt.Errorf("Error retrieving encryption of OpenStack volume type %s: %s", d.Id(), err)
+		}
+
+		encryptionOpts := resourceVolumeTypeV3Encryption(d)
+		switch {
+		case encryptionOpts == nil && encryption.EncryptionID != "":
+			log.Printf("[DEBUG] Deleting encryption of volume type %s", d.Id())
+			err = blockStorageV3VolumeTypeEncryptionDelete(blockStorageClient, d.Id(), encryption.EncryptionID).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error deleting encryption of OpenStack volume type %s: %s", d.Id(), err)
+			}
+		case encryptionOpts != nil && encryption.EncryptionID == "":
+			log.Printf("[DEBUG] Encryption Options: %#v", encryptionOpts)
+			_, err = blockStorageV3VolumeTypeEncryptionCreate(blockStorageClient, d.Id(), *encryptionOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error creating encryption of OpenStack volume type %s: %s", d.Id(), err)
+			}
+		case encryptionOpts != nil:
+			log.Printf("[DEBUG] Updating encryption of volume type %s with options: %+v", d.Id(), encryptionOpts)
+			_, err = blockStorageV3VolumeTypeEncryptionUpdate(blockStorageClient, d.Id(), encryption.EncryptionID, *encryptionOpts).Extract()
+			if err != nil {
+				return fmt.Errorf("Error updating encryption of OpenStack volume type %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceBlockStorageVolumeTypeV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeTypeV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	// The encryption spec must be deleted before the volume type.
+	encryption, err := blockStorageV3VolumeTypeEncryptionGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving encryption of OpenStack volume type")
+	}
+
+	if encryption.EncryptionID != "" {
+		log.Printf("[DEBUG] Deleting encryption of volume type %s", d.Id())
+		err = blockStorageV3VolumeTypeEncryptionDelete(blockStorageClient, d.Id(), encryption.EncryptionID).ExtractErr()
+		if err != nil {
+			return CheckDeleted(d, err, "Error deleting encryption of OpenStack volume type")
+		}
+	}
+
+	err = blockStorageV3VolumeTypeDelete(blockStorageClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack volume type")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceVolumeTypeV3ExtraSpecs(rawExtraSpecs map[string]interface{}) map[string]string {
+	extraSpecs := make(map[string]string)
+	for key, val := range rawExtraSpecs {
+		extraSpecs[key] = val.(string)
+	}
+	return extraSpecs
+}
+
+// resourceVolumeTypeV3Encryption returns the configured encryption spec of
+// a volume type, or nil if the volume type isn't encrypted.
+func resourceVolumeTypeV3Encryption(d *schema.ResourceData) *VolumeTypeEncryptionOpts {
+	rawEncryption := d.Get("encryption").([]interface{})
+	if len(rawEncryption) == 0 || rawEncryption[0] == nil {
+		return nil
+	}
+
+	encryption := rawEncryption[0].(map[string]interface{})
+	return &VolumeTypeEncryptionOpts{
+		Provider:        encryption["provider"].(string),
+		Cipher:          encryption["cipher"].(string),
+		KeySize:         encryption["key_size"].(int),
+		ControlLocation: encryption["control_location"].(string),
+	}
+}
@@ -0,0 +1,204 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBlockStorageV3VolumeType_basic(t *testing.T) {
+	var volumeType VolumeType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeType_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "name", "volume_type_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.volume_backend_name", "lvm"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeType_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "name", "volume_type_1-updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "is_public", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "extra_specs.tier", "gold"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3VolumeType_encryption(t *testing.T) {
+	var volumeType VolumeType
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeTypeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeType_encryption,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "encryption.0.provider", "luks"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "encryption.0.cipher", "aes-xts-plain64"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "encryption.0.key_size", "256"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "encryption.0.control_location", "front-end"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeType_encryptionUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "encryption.0.key_size", "512"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3VolumeType_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeTypeExists("openstack_blockstorage_volume_type_v3.volume_type_1", &volumeType),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_type_v3.volume_type_1", "encryption.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBlockStorageV3VolumeTypeDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_type_v3" {
+			continue
+		}
+
+		_, err := blockStorageV3VolumeTypeGet(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Volume type still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3VolumeTypeExists(n string, volumeType *VolumeType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := blockStorageV3VolumeTypeGet(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Volume type not found")
+		}
+
+		*volumeType = *found
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3VolumeType_basic = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+  description = "first test volume type"
+  extra_specs {
+    volume_backend_name = "lvm"
+    tier = "silver"
+  }
+}
+`
+
+const testAccBlockStorageV3VolumeType_update = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1-updated"
+  description = "first test volume type"
+  is_public = false
+  extra_specs {
+    tier = "gold"
+  }
+}
+`
+
+const testAccBlockStorageV3VolumeType_encryption = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+  description = "first test volume type"
+  extra_specs {
+    volume_backend_name = "lvm"
+    tier = "silver"
+  }
+
+  encryption {
+    provider = "luks"
+    cipher = "aes-xts-plain64"
+    key_size = 256
+  }
+}
+`
+
+const testAccBlockStorageV3VolumeType_encryptionUpdate = `
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+  description = "first test volume type"
+  extra_specs {
+    volume_backend_name = "lvm"
+    tier = "silver"
+  }
+
+  encryption {
+    provider = "luks"
+    cipher = "aes-xts-plain64"
+    key_size = 512
+  }
+}
+`
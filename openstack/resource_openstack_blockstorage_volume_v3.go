@@ -0,0 +1,514 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/blockstorage/extensions/volumeactions"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/volumeattach"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func resourceBlockStorageVolumeV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBlockStorageVolumeV3Create,
+		Read:   resourceBlockStorageVolumeV3Read,
+		Update: resourceBlockStorageVolumeV3Update,
+		Delete: resourceBlockStorageVolumeV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
+			"snapshot_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"source_vol_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"volume_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"consistency_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"source_replica": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"multiattach": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"scheduler_hints": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"same_host": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"different_host": &schema.Schema{
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"local_to_instance": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"attachment": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"device": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Set: resourceVolumeV2AttachmentHash,
+			},
+		},
+	}
+}
+
+func resourceBlockStorageVolumeV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := resourceBlockStorageVolumeV3Client(d, config, blockStorageV3VolumeMicroversion(d))
+	if err != nil {
+		return err
+	}
+
+	createOpts := VolumeV3CreateOpts{
+		AvailabilityZone:   d.Get("availability_zone").(string),
+		ConsistencyGroupID: d.Get("consistency_group_id").(string),
+		GroupID:            d.Get("group_id").(string),
+		Description:        d.Get("description").(string),
+		ImageID:            d.Get("image_id").(string),
+		Metadata:           resourceVolumeMetadataV2(d),
+		Multiattach:        d.Get("multiattach").(bool),
+		Name:               d.Get("name").(string),
+		Size:               d.Get("size").(int),
+		SnapshotID:         d.Get("snapshot_id").(string),
+		SourceReplica:      d.Get("source_replica").(string),
+		SourceVolID:        d.Get("source_vol_id").(string),
+		VolumeType:         d.Get("volume_type").(string),
+		SchedulerHints:     resourceBlockStorageVolumeV3SchedulerHints(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	v, err := blockStorageV3VolumeCreate(blockStorageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack volume: %s", err)
+	}
+	log.Printf("[INFO] Volume ID: %s", v.ID)
+
+	// Wait for the volume to become available.
+	log.Printf(
+		"[DEBUG] Waiting for volume (%s) to become available",
+		v.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"downloading", "creating"},
+		Target:     []string{"available"},
+		Refresh:    VolumeV3StateRefreshFunc(blockStorageClient, v.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for volume (%s) to become ready: %s",
+			v.ID, err)
+	}
+
+	// Store the ID now
+	d.SetId(v.ID)
+
+	return resourceBlockStorageVolumeV3Read(d, meta)
+}
+
+func resourceBlockStorageVolumeV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := resourceBlockStorageVolumeV3Client(d, config, "")
+	if err != nil {
+		return err
+	}
+
+	v, err := blockStorageV3VolumeGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "volume")
+	}
+
+	log.Printf("[DEBUG] Retrieved volume %s: %+v", d.Id(), v)
+
+	d.Set("size", v.Size)
+	d.Set("description", v.Description)
+	d.Set("availability_zone", v.AvailabilityZone)
+	d.Set("name", v.Name)
+	d.Set("snapshot_id", v.SnapshotID)
+	d.Set("source_vol_id", v.SourceVolID)
+	d.Set("volume_type", v.VolumeType)
+	d.Set("group_id", v.GroupID)
+	d.Set("multiattach", v.Multiattach)
+	d.Set("metadata", v.Metadata)
+	d.Set("region", GetRegion(d, config))
+
+	attachments := make([]map[string]interface{}, len(v.Attachments))
+	for i, attachment := range v.Attachments {
+		attachments[i] = make(map[string]interface{})
+		attachments[i]["id"] = attachment.AttachmentID
+		attachments[i]["instance_id"] = attachment.ServerID
+		attachments[i]["device"] = attachment.Device
+		log.Printf("[DEBUG] attachment: %v", attachment)
+	}
+	d.Set("attachment", attachments)
+
+	return nil
+}
+
+func resourceBlockStorageVolumeV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := resourceBlockStorageVolumeV3Client(d, config, "")
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("metadata") {
+		var updateOpts VolumeV3UpdateOpts
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			updateOpts.Name = &name
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChange("metadata") {
+			updateOpts.Metadata = resourceVolumeMetadataV2(d)
+		}
+
+		log.Printf("[DEBUG] Updating volume %s with options: %+v", d.Id(), updateOpts)
+
+		_, err = blockStorageV3VolumeUpdate(blockStorageClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack volume: %s", err)
+		}
+	}
+
+	if d.HasChange("size") {
+		if err := resourceBlockStorageVolumeV3Extend(d, config); err != nil {
+			return err
+		}
+	}
+
+	return resourceBlockStorageVolumeV3Read(d, meta)
+}
+
+// resourceBlockStorageVolumeV3Diff computes the diff of a volume. Volumes
+// can't be shrunk, so a smaller size is rejected before any other change is
+// applied.
+func resourceBlockStorageVolumeV3Diff(r *schema.Resource, s *terraform.InstanceState, c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+	diff, err := r.Diff(s, c)
+	if err != nil || diff == nil || diff.RequiresNew() {
+		return diff, err
+	}
+
+	if attr, ok := diff.Attributes["size"]; ok && attr != nil && !attr.NewComputed && attr.Old != "" {
+		oldSize, _ := strconv.Atoi(attr.Old)
+		newSize, _ := strconv.Atoi(attr.New)
+		if newSize < oldSize {
+			return nil, fmt.Errorf("OpenStack volume %s can't be shrunk from %d to %d GB", s.ID, oldSize, newSize)
+		}
+	}
+
+	return diff, nil
+}
+
+// resourceBlockStorageVolumeV3Extend extends the volume to the configured
+// size. Volumes can't be shrunk. An in-use volume is extended online, which
+// requires microversion 3.42.
+func resourceBlockStorageVolumeV3Extend(d *schema.ResourceData, config *Config) error {
+	o, n := d.GetChange("size")
+	oldSize, newSize := o.(int), n.(int)
+	if newSize < oldSize {
+		return fmt.Errorf("Error extending OpenStack volume %s: volumes can't be shrunk from %d to %d GB", d.Id(), oldSize, newSize)
+	}
+
+	blockStorageClient, err := resourceBlockStorageVolumeV3Client(d, config, "")
+	if err != nil {
+		return err
+	}
+
+	v, err := blockStorageV3VolumeGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving OpenStack volume %s: %s", d.Id(), err)
+	}
+
+	if v.Status == "in-use" {
+		blockStorageClient, err = resourceBlockStorageVolumeV3Client(d, config, blockStorageV3OnlineExtendMicroversion)
+		if err != nil {
+			return fmt.Errorf("Error extending in-use OpenStack volume %s: %s", d.Id(), err)
+		}
+	}
+
+	extendOpts := volumeactions.ExtendSizeOpts{
+		NewSize: newSize,
+	}
+
+	log.Printf("[DEBUG] Extending volume %s with options: %+v", d.Id(), extendOpts)
+	err = volumeactions.ExtendSize(blockStorageClient, d.Id(), extendOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error extending OpenStack volume %s: %s", d.Id(), err)
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"extending"},
+		Target:     []string{v.Status},
+		Refresh:    VolumeV3StateRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for volume (%s) to extend: %s",
+			d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourceBlockStorageVolumeV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	blockStorageClient, err := resourceBlockStorageVolumeV3Client(d, config, "")
+	if err != nil {
+		return err
+	}
+
+	v, err := blockStorageV3VolumeGet(blockStorageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "volume")
+	}
+
+	// Make sure this volume is detached from all instances before deleting.
+	// A multiattach volume can have several attachments.
+	if len(v.Attachments) > 0 {
+		log.Printf("[DEBUG] detaching volume %s", d.Id())
+		computeClient, err := config.computeV2Client(GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		for _, volumeAttachment := range v.Attachments {
+			log.Printf("[DEBUG] Attachment: %v", volumeAttachment)
+			if err := volumeattach.Delete(computeClient, volumeAttachment.ServerID, volumeAttachment.ID).ExtractErr(); err != nil {
+				return err
+			}
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"in-use", "attaching", "detaching"},
+			Target:     []string{"available"},
+			Refresh:    VolumeV3StateRefreshFunc(blockStorageClient, d.Id()),
+			Timeout:    10 * time.Minute,
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for volume (%s) to become available: %s",
+				d.Id(), err)
+		}
+	}
+
+	// It's possible that this volume was used as a boot device and is currently
+	// in a "deleting" state from when the instance was terminated.
+	// If this is true, just move on. It'll eventually delete.
+	if v.Status != "deleting" {
+		if err := blockStorageV3VolumeDelete(blockStorageClient, d.Id()).ExtractErr(); err != nil {
+			return CheckDeleted(d, err, "volume")
+		}
+	}
+
+	// Wait for the volume to delete before moving on.
+	log.Printf("[DEBUG] Waiting for volume (%s) to delete", d.Id())
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting", "downloading", "available"},
+		Target:     []string{"deleted"},
+		Refresh:    VolumeV3StateRefreshFunc(blockStorageClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for volume (%s) to delete: %s",
+			d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceBlockStorageVolumeV3Client returns a Block Storage v3 client
+// which uses the negotiated microversion. An error is returned if the
+// server does not support the required microversion.
+func resourceBlockStorageVolumeV3Client(d *schema.ResourceData, config *Config, required string) (*gophercloud.ServiceClient, error) {
+	blockStorageClient, err := config.blockStorageV3Client(GetRegion(d, config))
+	if err != nil {
+		return nil, fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	return blockStorageV3NegotiateMicroversion(blockStorageClient, required)
+}
+
+// blockStorageV3VolumeMicroversion returns the Block Storage API
+// microversion needed to create the configured volume.
+func blockStorageV3VolumeMicroversion(d *schema.ResourceData) string {
+	if d.Get("multiattach").(bool) {
+		return blockStorageV3MultiattachMicroversion
+	}
+
+	if d.Get("group_id").(string) != "" {
+		return blockStorageV3GroupMicroversion
+	}
+
+	return ""
+}
+
+// resourceBlockStorageVolumeV3SchedulerHints returns the configured
+// scheduler hints, or nil if none are set.
+func resourceBlockStorageVolumeV3SchedulerHints(d *schema.ResourceData) *VolumeV3SchedulerHints {
+	schedulerHintsRaw := d.Get("scheduler_hints").([]interface{})
+	if len(schedulerHintsRaw) == 0 || schedulerHintsRaw[0] == nil {
+		return nil
+	}
+	rawHints := schedulerHintsRaw[0].(map[string]interface{})
+
+	var hints VolumeV3SchedulerHints
+	for _, v := range rawHints["same_host"].([]interface{}) {
+		hints.SameHost = append(hints.SameHost, v.(string))
+	}
+	for _, v := range rawHints["different_host"].([]interface{}) {
+		hints.DifferentHost = append(hints.DifferentHost, v.(string))
+	}
+	hints.LocalToInstance = rawHints["local_to_instance"].(string)
+
+	return &hints
+}
+
+// VolumeV3StateRefreshFunc returns a resource.StateRefreshFunc that is used to watch
+// an OpenStack Block Storage v3 volume.
+func VolumeV3StateRefreshFunc(client *gophercloud.ServiceClient, volumeID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		v, err := blockStorageV3VolumeGet(client, volumeID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return v, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if v.Status == "error" {
+			return v, v.Status, fmt.Errorf("There was an error creating the volume. " +
+				"Please check with your cloud admin or check the Block Storage " +
+				"API logs to see why this error occurred.")
+		}
+
+		return v, v.Status, nil
+	}
+}
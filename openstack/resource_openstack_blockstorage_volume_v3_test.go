@@ -0,0 +1,296 @@
+package openstack
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/config"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccBlockStorageV3Volume_basic(t *testing.T) {
+	var volume VolumeV3
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3Volume_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					testAccCheckBlockStorageV3VolumeMetadata(&volume, "foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "name", "volume_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "multiattach", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3Volume_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					testAccCheckBlockStorageV3VolumeMetadata(&volume, "foo", "bar"),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "name", "volume_1-updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Volume_multiattach(t *testing.T) {
+	var volume VolumeV3
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3Volume_multiattach,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "multiattach", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Volume_extend(t *testing.T) {
+	var volume, extendedVolume VolumeV3
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3Volume_extend(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "size", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccBlockStorageV3Volume_extend(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &extendedVolume),
+					testAccCheckBlockStorageV3VolumeSame(&volume, &extendedVolume),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_1", "size", "2"),
+				),
+			},
+			resource.TestStep{
+				Config:      testAccBlockStorageV3Volume_extend(1),
+				ExpectError: regexp.MustCompile("can't be shrunk"),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageV3Volume_schedulerHints(t *testing.T) {
+	var volume1, volume2 VolumeV3
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBlockStorageV3VolumeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccBlockStorageV3Volume_schedulerHints,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_1", &volume1),
+					testAccCheckBlockStorageV3VolumeExists("openstack_blockstorage_volume_v3.volume_2", &volume2),
+					resource.TestCheckResourceAttr(
+						"openstack_blockstorage_volume_v3.volume_2", "scheduler_hints.#", "1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_blockstorage_volume_v3.volume_2", "scheduler_hints.0.same_host.0",
+						"openstack_blockstorage_volume_v3.volume_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceBlockStorageVolumeV3Diff(t *testing.T) {
+	testCases := []struct {
+		size  int
+		valid bool
+	}{
+		{size: 1, valid: false},
+		{size: 2, valid: true},
+		{size: 3, valid: true},
+	}
+
+	for i, tc := range testCases {
+		s := &terraform.InstanceState{
+			ID: "volume-1",
+			Attributes: map[string]string{
+				"size": "2",
+			},
+		}
+
+		rawConfig, err := config.NewRawConfig(map[string]interface{}{
+			"size": tc.size,
+		})
+		if err != nil {
+			t.Fatalf("Test case %d: unexpected error: %s", i, err)
+		}
+
+		_, err = resourceBlockStorageVolumeV3Diff(resourceBlockStorageVolumeV3(), s, terraform.NewResourceConfig(rawConfig))
+		if tc.valid && err != nil {
+			t.Fatalf("Test case %d: unexpected error: %s", i, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("Test case %d: expected an error", i)
+		}
+	}
+}
+
+func testAccCheckBlockStorageV3VolumeDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_blockstorage_volume_v3" {
+			continue
+		}
+
+		_, err := blockStorageV3VolumeGet(blockStorageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Volume still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckBlockStorageV3VolumeExists(n string, volume *VolumeV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		blockStorageClient, err := config.blockStorageV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		found, err := blockStorageV3VolumeGet(blockStorageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Volume not found")
+		}
+
+		*volume = *found
+
+		return nil
+	}
+}
+
+func testAccCheckBlockStorageV3VolumeMetadata(
+	volume *VolumeV3, k string, v string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if volume.Metadata == nil {
+			return fmt.Errorf("No metadata")
+		}
+
+		for key, value := range volume.Metadata {
+			if k != key {
+				continue
+			}
+
+			if v == value {
+				return nil
+			}
+
+			return fmt.Errorf("Bad value for %s: %s", k, value)
+		}
+
+		return fmt.Errorf("Metadata not found: %s", k)
+	}
+}
+
+func testAccCheckBlockStorageV3VolumeSame(before, after *VolumeV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before.ID != after.ID {
+			return fmt.Errorf("Volume was recreated: %s != %s", before.ID, after.ID)
+		}
+
+		return nil
+	}
+}
+
+const testAccBlockStorageV3Volume_basic = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  description = "first test volume"
+  metadata {
+    foo = "bar"
+  }
+  size = 1
+}
+`
+
+const testAccBlockStorageV3Volume_update = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1-updated"
+  description = "first test volume"
+  metadata {
+    foo = "bar"
+  }
+  size = 1
+}
+`
+
+const testAccBlockStorageV3Volume_multiattach = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+  multiattach = true
+}
+`
+
+const testAccBlockStorageV3Volume_schedulerHints = `
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = 1
+}
+
+resource "openstack_blockstorage_volume_v3" "volume_2" {
+  name = "volume_2"
+  size = 1
+
+  scheduler_hints {
+    same_host = ["${openstack_blockstorage_volume_v3.volume_1.id}"]
+  }
+}
+`
+
+func testAccBlockStorageV3Volume_extend(size int) string {
+	return fmt.Sprintf(`
+resource "openstack_blockstorage_volume_v3" "volume_1" {
+  name = "volume_1"
+  size = %d
+}
+`, size)
+}
@@ -12,6 +12,7 @@ func resourceComputeFlavorV2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeFlavorV2Create,
 		Read:   resourceComputeFlavorV2Read,
+		Update: resourceComputeFlavorV2Update,
 		Delete: resourceComputeFlavorV2Delete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -65,6 +66,11 @@ func resourceComputeFlavorV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"extra_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -99,6 +105,14 @@ func resourceComputeFlavorV2Create(d *schema.ResourceData, meta interface{}) err
 
 	d.SetId(fl.ID)
 
+	if v, ok := d.GetOk("extra_specs"); ok {
+		extraSpecs := resourceFlavorExtraSpecsV2(v)
+		err = computeV2FlavorExtraSpecsCreate(computeClient, fl.ID, extraSpecs).Err
+		if err != nil {
+			return fmt.Errorf("Error creating extra specs for OpenStack flavor %s: %s", fl.ID, err)
+		}
+	}
+
 	return resourceComputeFlavorV2Read(d, meta)
 }
 
@@ -109,11 +123,22 @@ func resourceComputeFlavorV2Read(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	fl, err := flavors.Get(computeClient, d.Id()).Extract()
+	r := flavors.Get(computeClient, d.Id())
+	fl, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "flavor")
 	}
 
+	ephemeral, err := resourceFlavorEphemeralV2(r)
+	if err != nil {
+		return err
+	}
+
+	extraSpecs, err := computeV2FlavorExtraSpecsList(computeClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving extra specs for OpenStack flavor %s: %s", d.Id(), err)
+	}
+
 	d.Set("name", fl.Name)
 	d.Set("ram", fl.RAM)
 	d.Set("vcpus", fl.VCPUs)
@@ -121,12 +146,45 @@ func resourceComputeFlavorV2Read(d *schema.ResourceData, meta interface{}) error
 	d.Set("swap", fl.Swap)
 	d.Set("rx_tx_factor", fl.RxTxFactor)
 	d.Set("is_public", fl.IsPublic)
-	// d.Set("ephemeral", fl.Ephemeral) TODO: Implement this in gophercloud
+	d.Set("ephemeral", ephemeral)
+	d.Set("extra_specs", extraSpecs)
 	d.Set("region", GetRegion(d, config))
 
 	return nil
 }
 
+func resourceComputeFlavorV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	if d.HasChange("extra_specs") {
+		oldRaw, newRaw := d.GetChange("extra_specs")
+		oldExtraSpecs := resourceFlavorExtraSpecsV2(oldRaw)
+		newExtraSpecs := resourceFlavorExtraSpecsV2(newRaw)
+
+		for key := range oldExtraSpecs {
+			if _, ok := newExtraSpecs[key]; !ok {
+				err := computeV2FlavorExtraSpecDelete(computeClient, d.Id(), key).ExtractErr()
+				if err != nil {
+					return fmt.Errorf("Error deleting extra spec %s of OpenStack flavor %s: %s", key, d.Id(), err)
+				}
+			}
+		}
+
+		if len(newExtraSpecs) > 0 {
+			err := computeV2FlavorExtraSpecsCreate(computeClient, d.Id(), newExtraSpecs).Err
+			if err != nil {
+				return fmt.Errorf("Error updating extra specs of OpenStack flavor %s: %s", d.Id(), err)
+			}
+		}
+	}
+
+	return resourceComputeFlavorV2Read(d, meta)
+}
+
 func resourceComputeFlavorV2Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	computeClient, err := config.computeV2Client(GetRegion(d, config))
@@ -33,6 +33,44 @@ func TestAccComputeV2Flavor_basic(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Flavor_extraSpecs(t *testing.T) {
+	var flavor flavors.Flavor
+	var flavorName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2FlavorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Flavor_extraSpecs_1(flavorName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2FlavorExists("openstack_compute_flavor_v2.flavor_1", &flavor),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_flavor_v2.flavor_1", "extra_specs.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_flavor_v2.flavor_1", "extra_specs.hw:cpu_policy", "CPU-POLICY"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_flavor_v2.flavor_1", "extra_specs.hw:cpu_thread_policy", "CPU-THREAD-POLICY"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Flavor_extraSpecs_2(flavorName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2FlavorExists("openstack_compute_flavor_v2.flavor_1", &flavor),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_flavor_v2.flavor_1", "extra_specs.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_flavor_v2.flavor_1", "extra_specs.hw:cpu_policy", "CPU-POLICY-2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2FlavorDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	computeClient, err := config.computeV2Client(OS_REGION_NAME)
@@ -98,3 +136,38 @@ func testAccComputeV2Flavor_basic(flavorName string) string {
     }
     `, flavorName)
 }
+
+func testAccComputeV2Flavor_extraSpecs_1(flavorName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_compute_flavor_v2" "flavor_1" {
+      name = "%s"
+      ram = 2048
+      vcpus = 2
+      disk = 5
+
+      is_public = true
+
+      extra_specs {
+        "hw:cpu_policy" = "CPU-POLICY",
+        "hw:cpu_thread_policy" = "CPU-THREAD-POLICY"
+      }
+    }
+    `, flavorName)
+}
+
+func testAccComputeV2Flavor_extraSpecs_2(flavorName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_compute_flavor_v2" "flavor_1" {
+      name = "%s"
+      ram = 2048
+      vcpus = 2
+      disk = 5
+
+      is_public = true
+
+      extra_specs {
+        "hw:cpu_policy" = "CPU-POLICY-2"
+      }
+    }
+    `, flavorName)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"sort"
 	"time"
 
 	"github.com/gophercloud/gophercloud"
@@ -22,8 +23,26 @@ import (
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
 )
 
+// computeV2BlockDeviceVolumeTypeMicroversion is the minimum Compute API
+// microversion which supports the volume_type of a block device.
+const computeV2BlockDeviceVolumeTypeMicroversion = "2.67"
+
+// computeV2TrustedImageCertificatesMicroversion is the minimum Compute API
+// microversion which supports trusted image certificates.
+const computeV2TrustedImageCertificatesMicroversion = "2.63"
+
+// computeV2InstanceNetworksRequiredMicroversion is the Compute API
+// microversion from which the networks of a new server have to be given,
+// either as a list or as "auto" or "none".
+const computeV2InstanceNetworksRequiredMicroversion = "2.37"
+
+// computeV2InstancePersonalityRemovedMicroversion is the Compute API
+// microversion which removed personality files.
+const computeV2InstancePersonalityRemovedMicroversion = "2.57"
+
 func resourceComputeInstanceV2() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceComputeInstanceV2Create,
@@ -97,6 +116,18 @@ func resourceComputeInstanceV2() *schema.Resource {
 					}
 				},
 			},
+			"user_data_update_policy": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "replace" && value != "rebuild" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'replace' and 'rebuild' are supported values for 'user_data_update_policy'"))
+					}
+					return
+				},
+			},
 			"security_groups": &schema.Schema{
 				Type:     schema.TypeSet,
 				Optional: true,
@@ -114,38 +145,38 @@ func resourceComputeInstanceV2() *schema.Resource {
 			"network": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
+				ForceNew: false,
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"uuid": &schema.Schema{
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
+							ForceNew: false,
 							Computed: true,
 						},
 						"name": &schema.Schema{
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
+							ForceNew: false,
 							Computed: true,
 						},
 						"port": &schema.Schema{
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
+							ForceNew: false,
 							Computed: true,
 						},
 						"fixed_ip_v4": &schema.Schema{
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
+							ForceNew: false,
 							Computed: true,
 						},
 						"fixed_ip_v6": &schema.Schema{
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
+							ForceNew: false,
 							Computed: true,
 						},
 						"floating_ip": &schema.Schema{
@@ -239,6 +270,11 @@ func resourceComputeInstanceV2() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"volume_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
 					},
 				},
 			},
@@ -303,6 +339,11 @@ func resourceComputeInstanceV2() *schema.Resource {
 							Optional: true,
 							ForceNew: true,
 						},
+						"additional_properties": &schema.Schema{
+							Type:     schema.TypeMap,
+							Optional: true,
+							ForceNew: true,
+						},
 					},
 				},
 				Set: resourceComputeSchedulerHintsHash,
@@ -339,10 +380,66 @@ func resourceComputeInstanceV2() *schema.Resource {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"trusted_image_certificates": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"locked": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"power_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "active",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "active" && value != "shutoff" && value != "shelved_offloaded" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'active', 'shutoff' and 'shelved_offloaded' are supported values for 'power_state'"))
+					}
+					return
+				},
+			},
 		},
 	}
 }
 
+// resourceComputeInstanceV2Diff computes the diff of an instance.
+//
+// If user_data_update_policy is "rebuild", a change of user_data rebuilds the
+// instance instead of forcing a new one. Changes to the fixed IPs of a
+// network with a port are rejected, see checkInstanceNetworkPortFixedIPs.
+func resourceComputeInstanceV2Diff(r *schema.Resource, s *terraform.InstanceState, c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+	if policy, ok := c.Get("user_data_update_policy"); ok && policy == "rebuild" {
+		r = resourceWithoutForceNew(r, "user_data")
+	}
+
+	diff, err := r.Diff(s, c)
+	if err != nil {
+		return diff, err
+	}
+
+	if err := checkInstanceNetworkPortFixedIPs(s, diff); err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
 func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	computeClient, err := config.computeV2Client(GetRegion(d, config))
@@ -352,6 +449,16 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 
 	var createOpts servers.CreateOptsBuilder
 
+	// Some arguments require a newer microversion, which doesn't accept
+	// personality files anymore.
+	createMicroversion := computeV2InstanceCreateMicroversion(d)
+	if computeV2MicroversionAtLeast(createMicroversion, computeV2InstancePersonalityRemovedMicroversion) &&
+		d.Get("personality").(*schema.Set).Len() > 0 {
+		return fmt.Errorf(
+			"personality can't be used with trusted_image_certificates or the volume_type of a block_device, "+
+				"which require Compute API microversion %s", createMicroversion)
+	}
+
 	// Determines the Image ID using the following rules:
 	// If a bootable block_device was specified, ignore the image altogether.
 	// If an image_id was specified, use it.
@@ -398,6 +505,16 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 		Personality:      resourceInstancePersonalityV2(d),
 	}
 
+	// Without any networks, the default microversion lets the Compute
+	// service choose the networks, which newer microversions only do when
+	// asked to.
+	if len(networks) == 0 && computeV2MicroversionAtLeast(createMicroversion, computeV2InstanceNetworksRequiredMicroversion) {
+		createOpts = &NetworksCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			Networks:          "auto",
+		}
+	}
+
 	if keyName, ok := d.Get("key_pair").(string); ok && keyName != "" {
 		createOpts = &keypairs.CreateOptsExt{
 			CreateOptsBuilder: createOpts,
@@ -411,12 +528,28 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 			return err
 		}
 
-		createOpts = &bootfromvolume.CreateOptsExt{
+		createOpts = &BlockDeviceCreateOptsExt{
 			CreateOptsBuilder: createOpts,
 			BlockDevice:       blockDevices,
 		}
 	}
 
+	// Server descriptions require a newer Compute microversion, so they
+	// are only sent when set.
+	if description := d.Get("description").(string); description != "" {
+		createOpts = &DescriptionCreateOptsExt{
+			CreateOptsBuilder: createOpts,
+			Description:       description,
+		}
+	}
+
+	if certificates := resourceInstanceTrustedImageCertificatesV2(d); len(certificates) > 0 {
+		createOpts = &TrustedImageCertificatesCreateOptsExt{
+			CreateOptsBuilder:        createOpts,
+			TrustedImageCertificates: certificates,
+		}
+	}
+
 	schedulerHintsRaw := d.Get("scheduler_hints").(*schema.Set).List()
 	if len(schedulerHintsRaw) > 0 {
 		log.Printf("[DEBUG] schedulerhints: %+v", schedulerHintsRaw)
@@ -431,11 +564,14 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 
 	// If a block_device is used, use the bootfromvolume.Create function as it allows an empty ImageRef.
 	// Otherwise, use the normal servers.Create function.
+	createClient := *computeClient
+	createClient.Microversion = createMicroversion
+
 	var server *servers.Server
 	if _, ok := d.GetOk("block_device"); ok {
-		server, err = bootfromvolume.Create(computeClient, createOpts).Extract()
+		server, err = bootfromvolume.Create(&createClient, createOpts).Extract()
 	} else {
-		server, err = servers.Create(computeClient, createOpts).Extract()
+		server, err = servers.Create(&createClient, createOpts).Extract()
 	}
 
 	if err != nil {
@@ -468,6 +604,27 @@ func resourceComputeInstanceV2Create(d *schema.ResourceData, meta interface{}) e
 			server.ID, err)
 	}
 
+	if tags := resourceInstanceTagsV2(d); len(tags) > 0 {
+		_, err := computeV2InstanceTagsReplace(computeClient, server.ID, tags).Extract()
+		if err != nil {
+			return fmt.Errorf("Error setting tags on OpenStack server (%s): %s", server.ID, err)
+		}
+	}
+
+	if powerState := d.Get("power_state").(string); powerState != "active" {
+		err := setInstancePowerState(computeClient, server.ID, powerState, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Lock the server last since a locked server can't be changed.
+	if d.Get("locked").(bool) {
+		if err := setInstanceLocked(computeClient, server.ID, true); err != nil {
+			return fmt.Errorf("Error locking OpenStack server (%s): %s", server.ID, err)
+		}
+	}
+
 	return resourceComputeInstanceV2Read(d, meta)
 }
 
@@ -547,25 +704,49 @@ func resourceComputeInstanceV2Read(d *schema.ResourceData, meta interface{}) err
 	}
 	d.Set("flavor_name", flavor.Name)
 
+	if powerState := flattenInstancePowerState(server.Status); powerState != "" {
+		d.Set("power_state", powerState)
+	}
+
 	// Set the instance's image information appropriately
 	if err := setImageInformation(computeClient, server, d); err != nil {
 		return err
 	}
 
-	// Build a custom struct for the availability zone extension
-	var serverWithAZ struct {
-		servers.Server
-		availabilityzones.ServerExt
-	}
-
-	// Do another Get so the above work is not disturbed.
-	err = servers.Get(computeClient, d.Id()).ExtractInto(&serverWithAZ)
+	// The tags, description and locked status require a newer Compute
+	// microversion which not all clouds support. They are retrieved along
+	// with the availability zone in a single request, and only the
+	// availability zone is retrieved if the microversion is not supported.
+	details, err := computeV2InstanceDetailsGet(computeClient, d.Id())
 	if err != nil {
-		return CheckDeleted(d, err, "server")
-	}
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return CheckDeleted(d, err, "server")
+		}
 
-	// Set the availability zone
-	d.Set("availability_zone", serverWithAZ.AvailabilityZone)
+		log.Printf("[DEBUG] Unable to retrieve tags, description and locked status of OpenStack server (%s): %s", d.Id(), err)
+
+		var serverWithAZ struct {
+			servers.Server
+			availabilityzones.ServerExt
+		}
+
+		err = servers.Get(computeClient, d.Id()).ExtractInto(&serverWithAZ)
+		if err != nil {
+			return CheckDeleted(d, err, "server")
+		}
+
+		d.Set("availability_zone", serverWithAZ.AvailabilityZone)
+	} else {
+		d.Set("availability_zone", details.AvailabilityZone)
+		d.Set("tags", details.Tags)
+		d.Set("locked", details.Locked)
+
+		description := ""
+		if details.Description != nil {
+			description = *details.Description
+		}
+		d.Set("description", description)
+	}
 
 	// Set the region
 	d.Set("region", GetRegion(d, config))
@@ -580,6 +761,15 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
+	// A locked server can't be changed, so unlock it first and lock it
+	// again once the remaining changes have been made.
+	wasLocked, locked := d.GetChange("locked")
+	if wasLocked.(bool) {
+		if err := setInstanceLocked(computeClient, d.Id(), false); err != nil {
+			return fmt.Errorf("Error unlocking OpenStack server (%s): %s", d.Id(), err)
+		}
+	}
+
 	var updateOpts servers.UpdateOpts
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
@@ -592,6 +782,13 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("description") {
+		_, err := computeV2InstanceDescriptionUpdate(computeClient, d.Id(), d.Get("description").(string)).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating description of OpenStack server (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("metadata") {
 		oldMetadata, newMetadata := d.GetChange("metadata")
 		var metadataToDelete []string
@@ -630,6 +827,12 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("network") {
+		if err := updateInstanceNetworks(d, meta, computeClient); err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("security_groups") {
 		oldSGRaw, newSGRaw := d.GetChange("security_groups")
 		oldSGSet := oldSGRaw.(*schema.Set)
@@ -663,6 +866,15 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	// An empty list of tags removes all tags of the server.
+	if d.HasChange("tags") {
+		tags := resourceInstanceTagsV2(d)
+		_, err := computeV2InstanceTagsReplace(computeClient, d.Id(), tags).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating tags of OpenStack server (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("admin_pass") {
 		if newPwd, ok := d.Get("admin_pass").(string); ok {
 			err := servers.ChangeAdminPassword(computeClient, d.Id(), newPwd).ExtractErr()
@@ -733,6 +945,28 @@ func resourceComputeInstanceV2Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	// user_data only changes in place if user_data_update_policy is
+	// "rebuild", otherwise a new instance is created.
+	if d.HasChange("user_data") {
+		err := rebuildInstanceUserData(computeClient, d.Id(), d.Get("user_data").(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("power_state") {
+		err := setInstancePowerState(computeClient, d.Id(), d.Get("power_state").(string), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	if locked.(bool) {
+		if err := setInstanceLocked(computeClient, d.Id(), true); err != nil {
+			return fmt.Errorf("Error locking OpenStack server (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceComputeInstanceV2Read(d, meta)
 }
 
@@ -743,6 +977,12 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
+	if d.Get("locked").(bool) {
+		if err := setInstanceLocked(computeClient, d.Id(), false); err != nil {
+			return fmt.Errorf("Error unlocking OpenStack server (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.Get("stop_before_destroy").(bool) {
 		err = startstop.Stop(computeClient, d.Id()).ExtractErr()
 		if err != nil {
@@ -782,7 +1022,7 @@ func resourceComputeInstanceV2Delete(d *schema.ResourceData, meta interface{}) e
 	log.Printf("[DEBUG] Waiting for instance (%s) to delete", d.Id())
 
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{"ACTIVE", "SHUTOFF"},
+		Pending:    []string{"ACTIVE", "SHUTOFF", "SHELVED", "SHELVED_OFFLOADED"},
 		Target:     []string{"DELETED", "SOFT_DELETED"},
 		Refresh:    ServerV2StateRefreshFunc(computeClient, d.Id()),
 		Timeout:    d.Timeout(schema.TimeoutDelete),
@@ -834,16 +1074,19 @@ func resourceInstanceMetadataV2(d *schema.ResourceData) map[string]string {
 	return m
 }
 
-func resourceInstanceBlockDevicesV2(d *schema.ResourceData, bds []interface{}) ([]bootfromvolume.BlockDevice, error) {
-	blockDeviceOpts := make([]bootfromvolume.BlockDevice, len(bds))
+func resourceInstanceBlockDevicesV2(d *schema.ResourceData, bds []interface{}) ([]BlockDevice, error) {
+	blockDeviceOpts := make([]BlockDevice, len(bds))
 	for i, bd := range bds {
 		bdM := bd.(map[string]interface{})
-		blockDeviceOpts[i] = bootfromvolume.BlockDevice{
-			UUID:                bdM["uuid"].(string),
-			VolumeSize:          bdM["volume_size"].(int),
-			BootIndex:           bdM["boot_index"].(int),
-			DeleteOnTermination: bdM["delete_on_termination"].(bool),
-			GuestFormat:         bdM["guest_format"].(string),
+		blockDeviceOpts[i] = BlockDevice{
+			BlockDevice: bootfromvolume.BlockDevice{
+				UUID:                bdM["uuid"].(string),
+				VolumeSize:          bdM["volume_size"].(int),
+				BootIndex:           bdM["boot_index"].(int),
+				DeleteOnTermination: bdM["delete_on_termination"].(bool),
+				GuestFormat:         bdM["guest_format"].(string),
+			},
+			VolumeType: bdM["volume_type"].(string),
 		}
 
 		sourceType := bdM["source_type"].(string)
@@ -875,7 +1118,62 @@ func resourceInstanceBlockDevicesV2(d *schema.ResourceData, bds []interface{}) (
 	return blockDeviceOpts, nil
 }
 
-func resourceInstanceSchedulerHintsV2(d *schema.ResourceData, schedulerHintsRaw map[string]interface{}) schedulerhints.SchedulerHints {
+// blockDevicesHaveVolumeType returns true if any block device has a
+// volume_type set.
+func blockDevicesHaveVolumeType(bds []interface{}) bool {
+	for _, bd := range bds {
+		if bd.(map[string]interface{})["volume_type"].(string) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resourceInstanceTrustedImageCertificatesV2(d *schema.ResourceData) []string {
+	rawCertificates := d.Get("trusted_image_certificates").([]interface{})
+	certificates := make([]string, len(rawCertificates))
+	for i, raw := range rawCertificates {
+		certificates[i] = raw.(string)
+	}
+	return certificates
+}
+
+// computeV2InstanceCreateMicroversion returns the lowest Compute API
+// microversion which supports every argument used to create the server, or
+// an empty string if the default microversion is sufficient.
+func computeV2InstanceCreateMicroversion(d *schema.ResourceData) string {
+	switch {
+	case blockDevicesHaveVolumeType(d.Get("block_device").([]interface{})):
+		return computeV2BlockDeviceVolumeTypeMicroversion
+	case len(d.Get("trusted_image_certificates").([]interface{})) > 0:
+		return computeV2TrustedImageCertificatesMicroversion
+	case d.Get("description").(string) != "":
+		return computeV2InstanceDescriptionMicroversion
+	}
+
+	return ""
+}
+
+// computeV2MicroversionAtLeast reports whether a Compute API microversion is
+// at least min. An empty microversion is the default microversion, which is
+// older than any microversion it is compared with.
+func computeV2MicroversionAtLeast(microversion, min string) bool {
+	if microversion == "" {
+		return false
+	}
+
+	var major, minor, minMajor, minMinor int
+	fmt.Sscanf(microversion, "%d.%d", &major, &minor)
+	fmt.Sscanf(min, "%d.%d", &minMajor, &minMinor)
+
+	if major != minMajor {
+		return major > minMajor
+	}
+	return minor >= minMinor
+}
+
+func resourceInstanceSchedulerHintsV2(d *schema.ResourceData, schedulerHintsRaw map[string]interface{}) SchedulerHints {
 	differentHost := []string{}
 	if len(schedulerHintsRaw["different_host"].([]interface{})) > 0 {
 		for _, dh := range schedulerHintsRaw["different_host"].([]interface{}) {
@@ -897,13 +1195,23 @@ func resourceInstanceSchedulerHintsV2(d *schema.ResourceData, schedulerHintsRaw
 		}
 	}
 
-	schedulerHints := schedulerhints.SchedulerHints{
-		Group:           schedulerHintsRaw["group"].(string),
-		DifferentHost:   differentHost,
-		SameHost:        sameHost,
-		Query:           query,
-		TargetCell:      schedulerHintsRaw["target_cell"].(string),
-		BuildNearHostIP: schedulerHintsRaw["build_near_host_ip"].(string),
+	additionalProperties := make(map[string]interface{})
+	if v, ok := schedulerHintsRaw["additional_properties"].(map[string]interface{}); ok {
+		for key, value := range v {
+			additionalProperties[key] = value
+		}
+	}
+
+	schedulerHints := SchedulerHints{
+		schedulerhints.SchedulerHints{
+			Group:           schedulerHintsRaw["group"].(string),
+			DifferentHost:   differentHost,
+			SameHost:        sameHost,
+			Query:           query,
+			TargetCell:      schedulerHintsRaw["target_cell"].(string),
+			BuildNearHostIP: schedulerHintsRaw["build_near_host_ip"].(string),
+		},
+		additionalProperties,
 	}
 
 	return schedulerHints
@@ -1021,14 +1329,35 @@ func resourceComputeSchedulerHintsHash(v interface{}) int {
 	buf.WriteString(fmt.Sprintf("%s-", m["same_host"].([]interface{})))
 	buf.WriteString(fmt.Sprintf("%s-", m["query"].([]interface{})))
 
+	if m["additional_properties"] != nil {
+		additionalProperties := m["additional_properties"].(map[string]interface{})
+		keys := make([]string, 0, len(additionalProperties))
+		for k := range additionalProperties {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			buf.WriteString(fmt.Sprintf("%s=%s-", k, additionalProperties[k]))
+		}
+	}
+
 	return hashcode.String(buf.String())
 }
 
 func checkBlockDeviceConfig(d *schema.ResourceData) error {
 	if vL, ok := d.GetOk("block_device"); ok {
+		var swapDisks int
 		for _, v := range vL.([]interface{}) {
 			vM := v.(map[string]interface{})
 
+			if vM["guest_format"] == "swap" {
+				swapDisks++
+				if vM["source_type"] != "blank" || vM["destination_type"] != "local" {
+					return fmt.Errorf("A swap block device must have a blank source_type and a local destination_type")
+				}
+			}
+
 			if vM["source_type"] != "blank" && vM["uuid"] == "" {
 				return fmt.Errorf("You must specify a uuid for %s block device types", vM["source_type"])
 			}
@@ -1043,8 +1372,16 @@ func checkBlockDeviceConfig(d *schema.ResourceData) error {
 				if vM["volume_size"] == 0 {
 					return fmt.Errorf("You must specify a volume_size when creating a blank block device")
 				}
+
+				if vM["boot_index"] == 0 {
+					return fmt.Errorf("Ephemeral and swap block devices can't be booted from, set their boot_index to -1")
+				}
 			}
 		}
+
+		if swapDisks > 1 {
+			return fmt.Errorf("Only one swap block device can be specified")
+		}
 	}
 
 	return nil
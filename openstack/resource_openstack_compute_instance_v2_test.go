@@ -2,9 +2,12 @@ package openstack
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 
 	"github.com/gophercloud/gophercloud"
@@ -202,6 +205,30 @@ func TestAccComputeV2Instance_blockDeviceExistingVolume(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2Instance_blockDeviceVolumeType(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_blockDeviceVolumeType,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceVolumeType(&instance, "volume_type_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "block_device.1.volume_type", "volume_type_1"),
+				),
+			},
+		},
+	})
+}
+
 // TODO: verify the personality really exists on the instance.
 func TestAccComputeV2Instance_personality(t *testing.T) {
 	var instance servers.Server
@@ -474,7 +501,617 @@ func TestAccComputeV2Instance_crazyNICs(t *testing.T) {
 				),
 			},
 		},
-	})
+	})
+}
+
+func TestAccComputeV2Instance_description(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_description("Owned by the web team"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceDescription(&instance, "Owned by the web team"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "description", "Owned by the web team"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_description("See ticket 1234"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceDescription(&instance, "See ticket 1234"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "description", "See ticket 1234"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_locked(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_locked(true, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceLocked(&instance, true),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_locked(true, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceMetadata(&instance, "foo", "baz"),
+					testAccCheckComputeV2InstanceLocked(&instance, true),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_locked(false, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceLocked(&instance, false),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_locked(true, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceLocked(&instance, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_schedulerHintsAdditionalProperties(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_schedulerHintsAdditionalProperties,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_tags(t *testing.T) {
+	var instance servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_tags_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceTags(&instance, []string{"tag1", "tag2"}),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "tags.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_tags_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceTags(&instance, []string{"tag3"}),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "tags.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_tags_3,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance),
+					testAccCheckComputeV2InstanceTags(&instance, []string{}),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "tags.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_networkUpdate(t *testing.T) {
+	var instance_1, instance_2, instance_3 servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_networkUpdate_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance_1),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "network.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_networkUpdate_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance_2),
+					testAccCheckComputeV2InstanceInstanceIDsMatch(&instance_1, &instance_2),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "network.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "network.1.fixed_ip_v4", "192.168.1.100"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_networkUpdate_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance_3),
+					testAccCheckComputeV2InstanceInstanceIDsMatch(&instance_1, &instance_3),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "network.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_powerState(t *testing.T) {
+	var instance_1, instance_2 servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_powerState("shutoff"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance_1),
+					testAccCheckComputeV2InstanceStatus(&instance_1, "SHUTOFF"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "shutoff"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_powerState("shelved_offloaded"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance_2),
+					testAccCheckComputeV2InstanceInstanceIDsMatch(&instance_1, &instance_2),
+					testAccCheckComputeV2InstanceStatus(&instance_2, "SHELVED_OFFLOADED"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "shelved_offloaded"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_powerState("active"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance_2),
+					testAccCheckComputeV2InstanceInstanceIDsMatch(&instance_1, &instance_2),
+					testAccCheckComputeV2InstanceStatus(&instance_2, "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "power_state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2Instance_userDataRebuild(t *testing.T) {
+	var instance_1, instance_2 servers.Server
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2InstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2Instance_userDataRebuild("instance_1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance_1),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_instance_v2.instance_1", "user_data_update_policy", "rebuild"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2Instance_userDataRebuild("instance_2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2InstanceExists("openstack_compute_instance_v2.instance_1", &instance_2),
+					testAccCheckComputeV2InstanceInstanceIDsMatch(&instance_1, &instance_2),
+					testAccCheckComputeV2InstanceStatus(&instance_2, "ACTIVE"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceInstanceTrustedImageCertificatesV2(t *testing.T) {
+	raw := map[string]interface{}{
+		"name":                       "instance_1",
+		"trusted_image_certificates": []interface{}{"cert-1", "cert-2"},
+	}
+	d := schema.TestResourceDataRaw(t, resourceComputeInstanceV2().Schema, raw)
+
+	expected := []string{"cert-1", "cert-2"}
+	actual := resourceInstanceTrustedImageCertificatesV2(d)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, actual)
+	}
+
+	createOpts := TrustedImageCertificatesCreateOptsExt{
+		CreateOptsBuilder: servers.CreateOpts{
+			Name:      "instance_1",
+			FlavorRef: "1",
+		},
+		TrustedImageCertificates: actual,
+	}
+
+	b, err := createOpts.ToServerCreateMap()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	serverMap := b["server"].(map[string]interface{})
+	if !reflect.DeepEqual(serverMap["trusted_image_certificates"], expected) {
+		t.Fatalf("Expected trusted_image_certificates %#v, got %#v", expected, serverMap["trusted_image_certificates"])
+	}
+}
+
+func TestNetworksCreateOptsExt(t *testing.T) {
+	createOpts := NetworksCreateOptsExt{
+		CreateOptsBuilder: servers.CreateOpts{
+			Name:      "instance_1",
+			FlavorRef: "1",
+		},
+		Networks: "auto",
+	}
+
+	b, err := createOpts.ToServerCreateMap()
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	serverMap := b["server"].(map[string]interface{})
+	if serverMap["networks"] != "auto" {
+		t.Fatalf("Expected networks %q, got %#v", "auto", serverMap["networks"])
+	}
+}
+
+func TestComputeV2MicroversionAtLeast(t *testing.T) {
+	testCases := []struct {
+		microversion string
+		min          string
+		expected     bool
+	}{
+		{"", "2.37", false},
+		{"2.19", "2.37", false},
+		{"2.37", "2.37", true},
+		{"2.63", "2.57", true},
+		{"2.67", "2.57", true},
+		{"2.9", "2.37", false},
+	}
+
+	for i, tc := range testCases {
+		if actual := computeV2MicroversionAtLeast(tc.microversion, tc.min); actual != tc.expected {
+			t.Fatalf("Test case %d: expected %t for %q >= %q, got %t", i, tc.expected, tc.microversion, tc.min, actual)
+		}
+	}
+}
+
+func TestComputeV2InstanceCreateMicroversion(t *testing.T) {
+	testCases := []struct {
+		raw      map[string]interface{}
+		expected string
+	}{
+		{
+			raw:      map[string]interface{}{},
+			expected: "",
+		},
+		{
+			raw: map[string]interface{}{
+				"description": "a server",
+			},
+			expected: computeV2InstanceDescriptionMicroversion,
+		},
+		{
+			raw: map[string]interface{}{
+				"description":                "a server",
+				"trusted_image_certificates": []interface{}{"cert-1"},
+			},
+			expected: computeV2TrustedImageCertificatesMicroversion,
+		},
+		{
+			raw: map[string]interface{}{
+				"trusted_image_certificates": []interface{}{"cert-1"},
+				"block_device": []interface{}{
+					map[string]interface{}{
+						"source_type":      "blank",
+						"destination_type": "volume",
+						"volume_size":      1,
+						"volume_type":      "ssd",
+					},
+				},
+			},
+			expected: computeV2BlockDeviceVolumeTypeMicroversion,
+		},
+	}
+
+	for i, tc := range testCases {
+		tc.raw["name"] = "instance_1"
+		d := schema.TestResourceDataRaw(t, resourceComputeInstanceV2().Schema, tc.raw)
+
+		actual := computeV2InstanceCreateMicroversion(d)
+		if actual != tc.expected {
+			t.Fatalf("Test case %d: expected microversion %q, got %q", i, tc.expected, actual)
+		}
+	}
+}
+
+func TestResourceComputeInstanceV2Diff(t *testing.T) {
+	testCases := []struct {
+		policy      string
+		requiresNew bool
+	}{
+		{
+			policy:      "",
+			requiresNew: true,
+		},
+		{
+			policy:      "replace",
+			requiresNew: true,
+		},
+		{
+			policy:      "rebuild",
+			requiresNew: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		s := &terraform.InstanceState{
+			ID: "server-1",
+			Attributes: map[string]string{
+				"name":      "instance_1",
+				"user_data": "e5fa44f2b31c1fb553b6021e7360d07d5d91ff5e",
+			},
+		}
+		raw := map[string]interface{}{
+			"name":      "instance_1",
+			"user_data": "new user data",
+		}
+		if tc.policy != "" {
+			s.Attributes["user_data_update_policy"] = tc.policy
+			raw["user_data_update_policy"] = tc.policy
+		}
+
+		rawConfig, err := config.NewRawConfig(raw)
+		if err != nil {
+			t.Fatalf("Test case %d: unexpected error: %s", i, err)
+		}
+
+		diff, err := resourceComputeInstanceV2Diff(resourceComputeInstanceV2(), s, terraform.NewResourceConfig(rawConfig))
+		if err != nil {
+			t.Fatalf("Test case %d: unexpected error: %s", i, err)
+		}
+
+		if _, ok := diff.Attributes["user_data"]; !ok {
+			t.Fatalf("Test case %d: expected a diff for user_data", i)
+		}
+
+		if diff.RequiresNew() != tc.requiresNew {
+			t.Fatalf("Test case %d: expected RequiresNew %t, got %t", i, tc.requiresNew, diff.RequiresNew())
+		}
+	}
+}
+
+func TestCheckInstanceNetworkPortFixedIPs(t *testing.T) {
+	s := &terraform.InstanceState{
+		ID: "server-1",
+		Attributes: map[string]string{
+			"network.#":             "2",
+			"network.0.port":        "port-1",
+			"network.0.fixed_ip_v4": "192.168.1.10",
+			"network.1.port":        "",
+			"network.1.fixed_ip_v4": "192.168.1.11",
+		},
+	}
+
+	testCases := []struct {
+		attributes map[string]*terraform.ResourceAttrDiff
+		valid      bool
+	}{
+		{
+			attributes: map[string]*terraform.ResourceAttrDiff{
+				"network.0.fixed_ip_v4": &terraform.ResourceAttrDiff{Old: "192.168.1.10", New: "192.168.1.20"},
+			},
+			valid: false,
+		},
+		{
+			attributes: map[string]*terraform.ResourceAttrDiff{
+				"network.0.port":        &terraform.ResourceAttrDiff{Old: "port-1", New: "port-2"},
+				"network.0.fixed_ip_v4": &terraform.ResourceAttrDiff{Old: "192.168.1.10", New: "192.168.1.20"},
+			},
+			valid: true,
+		},
+		{
+			attributes: map[string]*terraform.ResourceAttrDiff{
+				"network.1.fixed_ip_v4": &terraform.ResourceAttrDiff{Old: "192.168.1.11", New: "192.168.1.21"},
+			},
+			valid: true,
+		},
+		{
+			attributes: map[string]*terraform.ResourceAttrDiff{
+				"name": &terraform.ResourceAttrDiff{Old: "instance_1", New: "instance_2"},
+			},
+			valid: true,
+		},
+	}
+
+	for i, tc := range testCases {
+		diff := &terraform.InstanceDiff{Attributes: tc.attributes}
+
+		err := checkInstanceNetworkPortFixedIPs(s, diff)
+		if tc.valid && err != nil {
+			t.Fatalf("Test case %d: unexpected error: %s", i, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("Test case %d: expected an error", i)
+		}
+	}
+}
+
+func TestFlattenInstancePowerState(t *testing.T) {
+	testCases := map[string]string{
+		"ACTIVE":            "active",
+		"SHUTOFF":           "shutoff",
+		"SHELVED":           "shelved_offloaded",
+		"SHELVED_OFFLOADED": "shelved_offloaded",
+		"BUILD":             "",
+	}
+
+	for status, expected := range testCases {
+		if actual := flattenInstancePowerState(status); actual != expected {
+			t.Fatalf("Expected power_state %q for status %s, got %q", expected, status, actual)
+		}
+	}
+}
+
+func TestCheckBlockDeviceConfig(t *testing.T) {
+	testCases := []struct {
+		blockDevices []interface{}
+		valid        bool
+	}{
+		{
+			blockDevices: []interface{}{
+				map[string]interface{}{
+					"uuid":             "image-1",
+					"source_type":      "image",
+					"destination_type": "local",
+					"boot_index":       0,
+				},
+				map[string]interface{}{
+					"source_type":      "blank",
+					"destination_type": "local",
+					"boot_index":       -1,
+					"volume_size":      1,
+				},
+				map[string]interface{}{
+					"source_type":      "blank",
+					"destination_type": "local",
+					"boot_index":       -1,
+					"volume_size":      1,
+				},
+				map[string]interface{}{
+					"source_type":      "blank",
+					"destination_type": "local",
+					"boot_index":       -1,
+					"volume_size":      1,
+					"guest_format":     "swap",
+				},
+			},
+			valid: true,
+		},
+		{
+			blockDevices: []interface{}{
+				map[string]interface{}{
+					"source_type":      "blank",
+					"destination_type": "local",
+					"volume_size":      1,
+				},
+			},
+			valid: false,
+		},
+		{
+			blockDevices: []interface{}{
+				map[string]interface{}{
+					"source_type":      "blank",
+					"destination_type": "local",
+					"boot_index":       -1,
+				},
+			},
+			valid: false,
+		},
+		{
+			blockDevices: []interface{}{
+				map[string]interface{}{
+					"source_type":      "blank",
+					"destination_type": "volume",
+					"volume_size":      1,
+					"guest_format":     "swap",
+				},
+			},
+			valid: false,
+		},
+		{
+			blockDevices: []interface{}{
+				map[string]interface{}{
+					"source_type":      "blank",
+					"destination_type": "local",
+					"boot_index":       -1,
+					"volume_size":      1,
+					"guest_format":     "swap",
+				},
+				map[string]interface{}{
+					"source_type":      "blank",
+					"destination_type": "local",
+					"boot_index":       -1,
+					"volume_size":      1,
+					"guest_format":     "swap",
+				},
+			},
+			valid: false,
+		},
+		{
+			blockDevices: []interface{}{
+				map[string]interface{}{
+					"source_type":      "volume",
+					"destination_type": "volume",
+				},
+			},
+			valid: false,
+		},
+		{
+			blockDevices: []interface{}{
+				map[string]interface{}{
+					"uuid":             "image-1",
+					"source_type":      "image",
+					"destination_type": "volume",
+				},
+			},
+			valid: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		raw := map[string]interface{}{
+			"name":         "instance_1",
+			"block_device": tc.blockDevices,
+		}
+		d := schema.TestResourceDataRaw(t, resourceComputeInstanceV2().Schema, raw)
+
+		err := checkBlockDeviceConfig(d)
+		if tc.valid && err != nil {
+			t.Fatalf("Test case %d: unexpected error: %s", i, err)
+		}
+		if !tc.valid && err == nil {
+			t.Fatalf("Test case %d: expected an error", i)
+		}
+	}
 }
 
 func testAccCheckComputeV2InstanceDestroy(s *terraform.State) error {
@@ -592,6 +1229,90 @@ func testAccCheckComputeV2InstanceNoMetadataKey(
 	}
 }
 
+func testAccCheckComputeV2InstanceDescription(
+	instance *servers.Server, description string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		details, err := computeV2InstanceDetailsGet(computeClient, instance.ID)
+		if err != nil {
+			return err
+		}
+
+		instanceDescription := ""
+		if details.Description != nil {
+			instanceDescription = *details.Description
+		}
+
+		if instanceDescription != description {
+			return fmt.Errorf("Expected description %q, got %q", description, instanceDescription)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComputeV2InstanceLocked(
+	instance *servers.Server, locked bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		details, err := computeV2InstanceDetailsGet(computeClient, instance.ID)
+		if err != nil {
+			return err
+		}
+
+		if details.Locked != locked {
+			return fmt.Errorf("Expected locked to be %t, got %t", locked, details.Locked)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComputeV2InstanceTags(
+	instance *servers.Server, tags []string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		instanceTags, err := computeV2InstanceTagsList(computeClient, instance.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if len(instanceTags) != len(tags) {
+			return fmt.Errorf("Expected %d tags, got %d: %v", len(tags), len(instanceTags), instanceTags)
+		}
+
+		for _, tag := range tags {
+			var found bool
+			for _, instanceTag := range instanceTags {
+				if tag == instanceTag {
+					found = true
+				}
+			}
+
+			if !found {
+				return fmt.Errorf("Tag not found: %s", tag)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckComputeV2InstanceBootVolumeAttachment(
 	instance *servers.Server) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -623,6 +1344,69 @@ func testAccCheckComputeV2InstanceBootVolumeAttachment(
 	}
 }
 
+func testAccCheckComputeV2InstanceVolumeType(
+	instance *servers.Server, volumeType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		blockStorageClient, err := config.blockStorageV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack block storage client: %s", err)
+		}
+
+		allPages, err := volumeattach.List(computeClient, instance.ID).AllPages()
+		if err != nil {
+			return err
+		}
+
+		attachments, err := volumeattach.ExtractVolumeAttachments(allPages)
+		if err != nil {
+			return err
+		}
+
+		if len(attachments) != 1 {
+			return fmt.Errorf("Expected 1 attached volume, got %d", len(attachments))
+		}
+
+		volume, err := volumes.Get(blockStorageClient, attachments[0].VolumeID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if volume.VolumeType != volumeType {
+			return fmt.Errorf("Expected volume type %s, got %s", volumeType, volume.VolumeType)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComputeV2InstanceStatus(
+	instance *servers.Server, status string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if instance.Status != status {
+			return fmt.Errorf("Expected instance status %s, got %s", status, instance.Status)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComputeV2InstanceInstanceIDsMatch(
+	instance1, instance2 *servers.Server) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if instance1.ID != instance2.ID {
+			return fmt.Errorf("Instance was recreated.")
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckComputeV2InstanceInstanceIDsDoNotMatch(
 	instance1, instance2 *servers.Server) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -832,6 +1616,32 @@ resource "openstack_compute_instance_v2" "instance_1" {
 }
 `, OS_IMAGE_ID)
 
+var testAccComputeV2Instance_blockDeviceVolumeType = fmt.Sprintf(`
+resource "openstack_blockstorage_volume_type_v3" "volume_type_1" {
+  name = "volume_type_1"
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  block_device {
+    uuid = "%s"
+    source_type = "image"
+    destination_type = "local"
+    boot_index = 0
+    delete_on_termination = true
+  }
+  block_device {
+    source_type = "blank"
+    destination_type = "volume"
+    volume_size = 1
+    volume_type = "${openstack_blockstorage_volume_type_v3.volume_type_1.name}"
+    boot_index = 1
+    delete_on_termination = true
+  }
+}
+`, OS_IMAGE_ID)
+
 const testAccComputeV2Instance_personality = `
 resource "openstack_compute_instance_v2" "instance_1" {
   name = "instance_1"
@@ -1132,3 +1942,145 @@ resource "openstack_compute_instance_v2" "instance_1" {
   }
 }
 `, OS_NETWORK_ID)
+
+var testAccComputeV2Instance_networkUpdate_1 = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.1.0/24"
+  ip_version = 4
+  enable_dhcp = true
+  no_gateway = true
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  depends_on = ["openstack_networking_subnet_v2.subnet_1"]
+
+  name = "instance_1"
+  security_groups = ["default"]
+
+  network {
+    uuid = "%s"
+  }
+}
+`, OS_NETWORK_ID)
+
+var testAccComputeV2Instance_networkUpdate_2 = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  cidr = "192.168.1.0/24"
+  ip_version = 4
+  enable_dhcp = true
+  no_gateway = true
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  depends_on = ["openstack_networking_subnet_v2.subnet_1"]
+
+  name = "instance_1"
+  security_groups = ["default"]
+
+  network {
+    uuid = "%s"
+  }
+
+  network {
+    uuid = "${openstack_networking_network_v2.network_1.id}"
+    fixed_ip_v4 = "192.168.1.100"
+  }
+}
+`, OS_NETWORK_ID)
+
+func testAccComputeV2Instance_powerState(powerState string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  power_state = "%s"
+}
+`, powerState)
+}
+
+func testAccComputeV2Instance_userDataRebuild(hostname string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  user_data = "#cloud-config\nhostname: %s"
+  user_data_update_policy = "rebuild"
+}
+`, hostname)
+}
+
+func testAccComputeV2Instance_description(description string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  description = "%s"
+}
+`, description)
+}
+
+func testAccComputeV2Instance_locked(locked bool, foo string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  locked = %t
+  metadata {
+    foo = "%s"
+  }
+}
+`, locked, foo)
+}
+
+const testAccComputeV2Instance_schedulerHintsAdditionalProperties = `
+resource "openstack_compute_servergroup_v2" "sg_1" {
+  name = "sg_1"
+  policies = ["affinity"]
+}
+
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  scheduler_hints {
+    group = "${openstack_compute_servergroup_v2.sg_1.id}"
+    additional_properties {
+      foo = "bar"
+    }
+  }
+}
+`
+
+const testAccComputeV2Instance_tags_1 = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  tags = ["tag1", "tag2"]
+}
+`
+
+const testAccComputeV2Instance_tags_2 = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+  tags = ["tag3"]
+}
+`
+
+const testAccComputeV2Instance_tags_3 = `
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+`
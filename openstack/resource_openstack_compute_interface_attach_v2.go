@@ -0,0 +1,202 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceComputeInterfaceAttachV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeInterfaceAttachV2Create,
+		Read:   resourceComputeInterfaceAttachV2Read,
+		Delete: resourceComputeInterfaceAttachV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"instance_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"port_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"fixed_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"mac": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeInterfaceAttachV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceId := d.Get("instance_id").(string)
+	portId := d.Get("port_id").(string)
+	networkId := d.Get("network_id").(string)
+
+	if portId == "" && networkId == "" {
+		return fmt.Errorf("One of port_id or network_id must be set")
+	}
+
+	if portId != "" && networkId != "" {
+		return fmt.Errorf("Only one of port_id or network_id can be set")
+	}
+
+	attachOpts := InterfaceAttachmentCreateOpts{
+		PortID:    portId,
+		NetworkID: networkId,
+	}
+
+	if v, ok := d.GetOk("fixed_ip"); ok {
+		if networkId == "" {
+			return fmt.Errorf("fixed_ip can only be set together with network_id")
+		}
+
+		attachOpts.FixedIPs = []InterfaceAttachmentFixedIP{
+			{IPAddress: v.(string)},
+		}
+	}
+
+	log.Printf("[DEBUG] Creating interface attachment: %#v", attachOpts)
+
+	attachment, err := computeV2InterfaceAttachmentCreate(computeClient, instanceId, attachOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error attaching interface to OpenStack instance %s: %s", instanceId, err)
+	}
+
+	err = waitForComputeV2InterfaceAttachmentAttach(computeClient, instanceId, attachment.PortID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return fmt.Errorf("Error attaching OpenStack interface: %s", err)
+	}
+
+	log.Printf("[DEBUG] Created interface attachment: %#v", attachment)
+
+	// Use the instance ID and port ID as the resource ID.
+	// This is because an attachment cannot be retrieved just by its port ID alone.
+	id := fmt.Sprintf("%s/%s", instanceId, attachment.PortID)
+
+	d.SetId(id)
+
+	return resourceComputeInterfaceAttachV2Read(d, meta)
+}
+
+func resourceComputeInterfaceAttachV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceId, portId, err := parseComputeInterfaceAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	attachment, err := computeV2InterfaceAttachmentGet(computeClient, instanceId, portId).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "compute_interface_attach")
+	}
+
+	log.Printf("[DEBUG] Retrieved interface attachment: %#v", attachment)
+
+	d.Set("instance_id", instanceId)
+	d.Set("port_id", attachment.PortID)
+	d.Set("network_id", attachment.NetID)
+	d.Set("mac", attachment.MACAddr)
+	d.Set("region", GetRegion(d, config))
+
+	// Only keep track of the first fixed IP. Further addresses of the
+	// port are managed through the port itself.
+	if len(attachment.FixedIPs) > 0 {
+		d.Set("fixed_ip", attachment.FixedIPs[0].IPAddress)
+	}
+
+	return nil
+}
+
+func resourceComputeInterfaceAttachV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	instanceId, portId, err := parseComputeInterfaceAttachmentId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Detaching OpenStack interface %s from instance %s", portId, instanceId)
+
+	err = computeV2InterfaceAttachmentDelete(computeClient, instanceId, portId).ExtractErr()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); ok {
+			return nil
+		}
+		return fmt.Errorf("Error detaching OpenStack interface %s: %s", portId, err)
+	}
+
+	err = waitForComputeV2InterfaceAttachmentDetach(computeClient, instanceId, portId, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return fmt.Errorf("Error detaching OpenStack interface: %s", err)
+	}
+
+	return nil
+}
+
+func parseComputeInterfaceAttachmentId(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 2 {
+		return "", "", fmt.Errorf("Unable to determine interface attachment ID")
+	}
+
+	instanceId := idParts[0]
+	portId := idParts[1]
+
+	return instanceId, portId, nil
+}
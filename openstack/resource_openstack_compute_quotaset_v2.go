@@ -0,0 +1,211 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceComputeQuotaSetV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceComputeQuotaSetV2Create,
+		Read:   resourceComputeQuotaSetV2Read,
+		Update: resourceComputeQuotaSetV2Update,
+		Delete: resourceComputeQuotaSetV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"instances": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"cores": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"ram": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"key_pairs": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"metadata_items": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"server_groups": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"server_group_members": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceComputeQuotaSetV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+
+	// The ID is set first, so the quotas which are set to 0 can be told
+	// apart from the ones which are not set.
+	d.SetId(projectID)
+
+	quotas, err := configuredInts(d, "instances", "cores", "ram", "key_pairs",
+		"metadata_items", "server_groups", "server_group_members")
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	var updateOpts QuotaSetUpdateOpts
+	if v, ok := quotas["instances"]; ok {
+		updateOpts.Instances = &v
+	}
+	if v, ok := quotas["cores"]; ok {
+		updateOpts.Cores = &v
+	}
+	if v, ok := quotas["ram"]; ok {
+		updateOpts.RAM = &v
+	}
+	if v, ok := quotas["key_pairs"]; ok {
+		updateOpts.KeyPairs = &v
+	}
+	if v, ok := quotas["metadata_items"]; ok {
+		updateOpts.MetadataItems = &v
+	}
+	if v, ok := quotas["server_groups"]; ok {
+		updateOpts.ServerGroups = &v
+	}
+	if v, ok := quotas["server_group_members"]; ok {
+		updateOpts.ServerGroupMembers = &v
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	_, err = computeV2QuotaSetUpdate(computeClient, projectID, updateOpts).Extract()
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("Error setting OpenStack compute quotas of project %s: %s", projectID, err)
+	}
+
+	return resourceComputeQuotaSetV2Read(d, meta)
+}
+
+func resourceComputeQuotaSetV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	q, err := computeV2QuotaSetGet(computeClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "compute quotas")
+	}
+
+	log.Printf("[DEBUG] Retrieved compute quotas of project %s: %+v", d.Id(), q)
+
+	d.Set("project_id", d.Id())
+	d.Set("instances", q.Instances)
+	d.Set("cores", q.Cores)
+	d.Set("ram", q.RAM)
+	d.Set("key_pairs", q.KeyPairs)
+	d.Set("metadata_items", q.MetadataItems)
+	d.Set("server_groups", q.ServerGroups)
+	d.Set("server_group_members", q.ServerGroupMembers)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceComputeQuotaSetV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	var updateOpts QuotaSetUpdateOpts
+	if d.HasChange("instances") {
+		instances := d.Get("instances").(int)
+		updateOpts.Instances = &instances
+	}
+	if d.HasChange("cores") {
+		cores := d.Get("cores").(int)
+		updateOpts.Cores = &cores
+	}
+	if d.HasChange("ram") {
+		ram := d.Get("ram").(int)
+		updateOpts.RAM = &ram
+	}
+	if d.HasChange("key_pairs") {
+		keyPairs := d.Get("key_pairs").(int)
+		updateOpts.KeyPairs = &keyPairs
+	}
+	if d.HasChange("metadata_items") {
+		metadataItems := d.Get("metadata_items").(int)
+		updateOpts.MetadataItems = &metadataItems
+	}
+	if d.HasChange("server_groups") {
+		serverGroups := d.Get("server_groups").(int)
+		updateOpts.ServerGroups = &serverGroups
+	}
+	if d.HasChange("server_group_members") {
+		serverGroupMembers := d.Get("server_group_members").(int)
+		updateOpts.ServerGroupMembers = &serverGroupMembers
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	_, err = computeV2QuotaSetUpdate(computeClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack compute quotas of project %s: %s", d.Id(), err)
+	}
+
+	return resourceComputeQuotaSetV2Read(d, meta)
+}
+
+func resourceComputeQuotaSetV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	computeClient, err := config.computeV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Reverting compute quotas of project %s to their defaults", d.Id())
+	err = computeV2QuotaSetDelete(computeClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "compute quotas")
+	}
+
+	return nil
+}
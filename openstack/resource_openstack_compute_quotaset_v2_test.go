@@ -0,0 +1,127 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccComputeV2QuotaSet_basic(t *testing.T) {
+	var quotaSet QuotaSet
+	var projectName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2QuotaSet_basic(projectName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2QuotaSetExists("openstack_compute_quotaset_v2.quotaset_1", &quotaSet),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "instances", "5"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "cores", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "ram", "20480"),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "server_groups", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccComputeV2QuotaSet_basic(projectName, 8),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2QuotaSetExists("openstack_compute_quotaset_v2.quotaset_1", &quotaSet),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "instances", "8"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2QuotaSet_zero(t *testing.T) {
+	var quotaSet QuotaSet
+	var projectName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3ProjectDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2QuotaSet_basic(projectName, 0),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2QuotaSetExists("openstack_compute_quotaset_v2.quotaset_1", &quotaSet),
+					testAccCheckComputeV2QuotaSetInstances(&quotaSet, 0),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_quotaset_v2.quotaset_1", "instances", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeV2QuotaSetExists(n string, quotaSet *QuotaSet) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		computeClient, err := config.computeV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack compute client: %s", err)
+		}
+
+		found, err := computeV2QuotaSetGet(computeClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		*quotaSet = *found
+
+		return nil
+	}
+}
+
+func testAccCheckComputeV2QuotaSetInstances(quotaSet *QuotaSet, instances int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if quotaSet.Instances != instances {
+			return fmt.Errorf("Expected an instances quota of %d, got %d", instances, quotaSet.Instances)
+		}
+
+		return nil
+	}
+}
+
+func testAccComputeV2QuotaSet_basic(projectName string, instances int) string {
+	return fmt.Sprintf(`
+resource "openstack_identity_project_v3" "project_1" {
+  name = "%s"
+}
+
+resource "openstack_compute_quotaset_v2" "quotaset_1" {
+  project_id = "${openstack_identity_project_v3.project_1.id}"
+  instances = %d
+  cores = 10
+  ram = 20480
+  server_groups = 2
+}
+`, projectName, instances)
+}
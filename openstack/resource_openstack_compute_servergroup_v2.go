@@ -37,6 +37,21 @@ func resourceComputeServerGroupV2() *schema.Resource {
 				ForceNew: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"rules": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_server_per_host": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
 			"members": &schema.Schema{
 				Type:     schema.TypeList,
 				Computed: true,
@@ -58,16 +73,36 @@ func resourceComputeServerGroupV2Create(d *schema.ResourceData, meta interface{}
 		return fmt.Errorf("Error creating OpenStack compute client: %s", err)
 	}
 
-	createOpts := ServerGroupCreateOpts{
-		servergroups.CreateOpts{
-			Name:     d.Get("name").(string),
-			Policies: resourceServerGroupPoliciesV2(d),
-		},
-		MapValueSpecs(d),
+	policies := resourceServerGroupPoliciesV2(d)
+	rules, err := resourceServerGroupRulesV2(d)
+	if err != nil {
+		return err
 	}
 
+	var createOpts servergroups.CreateOptsBuilder
+	if rules != nil {
+		createOpts = ServerGroupRulesCreateOpts{
+			Name:       d.Get("name").(string),
+			Policy:     policies[0],
+			Rules:      rules,
+			ValueSpecs: MapValueSpecs(d),
+		}
+	} else {
+		createOpts = ServerGroupCreateOpts{
+			servergroups.CreateOpts{
+				Name:     d.Get("name").(string),
+				Policies: policies,
+			},
+			MapValueSpecs(d),
+		}
+	}
+
+	// The soft policies and rules require newer microversions.
+	createClient := *computeClient
+	createClient.Microversion = serverGroupV2Microversion(policies, rules)
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	newSG, err := servergroups.Create(computeClient, createOpts).Extract()
+	newSG, err := servergroups.Create(&createClient, createOpts).Extract()
 	if err != nil {
 		return fmt.Errorf("Error creating ServerGroup: %s", err)
 	}
@@ -108,6 +143,22 @@ func resourceComputeServerGroupV2Read(d *schema.ResourceData, meta interface{})
 	}
 	d.Set("members", members)
 
+	// Rules are only returned by microversion 2.64 and later, so only
+	// retrieve them if they were configured.
+	if len(d.Get("rules").([]interface{})) > 0 {
+		sgWithRules, err := computeV2ServerGroupWithRulesGet(computeClient, d.Id()).Extract()
+		if err != nil {
+			return fmt.Errorf("Error retrieving rules of ServerGroup %s: %s", d.Id(), err)
+		}
+
+		rules := []map[string]interface{}{
+			{
+				"max_server_per_host": sgWithRules.Rules.MaxServerPerHost,
+			},
+		}
+		d.Set("rules", rules)
+	}
+
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -50,6 +50,46 @@ func TestAccComputeV2ServerGroup_affinity(t *testing.T) {
 	})
 }
 
+func TestAccComputeV2ServerGroup_softAntiAffinity(t *testing.T) {
+	var sg servergroups.ServerGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2ServerGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2ServerGroup_softAntiAffinity,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2ServerGroupExists("openstack_compute_servergroup_v2.sg_1", &sg),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_servergroup_v2.sg_1", "policies.0", "soft-anti-affinity"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComputeV2ServerGroup_rules(t *testing.T) {
+	var sg servergroups.ServerGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeV2ServerGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccComputeV2ServerGroup_rules,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeV2ServerGroupExists("openstack_compute_servergroup_v2.sg_1", &sg),
+					resource.TestCheckResourceAttr(
+						"openstack_compute_servergroup_v2.sg_1", "rules.0.max_server_per_host", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckComputeV2ServerGroupDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	computeClient, err := config.computeV2Client(OS_REGION_NAME)
@@ -138,3 +178,20 @@ resource "openstack_compute_instance_v2" "instance_1" {
   }
 }
 `
+
+const testAccComputeV2ServerGroup_softAntiAffinity = `
+resource "openstack_compute_servergroup_v2" "sg_1" {
+  name = "sg_1"
+  policies = ["soft-anti-affinity"]
+}
+`
+
+const testAccComputeV2ServerGroup_rules = `
+resource "openstack_compute_servergroup_v2" "sg_1" {
+  name = "sg_1"
+  policies = ["anti-affinity"]
+  rules {
+    max_server_per_host = 2
+  }
+}
+`
@@ -0,0 +1,490 @@
+package openstack
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"gopkg.in/yaml.v2"
+)
+
+func resourceContainerInfraClusterV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContainerInfraClusterV1Create,
+		Read:   resourceContainerInfraClusterV1Read,
+		Update: resourceContainerInfraClusterV1Update,
+		Delete: resourceContainerInfraClusterV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_template_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"keypair": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"master_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"node_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"create_timeout": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"discovery_url": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"docker_volume_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"master_flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stack_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"api_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"coe_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"master_addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"node_addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ca_certificate": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"client_certificate": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"client_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"kubeconfig": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceContainerInfraClusterV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	createOpts := ClusterV1CreateOpts{
+		Name:              d.Get("name").(string),
+		ClusterTemplateID: d.Get("cluster_template_id").(string),
+		Keypair:           d.Get("keypair").(string),
+		MasterCount:       d.Get("master_count").(int),
+		NodeCount:         d.Get("node_count").(int),
+		CreateTimeout:     d.Get("create_timeout").(int),
+		DiscoveryURL:      d.Get("discovery_url").(string),
+		DockerVolumeSize:  d.Get("docker_volume_size").(int),
+		FlavorID:          d.Get("flavor_id").(string),
+		MasterFlavorID:    d.Get("master_flavor_id").(string),
+	}
+
+	if labels := resourceContainerInfraV1Labels(d); len(labels) > 0 {
+		createOpts.Labels = labels
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	cluster, err := containerInfraV1ClusterCreate(containerInfraClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack cluster: %s", err)
+	}
+
+	log.Printf("[INFO] Cluster ID: %s", cluster.UUID)
+
+	d.SetId(cluster.UUID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATE_IN_PROGRESS"},
+		Target:     []string{"CREATE_COMPLETE"},
+		Refresh:    resourceContainerInfraClusterV1RefreshFunc(containerInfraClient, cluster.UUID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      1 * time.Minute,
+		MinTimeout: 30 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack cluster %s to become ready: %s", cluster.UUID, err)
+	}
+
+	return resourceContainerInfraClusterV1Read(d, meta)
+}
+
+func resourceContainerInfraClusterV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	cluster, err := containerInfraV1ClusterGet(containerInfraClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "cluster")
+	}
+
+	log.Printf("[DEBUG] Retrieved cluster %s: %+v", d.Id(), cluster)
+
+	d.Set("name", cluster.Name)
+	d.Set("cluster_template_id", cluster.ClusterTemplateID)
+	d.Set("keypair", cluster.Keypair)
+	d.Set("master_count", cluster.MasterCount)
+	d.Set("node_count", cluster.NodeCount)
+	d.Set("create_timeout", cluster.CreateTimeout)
+	d.Set("discovery_url", cluster.DiscoveryURL)
+	d.Set("docker_volume_size", cluster.DockerVolumeSize)
+	d.Set("labels", cluster.Labels)
+	d.Set("flavor_id", cluster.FlavorID)
+	d.Set("master_flavor_id", cluster.MasterFlavorID)
+	d.Set("status", cluster.Status)
+	d.Set("stack_id", cluster.StackID)
+	d.Set("api_address", cluster.APIAddress)
+	d.Set("coe_version", cluster.COEVersion)
+	d.Set("master_addresses", cluster.MasterAddresses)
+	d.Set("node_addresses", cluster.NodeAddresses)
+	d.Set("project_id", cluster.ProjectID)
+	d.Set("user_id", cluster.UserID)
+	d.Set("created_at", cluster.CreatedAt)
+	d.Set("updated_at", cluster.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	// A client certificate is only signed once, after the cluster has been
+	// created or imported, so that the credentials don't change on every
+	// refresh.
+	if d.Get("client_key").(string) == "" && strings.HasSuffix(cluster.Status, "_COMPLETE") {
+		err = resourceContainerInfraClusterV1SetCredentials(d, containerInfraClient, cluster)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func resourceContainerInfraClusterV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	if d.HasChange("node_count") {
+		patches := []ContainerInfraV1Patch{
+			resourceContainerInfraV1Patch("node_count", d.Get("node_count").(int), false),
+		}
+
+		log.Printf("[DEBUG] Updating cluster %s with patches: %+v", d.Id(), patches)
+		_, err = containerInfraV1ClusterUpdate(containerInfraClient, d.Id(), patches).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack cluster %s: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"UPDATE_IN_PROGRESS"},
+			Target:     []string{"UPDATE_COMPLETE"},
+			Refresh:    resourceContainerInfraClusterV1RefreshFunc(containerInfraClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      1 * time.Minute,
+			MinTimeout: 30 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for OpenStack cluster %s to be updated: %s", d.Id(), err)
+		}
+	}
+
+	return resourceContainerInfraClusterV1Read(d, meta)
+}
+
+func resourceContainerInfraClusterV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	err = containerInfraV1ClusterDelete(containerInfraClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack cluster")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETE_IN_PROGRESS"},
+		Target:     []string{"DELETE_COMPLETE"},
+		Refresh:    resourceContainerInfraClusterV1RefreshFunc(containerInfraClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack cluster %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceContainerInfraClusterV1RefreshFunc(containerInfraClient *gophercloud.ServiceClient, clusterID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		cluster, err := containerInfraV1ClusterGet(containerInfraClient, clusterID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return cluster, "DELETE_COMPLETE", nil
+			}
+			return nil, "", err
+		}
+
+		if strings.HasSuffix(cluster.Status, "_FAILED") {
+			return cluster, cluster.Status, fmt.Errorf("The cluster is in status %s: %s", cluster.Status, cluster.StatusReason)
+		}
+
+		log.Printf("[DEBUG] OpenStack cluster %s current status: %s", clusterID, cluster.Status)
+		return cluster, cluster.Status, nil
+	}
+}
+
+// resourceContainerInfraClusterV1SetCredentials signs a new client
+// certificate with the CA of a cluster and sets the credentials of the
+// cluster. A kubeconfig is only generated for Kubernetes clusters. Clusters
+// without TLS have no credentials.
+func resourceContainerInfraClusterV1SetCredentials(d *schema.ResourceData, containerInfraClient *gophercloud.ServiceClient, cluster *ClusterV1) error {
+	template, err := containerInfraV1ClusterTemplateGet(containerInfraClient, cluster.ClusterTemplateID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving cluster template %s of OpenStack cluster %s: %s", cluster.ClusterTemplateID, cluster.UUID, err)
+	}
+
+	if template.TLSDisabled {
+		return nil
+	}
+
+	ca, err := containerInfraV1CertificateGet(containerInfraClient, cluster.UUID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving CA certificate of OpenStack cluster %s: %s", cluster.UUID, err)
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return fmt.Errorf("Error generating client key of OpenStack cluster %s: %s", cluster.UUID, err)
+	}
+
+	// Kubernetes maps the organization of a client certificate to a group,
+	// and members of system:masters are cluster admins.
+	csr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName:   "admin",
+			Organization: []string{"system:masters"},
+		},
+	}, key)
+	if err != nil {
+		return fmt.Errorf("Error creating client certificate request of OpenStack cluster %s: %s", cluster.UUID, err)
+	}
+
+	createOpts := CertificateV1CreateOpts{
+		ClusterUUID: cluster.UUID,
+		CSR:         string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})),
+	}
+
+	cert, err := containerInfraV1CertificateCreate(containerInfraClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error signing client certificate of OpenStack cluster %s: %s", cluster.UUID, err)
+	}
+
+	clientKey := string(pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}))
+
+	var kubeconfig string
+	if template.COE == "kubernetes" {
+		kubeconfig, err = containerInfraV1Kubeconfig(cluster, ca.PEM, cert.PEM, clientKey)
+		if err != nil {
+			return fmt.Errorf("Error generating kubeconfig of OpenStack cluster %s: %s", cluster.UUID, err)
+		}
+	}
+
+	d.Set("ca_certificate", ca.PEM)
+	d.Set("client_certificate", cert.PEM)
+	d.Set("client_key", clientKey)
+	d.Set("kubeconfig", kubeconfig)
+
+	return nil
+}
+
+type containerInfraV1KubeconfigCluster struct {
+	Name    string `yaml:"name"`
+	Cluster struct {
+		Server                   string `yaml:"server"`
+		CertificateAuthorityData string `yaml:"certificate-authority-data"`
+	} `yaml:"cluster"`
+}
+
+type containerInfraV1KubeconfigUser struct {
+	Name string `yaml:"name"`
+	User struct {
+		ClientCertificateData string `yaml:"client-certificate-data"`
+		ClientKeyData         string `yaml:"client-key-data"`
+	} `yaml:"user"`
+}
+
+type containerInfraV1KubeconfigContext struct {
+	Name    string `yaml:"name"`
+	Context struct {
+		Cluster string `yaml:"cluster"`
+		User    string `yaml:"user"`
+	} `yaml:"context"`
+}
+
+type containerInfraV1KubeconfigFile struct {
+	APIVersion     string                              `yaml:"apiVersion"`
+	Kind           string                              `yaml:"kind"`
+	Clusters       []containerInfraV1KubeconfigCluster `yaml:"clusters"`
+	Users          []containerInfraV1KubeconfigUser    `yaml:"users"`
+	Contexts       []containerInfraV1KubeconfigContext `yaml:"contexts"`
+	CurrentContext string                              `yaml:"current-context"`
+}
+
+// containerInfraV1Kubeconfig returns a kubeconfig which authenticates at the
+// API of a Kubernetes cluster with a client certificate.
+func containerInfraV1Kubeconfig(cluster *ClusterV1, caCert, clientCert, clientKey string) (string, error) {
+	name := cluster.Name
+	user := "admin"
+
+	var c containerInfraV1KubeconfigCluster
+	c.Name = name
+	c.Cluster.Server = cluster.APIAddress
+	c.Cluster.CertificateAuthorityData = base64.StdEncoding.EncodeToString([]byte(caCert))
+
+	var u containerInfraV1KubeconfigUser
+	u.Name = user
+	u.User.ClientCertificateData = base64.StdEncoding.EncodeToString([]byte(clientCert))
+	u.User.ClientKeyData = base64.StdEncoding.EncodeToString([]byte(clientKey))
+
+	var ctx containerInfraV1KubeconfigContext
+	ctx.Name = name
+	ctx.Context.Cluster = name
+	ctx.Context.User = user
+
+	b, err := yaml.Marshal(containerInfraV1KubeconfigFile{
+		APIVersion:     "v1",
+		Kind:           "Config",
+		Clusters:       []containerInfraV1KubeconfigCluster{c},
+		Users:          []containerInfraV1KubeconfigUser{u},
+		Contexts:       []containerInfraV1KubeconfigContext{ctx},
+		CurrentContext: name,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
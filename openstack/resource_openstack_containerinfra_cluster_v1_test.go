@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccContainerInfraClusterV1_basic(t *testing.T) {
+	var cluster ClusterV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerInfraClusterV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerInfraClusterV1_basic(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraClusterV1Exists("openstack_containerinfra_cluster_v1.cluster_1", &cluster),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_cluster_v1.cluster_1", "name", "cluster_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_cluster_v1.cluster_1", "status", "CREATE_COMPLETE"),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_cluster_v1.cluster_1", "node_count", "1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_containerinfra_cluster_v1.cluster_1", "api_address"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_containerinfra_cluster_v1.cluster_1", "kubeconfig"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContainerInfraClusterV1_basic(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraClusterV1Exists("openstack_containerinfra_cluster_v1.cluster_1", &cluster),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_cluster_v1.cluster_1", "status", "UPDATE_COMPLETE"),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_cluster_v1.cluster_1", "node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContainerInfraClusterV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_containerinfra_cluster_v1" {
+			continue
+		}
+
+		_, err := containerInfraV1ClusterGet(containerInfraClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Cluster still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckContainerInfraClusterV1Exists(n string, cluster *ClusterV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		containerInfraClient, err := config.containerInfraV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+		}
+
+		found, err := containerInfraV1ClusterGet(containerInfraClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.UUID != rs.Primary.ID {
+			return fmt.Errorf("Cluster not found")
+		}
+
+		*cluster = *found
+
+		return nil
+	}
+}
+
+func testAccContainerInfraClusterV1_basic(nodeCount int) string {
+	return fmt.Sprintf(`
+resource "openstack_containerinfra_clustertemplate_v1" "clustertemplate_1" {
+  name = "clustertemplate_1"
+  coe = "kubernetes"
+  image_id = "%s"
+  flavor_id = "%s"
+  master_flavor_id = "%s"
+  external_network_id = "%s"
+  network_driver = "flannel"
+}
+
+resource "openstack_containerinfra_cluster_v1" "cluster_1" {
+  name = "cluster_1"
+  cluster_template_id = "${openstack_containerinfra_clustertemplate_v1.clustertemplate_1.id}"
+  master_count = 1
+  node_count = %d
+}
+`, OS_MAGNUM_IMAGE, OS_MAGNUM_FLAVOR, OS_MAGNUM_FLAVOR, OS_EXTGW_ID, nodeCount)
+}
@@ -0,0 +1,403 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// The attributes of a cluster template which are updated with a JSON patch
+// of the attribute of the same name.
+var (
+	resourceContainerInfraClusterTemplateV1StringAttrs = []string{
+		"name", "coe", "image_id", "flavor_id", "master_flavor_id",
+		"keypair_id", "external_network_id", "fixed_network", "fixed_subnet",
+		"network_driver", "volume_driver", "docker_storage_driver",
+		"dns_nameserver", "http_proxy", "https_proxy", "no_proxy",
+		"server_type",
+	}
+	resourceContainerInfraClusterTemplateV1IntAttrs = []string{
+		"docker_volume_size", "apiserver_port",
+	}
+	resourceContainerInfraClusterTemplateV1BoolAttrs = []string{
+		"tls_disabled", "public", "registry_enabled", "master_lb_enabled",
+		"floating_ip_enabled",
+	}
+)
+
+func resourceContainerInfraClusterTemplateV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContainerInfraClusterTemplateV1Create,
+		Read:   resourceContainerInfraClusterTemplateV1Read,
+		Update: resourceContainerInfraClusterTemplateV1Update,
+		Delete: resourceContainerInfraClusterTemplateV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"coe": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: resourceContainerInfraClusterTemplateV1ValidCOE,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"master_flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"keypair_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"external_network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fixed_network": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"fixed_subnet": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network_driver": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"volume_driver": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"docker_volume_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"docker_storage_driver": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"dns_nameserver": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"http_proxy": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"https_proxy": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"no_proxy": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"server_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceContainerInfraClusterTemplateV1ValidServerType,
+			},
+			"apiserver_port": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"tls_disabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"public": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"registry_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"master_lb_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"floating_ip_enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"cluster_distro": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceContainerInfraClusterTemplateV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	createOpts := ClusterTemplateV1CreateOpts{
+		Name:                d.Get("name").(string),
+		COE:                 d.Get("coe").(string),
+		ImageID:             d.Get("image_id").(string),
+		FlavorID:            d.Get("flavor_id").(string),
+		MasterFlavorID:      d.Get("master_flavor_id").(string),
+		KeypairID:           d.Get("keypair_id").(string),
+		ExternalNetworkID:   d.Get("external_network_id").(string),
+		FixedNetwork:        d.Get("fixed_network").(string),
+		FixedSubnet:         d.Get("fixed_subnet").(string),
+		NetworkDriver:       d.Get("network_driver").(string),
+		VolumeDriver:        d.Get("volume_driver").(string),
+		DockerStorageDriver: d.Get("docker_storage_driver").(string),
+		DNSNameServer:       d.Get("dns_nameserver").(string),
+		HTTPProxy:           d.Get("http_proxy").(string),
+		HTTPSProxy:          d.Get("https_proxy").(string),
+		NoProxy:             d.Get("no_proxy").(string),
+		Labels:              resourceContainerInfraV1Labels(d),
+		ServerType:          d.Get("server_type").(string),
+		TLSDisabled:         d.Get("tls_disabled").(bool),
+		Public:              d.Get("public").(bool),
+		RegistryEnabled:     d.Get("registry_enabled").(bool),
+		MasterLBEnabled:     d.Get("master_lb_enabled").(bool),
+		FloatingIPEnabled:   d.Get("floating_ip_enabled").(bool),
+	}
+
+	if v, ok := d.GetOk("docker_volume_size"); ok {
+		dockerVolumeSize := v.(int)
+		createOpts.DockerVolumeSize = &dockerVolumeSize
+	}
+
+	if v, ok := d.GetOk("apiserver_port"); ok {
+		apiServerPort := v.(int)
+		createOpts.APIServerPort = &apiServerPort
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	template, err := containerInfraV1ClusterTemplateCreate(containerInfraClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack cluster template: %s", err)
+	}
+
+	log.Printf("[INFO] Cluster template ID: %s", template.UUID)
+
+	d.SetId(template.UUID)
+
+	return resourceContainerInfraClusterTemplateV1Read(d, meta)
+}
+
+func resourceContainerInfraClusterTemplateV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	template, err := containerInfraV1ClusterTemplateGet(containerInfraClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "cluster template")
+	}
+
+	log.Printf("[DEBUG] Retrieved cluster template %s: %+v", d.Id(), template)
+
+	d.Set("name", template.Name)
+	d.Set("coe", template.COE)
+	d.Set("image_id", template.ImageID)
+	d.Set("flavor_id", template.FlavorID)
+	d.Set("master_flavor_id", template.MasterFlavorID)
+	d.Set("keypair_id", template.KeypairID)
+	d.Set("external_network_id", template.ExternalNetworkID)
+	d.Set("fixed_network", template.FixedNetwork)
+	d.Set("fixed_subnet", template.FixedSubnet)
+	d.Set("network_driver", template.NetworkDriver)
+	d.Set("volume_driver", template.VolumeDriver)
+	d.Set("docker_volume_size", template.DockerVolumeSize)
+	d.Set("docker_storage_driver", template.DockerStorageDriver)
+	d.Set("dns_nameserver", template.DNSNameServer)
+	d.Set("http_proxy", template.HTTPProxy)
+	d.Set("https_proxy", template.HTTPSProxy)
+	d.Set("no_proxy", template.NoProxy)
+	d.Set("labels", template.Labels)
+	d.Set("server_type", template.ServerType)
+	d.Set("apiserver_port", template.APIServerPort)
+	d.Set("tls_disabled", template.TLSDisabled)
+	d.Set("public", template.Public)
+	d.Set("registry_enabled", template.RegistryEnabled)
+	d.Set("master_lb_enabled", template.MasterLBEnabled)
+	d.Set("floating_ip_enabled", template.FloatingIPEnabled)
+	d.Set("cluster_distro", template.ClusterDistro)
+	d.Set("project_id", template.ProjectID)
+	d.Set("user_id", template.UserID)
+	d.Set("created_at", template.CreatedAt)
+	d.Set("updated_at", template.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceContainerInfraClusterTemplateV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	var patches []ContainerInfraV1Patch
+
+	// Attributes which are unset are removed, which resets them to the
+	// default of Magnum.
+	for _, attr := range resourceContainerInfraClusterTemplateV1StringAttrs {
+		if d.HasChange(attr) {
+			v := d.Get(attr).(string)
+			patches = append(patches, resourceContainerInfraV1Patch(attr, v, v == ""))
+		}
+	}
+	for _, attr := range resourceContainerInfraClusterTemplateV1IntAttrs {
+		if d.HasChange(attr) {
+			v := d.Get(attr).(int)
+			patches = append(patches, resourceContainerInfraV1Patch(attr, v, v == 0))
+		}
+	}
+	for _, attr := range resourceContainerInfraClusterTemplateV1BoolAttrs {
+		if d.HasChange(attr) {
+			patches = append(patches, resourceContainerInfraV1Patch(attr, d.Get(attr).(bool), false))
+		}
+	}
+	if d.HasChange("labels") {
+		labels := resourceContainerInfraV1Labels(d)
+		patches = append(patches, resourceContainerInfraV1Patch("labels", labels, len(labels) == 0))
+	}
+
+	if len(patches) > 0 {
+		log.Printf("[DEBUG] Updating cluster template %s with patches: %+v", d.Id(), patches)
+		_, err = containerInfraV1ClusterTemplateUpdate(containerInfraClient, d.Id(), patches).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack cluster template %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceContainerInfraClusterTemplateV1Read(d, meta)
+}
+
+func resourceContainerInfraClusterTemplateV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	err = containerInfraV1ClusterTemplateDelete(containerInfraClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack cluster template")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceContainerInfraV1Patch returns a JSON patch which replaces an
+// attribute, or removes it if remove is true.
+func resourceContainerInfraV1Patch(attr string, value interface{}, remove bool) ContainerInfraV1Patch {
+	if remove {
+		return ContainerInfraV1Patch{Op: "remove", Path: attr}
+	}
+	return ContainerInfraV1Patch{Op: "replace", Path: attr, Value: value}
+}
+
+func resourceContainerInfraV1Labels(d *schema.ResourceData) map[string]string {
+	labels := make(map[string]string)
+	for k, v := range d.Get("labels").(map[string]interface{}) {
+		labels[k] = v.(string)
+	}
+	return labels
+}
+
+func resourceContainerInfraClusterTemplateV1ValidCOE(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validCOEs := []string{
+		"kubernetes",
+		"swarm",
+		"swarm-mode",
+		"mesos",
+		"dcos",
+	}
+
+	for _, v := range validCOEs {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validCOEs)
+	errors = append(errors, err)
+	return
+}
+
+func resourceContainerInfraClusterTemplateV1ValidServerType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validServerTypes := []string{
+		"vm",
+		"bm",
+	}
+
+	for _, v := range validServerTypes {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validServerTypes)
+	errors = append(errors, err)
+	return
+}
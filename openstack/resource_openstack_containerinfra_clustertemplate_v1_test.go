@@ -0,0 +1,138 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccContainerInfraClusterTemplateV1_basic(t *testing.T) {
+	var template ClusterTemplateV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerInfraClusterTemplateV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerInfraClusterTemplateV1_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraClusterTemplateV1Exists("openstack_containerinfra_clustertemplate_v1.clustertemplate_1", &template),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_clustertemplate_v1.clustertemplate_1", "name", "clustertemplate_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_clustertemplate_v1.clustertemplate_1", "coe", "kubernetes"),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_clustertemplate_v1.clustertemplate_1", "labels.kube_tag", "v1.11.6"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContainerInfraClusterTemplateV1_update(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraClusterTemplateV1Exists("openstack_containerinfra_clustertemplate_v1.clustertemplate_1", &template),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_clustertemplate_v1.clustertemplate_1", "name", "clustertemplate_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_clustertemplate_v1.clustertemplate_1", "docker_volume_size", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_clustertemplate_v1.clustertemplate_1", "labels.kube_tag", "v1.12.3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContainerInfraClusterTemplateV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_containerinfra_clustertemplate_v1" {
+			continue
+		}
+
+		_, err := containerInfraV1ClusterTemplateGet(containerInfraClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Cluster template still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckContainerInfraClusterTemplateV1Exists(n string, template *ClusterTemplateV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		containerInfraClient, err := config.containerInfraV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+		}
+
+		found, err := containerInfraV1ClusterTemplateGet(containerInfraClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.UUID != rs.Primary.ID {
+			return fmt.Errorf("Cluster template not found")
+		}
+
+		*template = *found
+
+		return nil
+	}
+}
+
+func testAccContainerInfraClusterTemplateV1_basic() string {
+	return fmt.Sprintf(`
+resource "openstack_containerinfra_clustertemplate_v1" "clustertemplate_1" {
+  name = "clustertemplate_1"
+  coe = "kubernetes"
+  image_id = "%s"
+  flavor_id = "%s"
+  master_flavor_id = "%s"
+  external_network_id = "%s"
+  network_driver = "flannel"
+
+  labels {
+    kube_tag = "v1.11.6"
+  }
+}
+`, OS_MAGNUM_IMAGE, OS_MAGNUM_FLAVOR, OS_MAGNUM_FLAVOR, OS_EXTGW_ID)
+}
+
+func testAccContainerInfraClusterTemplateV1_update() string {
+	return fmt.Sprintf(`
+resource "openstack_containerinfra_clustertemplate_v1" "clustertemplate_1" {
+  name = "clustertemplate_1_updated"
+  coe = "kubernetes"
+  image_id = "%s"
+  flavor_id = "%s"
+  master_flavor_id = "%s"
+  external_network_id = "%s"
+  network_driver = "flannel"
+  docker_volume_size = 10
+
+  labels {
+    kube_tag = "v1.12.3"
+  }
+}
+`, OS_MAGNUM_IMAGE, OS_MAGNUM_FLAVOR, OS_MAGNUM_FLAVOR, OS_EXTGW_ID)
+}
@@ -0,0 +1,333 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceContainerInfraNodeGroupV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceContainerInfraNodeGroupV1Create,
+		Read:   resourceContainerInfraNodeGroupV1Read,
+		Update: resourceContainerInfraNodeGroupV1Update,
+		Delete: resourceContainerInfraNodeGroupV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"cluster_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"role": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"flavor_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"docker_volume_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"labels": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"node_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"min_node_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"max_node_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"node_addresses": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"is_default": &schema.Schema{
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceContainerInfraNodeGroupV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	clusterID := d.Get("cluster_id").(string)
+	createOpts := NodeGroupV1CreateOpts{
+		Name:             d.Get("name").(string),
+		Role:             d.Get("role").(string),
+		FlavorID:         d.Get("flavor_id").(string),
+		ImageID:          d.Get("image_id").(string),
+		DockerVolumeSize: d.Get("docker_volume_size").(int),
+		NodeCount:        d.Get("node_count").(int),
+		MinNodeCount:     d.Get("min_node_count").(int),
+		MaxNodeCount:     d.Get("max_node_count").(int),
+	}
+
+	if labels := resourceContainerInfraV1Labels(d); len(labels) > 0 {
+		createOpts.Labels = labels
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	nodeGroup, err := containerInfraV1NodeGroupCreate(containerInfraClient, clusterID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack nodegroup in cluster %s: %s", clusterID, err)
+	}
+
+	log.Printf("[INFO] Nodegroup ID: %s", nodeGroup.UUID)
+
+	d.SetId(fmt.Sprintf("%s/%s", clusterID, nodeGroup.UUID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"CREATE_IN_PROGRESS"},
+		Target:     []string{"CREATE_COMPLETE"},
+		Refresh:    resourceContainerInfraNodeGroupV1RefreshFunc(containerInfraClient, clusterID, nodeGroup.UUID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack nodegroup %s to become ready: %s", nodeGroup.UUID, err)
+	}
+
+	return resourceContainerInfraNodeGroupV1Read(d, meta)
+}
+
+func resourceContainerInfraNodeGroupV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	clusterID, nodeGroupID, err := parseContainerInfraNodeGroupV1ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	nodeGroup, err := containerInfraV1NodeGroupGet(containerInfraClient, clusterID, nodeGroupID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "nodegroup")
+	}
+
+	log.Printf("[DEBUG] Retrieved nodegroup %s: %+v", d.Id(), nodeGroup)
+
+	d.Set("cluster_id", clusterID)
+	d.Set("name", nodeGroup.Name)
+	d.Set("role", nodeGroup.Role)
+	d.Set("flavor_id", nodeGroup.FlavorID)
+	d.Set("image_id", nodeGroup.ImageID)
+	d.Set("docker_volume_size", nodeGroup.DockerVolumeSize)
+	d.Set("labels", nodeGroup.Labels)
+	d.Set("node_count", nodeGroup.NodeCount)
+	d.Set("min_node_count", nodeGroup.MinNodeCount)
+	d.Set("max_node_count", nodeGroup.MaxNodeCount)
+	d.Set("node_addresses", nodeGroup.NodeAddresses)
+	d.Set("is_default", nodeGroup.IsDefault)
+	d.Set("status", nodeGroup.Status)
+	d.Set("project_id", nodeGroup.ProjectID)
+	d.Set("created_at", nodeGroup.CreatedAt)
+	d.Set("updated_at", nodeGroup.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceContainerInfraNodeGroupV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	clusterID, nodeGroupID, err := parseContainerInfraNodeGroupV1ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var patches []ContainerInfraV1Patch
+	if d.HasChange("min_node_count") {
+		patches = append(patches, resourceContainerInfraV1Patch("min_node_count", d.Get("min_node_count").(int), false))
+	}
+	if d.HasChange("max_node_count") {
+		maxNodeCount := d.Get("max_node_count").(int)
+		patches = append(patches, resourceContainerInfraV1Patch("max_node_count", maxNodeCount, maxNodeCount == 0))
+	}
+
+	if len(patches) > 0 {
+		log.Printf("[DEBUG] Updating nodegroup %s with patches: %+v", d.Id(), patches)
+		_, err = containerInfraV1NodeGroupUpdate(containerInfraClient, clusterID, nodeGroupID, patches).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack nodegroup %s: %s", d.Id(), err)
+		}
+	}
+
+	// The nodegroup is scaled after its bounds have been changed, since
+	// Magnum rejects a node count outside of them.
+	if d.HasChange("node_count") {
+		patches := []ContainerInfraV1Patch{
+			resourceContainerInfraV1Patch("node_count", d.Get("node_count").(int), false),
+		}
+
+		log.Printf("[DEBUG] Scaling nodegroup %s with patches: %+v", d.Id(), patches)
+		_, err = containerInfraV1NodeGroupUpdate(containerInfraClient, clusterID, nodeGroupID, patches).Extract()
+		if err != nil {
+			return fmt.Errorf("Error scaling OpenStack nodegroup %s: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"UPDATE_IN_PROGRESS"},
+			Target:     []string{"UPDATE_COMPLETE"},
+			Refresh:    resourceContainerInfraNodeGroupV1RefreshFunc(containerInfraClient, clusterID, nodeGroupID),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      30 * time.Second,
+			MinTimeout: 10 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for OpenStack nodegroup %s to be scaled: %s", d.Id(), err)
+		}
+	}
+
+	return resourceContainerInfraNodeGroupV1Read(d, meta)
+}
+
+func resourceContainerInfraNodeGroupV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	clusterID, nodeGroupID, err := parseContainerInfraNodeGroupV1ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = containerInfraV1NodeGroupDelete(containerInfraClient, clusterID, nodeGroupID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack nodegroup")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETE_IN_PROGRESS"},
+		Target:     []string{"DELETE_COMPLETE"},
+		Refresh:    resourceContainerInfraNodeGroupV1RefreshFunc(containerInfraClient, clusterID, nodeGroupID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      30 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack nodegroup %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceContainerInfraNodeGroupV1RefreshFunc(containerInfraClient *gophercloud.ServiceClient, clusterID, nodeGroupID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		nodeGroup, err := containerInfraV1NodeGroupGet(containerInfraClient, clusterID, nodeGroupID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return nodeGroup, "DELETE_COMPLETE", nil
+			}
+			return nil, "", err
+		}
+
+		if strings.HasSuffix(nodeGroup.Status, "_FAILED") {
+			return nodeGroup, nodeGroup.Status, fmt.Errorf("The nodegroup is in status %s: %s", nodeGroup.Status, nodeGroup.StatusReason)
+		}
+
+		log.Printf("[DEBUG] OpenStack nodegroup %s current status: %s", nodeGroupID, nodeGroup.Status)
+		return nodeGroup, nodeGroup.Status, nil
+	}
+}
+
+// parseContainerInfraNodeGroupV1ID splits the ID of a nodegroup resource,
+// which has the form <cluster id>/<nodegroup id>.
+func parseContainerInfraNodeGroupV1ID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine nodegroup ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
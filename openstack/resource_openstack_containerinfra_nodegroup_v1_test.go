@@ -0,0 +1,136 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccContainerInfraNodeGroupV1_basic(t *testing.T) {
+	var nodeGroup NodeGroupV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckContainerInfra(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckContainerInfraNodeGroupV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccContainerInfraNodeGroupV1_basic(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraNodeGroupV1Exists("openstack_containerinfra_nodegroup_v1.nodegroup_1", &nodeGroup),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_nodegroup_v1.nodegroup_1", "name", "nodegroup_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_nodegroup_v1.nodegroup_1", "role", "worker"),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_nodegroup_v1.nodegroup_1", "node_count", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccContainerInfraNodeGroupV1_basic(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckContainerInfraNodeGroupV1Exists("openstack_containerinfra_nodegroup_v1.nodegroup_1", &nodeGroup),
+					resource.TestCheckResourceAttr(
+						"openstack_containerinfra_nodegroup_v1.nodegroup_1", "node_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckContainerInfraNodeGroupV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	containerInfraClient, err := config.containerInfraV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_containerinfra_nodegroup_v1" {
+			continue
+		}
+
+		clusterID, nodeGroupID, err := parseContainerInfraNodeGroupV1ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = containerInfraV1NodeGroupGet(containerInfraClient, clusterID, nodeGroupID).Extract()
+		if err == nil {
+			return fmt.Errorf("Nodegroup still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckContainerInfraNodeGroupV1Exists(n string, nodeGroup *NodeGroupV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		containerInfraClient, err := config.containerInfraV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack container infra client: %s", err)
+		}
+
+		clusterID, nodeGroupID, err := parseContainerInfraNodeGroupV1ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := containerInfraV1NodeGroupGet(containerInfraClient, clusterID, nodeGroupID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.UUID != nodeGroupID {
+			return fmt.Errorf("Nodegroup not found")
+		}
+
+		*nodeGroup = *found
+
+		return nil
+	}
+}
+
+func testAccContainerInfraNodeGroupV1_basic(nodeCount int) string {
+	return fmt.Sprintf(`
+resource "openstack_containerinfra_clustertemplate_v1" "clustertemplate_1" {
+  name = "clustertemplate_1"
+  coe = "kubernetes"
+  image_id = "%s"
+  flavor_id = "%s"
+  master_flavor_id = "%s"
+  external_network_id = "%s"
+  network_driver = "flannel"
+}
+
+resource "openstack_containerinfra_cluster_v1" "cluster_1" {
+  name = "cluster_1"
+  cluster_template_id = "${openstack_containerinfra_clustertemplate_v1.clustertemplate_1.id}"
+  master_count = 1
+  node_count = 1
+}
+
+resource "openstack_containerinfra_nodegroup_v1" "nodegroup_1" {
+  cluster_id = "${openstack_containerinfra_cluster_v1.cluster_1.id}"
+  name = "nodegroup_1"
+  node_count = %d
+  min_node_count = 1
+  max_node_count = 3
+}
+`, OS_MAGNUM_IMAGE, OS_MAGNUM_FLAVOR, OS_MAGNUM_FLAVOR, OS_EXTGW_ID, nodeCount)
+}
@@ -0,0 +1,240 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDNSPtrRecordV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSPtrRecordV2Create,
+		Read:   resourceDNSPtrRecordV2Read,
+		Update: resourceDNSPtrRecordV2Update,
+		Delete: resourceDNSPtrRecordV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"floatingip_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ptrdname": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: resourceDNSZoneV2ValidName,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ttl": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"address": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSPtrRecordV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	region := GetRegion(d, config)
+	if region == "" {
+		return fmt.Errorf("A region must be set to manage the PTR record of a floating IP")
+	}
+
+	dnsClient, err := config.dnsV2Client(region)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	id := fmt.Sprintf("%s:%s", region, d.Get("floatingip_id").(string))
+	setOpts := PtrRecordSetOpts{
+		PtrDName:    d.Get("ptrdname").(string),
+		Description: d.Get("description").(string),
+		TTL:         d.Get("ttl").(int),
+	}
+
+	log.Printf("[DEBUG] Setting PTR record %s with options: %#v", id, setOpts)
+	_, err = dnsV2PtrRecordSet(dnsClient, id, setOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error setting OpenStack DNS PTR record %s: %s", id, err)
+	}
+
+	d.SetId(id)
+
+	err = waitForDNSPtrRecordV2(dnsClient, id, "ACTIVE", d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceDNSPtrRecordV2Read(d, meta)
+}
+
+func resourceDNSPtrRecordV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	region, floatingIPID, err := parseDNSPtrRecordV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	ptr, err := dnsV2PtrRecordGet(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "PTR record")
+	}
+
+	log.Printf("[DEBUG] Retrieved PTR record %s: %#v", d.Id(), ptr)
+
+	// The PTR record was removed outside of Terraform.
+	if ptr.PtrDName == "" {
+		log.Printf("[DEBUG] Floating IP %s has no PTR record", floatingIPID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("floatingip_id", floatingIPID)
+	d.Set("ptrdname", ptr.PtrDName)
+	d.Set("description", ptr.Description)
+	d.Set("ttl", ptr.TTL)
+	d.Set("address", ptr.Address)
+	d.Set("region", region)
+
+	return nil
+}
+
+func resourceDNSPtrRecordV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	// The reverse DNS API always replaces the whole PTR record.
+	setOpts := PtrRecordSetOpts{
+		PtrDName:    d.Get("ptrdname").(string),
+		Description: d.Get("description").(string),
+		TTL:         d.Get("ttl").(int),
+	}
+
+	log.Printf("[DEBUG] Updating PTR record %s with options: %#v", d.Id(), setOpts)
+	_, err = dnsV2PtrRecordSet(dnsClient, d.Id(), setOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack DNS PTR record %s: %s", d.Id(), err)
+	}
+
+	err = waitForDNSPtrRecordV2(dnsClient, d.Id(), "ACTIVE", d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		return err
+	}
+
+	return resourceDNSPtrRecordV2Read(d, meta)
+}
+
+func resourceDNSPtrRecordV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Unsetting PTR record %s", d.Id())
+	err = dnsV2PtrRecordUnset(dnsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error unsetting OpenStack DNS PTR record")
+	}
+
+	err = waitForDNSPtrRecordV2(dnsClient, d.Id(), "DELETED", d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForDNSPtrRecordV2(dnsClient *gophercloud.ServiceClient, id, target string, timeout time.Duration) error {
+	log.Printf("[DEBUG] Waiting for PTR record %s to become %s", id, target)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{target},
+		Pending:    []string{"PENDING"},
+		Refresh:    resourceDNSPtrRecordV2RefreshFunc(dnsClient, id),
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack DNS PTR record %s to become %s: %s", id, target, err)
+	}
+
+	return nil
+}
+
+// resourceDNSPtrRecordV2RefreshFunc reports a PTR record as DELETED once it
+// no longer has a PTR domain name.
+func resourceDNSPtrRecordV2RefreshFunc(dnsClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		ptr, err := dnsV2PtrRecordGet(dnsClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return "", "DELETED", nil
+			}
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenStack DNS PTR record (%s) current status: %s", id, ptr.Status)
+
+		if ptr.Status == "ERROR" {
+			return ptr, ptr.Status, fmt.Errorf("The PTR record is in error status")
+		}
+		if ptr.Status != "PENDING" && ptr.PtrDName == "" {
+			return ptr, "DELETED", nil
+		}
+
+		return ptr, ptr.Status, nil
+	}
+}
+
+// parseDNSPtrRecordV2ID splits the ID of a PTR record, which has the form
+// <region>:<floating ip id>.
+func parseDNSPtrRecordV2ID(id string) (string, string, error) {
+	idParts := strings.SplitN(id, ":", 2)
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine PTR record ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
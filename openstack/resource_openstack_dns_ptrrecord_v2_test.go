@@ -0,0 +1,113 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDNSV2PtrRecord_basic(t *testing.T) {
+	var ptr PtrRecord
+	var ptrName = fmt.Sprintf("ptr-%s.example.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2PtrRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2PtrRecord_basic(ptrName, "a ptr record", 3000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2PtrRecordExists("openstack_dns_ptrrecord_v2.ptr_1", &ptr),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptrrecord_v2.ptr_1", "ptrdname", ptrName),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptrrecord_v2.ptr_1", "ttl", "3000"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_ptrrecord_v2.ptr_1", "address",
+						"openstack_networking_floatingip_v2.fip_1", "address"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDNSV2PtrRecord_basic(ptrName, "an updated ptr record", 6000),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptrrecord_v2.ptr_1", "description", "an updated ptr record"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_ptrrecord_v2.ptr_1", "ttl", "6000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2PtrRecordDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_dns_ptrrecord_v2" {
+			continue
+		}
+
+		ptr, err := dnsV2PtrRecordGet(dnsClient, rs.Primary.ID).Extract()
+		if err == nil && ptr.PtrDName != "" {
+			return fmt.Errorf("PTR record still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDNSV2PtrRecordExists(n string, ptr *PtrRecord) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		found, err := dnsV2PtrRecordGet(dnsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("PTR record not found")
+		}
+
+		*ptr = *found
+
+		return nil
+	}
+}
+
+func testAccDNSV2PtrRecord_basic(ptrName, description string, ttl int) string {
+	return fmt.Sprintf(`
+		resource "openstack_networking_floatingip_v2" "fip_1" {
+			pool = "%s"
+		}
+
+		resource "openstack_dns_ptrrecord_v2" "ptr_1" {
+			floatingip_id = "${openstack_networking_floatingip_v2.fip_1.id}"
+			ptrdname = "%s"
+			description = "%s"
+			ttl = %d
+		}
+	`, OS_POOL_NAME, ptrName, description, ttl)
+}
@@ -0,0 +1,138 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDNSTransferAcceptV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSTransferAcceptV2Create,
+		Read:   resourceDNSTransferAcceptV2Read,
+		Delete: resourceDNSTransferAcceptV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"zone_transfer_request_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"key": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSTransferAcceptV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	createOpts := ZoneTransferAcceptCreateOpts{
+		ZoneTransferRequestID: d.Get("zone_transfer_request_id").(string),
+		Key:                   d.Get("key").(string),
+	}
+
+	log.Printf("[DEBUG] Accepting DNS transfer request %s", createOpts.ZoneTransferRequestID)
+	accept, err := dnsV2ZoneTransferAcceptCreate(dnsClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error accepting OpenStack DNS transfer request %s: %s", createOpts.ZoneTransferRequestID, err)
+	}
+
+	d.SetId(accept.ID)
+
+	log.Printf("[DEBUG] Waiting for DNS transfer accept %s to complete", accept.ID)
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"COMPLETE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    waitForDNSTransferAccept(dnsClient, accept.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack DNS transfer accept %s to complete: %s", accept.ID, err)
+	}
+
+	return resourceDNSTransferAcceptV2Read(d, meta)
+}
+
+func resourceDNSTransferAcceptV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	accept, err := dnsV2ZoneTransferAcceptGet(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "DNS transfer accept")
+	}
+
+	log.Printf("[DEBUG] Retrieved DNS transfer accept %s: %+v", d.Id(), accept)
+
+	d.Set("zone_transfer_request_id", accept.ZoneTransferRequestID)
+	d.Set("zone_id", accept.ZoneID)
+	d.Set("status", accept.Status)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// resourceDNSTransferAcceptV2Delete only removes the resource from the
+// state. An accepted transfer can't be undone, and the transferred zone is
+// left in place.
+func resourceDNSTransferAcceptV2Delete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing DNS transfer accept %s from the state", d.Id())
+
+	d.SetId("")
+	return nil
+}
+
+func waitForDNSTransferAccept(dnsClient *gophercloud.ServiceClient, acceptID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		accept, err := dnsV2ZoneTransferAcceptGet(dnsClient, acceptID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenStack DNS transfer accept (%s) current status: %s", accept.ID, accept.Status)
+
+		if accept.Status == "ERROR" {
+			return accept, accept.Status, fmt.Errorf("The DNS transfer accept is in error status")
+		}
+
+		return accept, accept.Status, nil
+	}
+}
@@ -0,0 +1,151 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDNSTransferRequestV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSTransferRequestV2Create,
+		Read:   resourceDNSTransferRequestV2Read,
+		Update: resourceDNSTransferRequestV2Update,
+		Delete: resourceDNSTransferRequestV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"zone_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"target_project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDNSTransferRequestV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	zoneID := d.Get("zone_id").(string)
+	createOpts := ZoneTransferRequestCreateOpts{
+		TargetProjectID: d.Get("target_project_id").(string),
+		Description:     d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	request, err := dnsV2ZoneTransferRequestCreate(dnsClient, zoneID, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS transfer request for zone %s: %s", zoneID, err)
+	}
+
+	log.Printf("[INFO] DNS transfer request ID: %s", request.ID)
+
+	d.SetId(request.ID)
+
+	return resourceDNSTransferRequestV2Read(d, meta)
+}
+
+func resourceDNSTransferRequestV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	request, err := dnsV2ZoneTransferRequestGet(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "DNS transfer request")
+	}
+
+	log.Printf("[DEBUG] Retrieved DNS transfer request %s: %+v", d.Id(), request)
+
+	d.Set("zone_id", request.ZoneID)
+	d.Set("target_project_id", request.TargetProjectID)
+	d.Set("description", request.Description)
+	d.Set("key", request.Key)
+	d.Set("status", request.Status)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceDNSTransferRequestV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	var updateOpts ZoneTransferRequestUpdateOpts
+	if d.HasChange("target_project_id") {
+		targetProjectID := d.Get("target_project_id").(string)
+		updateOpts.TargetProjectID = &targetProjectID
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] Updating DNS transfer request %s with options: %#v", d.Id(), updateOpts)
+	_, err = dnsV2ZoneTransferRequestUpdate(dnsClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack DNS transfer request %s: %s", d.Id(), err)
+	}
+
+	return resourceDNSTransferRequestV2Read(d, meta)
+}
+
+func resourceDNSTransferRequestV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	// A completed transfer request can't be deleted, and the zone already
+	// belongs to another project.
+	if d.Get("status").(string) == "COMPLETE" {
+		log.Printf("[DEBUG] Removing completed DNS transfer request %s from the state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	err = dnsV2ZoneTransferRequestDelete(dnsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack DNS transfer request")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// Accepting a transfer request requires the credentials of a second project,
+// so openstack_dns_transfer_accept_v2 is not tested here.
+func TestAccDNSV2TransferRequest_basic(t *testing.T) {
+	var request ZoneTransferRequest
+	var zoneName = fmt.Sprintf("ACPTTEST%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2TransferRequestDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2TransferRequest_basic(zoneName, "a transfer"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2TransferRequestExists(
+						"openstack_dns_transfer_request_v2.request_1", &request),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_transfer_request_v2.request_1", "zone_id",
+						"openstack_dns_zone_v2.zone_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_transfer_request_v2.request_1", "description", "a transfer"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_transfer_request_v2.request_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_dns_transfer_request_v2.request_1", "key"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDNSV2TransferRequest_basic(zoneName, "an updated transfer"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_transfer_request_v2.request_1", "description", "an updated transfer"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2TransferRequestDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_dns_transfer_request_v2" {
+			continue
+		}
+
+		_, err := dnsV2ZoneTransferRequestGet(dnsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("DNS transfer request still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDNSV2TransferRequestExists(n string, request *ZoneTransferRequest) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		found, err := dnsV2ZoneTransferRequestGet(dnsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("DNS transfer request not found")
+		}
+
+		*request = *found
+
+		return nil
+	}
+}
+
+func testAccDNSV2TransferRequest_basic(zoneName, description string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email1@example.com"
+			ttl = 3000
+		}
+
+		resource "openstack_dns_transfer_request_v2" "request_1" {
+			zone_id = "${openstack_dns_zone_v2.zone_1.id}"
+			description = "%s"
+		}
+	`, zoneName, description)
+}
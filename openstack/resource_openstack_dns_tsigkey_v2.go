@@ -0,0 +1,189 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceDNSTSIGKeyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDNSTSIGKeyV2Create,
+		Read:   resourceDNSTSIGKeyV2Read,
+		Update: resourceDNSTSIGKeyV2Update,
+		Delete: resourceDNSTSIGKeyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"algorithm": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: resourceDNSTSIGKeyV2ValidAlgorithm,
+			},
+			"secret": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"scope": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceDNSTSIGKeyV2ValidScope,
+			},
+			"resource_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceDNSTSIGKeyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	createOpts := TSIGKeyCreateOpts{
+		Name:       d.Get("name").(string),
+		Algorithm:  d.Get("algorithm").(string),
+		Secret:     d.Get("secret").(string),
+		Scope:      d.Get("scope").(string),
+		ResourceID: d.Get("resource_id").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	key, err := dnsV2TSIGKeyCreate(dnsClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS TSIG key: %s", err)
+	}
+
+	log.Printf("[INFO] DNS TSIG key ID: %s", key.ID)
+
+	d.SetId(key.ID)
+
+	return resourceDNSTSIGKeyV2Read(d, meta)
+}
+
+func resourceDNSTSIGKeyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	key, err := dnsV2TSIGKeyGet(dnsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "DNS TSIG key")
+	}
+
+	log.Printf("[DEBUG] Retrieved DNS TSIG key %s: %s (%s)", d.Id(), key.Name, key.Algorithm)
+
+	d.Set("name", key.Name)
+	d.Set("algorithm", key.Algorithm)
+	d.Set("secret", key.Secret)
+	d.Set("scope", key.Scope)
+	d.Set("resource_id", key.ResourceID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceDNSTSIGKeyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	var updateOpts TSIGKeyUpdateOpts
+	if d.HasChange("name") {
+		updateOpts.Name = d.Get("name").(string)
+	}
+	if d.HasChange("algorithm") {
+		updateOpts.Algorithm = d.Get("algorithm").(string)
+	}
+	if d.HasChange("secret") {
+		updateOpts.Secret = d.Get("secret").(string)
+	}
+
+	log.Printf("[DEBUG] Updating DNS TSIG key %s", d.Id())
+	_, err = dnsV2TSIGKeyUpdate(dnsClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack DNS TSIG key %s: %s", d.Id(), err)
+	}
+
+	return resourceDNSTSIGKeyV2Read(d, meta)
+}
+
+func resourceDNSTSIGKeyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	dnsClient, err := config.dnsV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	err = dnsV2TSIGKeyDelete(dnsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack DNS TSIG key")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDNSTSIGKeyV2ValidAlgorithm(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validAlgorithms := []string{
+		"hmac-md5",
+		"hmac-sha1",
+		"hmac-sha224",
+		"hmac-sha256",
+		"hmac-sha384",
+		"hmac-sha512",
+	}
+
+	for _, v := range validAlgorithms {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validAlgorithms)
+	errors = append(errors, err)
+	return
+}
+
+func resourceDNSTSIGKeyV2ValidScope(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validScopes := []string{
+		"POOL",
+		"ZONE",
+	}
+
+	for _, v := range validScopes {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validScopes)
+	errors = append(errors, err)
+	return
+}
@@ -0,0 +1,119 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccDNSV2TSIGKey_basic(t *testing.T) {
+	var key TSIGKey
+	var zoneName = fmt.Sprintf("ACPTTEST%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheckDNS(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2TSIGKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2TSIGKey_basic(zoneName, "hmac-sha256", "SmxrZ1pNaE5aU2VjcmV0MQ=="),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2TSIGKeyExists("openstack_dns_tsigkey_v2.key_1", &key),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.key_1", "algorithm", "hmac-sha256"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.key_1", "scope", "ZONE"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_dns_tsigkey_v2.key_1", "resource_id",
+						"openstack_dns_zone_v2.zone_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccDNSV2TSIGKey_basic(zoneName, "hmac-sha512", "SmxrZ1pNaE5aU2VjcmV0Mg=="),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.key_1", "algorithm", "hmac-sha512"),
+					resource.TestCheckResourceAttr(
+						"openstack_dns_tsigkey_v2.key_1", "secret", "SmxrZ1pNaE5aU2VjcmV0Mg=="),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDNSV2TSIGKeyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_dns_tsigkey_v2" {
+			continue
+		}
+
+		_, err := dnsV2TSIGKeyGet(dnsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("DNS TSIG key still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckDNSV2TSIGKeyExists(n string, key *TSIGKey) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		dnsClient, err := config.dnsV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack DNS client: %s", err)
+		}
+
+		found, err := dnsV2TSIGKeyGet(dnsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("DNS TSIG key not found")
+		}
+
+		*key = *found
+
+		return nil
+	}
+}
+
+func testAccDNSV2TSIGKey_basic(zoneName, algorithm, secret string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%s"
+			email = "email1@example.com"
+			ttl = 3000
+		}
+
+		resource "openstack_dns_tsigkey_v2" "key_1" {
+			name = "key_1"
+			algorithm = "%s"
+			secret = "%s"
+			scope = "ZONE"
+			resource_id = "${openstack_dns_zone_v2.zone_1.id}"
+		}
+	`, zoneName, algorithm, secret)
+}
@@ -3,6 +3,7 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/gophercloud/gophercloud"
@@ -36,13 +37,15 @@ func resourceDNSZoneV2() *schema.Resource {
 				ForceNew: true,
 			},
 			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceDNSZoneV2ValidName,
 			},
 			"email": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: false,
 			},
 			"type": &schema.Schema{
@@ -79,6 +82,10 @@ func resourceDNSZoneV2() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
+			"serial": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -96,6 +103,23 @@ func resourceDNSZoneV2Create(d *schema.ResourceData, meta interface{}) error {
 		masters[i] = masterraw.(string)
 	}
 
+	// Designate creates PRIMARY zones by default. Their SOA record needs an
+	// email, while SECONDARY zones are transferred from their masters.
+	zoneType := d.Get("type").(string)
+	email := d.Get("email").(string)
+	if zoneType == "SECONDARY" {
+		if len(masters) == 0 {
+			return fmt.Errorf("masters must be set for SECONDARY zones")
+		}
+	} else {
+		if email == "" {
+			return fmt.Errorf("email must be set for PRIMARY zones")
+		}
+		if len(masters) > 0 {
+			return fmt.Errorf("masters can only be set for SECONDARY zones")
+		}
+	}
+
 	attrsraw := d.Get("attributes").(map[string]interface{})
 	attrs := make(map[string]string, len(attrsraw))
 	for k, v := range attrsraw {
@@ -105,10 +129,10 @@ func resourceDNSZoneV2Create(d *schema.ResourceData, meta interface{}) error {
 	createOpts := ZoneCreateOpts{
 		zones.CreateOpts{
 			Name:        d.Get("name").(string),
-			Type:        d.Get("type").(string),
+			Type:        zoneType,
 			Attributes:  attrs,
 			TTL:         d.Get("ttl").(int),
-			Email:       d.Get("email").(string),
+			Email:       email,
 			Description: d.Get("description").(string),
 			Masters:     masters,
 		},
@@ -131,10 +155,13 @@ func resourceDNSZoneV2Create(d *schema.ResourceData, meta interface{}) error {
 		MinTimeout: 3 * time.Second,
 	}
 
-	_, err = stateConf.WaitForState()
-
 	d.SetId(n.ID)
 
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack DNS Zone %s to become active: %s", n.ID, err)
+	}
+
 	log.Printf("[DEBUG] Created OpenStack DNS Zone %s: %#v", n.ID, n)
 	return resourceDNSZoneV2Read(d, meta)
 }
@@ -160,6 +187,7 @@ func resourceDNSZoneV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("type", n.Type)
 	d.Set("attributes", n.Attributes)
 	d.Set("masters", n.Masters)
+	d.Set("serial", n.Serial)
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -180,6 +208,9 @@ func resourceDNSZoneV2Update(d *schema.ResourceData, meta interface{}) error {
 		updateOpts.TTL = d.Get("ttl").(int)
 	}
 	if d.HasChange("masters") {
+		if d.Get("type").(string) != "SECONDARY" {
+			return fmt.Errorf("masters can only be set for SECONDARY zones")
+		}
 		mastersraw := d.Get("masters").(*schema.Set).List()
 		masters := make([]string, len(mastersraw))
 		for i, masterraw := range mastersraw {
@@ -209,6 +240,9 @@ func resourceDNSZoneV2Update(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack DNS Zone %s to update: %s", d.Id(), err)
+	}
 
 	return resourceDNSZoneV2Read(d, meta)
 }
@@ -236,6 +270,9 @@ func resourceDNSZoneV2Delete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack DNS Zone %s to delete: %s", d.Id(), err)
+	}
 
 	d.SetId("")
 	return nil
@@ -259,6 +296,14 @@ func resourceDNSZoneV2ValidType(v interface{}, k string) (ws []string, errors []
 	return
 }
 
+func resourceDNSZoneV2ValidName(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !strings.HasSuffix(value, ".") {
+		errors = append(errors, fmt.Errorf("%s must be a fully qualified domain name ending with a '.'", k))
+	}
+	return
+}
+
 func waitForDNSZone(dnsClient *gophercloud.ServiceClient, zoneId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		zone, err := zones.Get(dnsClient, zoneId).Extract()
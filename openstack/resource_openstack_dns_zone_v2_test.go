@@ -27,6 +27,8 @@ func TestAccDNSV2Zone_basic(t *testing.T) {
 					testAccCheckDNSV2ZoneExists("openstack_dns_zone_v2.zone_1", &zone),
 					resource.TestCheckResourceAttr(
 						"openstack_dns_zone_v2.zone_1", "description", "a zone"),
+					resource.TestMatchResourceAttr(
+						"openstack_dns_zone_v2.zone_1", "serial", regexp.MustCompile("^[1-9][0-9]*$")),
 				),
 			},
 			resource.TestStep{
@@ -66,6 +68,27 @@ func TestAccDNSV2Zone_readTTL(t *testing.T) {
 	})
 }
 
+func TestAccDNSV2Zone_secondary(t *testing.T) {
+	var zone zones.Zone
+	var zoneName = fmt.Sprintf("ACPTTEST%s.com.", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckDNS(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDNSV2ZoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccDNSV2Zone_secondary(zoneName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDNSV2ZoneExists("openstack_dns_zone_v2.zone_1", &zone),
+					resource.TestCheckResourceAttr("openstack_dns_zone_v2.zone_1", "type", "SECONDARY"),
+					resource.TestCheckResourceAttr("openstack_dns_zone_v2.zone_1", "masters.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDNSV2Zone_timeout(t *testing.T) {
 	var zone zones.Zone
 	var zoneName = fmt.Sprintf("ACPTTEST%s.com.", acctest.RandString(5))
@@ -186,3 +209,13 @@ func testAccDNSV2Zone_timeout(zoneName string) string {
 		}
 	`, zoneName)
 }
+
+func testAccDNSV2Zone_secondary(zoneName string) string {
+	return fmt.Sprintf(`
+		resource "openstack_dns_zone_v2" "zone_1" {
+			name = "%s"
+			type = "SECONDARY"
+			masters = ["192.0.2.1"]
+		}
+	`, zoneName)
+}
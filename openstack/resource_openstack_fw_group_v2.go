@@ -0,0 +1,283 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceFWGroupV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFWGroupV2Create,
+		Read:   resourceFWGroupV2Read,
+		Update: resourceFWGroupV2Update,
+		Delete: resourceFWGroupV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ingress_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"egress_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"ports": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"shared": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceFWGroupV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := FirewallGroupCreateOpts{
+		Name:                    d.Get("name").(string),
+		Description:             d.Get("description").(string),
+		IngressFirewallPolicyID: d.Get("ingress_policy_id").(string),
+		EgressFirewallPolicyID:  d.Get("egress_policy_id").(string),
+		AdminStateUp:            &adminStateUp,
+		Ports:                   resourceFWGroupV2Ports(d),
+		Shared:                  d.Get("shared").(bool),
+		TenantID:                d.Get("tenant_id").(string),
+		ValueSpecs:              MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	group, err := networkingV2FirewallGroupCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack firewall group: %s", err)
+	}
+
+	log.Printf("[INFO] Firewall group ID: %s", group.ID)
+
+	d.SetId(group.ID)
+
+	if err := waitForFWGroupV2Active(networkingClient, group.ID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("Error waiting for firewall group %s to become active: %s", group.ID, err)
+	}
+
+	return resourceFWGroupV2Read(d, meta)
+}
+
+func resourceFWGroupV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	group, err := networkingV2FirewallGroupGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "firewall group")
+	}
+
+	log.Printf("[DEBUG] Retrieved firewall group %s: %+v", d.Id(), group)
+
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+	d.Set("ingress_policy_id", group.IngressFirewallPolicyID)
+	d.Set("egress_policy_id", group.EgressFirewallPolicyID)
+	d.Set("admin_state_up", group.AdminStateUp)
+	d.Set("ports", group.Ports)
+	d.Set("shared", group.Shared)
+	d.Set("status", group.Status)
+	d.Set("tenant_id", group.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceFWGroupV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts FirewallGroupUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("ingress_policy_id") {
+		ingressPolicyID := d.Get("ingress_policy_id").(string)
+		updateOpts.IngressFirewallPolicyID = &ingressPolicyID
+	}
+	if d.HasChange("egress_policy_id") {
+		egressPolicyID := d.Get("egress_policy_id").(string)
+		updateOpts.EgressFirewallPolicyID = &egressPolicyID
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+	if d.HasChange("ports") {
+		ports := resourceFWGroupV2Ports(d)
+		updateOpts.Ports = &ports
+	}
+	if d.HasChange("shared") {
+		shared := d.Get("shared").(bool)
+		updateOpts.Shared = &shared
+	}
+
+	log.Printf("[DEBUG] Updating firewall group %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2FirewallGroupUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack firewall group: %s", err)
+	}
+
+	if err := waitForFWGroupV2Active(networkingClient, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return fmt.Errorf("Error waiting for firewall group %s to become active: %s", d.Id(), err)
+	}
+
+	return resourceFWGroupV2Read(d, meta)
+}
+
+func resourceFWGroupV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2FirewallGroupDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack firewall group")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"DELETING"},
+		Target:     []string{"DELETED"},
+		Refresh:    fwGroupV2DeleteRefreshFunc(networkingClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for firewall group %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceFWGroupV2Ports(d *schema.ResourceData) []string {
+	rawPorts := d.Get("ports").(*schema.Set).List()
+	ports := make([]string, len(rawPorts))
+	for i, raw := range rawPorts {
+		ports[i] = raw.(string)
+	}
+	return ports
+}
+
+// waitForFWGroupV2Active waits until the firewall group is no longer pending.
+// A firewall group without ports is INACTIVE rather than ACTIVE.
+func waitForFWGroupV2Active(networkingClient *gophercloud.ServiceClient, id string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"PENDING_CREATE", "PENDING_UPDATE"},
+		Target:     []string{"ACTIVE", "INACTIVE", "DOWN"},
+		Refresh:    fwGroupV2RefreshFunc(networkingClient, id),
+		Timeout:    timeout,
+		Delay:      0,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	return err
+}
+
+func fwGroupV2RefreshFunc(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		group, err := networkingV2FirewallGroupGet(networkingClient, id).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		return group, group.Status, nil
+	}
+}
+
+func fwGroupV2DeleteRefreshFunc(networkingClient *gophercloud.ServiceClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		group, err := networkingV2FirewallGroupGet(networkingClient, id).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				log.Printf("[DEBUG] Firewall group %s is actually deleted", id)
+				return "", "DELETED", nil
+			}
+			return nil, "", fmt.Errorf("Unexpected error: %s", err)
+		}
+
+		log.Printf("[DEBUG] Firewall group %s deletion is pending", id)
+		return group, "DELETING", nil
+	}
+}
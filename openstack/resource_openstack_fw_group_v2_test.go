@@ -0,0 +1,188 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFWGroupV2_basic(t *testing.T) {
+	var group FirewallGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFWV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFWGroupV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFWGroupV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFWGroupV2Exists(
+						"openstack_fw_group_v2.group_1", &group),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_group_v2.group_1", "ports.#", "0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFWGroupV2_port,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_fw_group_v2.group_1", "id", &group.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_group_v2.group_1", "ports.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_group_v2.group_1", "status", "ACTIVE"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFWGroupV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_fw_group_v2.group_1", "id", &group.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_group_v2.group_1", "ports.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFWGroupV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_fw_group_v2" {
+			continue
+		}
+
+		_, err := networkingV2FirewallGroupGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Firewall group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFWGroupV2Exists(n string, group *FirewallGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2FirewallGroupGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Firewall group not found")
+		}
+
+		*group = *found
+
+		return nil
+	}
+}
+
+const testAccFWGroupV2_basic = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_fw_rule_v2" "rule_1" {
+  name = "rule_1"
+  protocol = "tcp"
+  action = "deny"
+  destination_port = "22"
+}
+
+resource "openstack_fw_policy_v2" "policy_1" {
+  name = "policy_1"
+  rules = ["${openstack_fw_rule_v2.rule_1.id}"]
+}
+
+resource "openstack_fw_group_v2" "group_1" {
+  name = "group_1"
+  ingress_policy_id = "${openstack_fw_policy_v2.policy_1.id}"
+  egress_policy_id = "${openstack_fw_policy_v2.policy_1.id}"
+}
+`
+
+const testAccFWGroupV2_port = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_fw_rule_v2" "rule_1" {
+  name = "rule_1"
+  protocol = "tcp"
+  action = "deny"
+  destination_port = "22"
+}
+
+resource "openstack_fw_policy_v2" "policy_1" {
+  name = "policy_1"
+  rules = ["${openstack_fw_rule_v2.rule_1.id}"]
+}
+
+resource "openstack_fw_group_v2" "group_1" {
+  name = "group_1"
+  ingress_policy_id = "${openstack_fw_policy_v2.policy_1.id}"
+  egress_policy_id = "${openstack_fw_policy_v2.policy_1.id}"
+  ports = ["${openstack_networking_router_interface_v2.router_interface_1.port_id}"]
+}
+`
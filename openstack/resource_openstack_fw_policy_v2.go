@@ -0,0 +1,182 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceFWPolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFWPolicyV2Create,
+		Read:   resourceFWPolicyV2Read,
+		Update: resourceFWPolicyV2Update,
+		Delete: resourceFWPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"rules": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"audited": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"shared": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceFWPolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := FirewallPolicyCreateOpts{
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		FirewallRules: resourceFWPolicyV2Rules(d),
+		Audited:       d.Get("audited").(bool),
+		Shared:        d.Get("shared").(bool),
+		TenantID:      d.Get("tenant_id").(string),
+		ValueSpecs:    MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	policy, err := networkingV2FirewallPolicyCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack firewall policy: %s", err)
+	}
+
+	log.Printf("[INFO] Firewall policy ID: %s", policy.ID)
+
+	d.SetId(policy.ID)
+
+	return resourceFWPolicyV2Read(d, meta)
+}
+
+func resourceFWPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policy, err := networkingV2FirewallPolicyGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "firewall policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved firewall policy %s: %+v", d.Id(), policy)
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("rules", policy.FirewallRules)
+	d.Set("audited", policy.Audited)
+	d.Set("shared", policy.Shared)
+	d.Set("tenant_id", policy.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceFWPolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts FirewallPolicyUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("rules") {
+		rules := resourceFWPolicyV2Rules(d)
+		updateOpts.FirewallRules = &rules
+	}
+	if d.HasChange("audited") {
+		audited := d.Get("audited").(bool)
+		updateOpts.Audited = &audited
+	}
+	if d.HasChange("shared") {
+		shared := d.Get("shared").(bool)
+		updateOpts.Shared = &shared
+	}
+
+	log.Printf("[DEBUG] Updating firewall policy %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2FirewallPolicyUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack firewall policy: %s", err)
+	}
+
+	return resourceFWPolicyV2Read(d, meta)
+}
+
+func resourceFWPolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2FirewallPolicyDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack firewall policy")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceFWPolicyV2Rules(d *schema.ResourceData) []string {
+	rawRules := d.Get("rules").([]interface{})
+	rules := make([]string, len(rawRules))
+	for i, raw := range rawRules {
+		rules[i] = raw.(string)
+	}
+	return rules
+}
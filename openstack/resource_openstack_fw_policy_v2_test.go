@@ -0,0 +1,140 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFWPolicyV2_basic(t *testing.T) {
+	var policy FirewallPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFWV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFWPolicyV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFWPolicyV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFWPolicyV2Exists(
+						"openstack_fw_policy_v2.policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_policy_v2.policy_1", "rules.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFWPolicyV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_fw_policy_v2.policy_1", "id", &policy.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_policy_v2.policy_1", "rules.#", "2"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_fw_policy_v2.policy_1", "rules.0",
+						"openstack_fw_rule_v2.rule_2", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFWPolicyV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_fw_policy_v2" {
+			continue
+		}
+
+		_, err := networkingV2FirewallPolicyGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Firewall policy still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFWPolicyV2Exists(n string, policy *FirewallPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2FirewallPolicyGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Firewall policy not found")
+		}
+
+		*policy = *found
+
+		return nil
+	}
+}
+
+const testAccFWPolicyV2_basic = `
+resource "openstack_fw_rule_v2" "rule_1" {
+  name = "rule_1"
+  protocol = "tcp"
+  action = "deny"
+  destination_port = "22"
+}
+
+resource "openstack_fw_rule_v2" "rule_2" {
+  name = "rule_2"
+  protocol = "udp"
+  action = "deny"
+  destination_port = "123"
+}
+
+resource "openstack_fw_policy_v2" "policy_1" {
+  name = "policy_1"
+  rules = ["${openstack_fw_rule_v2.rule_1.id}"]
+}
+`
+
+const testAccFWPolicyV2_update = `
+resource "openstack_fw_rule_v2" "rule_1" {
+  name = "rule_1"
+  protocol = "tcp"
+  action = "deny"
+  destination_port = "22"
+}
+
+resource "openstack_fw_rule_v2" "rule_2" {
+  name = "rule_2"
+  protocol = "udp"
+  action = "deny"
+  destination_port = "123"
+}
+
+resource "openstack_fw_policy_v2" "policy_1" {
+  name = "policy_1"
+  rules = [
+    "${openstack_fw_rule_v2.rule_2.id}",
+    "${openstack_fw_rule_v2.rule_1.id}",
+  ]
+}
+`
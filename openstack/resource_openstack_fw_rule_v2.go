@@ -0,0 +1,273 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceFWRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceFWRuleV2Create,
+		Read:   resourceFWRuleV2Read,
+		Update: resourceFWRuleV2Update,
+		Delete: resourceFWRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "any",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					switch v.(string) {
+					case "any", "icmp", "tcp", "udp":
+					default:
+						errors = append(errors, fmt.Errorf(
+							"Only 'any', 'icmp', 'tcp' and 'udp' are supported values for 'protocol'"))
+					}
+					return
+				},
+			},
+			"action": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "deny",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					switch v.(string) {
+					case "allow", "deny", "reject":
+					default:
+						errors = append(errors, fmt.Errorf(
+							"Only 'allow', 'deny' and 'reject' are supported values for 'action'"))
+					}
+					return
+				},
+			},
+			"ip_version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
+			},
+			"source_ip_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"destination_ip_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_port": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"destination_port": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"shared": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceFWRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := FirewallRuleCreateOpts{
+		Name:                 d.Get("name").(string),
+		Description:          d.Get("description").(string),
+		Protocol:             d.Get("protocol").(string),
+		Action:               d.Get("action").(string),
+		IPVersion:            d.Get("ip_version").(int),
+		SourceIPAddress:      d.Get("source_ip_address").(string),
+		DestinationIPAddress: d.Get("destination_ip_address").(string),
+		SourcePort:           d.Get("source_port").(string),
+		DestinationPort:      d.Get("destination_port").(string),
+		Enabled:              &enabled,
+		Shared:               d.Get("shared").(bool),
+		TenantID:             d.Get("tenant_id").(string),
+		ValueSpecs:           MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	rule, err := networkingV2FirewallRuleCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack firewall rule: %s", err)
+	}
+
+	log.Printf("[INFO] Firewall rule ID: %s", rule.ID)
+
+	d.SetId(rule.ID)
+
+	return resourceFWRuleV2Read(d, meta)
+}
+
+func resourceFWRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	rule, err := networkingV2FirewallRuleGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "firewall rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved firewall rule %s: %+v", d.Id(), rule)
+
+	d.Set("name", rule.Name)
+	d.Set("description", rule.Description)
+	d.Set("action", rule.Action)
+	d.Set("ip_version", rule.IPVersion)
+	d.Set("source_ip_address", rule.SourceIPAddress)
+	d.Set("destination_ip_address", rule.DestinationIPAddress)
+	d.Set("source_port", rule.SourcePort)
+	d.Set("destination_port", rule.DestinationPort)
+	d.Set("enabled", rule.Enabled)
+	d.Set("shared", rule.Shared)
+	d.Set("tenant_id", rule.TenantID)
+
+	if rule.Protocol == "" {
+		d.Set("protocol", "any")
+	} else {
+		d.Set("protocol", rule.Protocol)
+	}
+
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceFWRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts FirewallRuleUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("protocol") {
+		protocol := d.Get("protocol").(string)
+		updateOpts.Protocol = &protocol
+	}
+	if d.HasChange("action") {
+		action := d.Get("action").(string)
+		updateOpts.Action = &action
+	}
+	if d.HasChange("ip_version") {
+		ipVersion := d.Get("ip_version").(int)
+		updateOpts.IPVersion = &ipVersion
+	}
+	if d.HasChange("source_ip_address") {
+		sourceIPAddress := d.Get("source_ip_address").(string)
+		updateOpts.SourceIPAddress = &sourceIPAddress
+	}
+	if d.HasChange("destination_ip_address") {
+		destinationIPAddress := d.Get("destination_ip_address").(string)
+		updateOpts.DestinationIPAddress = &destinationIPAddress
+	}
+	if d.HasChange("source_port") {
+		sourcePort := d.Get("source_port").(string)
+		updateOpts.SourcePort = &sourcePort
+	}
+	if d.HasChange("destination_port") {
+		destinationPort := d.Get("destination_port").(string)
+		updateOpts.DestinationPort = &destinationPort
+	}
+	if d.HasChange("enabled") {
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+	if d.HasChange("shared") {
+		shared := d.Get("shared").(bool)
+		updateOpts.Shared = &shared
+	}
+
+	log.Printf("[DEBUG] Updating firewall rule %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2FirewallRuleUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack firewall rule: %s", err)
+	}
+
+	return resourceFWRuleV2Read(d, meta)
+}
+
+func resourceFWRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	rule, err := networkingV2FirewallRuleGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving OpenStack firewall rule")
+	}
+
+	// A rule which is still part of a policy can't be deleted.
+	if rule.FirewallPolicyID != "" {
+		_, err := networkingV2FirewallPolicyRemoveRule(networkingClient, rule.FirewallPolicyID, rule.ID).Extract()
+		if err != nil {
+			return fmt.Errorf("Error removing firewall rule %s from policy %s: %s", rule.ID, rule.FirewallPolicyID, err)
+		}
+	}
+
+	err = networkingV2FirewallRuleDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack firewall rule")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccFWRuleV2_basic(t *testing.T) {
+	var rule FirewallRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckFWV2(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckFWRuleV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccFWRuleV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFWRuleV2Exists(
+						"openstack_fw_rule_v2.rule_1", &rule),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_rule_v2.rule_1", "protocol", "tcp"),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_rule_v2.rule_1", "destination_port", "22"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccFWRuleV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_fw_rule_v2.rule_1", "id", &rule.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_rule_v2.rule_1", "protocol", "any"),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_rule_v2.rule_1", "destination_port", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_fw_rule_v2.rule_1", "action", "allow"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFWRuleV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_fw_rule_v2" {
+			continue
+		}
+
+		_, err := networkingV2FirewallRuleGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Firewall rule still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckFWRuleV2Exists(n string, rule *FirewallRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2FirewallRuleGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Firewall rule not found")
+		}
+
+		*rule = *found
+
+		return nil
+	}
+}
+
+const testAccFWRuleV2_basic = `
+resource "openstack_fw_rule_v2" "rule_1" {
+  name = "rule_1"
+  protocol = "tcp"
+  action = "deny"
+  destination_port = "22"
+}
+`
+
+const testAccFWRuleV2_update = `
+resource "openstack_fw_rule_v2" "rule_1" {
+  name = "rule_1"
+  protocol = "any"
+  action = "allow"
+  source_ip_address = "10.0.0.0/24"
+}
+`
@@ -0,0 +1,202 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIdentityEndpointV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityEndpointV3Create,
+		Read:   resourceIdentityEndpointV3Read,
+		Update: resourceIdentityEndpointV3Update,
+		Delete: resourceIdentityEndpointV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"endpoint_region": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"interface": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: resourceIdentityEndpointV3ValidInterface,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"service_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"url": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityEndpointV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := IdentityEndpointV3CreateOpts{
+		Name:      d.Get("name").(string),
+		ServiceID: d.Get("service_id").(string),
+		Interface: d.Get("interface").(string),
+		URL:       d.Get("url").(string),
+		RegionID:  d.Get("endpoint_region").(string),
+		Enabled:   &enabled,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	endpoint, err := identityV3EndpointCreate(identityClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack endpoint: %s", err)
+	}
+
+	d.SetId(endpoint.ID)
+
+	return resourceIdentityEndpointV3Read(d, meta)
+}
+
+func resourceIdentityEndpointV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	endpoint, err := identityV3EndpointGet(identityClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "endpoint")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack endpoint: %#v", endpoint)
+
+	d.Set("enabled", endpoint.Enabled)
+	d.Set("endpoint_region", endpoint.RegionID)
+	d.Set("interface", endpoint.Interface)
+	d.Set("name", endpoint.Name)
+	d.Set("service_id", endpoint.ServiceID)
+	d.Set("url", endpoint.URL)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityEndpointV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts IdentityEndpointV3UpdateOpts
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if d.HasChange("endpoint_region") {
+		hasChange = true
+		endpointRegion := d.Get("endpoint_region").(string)
+		updateOpts.RegionID = &endpointRegion
+	}
+
+	if d.HasChange("interface") {
+		hasChange = true
+		updateOpts.Interface = d.Get("interface").(string)
+	}
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("service_id") {
+		hasChange = true
+		updateOpts.ServiceID = d.Get("service_id").(string)
+	}
+
+	if d.HasChange("url") {
+		hasChange = true
+		updateOpts.URL = d.Get("url").(string)
+	}
+
+	if hasChange {
+		_, err := identityV3EndpointUpdate(identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack endpoint: %s", err)
+		}
+	}
+
+	return resourceIdentityEndpointV3Read(d, meta)
+}
+
+func resourceIdentityEndpointV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = identityV3EndpointDelete(identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack endpoint")
+	}
+
+	return nil
+}
+
+func resourceIdentityEndpointV3ValidInterface(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validInterfaces := []string{
+		"public",
+		"internal",
+		"admin",
+	}
+
+	for _, v := range validInterfaces {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validInterfaces)
+	errors = append(errors, err)
+	return
+}
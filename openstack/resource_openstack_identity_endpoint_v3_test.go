@@ -0,0 +1,120 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccIdentityV3Endpoint_basic(t *testing.T) {
+	var endpoint IdentityEndpointV3
+	var serviceName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3EndpointDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3Endpoint_basic(serviceName, "public", "http://my-service"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3EndpointExists("openstack_identity_endpoint_v3.endpoint_1", &endpoint),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_endpoint_v3.endpoint_1", "service_id",
+						"openstack_identity_service_v3.service_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_endpoint_v3.endpoint_1", "interface", "public"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_endpoint_v3.endpoint_1", "url", "http://my-service"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_endpoint_v3.endpoint_1", "endpoint_region", OS_REGION_NAME),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIdentityV3Endpoint_basic(serviceName, "internal", "http://my-service:8080"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3EndpointExists("openstack_identity_endpoint_v3.endpoint_1", &endpoint),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_endpoint_v3.endpoint_1", "interface", "internal"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_endpoint_v3.endpoint_1", "url", "http://my-service:8080"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3EndpointDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_endpoint_v3" {
+			continue
+		}
+
+		_, err := identityV3EndpointGet(identityClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Endpoint still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3EndpointExists(n string, endpoint *IdentityEndpointV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		found, err := identityV3EndpointGet(identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Endpoint not found")
+		}
+
+		*endpoint = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3Endpoint_basic(serviceName, endpointInterface, url string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_service_v3" "service_1" {
+      name = "%s"
+      type = "acctest"
+    }
+
+    resource "openstack_identity_endpoint_v3" "endpoint_1" {
+      service_id = "${openstack_identity_service_v3.service_1.id}"
+      endpoint_region = "%s"
+      interface = "%s"
+      url = "%s"
+    }
+  `, serviceName, OS_REGION_NAME, endpointInterface, url)
+}
@@ -0,0 +1,162 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIdentityLimitV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityLimitV3Create,
+		Read:   resourceIdentityLimitV3Read,
+		Update: resourceIdentityLimitV3Update,
+		Delete: resourceIdentityLimitV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"region_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"resource_limit": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"resource_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"service_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityLimitV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	createOpts := IdentityLimitV3CreateOpts{
+		ProjectID:     d.Get("project_id").(string),
+		ServiceID:     d.Get("service_id").(string),
+		RegionID:      d.Get("region_id").(string),
+		ResourceName:  d.Get("resource_name").(string),
+		ResourceLimit: d.Get("resource_limit").(int),
+		Description:   d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	limit, err := identityV3LimitCreate(identityClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack limit: %s", err)
+	}
+
+	d.SetId(limit.ID)
+
+	return resourceIdentityLimitV3Read(d, meta)
+}
+
+func resourceIdentityLimitV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	limit, err := identityV3LimitGet(identityClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "limit")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack limit: %#v", limit)
+
+	d.Set("description", limit.Description)
+	d.Set("project_id", limit.ProjectID)
+	d.Set("region_id", limit.RegionID)
+	d.Set("resource_limit", limit.ResourceLimit)
+	d.Set("resource_name", limit.ResourceName)
+	d.Set("service_id", limit.ServiceID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityLimitV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts IdentityLimitV3UpdateOpts
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("resource_limit") {
+		hasChange = true
+		resourceLimit := d.Get("resource_limit").(int)
+		updateOpts.ResourceLimit = &resourceLimit
+	}
+
+	if hasChange {
+		_, err := identityV3LimitUpdate(identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack limit: %s", err)
+		}
+	}
+
+	return resourceIdentityLimitV3Read(d, meta)
+}
+
+func resourceIdentityLimitV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = identityV3LimitDelete(identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack limit")
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccIdentityV3Limit_basic(t *testing.T) {
+	var limit IdentityLimitV3
+	var resourceName = fmt.Sprintf("acctest_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3LimitDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3Limit_basic(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3LimitExists("openstack_identity_limit_v3.limit_1", &limit),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_limit_v3.limit_1", "resource_name", resourceName),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_limit_v3.limit_1", "resource_limit", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_limit_v3.limit_1", "description", "A limit"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIdentityV3Limit_update(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3LimitExists("openstack_identity_limit_v3.limit_1", &limit),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_limit_v3.limit_1", "resource_limit", "20"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_limit_v3.limit_1", "description", "Some limit"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3LimitDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_limit_v3" {
+			continue
+		}
+
+		_, err := identityV3LimitGet(identityClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Limit still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3LimitExists(n string, limit *IdentityLimitV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		found, err := identityV3LimitGet(identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Limit not found")
+		}
+
+		*limit = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3Limit_basic(resourceName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_service_v3" "service_1" {
+      name = "acctest"
+      type = "acctest"
+    }
+
+    resource "openstack_identity_project_v3" "project_1" {
+      name = "%s"
+    }
+
+    resource "openstack_identity_registered_limit_v3" "registered_limit_1" {
+      service_id = "${openstack_identity_service_v3.service_1.id}"
+      resource_name = "%s"
+      default_limit = 5
+    }
+
+    resource "openstack_identity_limit_v3" "limit_1" {
+      project_id = "${openstack_identity_project_v3.project_1.id}"
+      service_id = "${openstack_identity_service_v3.service_1.id}"
+      resource_name = "${openstack_identity_registered_limit_v3.registered_limit_1.resource_name}"
+      resource_limit = 10
+      description = "A limit"
+    }
+  `, resourceName, resourceName)
+}
+
+func testAccIdentityV3Limit_update(resourceName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_service_v3" "service_1" {
+      name = "acctest"
+      type = "acctest"
+    }
+
+    resource "openstack_identity_project_v3" "project_1" {
+      name = "%s"
+    }
+
+    resource "openstack_identity_registered_limit_v3" "registered_limit_1" {
+      service_id = "${openstack_identity_service_v3.service_1.id}"
+      resource_name = "%s"
+      default_limit = 5
+    }
+
+    resource "openstack_identity_limit_v3" "limit_1" {
+      project_id = "${openstack_identity_project_v3.project_1.id}"
+      service_id = "${openstack_identity_service_v3.service_1.id}"
+      resource_name = "${openstack_identity_registered_limit_v3.registered_limit_1.resource_name}"
+      resource_limit = 20
+      description = "Some limit"
+    }
+  `, resourceName, resourceName)
+}
@@ -58,6 +58,14 @@ func resourceIdentityProjectV3() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+
+			"tags": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 		},
 	}
 }
@@ -88,6 +96,13 @@ func resourceIdentityProjectV3Create(d *schema.ResourceData, meta interface{}) e
 
 	d.SetId(project.ID)
 
+	if tags := resourceIdentityProjectV3Tags(d); len(tags) > 0 {
+		_, err := identityV3ProjectTagsReplace(identityClient, project.ID, tags).Extract()
+		if err != nil {
+			return fmt.Errorf("Error setting tags on OpenStack project (%s): %s", project.ID, err)
+		}
+	}
+
 	return resourceIdentityProjectV3Read(d, meta)
 }
 
@@ -113,6 +128,15 @@ func resourceIdentityProjectV3Read(d *schema.ResourceData, meta interface{}) err
 	d.Set("parent_id", project.ParentID)
 	d.Set("region", GetRegion(d, config))
 
+	// Tags require a newer Identity service which not all clouds run, so
+	// only warn if they can't be retrieved.
+	tags, err := identityV3ProjectTagsList(identityClient, d.Id()).Extract()
+	if err != nil {
+		log.Printf("[DEBUG] Unable to retrieve tags of OpenStack project (%s): %s", d.Id(), err)
+	} else {
+		d.Set("tags", tags)
+	}
+
 	return nil
 }
 
@@ -165,6 +189,14 @@ func resourceIdentityProjectV3Update(d *schema.ResourceData, meta interface{}) e
 		}
 	}
 
+	if d.HasChange("tags") {
+		tags := resourceIdentityProjectV3Tags(d)
+		_, err := identityV3ProjectTagsReplace(identityClient, d.Id(), tags).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating tags of OpenStack project (%s): %s", d.Id(), err)
+		}
+	}
+
 	return resourceIdentityProjectV3Read(d, meta)
 }
 
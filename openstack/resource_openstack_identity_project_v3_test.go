@@ -37,6 +37,8 @@ func TestAccIdentityV3Project_basic(t *testing.T) {
 						"openstack_identity_project_v3.project_1", "enabled", "true"),
 					resource.TestCheckResourceAttr(
 						"openstack_identity_project_v3.project_1", "is_domain", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_project_v3.project_1", "tags.#", "2"),
 				),
 			},
 			resource.TestStep{
@@ -53,6 +55,8 @@ func TestAccIdentityV3Project_basic(t *testing.T) {
 						"openstack_identity_project_v3.project_1", "enabled", "false"),
 					resource.TestCheckResourceAttr(
 						"openstack_identity_project_v3.project_1", "is_domain", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_project_v3.project_1", "tags.#", "1"),
 				),
 			},
 		},
@@ -117,6 +121,7 @@ func testAccIdentityV3Project_basic(projectName string) string {
     resource "openstack_identity_project_v3" "project_1" {
       name = "%s"
       description = "A project"
+      tags = ["tag1", "tag2"]
     }
   `, projectName)
 }
@@ -127,6 +132,7 @@ func testAccIdentityV3Project_update(projectName string) string {
       name = "%s"
       description = "Some project"
       enabled = false
+      tags = ["tag1"]
     }
   `, projectName)
 }
@@ -0,0 +1,154 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIdentityRegisteredLimitV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityRegisteredLimitV3Create,
+		Read:   resourceIdentityRegisteredLimitV3Read,
+		Update: resourceIdentityRegisteredLimitV3Update,
+		Delete: resourceIdentityRegisteredLimitV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"default_limit": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"region_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"resource_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"service_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityRegisteredLimitV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	createOpts := IdentityRegisteredLimitV3CreateOpts{
+		ServiceID:    d.Get("service_id").(string),
+		RegionID:     d.Get("region_id").(string),
+		ResourceName: d.Get("resource_name").(string),
+		DefaultLimit: d.Get("default_limit").(int),
+		Description:  d.Get("description").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	registeredLimit, err := identityV3RegisteredLimitCreate(identityClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack registered limit: %s", err)
+	}
+
+	d.SetId(registeredLimit.ID)
+
+	return resourceIdentityRegisteredLimitV3Read(d, meta)
+}
+
+func resourceIdentityRegisteredLimitV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	registeredLimit, err := identityV3RegisteredLimitGet(identityClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "registered limit")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack registered limit: %#v", registeredLimit)
+
+	d.Set("default_limit", registeredLimit.DefaultLimit)
+	d.Set("description", registeredLimit.Description)
+	d.Set("region_id", registeredLimit.RegionID)
+	d.Set("resource_name", registeredLimit.ResourceName)
+	d.Set("service_id", registeredLimit.ServiceID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityRegisteredLimitV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts IdentityRegisteredLimitV3UpdateOpts
+
+	if d.HasChange("default_limit") {
+		hasChange = true
+		defaultLimit := d.Get("default_limit").(int)
+		updateOpts.DefaultLimit = &defaultLimit
+	}
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if hasChange {
+		_, err := identityV3RegisteredLimitUpdate(identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack registered limit: %s", err)
+		}
+	}
+
+	return resourceIdentityRegisteredLimitV3Read(d, meta)
+}
+
+func resourceIdentityRegisteredLimitV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = identityV3RegisteredLimitDelete(identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack registered limit")
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccIdentityV3RegisteredLimit_basic(t *testing.T) {
+	var registeredLimit IdentityRegisteredLimitV3
+	var resourceName = fmt.Sprintf("acctest_%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3RegisteredLimitDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3RegisteredLimit_basic(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3RegisteredLimitExists("openstack_identity_registered_limit_v3.registered_limit_1", &registeredLimit),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_registered_limit_v3.registered_limit_1", "resource_name", resourceName),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_registered_limit_v3.registered_limit_1", "default_limit", "10"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_registered_limit_v3.registered_limit_1", "description", "A registered limit"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIdentityV3RegisteredLimit_update(resourceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3RegisteredLimitExists("openstack_identity_registered_limit_v3.registered_limit_1", &registeredLimit),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_registered_limit_v3.registered_limit_1", "default_limit", "20"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_registered_limit_v3.registered_limit_1", "description", "Some registered limit"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3RegisteredLimitDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_registered_limit_v3" {
+			continue
+		}
+
+		_, err := identityV3RegisteredLimitGet(identityClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Registered limit still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3RegisteredLimitExists(n string, registeredLimit *IdentityRegisteredLimitV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		found, err := identityV3RegisteredLimitGet(identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Registered limit not found")
+		}
+
+		*registeredLimit = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3RegisteredLimit_basic(resourceName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_service_v3" "service_1" {
+      name = "acctest"
+      type = "acctest"
+    }
+
+    resource "openstack_identity_registered_limit_v3" "registered_limit_1" {
+      service_id = "${openstack_identity_service_v3.service_1.id}"
+      resource_name = "%s"
+      default_limit = 10
+      description = "A registered limit"
+    }
+  `, resourceName)
+}
+
+func testAccIdentityV3RegisteredLimit_update(resourceName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_service_v3" "service_1" {
+      name = "acctest"
+      type = "acctest"
+    }
+
+    resource "openstack_identity_registered_limit_v3" "registered_limit_1" {
+      service_id = "${openstack_identity_service_v3.service_1.id}"
+      resource_name = "%s"
+      default_limit = 20
+      description = "Some registered limit"
+    }
+  `, resourceName)
+}
@@ -0,0 +1,157 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIdentityServiceV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityServiceV3Create,
+		Read:   resourceIdentityServiceV3Read,
+		Update: resourceIdentityServiceV3Update,
+		Delete: resourceIdentityServiceV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityServiceV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := IdentityServiceV3CreateOpts{
+		Type:        d.Get("type").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Enabled:     &enabled,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	service, err := identityV3ServiceCreate(identityClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack service: %s", err)
+	}
+
+	d.SetId(service.ID)
+
+	return resourceIdentityServiceV3Read(d, meta)
+}
+
+func resourceIdentityServiceV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	service, err := identityV3ServiceGet(identityClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "service")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack service: %#v", service)
+
+	d.Set("description", service.Description)
+	d.Set("enabled", service.Enabled)
+	d.Set("name", service.Name)
+	d.Set("type", service.Type)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIdentityServiceV3Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	var hasChange bool
+	var updateOpts IdentityServiceV3UpdateOpts
+
+	if d.HasChange("description") {
+		hasChange = true
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	if d.HasChange("enabled") {
+		hasChange = true
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	if d.HasChange("name") {
+		hasChange = true
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+
+	if d.HasChange("type") {
+		hasChange = true
+		updateOpts.Type = d.Get("type").(string)
+	}
+
+	if hasChange {
+		_, err := identityV3ServiceUpdate(identityClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack service: %s", err)
+		}
+	}
+
+	return resourceIdentityServiceV3Read(d, meta)
+}
+
+func resourceIdentityServiceV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = identityV3ServiceDelete(identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack service")
+	}
+
+	return nil
+}
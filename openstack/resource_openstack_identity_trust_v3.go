@@ -0,0 +1,187 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIdentityTrustV3() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIdentityTrustV3Create,
+		Read:   resourceIdentityTrustV3Read,
+		Delete: resourceIdentityTrustV3Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"allow_redelegation": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"expires_at": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     resourceIdentityTrustV3ValidExpiresAt,
+				DiffSuppressFunc: suppressEquivilentTimeDiffs,
+			},
+
+			"impersonation": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"remaining_uses": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"roles": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+
+			"trustee_user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"trustor_user_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIdentityTrustV3Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	createOpts := IdentityTrustV3CreateOpts{
+		TrustorUserID:     d.Get("trustor_user_id").(string),
+		TrusteeUserID:     d.Get("trustee_user_id").(string),
+		ProjectID:         d.Get("project_id").(string),
+		Impersonation:     d.Get("impersonation").(bool),
+		AllowRedelegation: d.Get("allow_redelegation").(bool),
+	}
+
+	if v, ok := d.GetOk("remaining_uses"); ok {
+		remainingUses := v.(int)
+		createOpts.RemainingUses = &remainingUses
+	}
+
+	if v, ok := d.GetOk("expires_at"); ok {
+		expiresAt, err := time.Parse(time.RFC3339, v.(string))
+		if err != nil {
+			return fmt.Errorf("Error parsing expires_at: %s", err)
+		}
+		createOpts.ExpiresAt = &expiresAt
+	}
+
+	for _, role := range d.Get("roles").(*schema.Set).List() {
+		createOpts.Roles = append(createOpts.Roles, IdentityTrustV3Role{
+			Name: role.(string),
+		})
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	trust, err := identityV3TrustCreate(identityClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack trust: %s", err)
+	}
+
+	d.SetId(trust.ID)
+
+	return resourceIdentityTrustV3Read(d, meta)
+}
+
+func resourceIdentityTrustV3Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	trust, err := identityV3TrustGet(identityClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "trust")
+	}
+
+	log.Printf("[DEBUG] Retrieved OpenStack trust: %#v", trust)
+
+	d.Set("allow_redelegation", trust.AllowRedelegation)
+	d.Set("expires_at", trust.ExpiresAt)
+	d.Set("impersonation", trust.Impersonation)
+	d.Set("project_id", trust.ProjectID)
+	d.Set("trustee_user_id", trust.TrusteeUserID)
+	d.Set("trustor_user_id", trust.TrustorUserID)
+	d.Set("region", GetRegion(d, config))
+
+	// The remaining uses are counted down while the trust is used, so they
+	// are only set when the trust is imported.
+	if _, ok := d.GetOk("remaining_uses"); !ok && trust.RemainingUses != nil {
+		d.Set("remaining_uses", *trust.RemainingUses)
+	}
+
+	roles := make([]string, len(trust.Roles))
+	for i, role := range trust.Roles {
+		roles[i] = role.Name
+	}
+	d.Set("roles", roles)
+
+	return nil
+}
+
+func resourceIdentityTrustV3Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	identityClient, err := config.identityV3Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	err = identityV3TrustDelete(identityClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack trust")
+	}
+
+	return nil
+}
+
+func resourceIdentityTrustV3ValidExpiresAt(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s must be an RFC3339 timestamp: %s", k, err))
+	}
+	return
+}
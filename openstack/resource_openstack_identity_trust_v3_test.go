@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccIdentityV3Trust_basic(t *testing.T) {
+	var trust IdentityTrustV3
+	var userName = fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIdentityV3TrustDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIdentityV3Trust_basic(userName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIdentityV3TrustExists("openstack_identity_trust_v3.trust_1", &trust),
+					resource.TestCheckResourceAttrPair(
+						"openstack_identity_trust_v3.trust_1", "trustee_user_id",
+						"openstack_identity_user_v3.user_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_trust_v3.trust_1", "impersonation", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_trust_v3.trust_1", "roles.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_identity_trust_v3.trust_1", "expires_at", "2099-01-01T00:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIdentityV3TrustDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	identityClient, err := config.identityV3Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_identity_trust_v3" {
+			continue
+		}
+
+		_, err := identityV3TrustGet(identityClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Trust still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIdentityV3TrustExists(n string, trust *IdentityTrustV3) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		identityClient, err := config.identityV3Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack identity client: %s", err)
+		}
+
+		found, err := identityV3TrustGet(identityClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Trust not found")
+		}
+
+		*trust = *found
+
+		return nil
+	}
+}
+
+func testAccIdentityV3Trust_basic(userName string) string {
+	return fmt.Sprintf(`
+    data "openstack_identity_user_v3" "admin" {
+      name = "admin"
+    }
+
+    resource "openstack_identity_user_v3" "user_1" {
+      name = "%s"
+      password = "password123@!"
+    }
+
+    resource "openstack_identity_trust_v3" "trust_1" {
+      trustor_user_id = "${data.openstack_identity_user_v3.admin.id}"
+      trustee_user_id = "${openstack_identity_user_v3.user_1.id}"
+      project_id = "${data.openstack_identity_user_v3.admin.default_project_id}"
+      impersonation = true
+      roles = ["admin"]
+      expires_at = "2099-01-01T00:00:00Z"
+    }
+  `, userName)
+}
@@ -0,0 +1,159 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImagesImageMemberAcceptV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesImageMemberAcceptV2Create,
+		Read:   resourceImagesImageMemberV2Read,
+		Update: resourceImagesImageMemberAcceptV2Update,
+		Delete: resourceImagesImageMemberAcceptV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "accepted",
+				ValidateFunc: resourceImagesImageMemberAcceptV2ValidStatus,
+			},
+
+			"schema": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesImageMemberAcceptV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	memberID := d.Get("member_id").(string)
+	if memberID == "" {
+		memberID, err = tokenProjectID(config, GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error determining the project of the image member: %s", err)
+		}
+	}
+
+	status := d.Get("status").(string)
+
+	log.Printf("[DEBUG] Setting status of image %s for project %s to %s", imageID, memberID, status)
+	member, err := imagesV2MemberUpdate(imageClient, imageID, memberID, status).Extract()
+	if err != nil {
+		return fmt.Errorf("Error setting status of OpenStack image %s for project %s: %s", imageID, memberID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", member.ImageID, member.MemberID))
+
+	return resourceImagesImageMemberV2Read(d, meta)
+}
+
+func resourceImagesImageMemberAcceptV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageMemberV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("status") {
+		status := d.Get("status").(string)
+		_, err := imagesV2MemberUpdate(imageClient, imageID, memberID, status).Extract()
+		if err != nil {
+			return fmt.Errorf("Error setting status of OpenStack image %s for project %s: %s", imageID, memberID, err)
+		}
+	}
+
+	return resourceImagesImageMemberV2Read(d, meta)
+}
+
+// resourceImagesImageMemberAcceptV2Delete reverts the status of the member
+// to pending, since only the owner of the image can remove the member.
+func resourceImagesImageMemberAcceptV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageMemberV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reverting status of image %s for project %s to pending", imageID, memberID)
+	_, err = imagesV2MemberUpdate(imageClient, imageID, memberID, "pending").Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error reverting status of OpenStack image member")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceImagesImageMemberAcceptV2ValidStatus(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validStatuses := []string{
+		"accepted",
+		"rejected",
+		"pending",
+	}
+
+	for _, v := range validStatuses {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validStatuses)
+	errors = append(errors, err)
+	return
+}
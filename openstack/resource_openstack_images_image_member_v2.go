@@ -0,0 +1,145 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImagesImageMemberV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesImageMemberV2Create,
+		Read:   resourceImagesImageMemberV2Read,
+		Delete: resourceImagesImageMemberV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"image_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"schema": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesImageMemberV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID := d.Get("image_id").(string)
+	memberID := d.Get("member_id").(string)
+
+	log.Printf("[DEBUG] Sharing image %s with project %s", imageID, memberID)
+	member, err := imagesV2MemberCreate(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error sharing OpenStack image %s with project %s: %s", imageID, memberID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", member.ImageID, member.MemberID))
+
+	return resourceImagesImageMemberV2Read(d, meta)
+}
+
+func resourceImagesImageMemberV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageMemberV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	member, err := imagesV2MemberGet(imageClient, imageID, memberID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "image member")
+	}
+
+	log.Printf("[DEBUG] Retrieved image member %s: %#v", d.Id(), member)
+
+	d.Set("image_id", member.ImageID)
+	d.Set("member_id", member.MemberID)
+	d.Set("status", member.Status)
+	d.Set("schema", member.Schema)
+	d.Set("created_at", member.CreatedAt)
+	d.Set("updated_at", member.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImagesImageMemberV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	imageID, memberID, err := parseImagesImageMemberV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Unsharing image %s with project %s", imageID, memberID)
+	err = imagesV2MemberDelete(imageClient, imageID, memberID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error unsharing OpenStack image")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseImagesImageMemberV2ID splits the ID of an image member resource,
+// which has the form <image id>/<member id>.
+func parseImagesImageMemberV2ID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine image member ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,121 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccImagesImageMemberV2_basic(t *testing.T) {
+	var member ImageMemberV2
+	projectName := fmt.Sprintf("ACCPTTEST-%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageMemberV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesImageMemberV2_basic(projectName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageMemberV2Exists("openstack_images_image_member_v2.member_1", &member),
+					resource.TestCheckResourceAttrPair(
+						"openstack_images_image_member_v2.member_1", "member_id",
+						"openstack_identity_project_v3.project_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_member_v2.member_1", "status", "pending"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesImageMemberV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_image_member_v2" {
+			continue
+		}
+
+		imageID, memberID, err := parseImagesImageMemberV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = imagesV2MemberGet(imageClient, imageID, memberID).Extract()
+		if err == nil {
+			return fmt.Errorf("Image member still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImagesImageMemberV2Exists(n string, member *ImageMemberV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		imageClient, err := config.imageV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack image client: %s", err)
+		}
+
+		imageID, memberID, err := parseImagesImageMemberV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := imagesV2MemberGet(imageClient, imageID, memberID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ImageID != imageID || found.MemberID != memberID {
+			return fmt.Errorf("Image member not found")
+		}
+
+		*member = *found
+
+		return nil
+	}
+}
+
+func testAccImagesImageMemberV2_basic(projectName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_identity_project_v3" "project_1" {
+      name = "%s"
+    }
+
+    resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      visibility = "shared"
+    }
+
+    resource "openstack_images_image_member_v2" "member_1" {
+      image_id = "${openstack_images_image_v2.image_1.id}"
+      member_id = "${openstack_identity_project_v3.project_1.id}"
+    }
+  `, projectName)
+}
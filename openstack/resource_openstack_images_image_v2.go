@@ -1,7 +1,11 @@
 package openstack
 
 import (
+	"compress/bzip2"
+	"compress/gzip"
 	"crypto/md5"
+	"crypto/sha256"
+	"crypto/sha512"
 	"encoding/hex"
 	"fmt"
 	"io"
@@ -58,6 +62,13 @@ func resourceImagesImageV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"decompress": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"disk_format": &schema.Schema{
 				Type:         schema.TypeString,
 				Required:     true,
@@ -87,7 +98,7 @@ func resourceImagesImageV2() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"image_source_url"},
+				ConflictsWith: []string{"image_source_url", "web_download"},
 			},
 
 			"metadata": &schema.Schema{
@@ -98,7 +109,6 @@ func resourceImagesImageV2() *schema.Resource {
 			"min_disk_gb": &schema.Schema{
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validatePositiveInt,
 				Default:      0,
 			},
@@ -106,7 +116,6 @@ func resourceImagesImageV2() *schema.Resource {
 			"min_ram_mb": &schema.Schema{
 				Type:         schema.TypeInt,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validatePositiveInt,
 				Default:      0,
 			},
@@ -125,7 +134,6 @@ func resourceImagesImageV2() *schema.Resource {
 			"protected": &schema.Schema{
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 				Default:  false,
 			},
 
@@ -156,6 +164,19 @@ func resourceImagesImageV2() *schema.Resource {
 				Computed: true,
 			},
 
+			"upload_buffer_size_mb": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validatePositiveInt,
+				Default:      8,
+			},
+
+			"verify_checksum": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
 			"visibility": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -169,6 +190,14 @@ func resourceImagesImageV2() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+
+			"web_download": &schema.Schema{
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				ConflictsWith: []string{"local_file_path"},
+			},
 		},
 	}
 }
@@ -212,35 +241,83 @@ func resourceImagesImageV2Create(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(newImg.ID)
 
-	// downloading/getting image file props
-	imgFilePath, err := resourceImagesImageV2File(d)
-	if err != nil {
-		return fmt.Errorf("Error opening file for Image: %s", err)
+	var fileSize int64
+	var fileHashes *imageV2FileHashes
 
-	}
-	fileSize, fileChecksum, err := resourceImagesImageV2FileProps(imgFilePath)
-	if err != nil {
-		return fmt.Errorf("Error getting file props: %s", err)
-	}
+	if d.Get("web_download").(bool) {
+		// The image service downloads the image itself, so its size and
+		// checksum aren't known beforehand.
+		imageSourceURL := d.Get("image_source_url").(string)
+		if imageSourceURL == "" {
+			return fmt.Errorf("image_source_url must be set when web_download is enabled")
+		}
 
-	// upload
-	imgFile, err := os.Open(imgFilePath)
-	if err != nil {
-		return fmt.Errorf("Error opening file %q: %s", imgFilePath, err)
-	}
-	defer imgFile.Close()
-	log.Printf("[WARN] Uploading image %s (%d bytes). This can be pretty long.", d.Id(), fileSize)
+		importOpts := ImageV2ImportOpts{
+			Method: ImageV2ImportMethod{
+				Name: "web-download",
+				URI:  imageSourceURL,
+			},
+		}
+
+		log.Printf("[DEBUG] Importing image %s from %s", d.Id(), imageSourceURL)
+		err = imagesImageV2Import(imageClient, d.Id(), importOpts).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error importing Image from %q: %s", imageSourceURL, err)
+		}
+	} else {
+		// downloading/getting image file props
+		imgFilePath, err := resourceImagesImageV2File(d)
+		if err != nil {
+			return fmt.Errorf("Error opening file for Image: %s", err)
+
+		}
+
+		if d.Get("decompress").(bool) {
+			decompressedFilePath, err := resourceImagesImageV2Decompress(imgFilePath, d.Get("image_cache_path").(string))
+			if err != nil {
+				return fmt.Errorf("Error decompressing file for Image: %s", err)
+			}
+
+			// The decompressed file is only needed for the upload, so it
+			// doesn't take up space in the image cache afterwards.
+			if decompressedFilePath != imgFilePath {
+				defer os.Remove(decompressedFilePath)
+				imgFilePath = decompressedFilePath
+			}
+		}
+
+		var hashes imageV2FileHashes
+		fileSize, hashes, err = resourceImagesImageV2FileProps(imgFilePath)
+		if err != nil {
+			return fmt.Errorf("Error getting file props: %s", err)
+		}
+		if d.Get("verify_checksum").(bool) {
+			fileHashes = &hashes
+		}
 
-	res := imagedata.Upload(imageClient, d.Id(), imgFile)
-	if res.Err != nil {
-		return fmt.Errorf("Error while uploading file %q: %s", imgFilePath, res.Err)
+		// upload
+		imgFile, err := os.Open(imgFilePath)
+		if err != nil {
+			return fmt.Errorf("Error opening file %q: %s", imgFilePath, err)
+		}
+		defer imgFile.Close()
+		log.Printf("[WARN] Uploading image %s (%d bytes). This can be pretty long.", d.Id(), fileSize)
+
+		reader := &imageV2BufferedReader{
+			file:       imgFile,
+			bufferSize: d.Get("upload_buffer_size_mb").(int) * 1024 * 1024,
+		}
+		res := imagedata.Upload(imageClient, d.Id(), reader)
+		if res.Err != nil {
+			return fmt.Errorf("Error while uploading file %q: %s", imgFilePath, res.Err)
+		}
 	}
 
 	//wait for active
 	stateConf := &resource.StateChangeConf{
-		Pending:    []string{string(images.ImageStatusQueued), string(images.ImageStatusSaving)},
+		Pending:    []string{string(images.ImageStatusQueued), string(images.ImageStatusSaving), "importing"},
 		Target:     []string{string(images.ImageStatusActive)},
-		Refresh:    resourceImagesImageV2RefreshFunc(imageClient, d.Id(), fileSize, fileChecksum),
+		Refresh:    resourceImagesImageV2RefreshFunc(imageClient, d.Id(), fileSize, fileHashes),
 		Timeout:    d.Timeout(schema.TimeoutCreate),
 		Delay:      10 * time.Second,
 		MinTimeout: 3 * time.Second,
@@ -288,7 +365,7 @@ func resourceImagesImageV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("size_bytes", img.SizeBytes)
 	d.Set("tags", img.Tags)
 	d.Set("visibility", img.Visibility)
-	d.Set("properties", img.Properties)
+	d.Set("properties", resourceImagesImageV2Properties(d, img.Properties))
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -322,6 +399,44 @@ func resourceImagesImageV2Update(d *schema.ResourceData, meta interface{}) error
 		updateOpts = append(updateOpts, v)
 	}
 
+	if d.HasChange("min_disk_gb") {
+		v := ImageV2Patch{Op: "replace", Name: "min_disk", Value: d.Get("min_disk_gb").(int)}
+		updateOpts = append(updateOpts, v)
+	}
+
+	if d.HasChange("min_ram_mb") {
+		v := ImageV2Patch{Op: "replace", Name: "min_ram", Value: d.Get("min_ram_mb").(int)}
+		updateOpts = append(updateOpts, v)
+	}
+
+	if d.HasChange("protected") {
+		v := ImageV2Patch{Op: "replace", Name: "protected", Value: d.Get("protected").(bool)}
+		updateOpts = append(updateOpts, v)
+	}
+
+	if d.HasChange("properties") {
+		o, n := d.GetChange("properties")
+		oldProperties := o.(map[string]interface{})
+		newProperties := n.(map[string]interface{})
+
+		for key := range oldProperties {
+			if _, ok := newProperties[key]; !ok {
+				v := ImageV2Patch{Op: "remove", Name: key}
+				updateOpts = append(updateOpts, v)
+			}
+		}
+
+		for key, value := range newProperties {
+			if oldValue, ok := oldProperties[key]; !ok {
+				v := ImageV2Patch{Op: "add", Name: key, Value: value}
+				updateOpts = append(updateOpts, v)
+			} else if oldValue != value {
+				v := ImageV2Patch{Op: "replace", Name: key, Value: value}
+				updateOpts = append(updateOpts, v)
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
 
 	_, err = images.Update(imageClient, d.Id(), updateOpts).Extract()
@@ -418,38 +533,162 @@ func resourceImagesImageV2VisibilityFromString(v string) images.ImageVisibility
 	return ""
 }
 
-func fileMD5Checksum(f *os.File) (string, error) {
-	hash := md5.New()
-	if _, err := io.Copy(hash, f); err != nil {
-		return "", err
+// imageV2FileHashes holds the hashes of an image file which are compared
+// with the ones computed by Glance.
+type imageV2FileHashes struct {
+	MD5    string
+	SHA256 string
+	SHA512 string
+}
+
+// fileHashes computes the hashes of a file in a single pass.
+func fileHashes(f *os.File) (imageV2FileHashes, error) {
+	md5Hash := md5.New()
+	sha256Hash := sha256.New()
+	sha512Hash := sha512.New()
+
+	if _, err := io.Copy(io.MultiWriter(md5Hash, sha256Hash, sha512Hash), f); err != nil {
+		return imageV2FileHashes{}, err
 	}
-	return hex.EncodeToString(hash.Sum(nil)), nil
+
+	return imageV2FileHashes{
+		MD5:    hex.EncodeToString(md5Hash.Sum(nil)),
+		SHA256: hex.EncodeToString(sha256Hash.Sum(nil)),
+		SHA512: hex.EncodeToString(sha512Hash.Sum(nil)),
+	}, nil
 }
 
-func resourceImagesImageV2FileProps(filename string) (int64, string, error) {
+func resourceImagesImageV2FileProps(filename string) (int64, imageV2FileHashes, error) {
 	var filesize int64
-	var filechecksum string
+	var filehashes imageV2FileHashes
 
 	file, err := os.Open(filename)
 	if err != nil {
-		return -1, "", fmt.Errorf("Error opening file for Image: %s", err)
+		return -1, filehashes, fmt.Errorf("Error opening file for Image: %s", err)
 
 	}
 	defer file.Close()
 
 	fstat, err := file.Stat()
 	if err != nil {
-		return -1, "", fmt.Errorf("Error reading image file %q: %s", file.Name(), err)
+		return -1, filehashes, fmt.Errorf("Error reading image file %q: %s", file.Name(), err)
 	}
 
 	filesize = fstat.Size()
-	filechecksum, err = fileMD5Checksum(file)
+	filehashes, err = fileHashes(file)
 
 	if err != nil {
-		return -1, "", fmt.Errorf("Error computing image file %q checksum: %s", file.Name(), err)
+		return -1, filehashes, fmt.Errorf("Error computing image file %q checksum: %s", file.Name(), err)
+	}
+
+	return filesize, filehashes, nil
+}
+
+// imageV2BufferedReader streams an image file through a buffer of a fixed
+// size, so that the file is never read into memory as a whole. Glance has
+// no chunked uploads, so the file is still sent in a single request. The
+// reader is seekable, so that the request can be sent again from the start
+// after reauthentication.
+type imageV2BufferedReader struct {
+	file       *os.File
+	bufferSize int
+}
+
+func (r *imageV2BufferedReader) Read(p []byte) (int, error) {
+	if len(p) > r.bufferSize {
+		p = p[:r.bufferSize]
 	}
+	return r.file.Read(p)
+}
 
-	return filesize, filechecksum, nil
+func (r *imageV2BufferedReader) Seek(offset int64, whence int) (int64, error) {
+	return r.file.Seek(offset, whence)
+}
+
+// WriteTo writes the rest of the file to w, at most bufferSize bytes at a
+// time. It is used by the HTTP client to send the request body.
+func (r *imageV2BufferedReader) WriteTo(w io.Writer) (int64, error) {
+	buf := make([]byte, r.bufferSize)
+
+	var written int64
+	for {
+		n, err := io.ReadFull(r.file, buf)
+		if n > 0 {
+			m, werr := w.Write(buf[:n])
+			written += int64(m)
+			if werr != nil {
+				return written, werr
+			}
+		}
+
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return written, nil
+		}
+		if err != nil {
+			return written, err
+		}
+	}
+}
+
+// resourceImagesImageV2Decompress decompresses a gzip or bzip2 compressed
+// image file into the image cache and returns the path of the decompressed
+// file. Files which aren't compressed are returned as is.
+func resourceImagesImageV2Decompress(filename, cacheDir string) (string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", fmt.Errorf("Error opening file %q: %s", filename, err)
+	}
+	defer file.Close()
+
+	magic := make([]byte, 3)
+	if _, err := io.ReadFull(file, magic); err != nil && err != io.ErrUnexpectedEOF {
+		return "", fmt.Errorf("Error reading file %q: %s", filename, err)
+	}
+	if _, err := file.Seek(0, 0); err != nil {
+		return "", fmt.Errorf("Error reading file %q: %s", filename, err)
+	}
+
+	var reader io.Reader
+	switch {
+	case magic[0] == 0x1f && magic[1] == 0x8b:
+		gzipReader, err := gzip.NewReader(file)
+		if err != nil {
+			return "", fmt.Errorf("Error decompressing file %q: %s", filename, err)
+		}
+		defer gzipReader.Close()
+		reader = gzipReader
+	case string(magic) == "BZh":
+		reader = bzip2.NewReader(file)
+	default:
+		log.Printf("[DEBUG] File %s is not compressed", filename)
+		return filename, nil
+	}
+
+	os.MkdirAll(cacheDir, 0700)
+	decompressed := filepath.Join(cacheDir, fmt.Sprintf("%x.decompressed.img", md5.Sum([]byte(filename))))
+	partFilename := decompressed + ".part"
+
+	log.Printf("[DEBUG] Decompressing file %s to %s", filename, decompressed)
+	out, err := os.Create(partFilename)
+	if err != nil {
+		return "", fmt.Errorf("Error creating file %q: %s", partFilename, err)
+	}
+
+	if _, err := io.Copy(out, reader); err != nil {
+		out.Close()
+		os.Remove(partFilename)
+		return "", fmt.Errorf("Error decompressing file %q: %s", filename, err)
+	}
+
+	if err := out.Close(); err != nil {
+		return "", fmt.Errorf("Error writing file %q: %s", partFilename, err)
+	}
+
+	if err := os.Rename(partFilename, decompressed); err != nil {
+		return "", fmt.Errorf("Error renaming file %q: %s", partFilename, err)
+	}
+
+	return decompressed, nil
 }
 
 func resourceImagesImageV2File(d *schema.ResourceData) (string, error) {
@@ -465,19 +704,8 @@ func resourceImagesImageV2File(d *schema.ResourceData) (string, error) {
 				return "", fmt.Errorf("Error while trying to access file %q: %s", filename, err)
 			}
 			log.Printf("[DEBUG] File doens't exists %s. will download from %s", filename, furl)
-			file, err := os.Create(filename)
-			if err != nil {
-				return "", fmt.Errorf("Error creating file %q: %s", filename, err)
-			}
-			defer file.Close()
-			resp, err := http.Get(furl)
-			if err != nil {
-				return "", fmt.Errorf("Error downloading image from %q", furl)
-			}
-			defer resp.Body.Close()
-
-			if _, err = io.Copy(file, resp.Body); err != nil {
-				return "", fmt.Errorf("Error downloading image %q to file %q: %s", furl, filename, err)
+			if err := resourceImagesImageV2Download(furl, filename); err != nil {
+				return "", err
 			}
 			return filename, nil
 		} else {
@@ -489,7 +717,70 @@ func resourceImagesImageV2File(d *schema.ResourceData) (string, error) {
 	}
 }
 
-func resourceImagesImageV2RefreshFunc(client *gophercloud.ServiceClient, id string, fileSize int64, checksum string) resource.StateRefreshFunc {
+// resourceImagesImageV2Download downloads an image to the given file. The
+// image is downloaded to a partial file first, so that an interrupted
+// download is resumed on the next run instead of being used as is.
+func resourceImagesImageV2Download(furl, filename string) error {
+	partFilename := filename + ".part"
+	file, err := os.OpenFile(partFilename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("Error creating file %q: %s", partFilename, err)
+	}
+	defer file.Close()
+
+	fstat, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Error reading file %q: %s", partFilename, err)
+	}
+	offset := fstat.Size()
+
+	req, err := http.NewRequest("GET", furl, nil)
+	if err != nil {
+		return fmt.Errorf("Error downloading image from %q: %s", furl, err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("Error downloading image from %q: %s", furl, err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusPartialContent:
+		log.Printf("[DEBUG] Resuming download of %s at %d bytes", furl, offset)
+	case http.StatusOK:
+		// The server doesn't support ranges, so start over.
+		if err := file.Truncate(0); err != nil {
+			return fmt.Errorf("Error truncating file %q: %s", partFilename, err)
+		}
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The previous download was complete but wasn't renamed.
+		log.Printf("[DEBUG] Download of %s is already complete", furl)
+	default:
+		return fmt.Errorf("Error downloading image from %q: unexpected status %s", furl, resp.Status)
+	}
+
+	if resp.StatusCode != http.StatusRequestedRangeNotSatisfiable {
+		if _, err = io.Copy(file, resp.Body); err != nil {
+			return fmt.Errorf("Error downloading image %q to file %q: %s", furl, partFilename, err)
+		}
+	}
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("Error writing file %q: %s", partFilename, err)
+	}
+
+	if err := os.Rename(partFilename, filename); err != nil {
+		return fmt.Errorf("Error renaming file %q: %s", partFilename, err)
+	}
+
+	return nil
+}
+
+func resourceImagesImageV2RefreshFunc(client *gophercloud.ServiceClient, id string, fileSize int64, hashes *imageV2FileHashes) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		img, err := images.Get(client, id).Extract()
 		if err != nil {
@@ -497,14 +788,57 @@ func resourceImagesImageV2RefreshFunc(client *gophercloud.ServiceClient, id stri
 		}
 		log.Printf("[DEBUG] OpenStack image status is: %s", img.Status)
 
-		if img.Checksum != checksum || int64(img.SizeBytes) != fileSize {
-			return img, fmt.Sprintf("%s", img.Status), fmt.Errorf("Error wrong size %v or checksum %q", img.SizeBytes, img.Checksum)
+		if img.Status == images.ImageStatusKilled {
+			return img, fmt.Sprintf("%s", img.Status), fmt.Errorf("Error uploading image data")
+		}
+
+		// Imported images have no known checksum, so they aren't verified.
+		if img.Status == images.ImageStatusActive && hashes != nil {
+			if err := resourceImagesImageV2VerifyChecksum(img, fileSize, hashes); err != nil {
+				return img, fmt.Sprintf("%s", img.Status), err
+			}
 		}
 
 		return img, fmt.Sprintf("%s", img.Status), nil
 	}
 }
 
+// resourceImagesImageV2VerifyChecksum compares the size and hashes of an
+// uploaded image file with the ones computed by Glance. Besides the MD5
+// checksum, newer Glance releases compute a multihash with the algorithm in
+// the os_hash_algo property.
+func resourceImagesImageV2VerifyChecksum(img *images.Image, fileSize int64, hashes *imageV2FileHashes) error {
+	if int64(img.SizeBytes) != fileSize {
+		return fmt.Errorf("Error wrong size %v, expected %v", img.SizeBytes, fileSize)
+	}
+
+	if img.Checksum != "" && img.Checksum != hashes.MD5 {
+		return fmt.Errorf("Error wrong checksum %q, expected %q", img.Checksum, hashes.MD5)
+	}
+
+	algo, _ := img.Properties["os_hash_algo"].(string)
+	value, _ := img.Properties["os_hash_value"].(string)
+
+	var expected string
+	switch algo {
+	case "sha256":
+		expected = hashes.SHA256
+	case "sha512":
+		expected = hashes.SHA512
+	default:
+		if algo != "" {
+			log.Printf("[DEBUG] Unable to verify %s hash of image %s", algo, img.ID)
+		}
+		return nil
+	}
+
+	if value != expected {
+		return fmt.Errorf("Error wrong %s hash %q, expected %q", algo, value, expected)
+	}
+
+	return nil
+}
+
 func resourceImagesImageV2BuildTags(v []interface{}) []string {
 	var tags []string
 	for _, tag := range v {
@@ -524,3 +858,22 @@ func resourceImagesImageV2ExpandProperties(v map[string]interface{}) map[string]
 
 	return properties
 }
+
+// resourceImagesImageV2Properties returns the properties of an image which
+// are stored in the state. The image service adds properties of its own, so
+// only the configured properties are stored if there are any.
+func resourceImagesImageV2Properties(d *schema.ResourceData, imgProperties map[string]interface{}) map[string]string {
+	configured := d.Get("properties").(map[string]interface{})
+
+	properties := make(map[string]string)
+	for key, value := range imgProperties {
+		if _, ok := configured[key]; len(configured) > 0 && !ok {
+			continue
+		}
+		if v, ok := value.(string); ok {
+			properties[key] = v
+		}
+	}
+
+	return properties
+}
@@ -2,6 +2,7 @@ package openstack
 
 import (
 	"fmt"
+	"path/filepath"
 	"testing"
 
 	"github.com/gophercloud/gophercloud/openstack/imageservice/v2/images"
@@ -157,6 +158,107 @@ func TestAccImagesImageV2_timeout(t *testing.T) {
 	})
 }
 
+func TestAccImagesImageV2_webDownload(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesImageV2_webDownload,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "web_download", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImagesImageV2_attributes(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesImageV2_attributes_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "min_disk_gb", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "min_ram_mb", "512"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "protected", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "properties.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "properties.foo", "bar"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImagesImageV2_attributes_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "min_disk_gb", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "min_ram_mb", "1024"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "protected", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "properties.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "properties.foo", "baz"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "properties.os_distro", "rancheros"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImagesImageV2_attributes_3,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "protected", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccImagesImageV2_upload(t *testing.T) {
+	var image images.Image
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesImageV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesImageV2_upload,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesImageV2Exists("openstack_images_image_v2.image_1", &image),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "status", "active"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "upload_buffer_size_mb", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_image_v2.image_1", "verify_checksum", "true"),
+					testAccCheckImagesImageV2DecompressedFilesRemoved("openstack_images_image_v2.image_1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckImagesImageV2Destroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	imageClient, err := config.imageV2Client(OS_REGION_NAME)
@@ -178,6 +280,27 @@ func testAccCheckImagesImageV2Destroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccCheckImagesImageV2DecompressedFilesRemoved(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		cacheDir := rs.Primary.Attributes["image_cache_path"]
+		files, err := filepath.Glob(filepath.Join(cacheDir, "*.decompressed.img*"))
+		if err != nil {
+			return err
+		}
+
+		if len(files) > 0 {
+			return fmt.Errorf("Decompressed image files were left in %s: %v", cacheDir, files)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckImagesImageV2Exists(n string, image *images.Image) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -364,3 +487,67 @@ var testAccImagesImageV2_timeout = `
         create = "10m"
       }
   }`
+
+var testAccImagesImageV2_webDownload = `
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      web_download = true
+      container_format = "bare"
+      disk_format = "qcow2"
+  }`
+
+var testAccImagesImageV2_attributes_1 = `
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      min_disk_gb = 1
+      min_ram_mb = 512
+      properties {
+        foo = "bar"
+        bar = "foo"
+      }
+  }`
+
+var testAccImagesImageV2_attributes_2 = `
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      min_disk_gb = 2
+      min_ram_mb = 1024
+      protected = true
+      properties {
+        foo = "baz"
+        os_distro = "rancheros"
+      }
+  }`
+
+var testAccImagesImageV2_attributes_3 = `
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      min_disk_gb = 2
+      min_ram_mb = 1024
+      protected = false
+      properties {
+        foo = "baz"
+        os_distro = "rancheros"
+      }
+  }`
+
+var testAccImagesImageV2_upload = `
+  resource "openstack_images_image_v2" "image_1" {
+      name   = "Rancher TerraformAccTest"
+      image_source_url = "https://releases.rancher.com/os/latest/rancheros-openstack.img"
+      container_format = "bare"
+      disk_format = "qcow2"
+      upload_buffer_size_mb = 1
+      verify_checksum = true
+      decompress = true
+  }`
@@ -0,0 +1,189 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImagesMetadefNamespaceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesMetadefNamespaceV2Create,
+		Read:   resourceImagesMetadefNamespaceV2Read,
+		Update: resourceImagesMetadefNamespaceV2Update,
+		Delete: resourceImagesMetadefNamespaceV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"namespace": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"display_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"visibility": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "private",
+				ValidateFunc: resourceImagesMetadefNamespaceV2ValidateVisibility,
+			},
+
+			"protected": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"owner": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"schema": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesMetadefNamespaceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	createOpts := resourceImagesMetadefNamespaceV2Opts(d)
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	namespace, err := imagesV2MetadefNamespaceCreate(imageClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack metadef namespace: %s", err)
+	}
+
+	d.SetId(namespace.Namespace)
+
+	return resourceImagesMetadefNamespaceV2Read(d, meta)
+}
+
+func resourceImagesMetadefNamespaceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, err := imagesV2MetadefNamespaceGet(imageClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "metadef namespace")
+	}
+
+	log.Printf("[DEBUG] Retrieved metadef namespace %s: %#v", d.Id(), namespace)
+
+	d.Set("namespace", namespace.Namespace)
+	d.Set("display_name", namespace.DisplayName)
+	d.Set("description", namespace.Description)
+	d.Set("visibility", namespace.Visibility)
+	d.Set("protected", namespace.Protected)
+	d.Set("owner", namespace.Owner)
+	d.Set("schema", namespace.Schema)
+	d.Set("created_at", namespace.CreatedAt)
+	d.Set("updated_at", namespace.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImagesMetadefNamespaceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	// An update replaces all attributes of the namespace, so the unchanged
+	// ones are sent as well.
+	updateOpts := resourceImagesMetadefNamespaceV2Opts(d)
+
+	log.Printf("[DEBUG] Updating metadef namespace %s with options: %#v", d.Id(), updateOpts)
+	_, err = imagesV2MetadefNamespaceUpdate(imageClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack metadef namespace %s: %s", d.Id(), err)
+	}
+
+	return resourceImagesMetadefNamespaceV2Read(d, meta)
+}
+
+func resourceImagesMetadefNamespaceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Deleting metadef namespace %s", d.Id())
+	err = imagesV2MetadefNamespaceDelete(imageClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack metadef namespace")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceImagesMetadefNamespaceV2Opts(d *schema.ResourceData) ImageMetadefNamespaceV2Opts {
+	protected := d.Get("protected").(bool)
+	return ImageMetadefNamespaceV2Opts{
+		Namespace:   d.Get("namespace").(string),
+		DisplayName: d.Get("display_name").(string),
+		Description: d.Get("description").(string),
+		Visibility:  d.Get("visibility").(string),
+		Protected:   &protected,
+	}
+}
+
+func resourceImagesMetadefNamespaceV2ValidateVisibility(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validVisibilities := []string{"public", "private"}
+
+	for _, visibility := range validVisibilities {
+		if value == visibility {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validVisibilities)
+	errors = append(errors, err)
+	return
+}
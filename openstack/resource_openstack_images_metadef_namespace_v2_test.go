@@ -0,0 +1,123 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccImagesMetadefNamespaceV2_basic(t *testing.T) {
+	var namespace ImageMetadefNamespaceV2
+	namespaceName := fmt.Sprintf("ACCPTTEST::%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesMetadefNamespaceV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesMetadefNamespaceV2_basic(namespaceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefNamespaceV2Exists("openstack_images_metadef_namespace_v2.namespace_1", &namespace),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "namespace", namespaceName),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "display_name", "Acceptance test"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "visibility", "private"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImagesMetadefNamespaceV2_update(namespaceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefNamespaceV2Exists("openstack_images_metadef_namespace_v2.namespace_1", &namespace),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "display_name", "Updated acceptance test"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "description", "Metadata of the acceptance tests"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_namespace_v2.namespace_1", "visibility", "public"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesMetadefNamespaceV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_metadef_namespace_v2" {
+			continue
+		}
+
+		_, err := imagesV2MetadefNamespaceGet(imageClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Metadef namespace still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImagesMetadefNamespaceV2Exists(n string, namespace *ImageMetadefNamespaceV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		imageClient, err := config.imageV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack image client: %s", err)
+		}
+
+		found, err := imagesV2MetadefNamespaceGet(imageClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.Namespace != rs.Primary.ID {
+			return fmt.Errorf("Metadef namespace not found")
+		}
+
+		*namespace = *found
+
+		return nil
+	}
+}
+
+func testAccImagesMetadefNamespaceV2_basic(namespaceName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+      namespace = "%s"
+      display_name = "Acceptance test"
+    }
+  `, namespaceName)
+}
+
+func testAccImagesMetadefNamespaceV2_update(namespaceName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+      namespace = "%s"
+      display_name = "Updated acceptance test"
+      description = "Metadata of the acceptance tests"
+      visibility = "public"
+    }
+  `, namespaceName)
+}
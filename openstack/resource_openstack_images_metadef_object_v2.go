@@ -0,0 +1,216 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImagesMetadefObjectV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesMetadefObjectV2Create,
+		Read:   resourceImagesMetadefObjectV2Read,
+		Update: resourceImagesMetadefObjectV2Update,
+		Delete: resourceImagesMetadefObjectV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"namespace": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"required": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"properties": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+			},
+
+			"schema": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceImagesMetadefObjectV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace := d.Get("namespace").(string)
+	createOpts, err := resourceImagesMetadefObjectV2Opts(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	object, err := imagesV2MetadefObjectCreate(imageClient, namespace, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack metadef object in namespace %s: %s", namespace, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", namespace, object.Name))
+
+	return resourceImagesMetadefObjectV2Read(d, meta)
+}
+
+func resourceImagesMetadefObjectV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	object, err := imagesV2MetadefObjectGet(imageClient, namespace, name).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "metadef object")
+	}
+
+	log.Printf("[DEBUG] Retrieved metadef object %s: %#v", d.Id(), object)
+
+	properties, err := imagesV2MetadefEncodeJSON(object.Properties)
+	if err != nil {
+		return fmt.Errorf("Error encoding properties of OpenStack metadef object %s: %s", d.Id(), err)
+	}
+
+	d.Set("namespace", namespace)
+	d.Set("name", object.Name)
+	d.Set("description", object.Description)
+	d.Set("required", object.Required)
+	d.Set("properties", properties)
+	d.Set("schema", object.Schema)
+	d.Set("created_at", object.CreatedAt)
+	d.Set("updated_at", object.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImagesMetadefObjectV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// An update replaces all attributes of the object, so the unchanged ones
+	// are sent as well.
+	updateOpts, err := resourceImagesMetadefObjectV2Opts(d)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating metadef object %s with options: %#v", d.Id(), updateOpts)
+	_, err = imagesV2MetadefObjectUpdate(imageClient, namespace, name, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack metadef object %s: %s", d.Id(), err)
+	}
+
+	return resourceImagesMetadefObjectV2Read(d, meta)
+}
+
+func resourceImagesMetadefObjectV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting metadef object %s", d.Id())
+	err = imagesV2MetadefObjectDelete(imageClient, namespace, name).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack metadef object")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceImagesMetadefObjectV2Opts(d *schema.ResourceData) (ImageMetadefObjectV2Opts, error) {
+	properties, err := imagesV2MetadefDecodeJSON(d.Get("properties").(string))
+	if err != nil {
+		return ImageMetadefObjectV2Opts{}, fmt.Errorf("Error decoding properties: %s", err)
+	}
+
+	var required []string
+	for _, v := range d.Get("required").([]interface{}) {
+		required = append(required, v.(string))
+	}
+
+	return ImageMetadefObjectV2Opts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Required:    required,
+		Properties:  properties,
+	}, nil
+}
+
+// parseImagesMetadefV2ID splits the ID of a metadef object or property
+// resource, which has the form <namespace>/<name>.
+func parseImagesMetadefV2ID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine metadef ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
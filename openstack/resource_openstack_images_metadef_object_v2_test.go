@@ -0,0 +1,156 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccImagesMetadefObjectV2_basic(t *testing.T) {
+	var object ImageMetadefObjectV2
+	namespaceName := fmt.Sprintf("ACCPTTEST::%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesMetadefObjectV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesMetadefObjectV2_basic(namespaceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefObjectV2Exists("openstack_images_metadef_object_v2.object_1", &object),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_object_v2.object_1", "name", "Watchdog"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_object_v2.object_1", "required.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImagesMetadefObjectV2_update(namespaceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefObjectV2Exists("openstack_images_metadef_object_v2.object_1", &object),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_object_v2.object_1", "description", "Watchdog device of the instance"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_object_v2.object_1", "required.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesMetadefObjectV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_metadef_object_v2" {
+			continue
+		}
+
+		namespace, name, err := parseImagesMetadefV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = imagesV2MetadefObjectGet(imageClient, namespace, name).Extract()
+		if err == nil {
+			return fmt.Errorf("Metadef object still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImagesMetadefObjectV2Exists(n string, object *ImageMetadefObjectV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		imageClient, err := config.imageV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack image client: %s", err)
+		}
+
+		namespace, name, err := parseImagesMetadefV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := imagesV2MetadefObjectGet(imageClient, namespace, name).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.Name != name {
+			return fmt.Errorf("Metadef object not found")
+		}
+
+		*object = *found
+
+		return nil
+	}
+}
+
+func testAccImagesMetadefObjectV2_basic(namespaceName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+      namespace = "%s"
+    }
+
+    resource "openstack_images_metadef_object_v2" "object_1" {
+      namespace = "${openstack_images_metadef_namespace_v2.namespace_1.namespace}"
+      name = "Watchdog"
+      required = ["hw_watchdog_action"]
+      properties = <<EOF
+{
+  "hw_watchdog_action": {
+    "title": "Watchdog Action",
+    "type": "string",
+    "enum": ["disabled", "reset", "poweroff", "pause", "none"]
+  }
+}
+EOF
+    }
+  `, namespaceName)
+}
+
+func testAccImagesMetadefObjectV2_update(namespaceName string) string {
+	return fmt.Sprintf(`
+    resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+      namespace = "%s"
+    }
+
+    resource "openstack_images_metadef_object_v2" "object_1" {
+      namespace = "${openstack_images_metadef_namespace_v2.namespace_1.namespace}"
+      name = "Watchdog"
+      description = "Watchdog device of the instance"
+      properties = <<EOF
+{
+  "hw_watchdog_action": {
+    "title": "Watchdog Action",
+    "type": "string",
+    "enum": ["disabled", "reset", "poweroff", "pause", "none"],
+    "default": "disabled"
+  }
+}
+EOF
+    }
+  `, namespaceName)
+}
@@ -0,0 +1,153 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceImagesMetadefPropertyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceImagesMetadefPropertyV2Create,
+		Read:   resourceImagesMetadefPropertyV2Read,
+		Update: resourceImagesMetadefPropertyV2Update,
+		Delete: resourceImagesMetadefPropertyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"namespace": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"definition": &schema.Schema{
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+			},
+		},
+	}
+}
+
+func resourceImagesMetadefPropertyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace := d.Get("namespace").(string)
+	name := d.Get("name").(string)
+	definition, err := imagesV2MetadefDecodeJSON(d.Get("definition").(string))
+	if err != nil {
+		return fmt.Errorf("Error decoding definition: %s", err)
+	}
+
+	log.Printf("[DEBUG] Creating metadef property %s in namespace %s: %#v", name, namespace, definition)
+	_, err = imagesV2MetadefPropertyCreate(imageClient, namespace, name, definition).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack metadef property %s in namespace %s: %s", name, namespace, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", namespace, name))
+
+	return resourceImagesMetadefPropertyV2Read(d, meta)
+}
+
+func resourceImagesMetadefPropertyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	definition, err := imagesV2MetadefPropertyGet(imageClient, namespace, name).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "metadef property")
+	}
+
+	log.Printf("[DEBUG] Retrieved metadef property %s: %#v", d.Id(), definition)
+
+	rawDefinition, err := imagesV2MetadefEncodeJSON(definition)
+	if err != nil {
+		return fmt.Errorf("Error encoding definition of OpenStack metadef property %s: %s", d.Id(), err)
+	}
+
+	d.Set("namespace", namespace)
+	d.Set("name", name)
+	d.Set("definition", rawDefinition)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceImagesMetadefPropertyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	definition, err := imagesV2MetadefDecodeJSON(d.Get("definition").(string))
+	if err != nil {
+		return fmt.Errorf("Error decoding definition: %s", err)
+	}
+
+	log.Printf("[DEBUG] Updating metadef property %s: %#v", d.Id(), definition)
+	_, err = imagesV2MetadefPropertyUpdate(imageClient, namespace, name, definition).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack metadef property %s: %s", d.Id(), err)
+	}
+
+	return resourceImagesMetadefPropertyV2Read(d, meta)
+}
+
+func resourceImagesMetadefPropertyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	imageClient, err := config.imageV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	namespace, name, err := parseImagesMetadefV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting metadef property %s", d.Id())
+	err = imagesV2MetadefPropertyDelete(imageClient, namespace, name).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack metadef property")
+	}
+
+	d.SetId("")
+	return nil
+}
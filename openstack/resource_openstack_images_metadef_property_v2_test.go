@@ -0,0 +1,113 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccImagesMetadefPropertyV2_basic(t *testing.T) {
+	namespaceName := fmt.Sprintf("ACCPTTEST::%s", acctest.RandString(5))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckImagesMetadefPropertyV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccImagesMetadefPropertyV2_basic(namespaceName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefPropertyV2Exists("openstack_images_metadef_property_v2.property_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_images_metadef_property_v2.property_1", "name", "hw_cpu_cores"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccImagesMetadefPropertyV2_basic(namespaceName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckImagesMetadefPropertyV2Exists("openstack_images_metadef_property_v2.property_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckImagesMetadefPropertyV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	imageClient, err := config.imageV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack image client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_images_metadef_property_v2" {
+			continue
+		}
+
+		namespace, name, err := parseImagesMetadefV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = imagesV2MetadefPropertyGet(imageClient, namespace, name).Extract()
+		if err == nil {
+			return fmt.Errorf("Metadef property still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckImagesMetadefPropertyV2Exists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		imageClient, err := config.imageV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack image client: %s", err)
+		}
+
+		namespace, name, err := parseImagesMetadefV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = imagesV2MetadefPropertyGet(imageClient, namespace, name).Extract()
+		return err
+	}
+}
+
+func testAccImagesMetadefPropertyV2_basic(namespaceName string, minimum int) string {
+	return fmt.Sprintf(`
+    resource "openstack_images_metadef_namespace_v2" "namespace_1" {
+      namespace = "%s"
+    }
+
+    resource "openstack_images_metadef_property_v2" "property_1" {
+      namespace = "${openstack_images_metadef_namespace_v2.namespace_1.namespace}"
+      name = "hw_cpu_cores"
+      definition = <<EOF
+{
+  "title": "vCPU Cores",
+  "description": "Preferred number of cores per socket.",
+  "type": "integer",
+  "minimum": %d
+}
+EOF
+    }
+  `, namespaceName, minimum)
+}
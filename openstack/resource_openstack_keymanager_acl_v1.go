@@ -0,0 +1,207 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceKeyManagerACLV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyManagerACLV1Create,
+		Read:   resourceKeyManagerACLV1Read,
+		Update: resourceKeyManagerACLV1Update,
+		Delete: resourceKeyManagerACLV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"secret_ref": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"container_ref"},
+			},
+			"container_ref": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"secret_ref"},
+			},
+			"project_access": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"users": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyManagerACLV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	var kind, ref string
+	if v, ok := d.GetOk("secret_ref"); ok {
+		kind, ref = "secrets", v.(string)
+	} else if v, ok := d.GetOk("container_ref"); ok {
+		kind, ref = "containers", v.(string)
+	} else {
+		return fmt.Errorf("One of secret_ref or container_ref must be set")
+	}
+
+	id := keyManagerV1RefID(ref)
+	setOpts := resourceKeyManagerACLV1SetOpts(d)
+
+	log.Printf("[DEBUG] Setting ACL of key manager %s %s: %#v", kind, id, setOpts)
+	err = keyManagerV1ACLSet(kmClient, kind, id, setOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error setting ACL of OpenStack key manager %s %s: %s", kind, id, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", kind, id))
+
+	return resourceKeyManagerACLV1Read(d, meta)
+}
+
+func resourceKeyManagerACLV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	kind, id, err := parseKeyManagerACLV1ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	acl, err := keyManagerV1ACLGet(kmClient, kind, id).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "key manager ACL")
+	}
+
+	log.Printf("[DEBUG] Retrieved ACL of key manager %s %s: %+v", kind, id, acl)
+
+	// Secrets and containers without an ACL only return the default.
+	if acl == nil {
+		acl = &KeyManagerACLV1{ProjectAccess: true}
+	}
+
+	// The configured ref is kept, because Barbican may build refs from
+	// another host name than the one in the service catalog. It's only
+	// derived from the ID after an import.
+	if d.Get("secret_ref").(string) == "" && d.Get("container_ref").(string) == "" {
+		ref := kmClient.ServiceURL(kind, id)
+		if kind == "secrets" {
+			d.Set("secret_ref", ref)
+		} else {
+			d.Set("container_ref", ref)
+		}
+	}
+
+	d.Set("project_access", acl.ProjectAccess)
+	d.Set("users", acl.Users)
+	d.Set("created_at", acl.Created)
+	d.Set("updated_at", acl.Updated)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceKeyManagerACLV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	kind, id, err := parseKeyManagerACLV1ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	setOpts := resourceKeyManagerACLV1SetOpts(d)
+
+	log.Printf("[DEBUG] Updating ACL of key manager %s %s: %#v", kind, id, setOpts)
+	err = keyManagerV1ACLSet(kmClient, kind, id, setOpts).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error updating ACL of OpenStack key manager %s %s: %s", kind, id, err)
+	}
+
+	return resourceKeyManagerACLV1Read(d, meta)
+}
+
+func resourceKeyManagerACLV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	kind, id, err := parseKeyManagerACLV1ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reverting ACL of key manager %s %s to the default", kind, id)
+	err = keyManagerV1ACLDelete(kmClient, kind, id).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack key manager ACL")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKeyManagerACLV1SetOpts(d *schema.ResourceData) KeyManagerACLV1SetOpts {
+	rawUsers := d.Get("users").(*schema.Set).List()
+	users := make([]string, len(rawUsers))
+	for i, raw := range rawUsers {
+		users[i] = raw.(string)
+	}
+
+	return KeyManagerACLV1SetOpts{
+		ProjectAccess: d.Get("project_access").(bool),
+		Users:         users,
+	}
+}
+
+// parseKeyManagerACLV1ID splits the ID of an ACL resource, which has the
+// form secrets/<secret id> or containers/<container id>.
+func parseKeyManagerACLV1ID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || (idParts[0] != "secrets" && idParts[0] != "containers") || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine key manager ACL ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,141 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccKeyManagerACLV1_basic(t *testing.T) {
+	var acl KeyManagerACLV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerACLV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerACLV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerACLV1Exists("openstack_keymanager_acl_v1.acl_1", &acl),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_acl_v1.acl_1", "project_access", "false"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_acl_v1.acl_1", "users.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccKeyManagerACLV1_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerACLV1Exists("openstack_keymanager_acl_v1.acl_1", &acl),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_acl_v1.acl_1", "project_access", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_acl_v1.acl_1", "users.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+// After an ACL is deleted, Barbican reports the default ACL of the secret,
+// which only grants access to its project.
+func testAccCheckKeyManagerACLV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_keymanager_acl_v1" {
+			continue
+		}
+
+		kind, id, err := parseKeyManagerACLV1ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		acl, err := keyManagerV1ACLGet(kmClient, kind, id).Extract()
+		if err == nil && acl != nil && len(acl.Users) > 0 {
+			return fmt.Errorf("Key manager ACL still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKeyManagerACLV1Exists(n string, acl *KeyManagerACLV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+		}
+
+		kind, id, err := parseKeyManagerACLV1ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := keyManagerV1ACLGet(kmClient, kind, id).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found == nil {
+			return fmt.Errorf("Key manager ACL not found")
+		}
+
+		*acl = *found
+
+		return nil
+	}
+}
+
+const testAccKeyManagerACLV1_basic = `
+resource "openstack_identity_user_v3" "user_1" {
+  name = "user_1"
+}
+
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "secret_1"
+  payload = "secret payload"
+  payload_content_type = "text/plain"
+}
+
+resource "openstack_keymanager_acl_v1" "acl_1" {
+  secret_ref = "${openstack_keymanager_secret_v1.secret_1.secret_ref}"
+  project_access = false
+  users = ["${openstack_identity_user_v3.user_1.id}"]
+}
+`
+
+const testAccKeyManagerACLV1_update = `
+resource "openstack_identity_user_v3" "user_1" {
+  name = "user_1"
+}
+
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "secret_1"
+  payload = "secret payload"
+  payload_content_type = "text/plain"
+}
+
+resource "openstack_keymanager_acl_v1" "acl_1" {
+  secret_ref = "${openstack_keymanager_secret_v1.secret_1.secret_ref}"
+  project_access = true
+}
+`
@@ -0,0 +1,251 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// keyManagerContainerV1SecretNames are the names of the secrets which can be
+// stored in the container types with a fixed layout.
+var keyManagerContainerV1SecretNames = map[string][]string{
+	"certificate": []string{"certificate", "private_key", "private_key_passphrase", "intermediates"},
+	"rsa":         []string{"private_key", "private_key_passphrase", "public_key"},
+}
+
+func resourceKeyManagerContainerV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyManagerContainerV1Create,
+		Read:   resourceKeyManagerContainerV1Read,
+		Delete: resourceKeyManagerContainerV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceKeyManagerContainerV1ValidType,
+			},
+			"secret_refs": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"secret_ref": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"container_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"consumers": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"url": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"creator_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyManagerContainerV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	containerType := d.Get("type").(string)
+	secretRefs := resourceKeyManagerContainerV1SecretRefs(d.Get("secret_refs").(*schema.Set))
+	if err := resourceKeyManagerContainerV1ValidateSecretRefs(containerType, secretRefs); err != nil {
+		return err
+	}
+
+	createOpts := KeyManagerContainerV1CreateOpts{
+		Name:       d.Get("name").(string),
+		Type:       containerType,
+		SecretRefs: secretRefs,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	container, err := keyManagerV1ContainerCreate(kmClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager container: %s", err)
+	}
+
+	containerID := keyManagerV1RefID(container.ContainerRef)
+	log.Printf("[INFO] Key manager container ID: %s", containerID)
+
+	d.SetId(containerID)
+
+	return resourceKeyManagerContainerV1Read(d, meta)
+}
+
+func resourceKeyManagerContainerV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	container, err := keyManagerV1ContainerGet(kmClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "key manager container")
+	}
+
+	log.Printf("[DEBUG] Retrieved key manager container %s: %+v", d.Id(), container)
+
+	d.Set("name", container.Name)
+	d.Set("type", container.Type)
+	d.Set("container_ref", container.ContainerRef)
+	d.Set("status", container.Status)
+	d.Set("creator_id", container.CreatorID)
+	d.Set("created_at", container.Created)
+	d.Set("updated_at", container.Updated)
+	d.Set("region", GetRegion(d, config))
+
+	secretRefs := make([]map[string]interface{}, len(container.SecretRefs))
+	for i, secretRef := range container.SecretRefs {
+		secretRefs[i] = map[string]interface{}{
+			"name":       secretRef.Name,
+			"secret_ref": secretRef.SecretRef,
+		}
+	}
+	d.Set("secret_refs", secretRefs)
+
+	consumers := make([]map[string]interface{}, len(container.Consumers))
+	for i, consumer := range container.Consumers {
+		consumers[i] = map[string]interface{}{
+			"name": consumer.Name,
+			"url":  consumer.URL,
+		}
+	}
+	d.Set("consumers", consumers)
+
+	return nil
+}
+
+func resourceKeyManagerContainerV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	err = keyManagerV1ContainerDelete(kmClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack key manager container")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKeyManagerContainerV1SecretRefs(set *schema.Set) []KeyManagerContainerV1SecretRef {
+	var secretRefs []KeyManagerContainerV1SecretRef
+	for _, raw := range set.List() {
+		v := raw.(map[string]interface{})
+		secretRefs = append(secretRefs, KeyManagerContainerV1SecretRef{
+			Name:      v["name"].(string),
+			SecretRef: v["secret_ref"].(string),
+		})
+	}
+	return secretRefs
+}
+
+// resourceKeyManagerContainerV1ValidateSecretRefs checks that certificate and
+// rsa containers only hold secrets with the names defined for their type.
+func resourceKeyManagerContainerV1ValidateSecretRefs(containerType string, secretRefs []KeyManagerContainerV1SecretRef) error {
+	validNames, ok := keyManagerContainerV1SecretNames[containerType]
+	if !ok {
+		return nil
+	}
+
+	for _, secretRef := range secretRefs {
+		var valid bool
+		for _, name := range validNames {
+			if secretRef.Name == name {
+				valid = true
+				break
+			}
+		}
+
+		if !valid {
+			return fmt.Errorf("The secret names of a %s container must be one of %s, got %s",
+				containerType, validNames, secretRef.Name)
+		}
+	}
+
+	return nil
+}
+
+func resourceKeyManagerContainerV1ValidType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := []string{
+		"generic",
+		"rsa",
+		"certificate",
+	}
+
+	for _, v := range validTypes {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validTypes)
+	errors = append(errors, err)
+	return
+}
@@ -0,0 +1,122 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccKeyManagerContainerV1_basic(t *testing.T) {
+	var container KeyManagerContainerV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerContainerV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerContainerV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerContainerV1Exists(
+						"openstack_keymanager_container_v1.container_1", &container),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_container_v1.container_1", "name", "container_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_container_v1.container_1", "type", "certificate"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_container_v1.container_1", "secret_refs.#", "2"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_keymanager_container_v1.container_1", "container_ref"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyManagerContainerV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_keymanager_container_v1" {
+			continue
+		}
+
+		_, err := keyManagerV1ContainerGet(kmClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Container still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKeyManagerContainerV1Exists(n string, container *KeyManagerContainerV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+		}
+
+		found, err := keyManagerV1ContainerGet(kmClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if keyManagerV1RefID(found.ContainerRef) != rs.Primary.ID {
+			return fmt.Errorf("Container not found")
+		}
+
+		*container = *found
+
+		return nil
+	}
+}
+
+const testAccKeyManagerContainerV1_basic = `
+resource "openstack_keymanager_secret_v1" "certificate_1" {
+  name = "certificate"
+  secret_type = "certificate"
+  payload = "Y2VydGlmaWNhdGU="
+  payload_content_type = "application/octet-stream"
+  payload_content_encoding = "base64"
+}
+
+resource "openstack_keymanager_secret_v1" "private_key_1" {
+  name = "private_key"
+  secret_type = "private"
+  payload = "cHJpdmF0ZSBrZXk="
+  payload_content_type = "application/octet-stream"
+  payload_content_encoding = "base64"
+}
+
+resource "openstack_keymanager_container_v1" "container_1" {
+  name = "container_1"
+  type = "certificate"
+
+  secret_refs {
+    name = "certificate"
+    secret_ref = "${openstack_keymanager_secret_v1.certificate_1.secret_ref}"
+  }
+
+  secret_refs {
+    name = "private_key"
+    secret_ref = "${openstack_keymanager_secret_v1.private_key_1.secret_ref}"
+  }
+}
+`
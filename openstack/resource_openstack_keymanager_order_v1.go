@@ -0,0 +1,260 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceKeyManagerOrderV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyManagerOrderV1Create,
+		Read:   resourceKeyManagerOrderV1Read,
+		Delete: resourceKeyManagerOrderV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceKeyManagerOrderV1ValidType,
+			},
+			"meta": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"algorithm": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"bit_length": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+							ForceNew: true,
+						},
+						"mode": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"payload_content_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"expiration": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     resourceKeyManagerSecretV1ValidExpiration,
+							DiffSuppressFunc: resourceKeyManagerSecretV1ExpirationDiffSuppress,
+						},
+					},
+				},
+			},
+			"order_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"container_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sub_status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sub_status_message": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"creator_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyManagerOrderV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	rawMeta := d.Get("meta").([]interface{})[0].(map[string]interface{})
+	createOpts := KeyManagerOrderV1CreateOpts{
+		Type: d.Get("type").(string),
+		Meta: KeyManagerOrderV1Meta{
+			Name:               rawMeta["name"].(string),
+			Algorithm:          rawMeta["algorithm"].(string),
+			BitLength:          rawMeta["bit_length"].(int),
+			Mode:               rawMeta["mode"].(string),
+			PayloadContentType: rawMeta["payload_content_type"].(string),
+			Expiration:         rawMeta["expiration"].(string),
+		},
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	order, err := keyManagerV1OrderCreate(kmClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager order: %s", err)
+	}
+
+	orderID := keyManagerV1RefID(order.OrderRef)
+	log.Printf("[INFO] Key manager order ID: %s", orderID)
+
+	d.SetId(orderID)
+
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"ACTIVE"},
+		Pending:    []string{"PENDING"},
+		Refresh:    resourceKeyManagerOrderV1RefreshFunc(kmClient, orderID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack key manager order %s to become active: %s", orderID, err)
+	}
+
+	return resourceKeyManagerOrderV1Read(d, meta)
+}
+
+func resourceKeyManagerOrderV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	order, err := keyManagerV1OrderGet(kmClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "key manager order")
+	}
+
+	log.Printf("[DEBUG] Retrieved key manager order %s: %+v", d.Id(), order)
+
+	d.Set("type", order.Type)
+	d.Set("order_ref", order.OrderRef)
+	d.Set("secret_ref", order.SecretRef)
+	d.Set("container_ref", order.ContainerRef)
+	d.Set("status", order.Status)
+	d.Set("sub_status", order.SubStatus)
+	d.Set("sub_status_message", order.SubStatusMessage)
+	d.Set("creator_id", order.CreatorID)
+	d.Set("created_at", order.Created)
+	d.Set("updated_at", order.Updated)
+	d.Set("region", GetRegion(d, config))
+
+	orderMeta := []map[string]interface{}{
+		{
+			"name":                 order.Meta.Name,
+			"algorithm":            order.Meta.Algorithm,
+			"bit_length":           order.Meta.BitLength,
+			"mode":                 order.Meta.Mode,
+			"payload_content_type": order.Meta.PayloadContentType,
+			"expiration":           order.Meta.Expiration,
+		},
+	}
+	d.Set("meta", orderMeta)
+
+	return nil
+}
+
+// resourceKeyManagerOrderV1Delete only deletes the order. The generated
+// secret or container is kept.
+func resourceKeyManagerOrderV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	err = keyManagerV1OrderDelete(kmClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack key manager order")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKeyManagerOrderV1RefreshFunc(kmClient *gophercloud.ServiceClient, orderID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		order, err := keyManagerV1OrderGet(kmClient, orderID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if order.Status == "ERROR" {
+			return order, order.Status, fmt.Errorf("The order failed: %s", order.ErrorReason)
+		}
+
+		log.Printf("[DEBUG] OpenStack key manager order %s current status: %s", orderID, order.Status)
+		return order, order.Status, nil
+	}
+}
+
+func resourceKeyManagerOrderV1ValidType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := []string{
+		"key",
+		"asymmetric",
+	}
+
+	for _, v := range validTypes {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validTypes)
+	errors = append(errors, err)
+	return
+}
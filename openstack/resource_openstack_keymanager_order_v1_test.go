@@ -0,0 +1,133 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccKeyManagerOrderV1_symmetric(t *testing.T) {
+	var order KeyManagerOrderV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerOrderV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerOrderV1_symmetric,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerOrderV1Exists("openstack_keymanager_order_v1.order_1", &order),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_order_v1.order_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_order_v1.order_1", "meta.0.algorithm", "aes"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_keymanager_order_v1.order_1", "secret_ref"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyManagerOrderV1_asymmetric(t *testing.T) {
+	var order KeyManagerOrderV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerOrderV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerOrderV1_asymmetric,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerOrderV1Exists("openstack_keymanager_order_v1.order_1", &order),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_order_v1.order_1", "status", "ACTIVE"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_keymanager_order_v1.order_1", "container_ref"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyManagerOrderV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_keymanager_order_v1" {
+			continue
+		}
+
+		_, err := keyManagerV1OrderGet(kmClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Order still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKeyManagerOrderV1Exists(n string, order *KeyManagerOrderV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+		}
+
+		found, err := keyManagerV1OrderGet(kmClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if keyManagerV1RefID(found.OrderRef) != rs.Primary.ID {
+			return fmt.Errorf("Order not found")
+		}
+
+		*order = *found
+
+		return nil
+	}
+}
+
+const testAccKeyManagerOrderV1_symmetric = `
+resource "openstack_keymanager_order_v1" "order_1" {
+  type = "key"
+
+  meta {
+    name = "key_1"
+    algorithm = "aes"
+    bit_length = 256
+    mode = "cbc"
+  }
+}
+`
+
+const testAccKeyManagerOrderV1_asymmetric = `
+resource "openstack_keymanager_order_v1" "order_1" {
+  type = "asymmetric"
+
+  meta {
+    name = "key_pair_1"
+    algorithm = "rsa"
+    bit_length = 2048
+  }
+}
+`
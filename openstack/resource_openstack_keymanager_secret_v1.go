@@ -0,0 +1,353 @@
+package openstack
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceKeyManagerSecretV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceKeyManagerSecretV1Create,
+		Read:   resourceKeyManagerSecretV1Read,
+		Update: resourceKeyManagerSecretV1Update,
+		Delete: resourceKeyManagerSecretV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"secret_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceKeyManagerSecretV1ValidSecretType,
+			},
+			"algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"bit_length": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"expiration": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     resourceKeyManagerSecretV1ValidExpiration,
+				DiffSuppressFunc: resourceKeyManagerSecretV1ExpirationDiffSuppress,
+			},
+			"payload": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
+			},
+			"payload_content_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceKeyManagerSecretV1ValidPayloadContentType,
+			},
+			"payload_content_encoding": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceKeyManagerSecretV1ValidPayloadContentEncoding,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"secret_ref": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"content_types": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"creator_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceKeyManagerSecretV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	createOpts := SecretV1CreateOpts{
+		Name:                   d.Get("name").(string),
+		SecretType:             d.Get("secret_type").(string),
+		Algorithm:              d.Get("algorithm").(string),
+		BitLength:              d.Get("bit_length").(int),
+		Mode:                   d.Get("mode").(string),
+		Expiration:             d.Get("expiration").(string),
+		PayloadContentType:     d.Get("payload_content_type").(string),
+		PayloadContentEncoding: d.Get("payload_content_encoding").(string),
+	}
+
+	if createOpts.PayloadContentEncoding != "" && createOpts.PayloadContentType == "" {
+		return fmt.Errorf("payload_content_type must be set if payload_content_encoding is set")
+	}
+
+	payload := d.Get("payload").(string)
+	if payload != "" && createOpts.PayloadContentType == "" {
+		return fmt.Errorf("payload_content_type must be set if payload is set")
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+
+	// The payload is added after logging the options to keep it out of the
+	// logs.
+	createOpts.Payload = payload
+
+	secret, err := keyManagerV1SecretCreate(kmClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager secret: %s", err)
+	}
+
+	secretID := keyManagerV1RefID(secret.SecretRef)
+	log.Printf("[INFO] Key manager secret ID: %s", secretID)
+
+	d.SetId(secretID)
+
+	metadata := resourceKeyManagerSecretV1Metadata(d)
+	if len(metadata) > 0 {
+		_, err = keyManagerV1SecretMetadataUpdate(kmClient, secretID, metadata).Extract()
+		if err != nil {
+			return fmt.Errorf("Error setting metadata of OpenStack key manager secret %s: %s", secretID, err)
+		}
+	}
+
+	return resourceKeyManagerSecretV1Read(d, meta)
+}
+
+func resourceKeyManagerSecretV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	secret, err := keyManagerV1SecretGet(kmClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "key manager secret")
+	}
+
+	log.Printf("[DEBUG] Retrieved key manager secret %s: %+v", d.Id(), secret)
+
+	d.Set("name", secret.Name)
+	d.Set("secret_type", secret.SecretType)
+	d.Set("algorithm", secret.Algorithm)
+	d.Set("bit_length", secret.BitLength)
+	d.Set("mode", secret.Mode)
+	d.Set("expiration", secret.Expiration)
+	d.Set("secret_ref", secret.SecretRef)
+	d.Set("status", secret.Status)
+	d.Set("content_types", secret.ContentTypes)
+	d.Set("creator_id", secret.CreatorID)
+	d.Set("created_at", secret.Created)
+	d.Set("updated_at", secret.Updated)
+	d.Set("region", GetRegion(d, config))
+
+	// A secret without content types has no payload.
+	if len(secret.ContentTypes) > 0 {
+		contentType := d.Get("payload_content_type").(string)
+		if contentType == "" {
+			contentType = secret.ContentTypes["default"]
+		}
+
+		payload, err := keyManagerV1SecretPayload(kmClient, d.Id(), contentType)
+		if err != nil {
+			return fmt.Errorf("Error retrieving payload of OpenStack key manager secret %s: %s", d.Id(), err)
+		}
+
+		if d.Get("payload_content_encoding").(string) == "base64" {
+			d.Set("payload", base64.StdEncoding.EncodeToString(payload))
+		} else {
+			d.Set("payload", string(payload))
+		}
+		d.Set("payload_content_type", contentType)
+	}
+
+	metadata, err := keyManagerV1SecretMetadataGet(kmClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving metadata of OpenStack key manager secret %s: %s", d.Id(), err)
+	}
+	d.Set("metadata", metadata)
+
+	return nil
+}
+
+func resourceKeyManagerSecretV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	if d.HasChange("metadata") {
+		metadata := resourceKeyManagerSecretV1Metadata(d)
+
+		log.Printf("[DEBUG] Updating metadata of key manager secret %s: %#v", d.Id(), metadata)
+		_, err = keyManagerV1SecretMetadataUpdate(kmClient, d.Id(), metadata).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating metadata of OpenStack key manager secret %s: %s", d.Id(), err)
+		}
+	}
+
+	return resourceKeyManagerSecretV1Read(d, meta)
+}
+
+func resourceKeyManagerSecretV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	kmClient, err := config.keyManagerV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	err = keyManagerV1SecretDelete(kmClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack key manager secret")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceKeyManagerSecretV1Metadata(d *schema.ResourceData) map[string]string {
+	m := make(map[string]string)
+	for key, val := range d.Get("metadata").(map[string]interface{}) {
+		m[key] = val.(string)
+	}
+	return m
+}
+
+func resourceKeyManagerSecretV1ValidSecretType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := []string{
+		"symmetric",
+		"public",
+		"private",
+		"passphrase",
+		"certificate",
+		"opaque",
+	}
+
+	for _, v := range validTypes {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validTypes)
+	errors = append(errors, err)
+	return
+}
+
+func resourceKeyManagerSecretV1ValidPayloadContentType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validContentTypes := []string{
+		"text/plain",
+		"text/plain;charset=utf-8",
+		"text/plain; charset=utf-8",
+		"application/octet-stream",
+		"application/pkcs8",
+		"application/pkix-cert",
+	}
+
+	for _, v := range validContentTypes {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validContentTypes)
+	errors = append(errors, err)
+	return
+}
+
+func resourceKeyManagerSecretV1ValidPayloadContentEncoding(v interface{}, k string) (ws []string, errors []error) {
+	if v.(string) != "base64" {
+		errors = append(errors, fmt.Errorf("%s must be base64", k))
+	}
+	return
+}
+
+func resourceKeyManagerSecretV1ValidExpiration(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := time.Parse(time.RFC3339, v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%s must be an RFC3339 timestamp: %s", k, err))
+	}
+	return
+}
+
+// resourceKeyManagerSecretV1ExpirationDiffSuppress suppresses the difference
+// between the configured expiration and the one returned by the key manager,
+// which is formatted differently and has no time zone.
+func resourceKeyManagerSecretV1ExpirationDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := resourceKeyManagerSecretV1ParseExpiration(old)
+	if err != nil {
+		return false
+	}
+
+	newTime, err := resourceKeyManagerSecretV1ParseExpiration(new)
+	if err != nil {
+		return false
+	}
+
+	return oldTime.Equal(newTime)
+}
+
+func resourceKeyManagerSecretV1ParseExpiration(expiration string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, expiration)
+	if err == nil {
+		return t, nil
+	}
+
+	return time.Parse("2006-01-02T15:04:05.999999", expiration)
+}
@@ -0,0 +1,145 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccKeyManagerSecretV1_basic(t *testing.T) {
+	var secret SecretV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerSecretV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerSecretV1_basic("foo"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerSecretV1Exists("openstack_keymanager_secret_v1.secret_1", &secret),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "name", "secret_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "payload", "secret payload"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "secret_type", "passphrase"),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "metadata.purpose", "foo"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_keymanager_secret_v1.secret_1", "secret_ref"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccKeyManagerSecretV1_basic("bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "metadata.purpose", "bar"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKeyManagerSecretV1_base64(t *testing.T) {
+	var secret SecretV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckKeyManager(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckKeyManagerSecretV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccKeyManagerSecretV1_base64,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckKeyManagerSecretV1Exists("openstack_keymanager_secret_v1.secret_1", &secret),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "payload", "c2VjcmV0IHBheWxvYWQ="),
+					resource.TestCheckResourceAttr(
+						"openstack_keymanager_secret_v1.secret_1", "payload_content_type", "application/octet-stream"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckKeyManagerSecretV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_keymanager_secret_v1" {
+			continue
+		}
+
+		_, err := keyManagerV1SecretGet(kmClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Secret still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckKeyManagerSecretV1Exists(n string, secret *SecretV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		kmClient, err := config.keyManagerV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack key manager client: %s", err)
+		}
+
+		found, err := keyManagerV1SecretGet(kmClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if keyManagerV1RefID(found.SecretRef) != rs.Primary.ID {
+			return fmt.Errorf("Secret not found")
+		}
+
+		*secret = *found
+
+		return nil
+	}
+}
+
+func testAccKeyManagerSecretV1_basic(purpose string) string {
+	return fmt.Sprintf(`
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "secret_1"
+  secret_type = "passphrase"
+  payload = "secret payload"
+  payload_content_type = "text/plain"
+
+  metadata {
+    purpose = "%s"
+  }
+}
+`, purpose)
+}
+
+const testAccKeyManagerSecretV1_base64 = `
+resource "openstack_keymanager_secret_v1" "secret_1" {
+  name = "secret_1"
+  secret_type = "opaque"
+  payload = "c2VjcmV0IHBheWxvYWQ="
+  payload_content_type = "application/octet-stream"
+  payload_content_encoding = "base64"
+  expiration = "2038-01-01T00:00:00Z"
+}
+`
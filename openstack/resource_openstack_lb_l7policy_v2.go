@@ -0,0 +1,315 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceL7PolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7PolicyV2Create,
+		Read:   resourceL7PolicyV2Read,
+		Update: resourceL7PolicyV2Update,
+		Delete: resourceL7PolicyV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"action": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "REDIRECT_TO_POOL" && value != "REDIRECT_TO_URL" && value != "REJECT" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'REDIRECT_TO_POOL', 'REDIRECT_TO_URL' and 'REJECT' are supported values for 'action'"))
+					}
+					return
+				},
+			},
+
+			"position": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+
+			"redirect_pool_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_url"},
+			},
+
+			"redirect_url": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"redirect_pool_id"},
+			},
+
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceL7PolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	action := d.Get("action").(string)
+	redirectPoolID := d.Get("redirect_pool_id").(string)
+	redirectURL := d.Get("redirect_url").(string)
+	if err := resourceL7PolicyV2CheckAction(action, redirectPoolID, redirectURL); err != nil {
+		return err
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := L7PolicyV2CreateOpts{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		ListenerID:     d.Get("listener_id").(string),
+		Action:         action,
+		Position:       d.Get("position").(int),
+		RedirectPoolID: redirectPoolID,
+		RedirectURL:    redirectURL,
+		TenantID:       d.Get("tenant_id").(string),
+		AdminStateUp:   &adminStateUp,
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	lbID, err := lbV2ListenerLoadBalancerID(lbClient, createOpts.ListenerID)
+	if err != nil {
+		return err
+	}
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	var l7Policy *L7PolicyV2
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		l7Policy, err = lbV2L7PolicyCreate(lbClient, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating L7 policy: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(l7Policy.ID)
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+func resourceL7PolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	l7Policy, err := lbV2L7PolicyGet(lbClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "L7 policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved L7 policy %s: %#v", d.Id(), l7Policy)
+
+	d.Set("name", l7Policy.Name)
+	d.Set("description", l7Policy.Description)
+	d.Set("listener_id", l7Policy.ListenerID)
+	d.Set("action", l7Policy.Action)
+	d.Set("position", l7Policy.Position)
+	d.Set("redirect_pool_id", l7Policy.RedirectPoolID)
+	d.Set("redirect_url", l7Policy.RedirectURL)
+	d.Set("tenant_id", l7Policy.TenantID)
+	d.Set("admin_state_up", l7Policy.AdminStateUp)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7PolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	action := d.Get("action").(string)
+	redirectPoolID := d.Get("redirect_pool_id").(string)
+	redirectURL := d.Get("redirect_url").(string)
+	if err := resourceL7PolicyV2CheckAction(action, redirectPoolID, redirectURL); err != nil {
+		return err
+	}
+
+	var updateOpts L7PolicyV2UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("action") {
+		updateOpts.Action = action
+	}
+	if d.HasChange("position") {
+		updateOpts.Position = d.Get("position").(int)
+	}
+	if d.HasChange("redirect_pool_id") {
+		updateOpts.RedirectPoolID = &redirectPoolID
+	}
+	if d.HasChange("redirect_url") {
+		updateOpts.RedirectURL = &redirectURL
+	}
+	if d.HasChange("admin_state_up") {
+		asu := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &asu
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	lbID, err := lbV2ListenerLoadBalancerID(lbClient, d.Get("listener_id").(string))
+	if err != nil {
+		return err
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating L7 policy %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err = lbV2L7PolicyUpdate(lbClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating L7 policy %s: %s", d.Id(), err)
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceL7PolicyV2Read(d, meta)
+}
+
+func resourceL7PolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	lbID, err := lbV2ListenerLoadBalancerID(lbClient, d.Get("listener_id").(string))
+	if err != nil {
+		return err
+	}
+	timeout := d.Timeout(schema.TimeoutDelete)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting L7 policy %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = lbV2L7PolicyDelete(lbClient, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting L7 policy")
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// resourceL7PolicyV2CheckAction checks that the redirect target of an L7
+// policy matches its action.
+func resourceL7PolicyV2CheckAction(action, redirectPoolID, redirectURL string) error {
+	switch action {
+	case "REDIRECT_TO_POOL":
+		if redirectPoolID == "" {
+			return fmt.Errorf("redirect_pool_id is required if action is REDIRECT_TO_POOL")
+		}
+	case "REDIRECT_TO_URL":
+		if redirectURL == "" {
+			return fmt.Errorf("redirect_url is required if action is REDIRECT_TO_URL")
+		}
+	case "REJECT":
+		if redirectPoolID != "" || redirectURL != "" {
+			return fmt.Errorf("redirect_pool_id and redirect_url can't be used if action is REJECT")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,155 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2L7Policy_basic(t *testing.T) {
+	var l7Policy L7PolicyV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7PolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2L7PolicyConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7PolicyExists("openstack_lb_l7policy_v2.l7policy_1", &l7Policy),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_TO_URL"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_url", "http://www.example.com"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2L7PolicyConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "name", "l7policy_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "action", "REDIRECT_TO_POOL"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_url", ""),
+					resource.TestCheckResourceAttrPair(
+						"openstack_lb_l7policy_v2.l7policy_1", "redirect_pool_id",
+						"openstack_lb_pool_v2.pool_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2L7PolicyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	lbClient, err := testAccLBV2Client(config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_l7policy_v2" {
+			continue
+		}
+
+		_, err := lbV2L7PolicyGet(lbClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("L7 policy still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2L7PolicyExists(n string, l7Policy *L7PolicyV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		lbClient, err := testAccLBV2Client(config)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := lbV2L7PolicyGet(lbClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("L7 policy not found")
+		}
+
+		*l7Policy = *found
+
+		return nil
+	}
+}
+
+const testAccLBV2PoolConfig = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_lb_loadbalancer_v2" "loadbalancer_1" {
+  name = "loadbalancer_1"
+  vip_subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_lb_listener_v2" "listener_1" {
+  name = "listener_1"
+  protocol = "HTTP"
+  protocol_port = 8080
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+
+resource "openstack_lb_pool_v2" "pool_1" {
+  name = "pool_1"
+  protocol = "HTTP"
+  lb_method = "ROUND_ROBIN"
+  loadbalancer_id = "${openstack_lb_loadbalancer_v2.loadbalancer_1.id}"
+}
+`
+
+var testAccLBV2L7PolicyConfig_basic = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1"
+  action = "REDIRECT_TO_URL"
+  position = 1
+  redirect_url = "http://www.example.com"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+}
+`, testAccLBV2PoolConfig)
+
+var testAccLBV2L7PolicyConfig_update = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1_updated"
+  action = "REDIRECT_TO_POOL"
+  position = 1
+  redirect_pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+}
+`, testAccLBV2PoolConfig)
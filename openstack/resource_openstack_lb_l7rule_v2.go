@@ -0,0 +1,330 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceL7RuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceL7RuleV2Create,
+		Read:   resourceL7RuleV2Read,
+		Update: resourceL7RuleV2Update,
+		Delete: resourceL7RuleV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"l7policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "HOST_NAME" && value != "PATH" && value != "FILE_TYPE" && value != "HEADER" && value != "COOKIE" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'HOST_NAME', 'PATH', 'FILE_TYPE', 'HEADER' and 'COOKIE' are supported values for 'type'"))
+					}
+					return
+				},
+			},
+
+			"compare_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "REGEX" && value != "STARTS_WITH" && value != "ENDS_WITH" && value != "CONTAINS" && value != "EQUAL_TO" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'REGEX', 'STARTS_WITH', 'ENDS_WITH', 'CONTAINS' and 'EQUAL_TO' are supported values for 'compare_type'"))
+					}
+					return
+				},
+			},
+
+			"key": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"value": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"invert": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  false,
+				Optional: true,
+			},
+
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Default:  true,
+				Optional: true,
+			},
+
+			"listener_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceL7RuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	ruleType := d.Get("type").(string)
+	key := d.Get("key").(string)
+	if err := resourceL7RuleV2CheckKey(ruleType, key); err != nil {
+		return err
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := L7RuleV2CreateOpts{
+		Type:         ruleType,
+		CompareType:  d.Get("compare_type").(string),
+		Key:          key,
+		Value:        d.Get("value").(string),
+		Invert:       d.Get("invert").(bool),
+		TenantID:     d.Get("tenant_id").(string),
+		AdminStateUp: &adminStateUp,
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	policyID := d.Get("l7policy_id").(string)
+	lbID, err := resourceL7RuleV2LoadBalancerID(lbClient, policyID)
+	if err != nil {
+		return err
+	}
+	timeout := d.Timeout(schema.TimeoutCreate)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	var l7Rule *L7RuleV2
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		l7Rule, err = lbV2L7RuleCreate(lbClient, policyID, createOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating L7 rule: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(l7Rule.ID)
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID := d.Get("l7policy_id").(string)
+	l7Policy, err := lbV2L7PolicyGet(lbClient, policyID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "L7 policy")
+	}
+
+	l7Rule, err := lbV2L7RuleGet(lbClient, policyID, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "L7 rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved L7 rule %s: %#v", d.Id(), l7Rule)
+
+	d.Set("l7policy_id", policyID)
+	d.Set("listener_id", l7Policy.ListenerID)
+	d.Set("type", l7Rule.Type)
+	d.Set("compare_type", l7Rule.CompareType)
+	d.Set("key", l7Rule.Key)
+	d.Set("value", l7Rule.Value)
+	d.Set("invert", l7Rule.Invert)
+	d.Set("tenant_id", l7Rule.TenantID)
+	d.Set("admin_state_up", l7Rule.AdminStateUp)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceL7RuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	ruleType := d.Get("type").(string)
+	key := d.Get("key").(string)
+	if err := resourceL7RuleV2CheckKey(ruleType, key); err != nil {
+		return err
+	}
+
+	var updateOpts L7RuleV2UpdateOpts
+	if d.HasChange("type") {
+		updateOpts.Type = ruleType
+	}
+	if d.HasChange("compare_type") {
+		updateOpts.CompareType = d.Get("compare_type").(string)
+	}
+	if d.HasChange("key") {
+		updateOpts.Key = &key
+	}
+	if d.HasChange("value") {
+		updateOpts.Value = d.Get("value").(string)
+	}
+	if d.HasChange("invert") {
+		invert := d.Get("invert").(bool)
+		updateOpts.Invert = &invert
+	}
+	if d.HasChange("admin_state_up") {
+		asu := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &asu
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	policyID := d.Get("l7policy_id").(string)
+	lbID, err := resourceL7RuleV2LoadBalancerID(lbClient, policyID)
+	if err != nil {
+		return err
+	}
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating L7 rule %s with options: %#v", d.Id(), updateOpts)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		_, err = lbV2L7RuleUpdate(lbClient, policyID, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating L7 rule %s: %s", d.Id(), err)
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	return resourceL7RuleV2Read(d, meta)
+}
+
+func resourceL7RuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	// Wait for LoadBalancer to become active before continuing
+	policyID := d.Get("l7policy_id").(string)
+	lbID, err := resourceL7RuleV2LoadBalancerID(lbClient, policyID)
+	if err != nil {
+		return CheckDeleted(d, err, "L7 policy")
+	}
+	timeout := d.Timeout(schema.TimeoutDelete)
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Deleting L7 rule %s", d.Id())
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = lbV2L7RuleDelete(lbClient, policyID, d.Id()).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting L7 rule")
+	}
+
+	// Wait for LoadBalancer to become active again before continuing
+	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// resourceL7RuleV2LoadBalancerID returns the ID of the load balancer of the
+// listener of an L7 policy.
+func resourceL7RuleV2LoadBalancerID(lbClient *gophercloud.ServiceClient, policyID string) (string, error) {
+	l7Policy, err := lbV2L7PolicyGet(lbClient, policyID).Extract()
+	if err != nil {
+		return "", err
+	}
+
+	return lbV2ListenerLoadBalancerID(lbClient, l7Policy.ListenerID)
+}
+
+// resourceL7RuleV2CheckKey checks that a key is set for the rule types which
+// compare the value of a header or cookie.
+func resourceL7RuleV2CheckKey(ruleType, key string) error {
+	switch ruleType {
+	case "HEADER", "COOKIE":
+		if key == "" {
+			return fmt.Errorf("key is required if type is %s", ruleType)
+		}
+	default:
+		if key != "" {
+			return fmt.Errorf("key can only be used if type is HEADER or COOKIE")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,130 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2L7Rule_basic(t *testing.T) {
+	var l7Rule L7RuleV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2L7RuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2L7RuleConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2L7RuleExists("openstack_lb_l7rule_v2.l7rule_1", &l7Rule),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "type", "PATH"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "value", "/api"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2L7RuleConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "type", "HEADER"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "key", "X-Api-Version"),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_l7rule_v2.l7rule_1", "invert", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2L7RuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	lbClient, err := testAccLBV2Client(config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_l7rule_v2" {
+			continue
+		}
+
+		_, err := lbV2L7RuleGet(lbClient, rs.Primary.Attributes["l7policy_id"], rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("L7 rule still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2L7RuleExists(n string, l7Rule *L7RuleV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		lbClient, err := testAccLBV2Client(config)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := lbV2L7RuleGet(lbClient, rs.Primary.Attributes["l7policy_id"], rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("L7 rule not found")
+		}
+
+		*l7Rule = *found
+
+		return nil
+	}
+}
+
+var testAccLBV2L7RuleConfig = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7policy_v2" "l7policy_1" {
+  name = "l7policy_1"
+  action = "REDIRECT_TO_POOL"
+  redirect_pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+  listener_id = "${openstack_lb_listener_v2.listener_1.id}"
+}
+`, testAccLBV2PoolConfig)
+
+var testAccLBV2L7RuleConfig_basic = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id = "${openstack_lb_l7policy_v2.l7policy_1.id}"
+  type = "PATH"
+  compare_type = "STARTS_WITH"
+  value = "/api"
+}
+`, testAccLBV2L7RuleConfig)
+
+var testAccLBV2L7RuleConfig_update = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_l7rule_v2" "l7rule_1" {
+  l7policy_id = "${openstack_lb_l7policy_v2.l7policy_1.id}"
+  type = "HEADER"
+  compare_type = "EQUAL_TO"
+  key = "X-Api-Version"
+  value = "v2"
+  invert = true
+}
+`, testAccLBV2L7RuleConfig)
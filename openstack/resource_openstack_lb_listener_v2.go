@@ -3,6 +3,7 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"net"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -38,9 +39,9 @@ func resourceListenerV2() *schema.Resource {
 				ForceNew: true,
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					value := v.(string)
-					if value != "TCP" && value != "HTTP" && value != "HTTPS" && value != "TERMINATED_HTTPS" {
+					if value != "TCP" && value != "UDP" && value != "HTTP" && value != "HTTPS" && value != "TERMINATED_HTTPS" {
 						errors = append(errors, fmt.Errorf(
-							"Only 'TCP', 'HTTP', 'HTTPS' and 'TERMINATED_HTTPS' are supported values for 'protocol'"))
+							"Only 'TCP', 'UDP', 'HTTP', 'HTTPS' and 'TERMINATED_HTTPS' are supported values for 'protocol'"))
 					}
 					return
 				},
@@ -87,6 +88,61 @@ func resourceListenerV2() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					if v.(int) < -1 {
+						errors = append(errors, fmt.Errorf(
+							"'connection_limit' must be -1 (unlimited) or a positive number"))
+					}
+					return
+				},
+			},
+
+			"timeout_client_data": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceListenerV2ValidateTimeout,
+			},
+
+			"timeout_member_connect": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceListenerV2ValidateTimeout,
+			},
+
+			"timeout_member_data": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceListenerV2ValidateTimeout,
+			},
+
+			"timeout_tcp_inspect": &schema.Schema{
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: resourceListenerV2ValidateTimeout,
+			},
+
+			"allowed_cidrs": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						if _, _, err := net.ParseCIDR(v.(string)); err != nil {
+							errors = append(errors, fmt.Errorf(
+								"'allowed_cidrs' must only contain CIDRs: %s", err))
+						}
+						return
+					},
+				},
+			},
+
+			"insert_headers": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
 			},
 
 			"default_tls_container_ref": &schema.Schema{
@@ -100,6 +156,27 @@ func resourceListenerV2() *schema.Resource {
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 
+			"tls_ciphers": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"tls_versions": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+						value := v.(string)
+						if value != "SSLv3" && value != "TLSv1" && value != "TLSv1.1" && value != "TLSv1.2" && value != "TLSv1.3" {
+							errors = append(errors, fmt.Errorf(
+								"Only 'SSLv3', 'TLSv1', 'TLSv1.1', 'TLSv1.2' and 'TLSv1.3' are supported values for 'tls_versions'"))
+						}
+						return
+					},
+				},
+			},
+
 			"admin_state_up": &schema.Schema{
 				Type:     schema.TypeBool,
 				Default:  true,
@@ -116,15 +193,25 @@ func resourceListenerV2Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	adminStateUp := d.Get("admin_state_up").(bool)
-	var sniContainerRefs []string
-	if raw, ok := d.GetOk("sni_container_refs"); ok {
-		for _, v := range raw.([]interface{}) {
-			sniContainerRefs = append(sniContainerRefs, v.(string))
+	var octaviaArgs []string
+	for _, arg := range resourceListenerV2OctaviaArgs {
+		if _, ok := d.GetOk(arg); ok {
+			octaviaArgs = append(octaviaArgs, arg)
 		}
 	}
-	createOpts := listeners.CreateOpts{
-		Protocol:               listeners.Protocol(d.Get("protocol").(string)),
+	if err := lbV2CheckOctavia(config, octaviaArgs...); err != nil {
+		return err
+	}
+
+	protocol := d.Get("protocol").(string)
+	if _, ok := d.GetOk("default_tls_container_ref"); !ok && protocol == "TERMINATED_HTTPS" {
+		return fmt.Errorf("default_tls_container_ref is required for TERMINATED_HTTPS listeners")
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	sniContainerRefs := resourceListenerV2SNIContainerRefs(d)
+	opts := listeners.CreateOpts{
+		Protocol:               listeners.Protocol(protocol),
 		ProtocolPort:           d.Get("protocol_port").(int),
 		TenantID:               d.Get("tenant_id").(string),
 		LoadbalancerID:         d.Get("loadbalancer_id").(string),
@@ -138,13 +225,38 @@ func resourceListenerV2Create(d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("connection_limit"); ok {
 		connectionLimit := v.(int)
-		createOpts.ConnLimit = &connectionLimit
+		opts.ConnLimit = &connectionLimit
+	}
+
+	createOpts := ListenerV2CreateOpts{
+		CreateOptsBuilder: opts,
+		TLSCiphers:        d.Get("tls_ciphers").(string),
+		TLSVersions:       resourceListenerV2TLSVersions(d),
+		AllowedCIDRs:      resourceListenerV2AllowedCIDRs(d),
+		InsertHeaders:     resourceListenerV2InsertHeaders(d),
+	}
+
+	if v, ok := d.GetOk("timeout_client_data"); ok {
+		timeoutClientData := v.(int)
+		createOpts.TimeoutClientData = &timeoutClientData
+	}
+	if v, ok := d.GetOk("timeout_member_connect"); ok {
+		timeoutMemberConnect := v.(int)
+		createOpts.TimeoutMemberConnect = &timeoutMemberConnect
+	}
+	if v, ok := d.GetOk("timeout_member_data"); ok {
+		timeoutMemberData := v.(int)
+		createOpts.TimeoutMemberData = &timeoutMemberData
+	}
+	if v, ok := d.GetOk("timeout_tcp_inspect"); ok {
+		timeoutTCPInspect := v.(int)
+		createOpts.TimeoutTCPInspect = &timeoutTCPInspect
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 
 	// Wait for LoadBalancer to become active before continuing
-	lbID := createOpts.LoadbalancerID
+	lbID := opts.LoadbalancerID
 	timeout := d.Timeout(schema.TimeoutCreate)
 	err = waitForLBV2LoadBalancer(lbClient, lbID, "ACTIVE", nil, timeout)
 	if err != nil {
@@ -182,7 +294,8 @@ func resourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	listener, err := listeners.Get(lbClient, d.Id()).Extract()
+	r := listeners.Get(lbClient, d.Id())
+	listener, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "listener")
 	}
@@ -201,6 +314,29 @@ func resourceListenerV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("default_tls_container_ref", listener.DefaultTlsContainerRef)
 	d.Set("region", GetRegion(d, config))
 
+	if config.useOctavia {
+		octavia, err := lbV2OctaviaListener(r)
+		if err != nil {
+			return fmt.Errorf("Error extracting Octavia attributes of listener %s: %s", d.Id(), err)
+		}
+
+		// Octavia reports its default ciphers and versions if none were
+		// set, so they are only stored if they are configured.
+		if _, ok := d.GetOk("tls_ciphers"); ok {
+			d.Set("tls_ciphers", octavia.TLSCiphers)
+		}
+		if _, ok := d.GetOk("tls_versions"); ok {
+			d.Set("tls_versions", octavia.TLSVersions)
+		}
+
+		d.Set("timeout_client_data", octavia.TimeoutClientData)
+		d.Set("timeout_member_connect", octavia.TimeoutMemberConnect)
+		d.Set("timeout_member_data", octavia.TimeoutMemberData)
+		d.Set("timeout_tcp_inspect", octavia.TimeoutTCPInspect)
+		d.Set("allowed_cidrs", octavia.AllowedCIDRs)
+		d.Set("insert_headers", octavia.InsertHeaders)
+	}
+
 	return nil
 }
 
@@ -211,7 +347,17 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts listeners.UpdateOpts
+	var octaviaArgs []string
+	for _, arg := range resourceListenerV2OctaviaArgs {
+		if d.HasChange(arg) {
+			octaviaArgs = append(octaviaArgs, arg)
+		}
+	}
+	if err := lbV2CheckOctavia(config, octaviaArgs...); err != nil {
+		return err
+	}
+
+	var updateOpts ListenerV2UpdateOpts
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
 	}
@@ -223,16 +369,50 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 		updateOpts.ConnLimit = &connLimit
 	}
 	if d.HasChange("default_tls_container_ref") {
-		updateOpts.DefaultTlsContainerRef = d.Get("default_tls_container_ref").(string)
+		defaultTLSContainerRef := d.Get("default_tls_container_ref").(string)
+		updateOpts.DefaultTLSContainerRef = &defaultTLSContainerRef
 	}
 	if d.HasChange("sni_container_refs") {
-		var sniContainerRefs []string
-		if raw, ok := d.GetOk("sni_container_refs"); ok {
-			for _, v := range raw.([]interface{}) {
-				sniContainerRefs = append(sniContainerRefs, v.(string))
-			}
+		sniContainerRefs := resourceListenerV2SNIContainerRefs(d)
+		if sniContainerRefs == nil {
+			sniContainerRefs = []string{}
+		}
+		updateOpts.SNIContainerRefs = &sniContainerRefs
+	}
+	if d.HasChange("tls_ciphers") {
+		tlsCiphers := d.Get("tls_ciphers").(string)
+		updateOpts.TLSCiphers = &tlsCiphers
+	}
+	if d.HasChange("tls_versions") {
+		tlsVersions := resourceListenerV2TLSVersions(d)
+		updateOpts.TLSVersions = &tlsVersions
+	}
+	if d.HasChange("timeout_client_data") {
+		timeoutClientData := d.Get("timeout_client_data").(int)
+		updateOpts.TimeoutClientData = &timeoutClientData
+	}
+	if d.HasChange("timeout_member_connect") {
+		timeoutMemberConnect := d.Get("timeout_member_connect").(int)
+		updateOpts.TimeoutMemberConnect = &timeoutMemberConnect
+	}
+	if d.HasChange("timeout_member_data") {
+		timeoutMemberData := d.Get("timeout_member_data").(int)
+		updateOpts.TimeoutMemberData = &timeoutMemberData
+	}
+	if d.HasChange("timeout_tcp_inspect") {
+		timeoutTCPInspect := d.Get("timeout_tcp_inspect").(int)
+		updateOpts.TimeoutTCPInspect = &timeoutTCPInspect
+	}
+	if d.HasChange("allowed_cidrs") {
+		allowedCIDRs := resourceListenerV2AllowedCIDRs(d)
+		if allowedCIDRs == nil {
+			allowedCIDRs = []string{}
 		}
-		updateOpts.SniContainerRefs = sniContainerRefs
+		updateOpts.AllowedCIDRs = &allowedCIDRs
+	}
+	if d.HasChange("insert_headers") {
+		insertHeaders := resourceListenerV2InsertHeaders(d)
+		updateOpts.InsertHeaders = &insertHeaders
 	}
 	if d.HasChange("admin_state_up") {
 		asu := d.Get("admin_state_up").(bool)
@@ -249,7 +429,7 @@ func resourceListenerV2Update(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Updating listener %s with options: %#v", d.Id(), updateOpts)
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		_, err = listeners.Update(lbClient, d.Id(), updateOpts).Extract()
+		_, err = lbV2ListenerUpdate(lbClient, d.Id(), updateOpts).Extract()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -312,3 +492,57 @@ func resourceListenerV2Delete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+func resourceListenerV2SNIContainerRefs(d *schema.ResourceData) []string {
+	var sniContainerRefs []string
+	for _, v := range d.Get("sni_container_refs").([]interface{}) {
+		sniContainerRefs = append(sniContainerRefs, v.(string))
+	}
+	return sniContainerRefs
+}
+
+func resourceListenerV2TLSVersions(d *schema.ResourceData) []string {
+	var tlsVersions []string
+	for _, v := range d.Get("tls_versions").([]interface{}) {
+		tlsVersions = append(tlsVersions, v.(string))
+	}
+	return tlsVersions
+}
+
+func resourceListenerV2AllowedCIDRs(d *schema.ResourceData) []string {
+	var allowedCIDRs []string
+	for _, v := range d.Get("allowed_cidrs").([]interface{}) {
+		allowedCIDRs = append(allowedCIDRs, v.(string))
+	}
+	return allowedCIDRs
+}
+
+func resourceListenerV2InsertHeaders(d *schema.ResourceData) map[string]string {
+	insertHeaders := make(map[string]string)
+	for k, v := range d.Get("insert_headers").(map[string]interface{}) {
+		insertHeaders[k] = v.(string)
+	}
+	return insertHeaders
+}
+
+// resourceListenerV2OctaviaArgs are the arguments of a listener which can
+// only be used with Octavia.
+var resourceListenerV2OctaviaArgs = []string{
+	"tls_ciphers",
+	"tls_versions",
+	"timeout_client_data",
+	"timeout_member_connect",
+	"timeout_member_data",
+	"timeout_tcp_inspect",
+	"allowed_cidrs",
+	"insert_headers",
+}
+
+// resourceListenerV2ValidateTimeout validates the timeouts of a listener,
+// which are in milliseconds.
+func resourceListenerV2ValidateTimeout(v interface{}, k string) (ws []string, errors []error) {
+	if v.(int) < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+	return
+}
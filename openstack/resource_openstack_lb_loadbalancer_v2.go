@@ -76,8 +76,24 @@ func resourceLoadBalancerV2() *schema.Resource {
 			},
 
 			"flavor": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"flavor_id"},
+			},
+
+			"flavor_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"flavor"},
+			},
+
+			"availability_zone": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
+				Computed: true,
 				ForceNew: true,
 			},
 
@@ -111,8 +127,24 @@ func resourceLoadBalancerV2Create(d *schema.ResourceData, meta interface{}) erro
 		lbProvider = v.(string)
 	}
 
+	var octaviaArgs []string
+	for _, arg := range []string{"flavor_id", "availability_zone"} {
+		if _, ok := d.GetOk(arg); ok {
+			octaviaArgs = append(octaviaArgs, arg)
+		}
+	}
+	if err := lbV2CheckOctavia(config, octaviaArgs...); err != nil {
+		return err
+	}
+
+	// Octavia rejects the flavor attribute of Neutron LBaaS v2.
+	if _, ok := d.GetOk("flavor"); ok && config.useOctavia {
+		return fmt.Errorf("flavor can't be used with Octavia, use flavor_id instead")
+	}
+
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := loadbalancers.CreateOpts{
+	var createOpts loadbalancers.CreateOptsBuilder
+	createOpts = loadbalancers.CreateOpts{
 		Name:         d.Get("name").(string),
 		Description:  d.Get("description").(string),
 		VipSubnetID:  d.Get("vip_subnet_id").(string),
@@ -123,6 +155,14 @@ func resourceLoadBalancerV2Create(d *schema.ResourceData, meta interface{}) erro
 		Provider:     lbProvider,
 	}
 
+	if config.useOctavia {
+		createOpts = LoadBalancerV2CreateOpts{
+			CreateOptsBuilder: createOpts,
+			FlavorID:          d.Get("flavor_id").(string),
+			AvailabilityZone:  d.Get("availability_zone").(string),
+		}
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
 	lb, err := loadbalancers.Create(lbClient, createOpts).Extract()
 	if err != nil {
@@ -159,7 +199,8 @@ func resourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	lb, err := loadbalancers.Get(lbClient, d.Id()).Extract()
+	r := loadbalancers.Get(lbClient, d.Id())
+	lb, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "loadbalancer")
 	}
@@ -173,10 +214,20 @@ func resourceLoadBalancerV2Read(d *schema.ResourceData, meta interface{}) error
 	d.Set("vip_address", lb.VipAddress)
 	d.Set("vip_port_id", lb.VipPortID)
 	d.Set("admin_state_up", lb.AdminStateUp)
-	d.Set("flavor", lb.Flavor)
 	d.Set("loadbalancer_provider", lb.Provider)
 	d.Set("region", GetRegion(d, config))
 
+	if config.useOctavia {
+		octavia, err := lbV2OctaviaLoadBalancer(r)
+		if err != nil {
+			return fmt.Errorf("Error extracting Octavia attributes of loadbalancer %s: %s", d.Id(), err)
+		}
+		d.Set("flavor_id", octavia.FlavorID)
+		d.Set("availability_zone", octavia.AvailabilityZone)
+	} else {
+		d.Set("flavor", lb.Flavor)
+	}
+
 	// Get any security groups on the VIP Port
 	if lb.VipPortID != "" {
 		networkingClient, err := config.networkingV2Client(GetRegion(d, config))
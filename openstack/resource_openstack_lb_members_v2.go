@@ -0,0 +1,233 @@
+package openstack
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+)
+
+func resourceMembersV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceMembersV2Create,
+		Read:   resourceMembersV2Read,
+		Update: resourceMembersV2Update,
+		Delete: resourceMembersV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+
+			"pool_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"member": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Set:      resourceMembersV2MemberHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"protocol_port": &schema.Schema{
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+
+						"weight": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  1,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(int)
+								if value < 0 || value > 256 {
+									errors = append(errors, fmt.Errorf(
+										"Only numbers between 0 and 256 are supported values for 'weight'"))
+								}
+								return
+							},
+						},
+
+						"subnet_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"admin_state_up": &schema.Schema{
+							Type:     schema.TypeBool,
+							Default:  true,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceMembersV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	if err := lbV2CheckOctavia(config, "openstack_lb_members_v2"); err != nil {
+		return err
+	}
+
+	poolID := d.Get("pool_id").(string)
+	if err := resourceMembersV2BatchUpdate(d, meta, poolID, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	d.SetId(poolID)
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	pages, err := pools.ListMembers(lbClient, d.Id(), pools.ListMembersOpts{}).AllPages()
+	if err != nil {
+		return CheckDeleted(d, err, "members")
+	}
+
+	allMembers, err := pools.ExtractMembers(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract members of pool %s: %s", d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Retrieved members of pool %s: %#v", d.Id(), allMembers)
+
+	members := make([]map[string]interface{}, len(allMembers))
+	for i, member := range allMembers {
+		members[i] = map[string]interface{}{
+			"id":             member.ID,
+			"name":           member.Name,
+			"address":        member.Address,
+			"protocol_port":  member.ProtocolPort,
+			"weight":         member.Weight,
+			"subnet_id":      member.SubnetID,
+			"admin_state_up": member.AdminStateUp,
+		}
+	}
+
+	d.Set("pool_id", d.Id())
+	d.Set("member", members)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceMembersV2Update(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("member") {
+		if err := resourceMembersV2BatchUpdate(d, meta, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return err
+		}
+	}
+
+	return resourceMembersV2Read(d, meta)
+}
+
+func resourceMembersV2Delete(d *schema.ResourceData, meta interface{}) error {
+	d.Set("member", nil)
+	if err := resourceMembersV2BatchUpdate(d, meta, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return CheckDeleted(d, err, "members")
+	}
+
+	return nil
+}
+
+// resourceMembersV2BatchUpdate replaces the members of a pool with the
+// configured members.
+func resourceMembersV2BatchUpdate(d *schema.ResourceData, meta interface{}, poolID string, timeout time.Duration) error {
+	config := meta.(*Config)
+	lbClient, err := chooseLBV2Client(d, config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var members []MemberV2BatchUpdateOpts
+	for _, raw := range d.Get("member").(*schema.Set).List() {
+		m := raw.(map[string]interface{})
+		weight := m["weight"].(int)
+		adminStateUp := m["admin_state_up"].(bool)
+		members = append(members, MemberV2BatchUpdateOpts{
+			Name:         m["name"].(string),
+			Address:      m["address"].(string),
+			ProtocolPort: m["protocol_port"].(int),
+			Weight:       &weight,
+			SubnetID:     m["subnet_id"].(string),
+			AdminStateUp: &adminStateUp,
+		})
+	}
+
+	// Wait for LB to become active before continuing
+	err = waitForLBV2viaPool(lbClient, poolID, "ACTIVE", timeout)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Updating members of pool %s: %#v", poolID, members)
+	err = resource.Retry(timeout, func() *resource.RetryError {
+		err = lbV2MembersBatchUpdate(lbClient, poolID, members).ExtractErr()
+		if err != nil {
+			return checkForRetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating members of pool %s: %s", poolID, err)
+	}
+
+	// Wait for LB to become ACTIVE again
+	return waitForLBV2viaPool(lbClient, poolID, "ACTIVE", timeout)
+}
+
+// resourceMembersV2MemberHash hashes a member without its computed ID, so
+// that configured members match the ones read back.
+func resourceMembersV2MemberHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["name"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["address"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["protocol_port"].(int)))
+	buf.WriteString(fmt.Sprintf("%d-", m["weight"].(int)))
+	buf.WriteString(fmt.Sprintf("%s-", m["subnet_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%t-", m["admin_state_up"].(bool)))
+
+	return hashcode.String(buf.String())
+}
@@ -0,0 +1,146 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/lbaas_v2/pools"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLBV2Members_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckOctavia(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2MembersDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccLBV2MembersConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersCount("openstack_lb_members_v2.members_1", 2),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_members_v2.members_1", "member.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccLBV2MembersConfig_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MembersCount("openstack_lb_members_v2.members_1", 3),
+					resource.TestCheckResourceAttr(
+						"openstack_lb_members_v2.members_1", "member.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLBV2MembersDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	lbClient, err := testAccLBV2Client(config)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_lb_members_v2" {
+			continue
+		}
+
+		pages, err := pools.ListMembers(lbClient, rs.Primary.ID, pools.ListMembersOpts{}).AllPages()
+		if err != nil {
+			continue
+		}
+
+		members, err := pools.ExtractMembers(pages)
+		if err == nil && len(members) > 0 {
+			return fmt.Errorf("Members of pool %s still exist", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLBV2MembersCount(n string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		lbClient, err := testAccLBV2Client(config)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		pages, err := pools.ListMembers(lbClient, rs.Primary.ID, pools.ListMembersOpts{}).AllPages()
+		if err != nil {
+			return err
+		}
+
+		members, err := pools.ExtractMembers(pages)
+		if err != nil {
+			return err
+		}
+
+		if len(members) != count {
+			return fmt.Errorf("Expected %d members in pool %s, got %d", count, rs.Primary.ID, len(members))
+		}
+
+		return nil
+	}
+}
+
+var testAccLBV2MembersConfig_basic = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_members_v2" "members_1" {
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+
+  member {
+    address = "192.168.199.10"
+    protocol_port = 8080
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+
+  member {
+    address = "192.168.199.11"
+    protocol_port = 8080
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+`, testAccLBV2PoolConfig)
+
+var testAccLBV2MembersConfig_update = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_members_v2" "members_1" {
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+
+  member {
+    address = "192.168.199.10"
+    protocol_port = 8080
+    weight = 10
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+
+  member {
+    address = "192.168.199.11"
+    protocol_port = 8080
+    admin_state_up = false
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+
+  member {
+    name = "member_3"
+    address = "192.168.199.12"
+    protocol_port = 8080
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+`, testAccLBV2PoolConfig)
@@ -3,6 +3,8 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/resource"
@@ -54,6 +56,14 @@ func resourceMonitorV2() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "PING" && value != "TCP" && value != "HTTP" && value != "HTTPS" && value != "TLS-HELLO" && value != "UDP-CONNECT" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'PING', 'TCP', 'HTTP', 'HTTPS', 'TLS-HELLO' and 'UDP-CONNECT' are supported values for 'type'"))
+					}
+					return
+				},
 			},
 
 			"delay": &schema.Schema{
@@ -71,22 +81,61 @@ func resourceMonitorV2() *schema.Resource {
 				Required: true,
 			},
 
+			"max_retries_down": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(int)
+					if value < 1 || value > 10 {
+						errors = append(errors, fmt.Errorf(
+							"'max_retries_down' must be between 1 and 10"))
+					}
+					return
+				},
+			},
+
 			"url_path": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if !strings.HasPrefix(value, "/") {
+						errors = append(errors, fmt.Errorf(
+							"'url_path' must start with '/'"))
+					}
+					return
+				},
 			},
 
 			"http_method": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					switch v.(string) {
+					case "CONNECT", "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT", "TRACE":
+					default:
+						errors = append(errors, fmt.Errorf(
+							"Only 'CONNECT', 'DELETE', 'GET', 'HEAD', 'OPTIONS', 'PATCH', 'POST', 'PUT' and 'TRACE' are supported values for 'http_method'"))
+					}
+					return
+				},
 			},
 
 			"expected_codes": &schema.Schema{
 				Type:     schema.TypeString,
 				Optional: true,
 				Computed: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if !lbV2MonitorExpectedCodesRegexp.MatchString(value) {
+						errors = append(errors, fmt.Errorf(
+							"'expected_codes' must be a single HTTP status code like '200', a list like '200,202' or a range like '200-204'"))
+					}
+					return
+				},
 			},
 
 			"admin_state_up": &schema.Schema{
@@ -98,6 +147,11 @@ func resourceMonitorV2() *schema.Resource {
 	}
 }
 
+// lbV2MonitorExpectedCodesRegexp matches the HTTP status codes which can be
+// expected by a monitor: a single code, a comma separated list of codes or a
+// range of codes.
+var lbV2MonitorExpectedCodesRegexp = regexp.MustCompile(`^[1-5][0-9]{2}((,[1-5][0-9]{2})*|-[1-5][0-9]{2})$`)
+
 func resourceMonitorV2Create(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	lbClient, err := chooseLBV2Client(d, config)
@@ -105,23 +159,49 @@ func resourceMonitorV2Create(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	if _, ok := d.GetOk("max_retries_down"); ok {
+		if err := lbV2CheckOctavia(config, "max_retries_down"); err != nil {
+			return err
+		}
+	}
+
+	monitorType := d.Get("type").(string)
+	urlPath := d.Get("url_path").(string)
+	httpMethod := d.Get("http_method").(string)
+	expectedCodes := d.Get("expected_codes").(string)
+	if monitorType == "HTTP" || monitorType == "HTTPS" {
+		// Both APIs default to checking that / returns 200, but
+		// Gophercloud requires them to be set.
+		if urlPath == "" {
+			urlPath = "/"
+		}
+		if expectedCodes == "" {
+			expectedCodes = "200"
+		}
+	} else if urlPath != "" || httpMethod != "" || expectedCodes != "" {
+		return fmt.Errorf("url_path, http_method and expected_codes can only be used with HTTP and HTTPS monitors")
+	}
+
 	adminStateUp := d.Get("admin_state_up").(bool)
-	createOpts := monitors.CreateOpts{
-		PoolID:        d.Get("pool_id").(string),
-		TenantID:      d.Get("tenant_id").(string),
-		Type:          d.Get("type").(string),
-		Delay:         d.Get("delay").(int),
-		Timeout:       d.Get("timeout").(int),
-		MaxRetries:    d.Get("max_retries").(int),
-		URLPath:       d.Get("url_path").(string),
-		HTTPMethod:    d.Get("http_method").(string),
-		ExpectedCodes: d.Get("expected_codes").(string),
-		Name:          d.Get("name").(string),
-		AdminStateUp:  &adminStateUp,
+	createOpts := MonitorV2CreateOpts{
+		CreateOptsBuilder: monitors.CreateOpts{
+			PoolID:        d.Get("pool_id").(string),
+			TenantID:      d.Get("tenant_id").(string),
+			Type:          monitorType,
+			Delay:         d.Get("delay").(int),
+			Timeout:       d.Get("timeout").(int),
+			MaxRetries:    d.Get("max_retries").(int),
+			URLPath:       urlPath,
+			HTTPMethod:    httpMethod,
+			ExpectedCodes: expectedCodes,
+			Name:          d.Get("name").(string),
+			AdminStateUp:  &adminStateUp,
+		},
+		MaxRetriesDown: d.Get("max_retries_down").(int),
 	}
 
 	timeout := d.Timeout(schema.TimeoutCreate)
-	poolID := createOpts.PoolID
+	poolID := d.Get("pool_id").(string)
 	err = waitForLBV2viaPool(lbClient, poolID, "ACTIVE", timeout)
 	if err != nil {
 		return err
@@ -159,7 +239,8 @@ func resourceMonitorV2Read(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	monitor, err := monitors.Get(lbClient, d.Id()).Extract()
+	r := monitors.Get(lbClient, d.Id())
+	monitor, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "monitor")
 	}
@@ -178,6 +259,14 @@ func resourceMonitorV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", monitor.Name)
 	d.Set("region", GetRegion(d, config))
 
+	if config.useOctavia {
+		octavia, err := lbV2OctaviaMonitor(r)
+		if err != nil {
+			return fmt.Errorf("Error extracting Octavia attributes of monitor %s: %s", d.Id(), err)
+		}
+		d.Set("max_retries_down", octavia.MaxRetriesDown)
+	}
+
 	return nil
 }
 
@@ -188,6 +277,21 @@ func resourceMonitorV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
+	if d.HasChange("max_retries_down") {
+		if err := lbV2CheckOctavia(config, "max_retries_down"); err != nil {
+			return err
+		}
+	}
+
+	monitorType := d.Get("type").(string)
+	if monitorType != "HTTP" && monitorType != "HTTPS" {
+		for _, arg := range []string{"url_path", "http_method", "expected_codes"} {
+			if d.HasChange(arg) {
+				return fmt.Errorf("url_path, http_method and expected_codes can only be used with HTTP and HTTPS monitors")
+			}
+		}
+	}
+
 	var updateOpts monitors.UpdateOpts
 	if d.HasChange("url_path") {
 		updateOpts.URLPath = d.Get("url_path").(string)
@@ -215,7 +319,14 @@ func resourceMonitorV2Update(d *schema.ResourceData, meta interface{}) error {
 		updateOpts.HTTPMethod = d.Get("http_method").(string)
 	}
 
-	log.Printf("[DEBUG] Updating monitor %s with options: %#v", d.Id(), updateOpts)
+	monitorUpdateOpts := MonitorV2UpdateOpts{
+		UpdateOptsBuilder: updateOpts,
+	}
+	if d.HasChange("max_retries_down") {
+		monitorUpdateOpts.MaxRetriesDown = d.Get("max_retries_down").(int)
+	}
+
+	log.Printf("[DEBUG] Updating monitor %s with options: %#v", d.Id(), monitorUpdateOpts)
 	timeout := d.Timeout(schema.TimeoutUpdate)
 	poolID := d.Get("pool_id").(string)
 	err = waitForLBV2viaPool(lbClient, poolID, "ACTIVE", timeout)
@@ -224,7 +335,7 @@ func resourceMonitorV2Update(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		_, err = monitors.Update(lbClient, d.Id(), updateOpts).Extract()
+		_, err = monitors.Update(lbClient, d.Id(), monitorUpdateOpts).Extract()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -252,7 +363,7 @@ func resourceMonitorV2Delete(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	log.Printf("[DEBUG] Deleting monitor %s", d.Id())
-	timeout := d.Timeout(schema.TimeoutUpdate)
+	timeout := d.Timeout(schema.TimeoutDelete)
 	poolID := d.Get("pool_id").(string)
 	err = waitForLBV2viaPool(lbClient, poolID, "ACTIVE", timeout)
 	if err != nil {
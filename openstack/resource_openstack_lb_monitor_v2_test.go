@@ -36,9 +36,66 @@ func TestAccLBV2Monitor_basic(t *testing.T) {
 	})
 }
 
+func TestAccLBV2Monitor_http(t *testing.T) {
+	var monitor monitors.Monitor
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2MonitorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2MonitorConfig_http,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MonitorExists(t, "openstack_lb_monitor_v2.monitor_1", &monitor),
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "url_path", "/"),
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "http_method", "GET"),
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "expected_codes", "200"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccLBV2MonitorConfig_httpUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "url_path", "/healthz"),
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "http_method", "HEAD"),
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "expected_codes", "200-204"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLBV2Monitor_octavia(t *testing.T) {
+	var monitor monitors.Monitor
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckOctavia(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBV2MonitorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: TestAccLBV2MonitorConfig_octavia,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBV2MonitorExists(t, "openstack_lb_monitor_v2.monitor_1", &monitor),
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "max_retries_down", "3"),
+				),
+			},
+			resource.TestStep{
+				Config: TestAccLBV2MonitorConfig_octaviaUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("openstack_lb_monitor_v2.monitor_1", "max_retries_down", "5"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLBV2MonitorDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
-	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	lbClient, err := testAccLBV2Client(config)
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
@@ -48,7 +105,7 @@ func testAccCheckLBV2MonitorDestroy(s *terraform.State) error {
 			continue
 		}
 
-		_, err := monitors.Get(networkingClient, rs.Primary.ID).Extract()
+		_, err := monitors.Get(lbClient, rs.Primary.ID).Extract()
 		if err == nil {
 			return fmt.Errorf("Monitor still exists: %s", rs.Primary.ID)
 		}
@@ -69,12 +126,12 @@ func testAccCheckLBV2MonitorExists(t *testing.T, n string, monitor *monitors.Mon
 		}
 
 		config := testAccProvider.Meta().(*Config)
-		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		lbClient, err := testAccLBV2Client(config)
 		if err != nil {
 			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 		}
 
-		found, err := monitors.Get(networkingClient, rs.Primary.ID).Extract()
+		found, err := monitors.Get(lbClient, rs.Primary.ID).Extract()
 		if err != nil {
 			return err
 		}
@@ -185,3 +242,60 @@ resource "openstack_lb_monitor_v2" "monitor_1" {
   }
 }
 `
+
+var TestAccLBV2MonitorConfig_http = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_monitor_v2" "monitor_1" {
+  name = "monitor_1"
+  type = "HTTP"
+  delay = 20
+  timeout = 10
+  max_retries = 5
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+}
+`, testAccLBV2PoolConfig)
+
+var TestAccLBV2MonitorConfig_httpUpdate = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_monitor_v2" "monitor_1" {
+  name = "monitor_1"
+  type = "HTTP"
+  delay = 20
+  timeout = 10
+  max_retries = 5
+  url_path = "/healthz"
+  http_method = "HEAD"
+  expected_codes = "200-204"
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+}
+`, testAccLBV2PoolConfig)
+
+var TestAccLBV2MonitorConfig_octavia = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_monitor_v2" "monitor_1" {
+  name = "monitor_1"
+  type = "TCP"
+  delay = 20
+  timeout = 10
+  max_retries = 5
+  max_retries_down = 3
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+}
+`, testAccLBV2PoolConfig)
+
+var TestAccLBV2MonitorConfig_octaviaUpdate = fmt.Sprintf(`
+%s
+
+resource "openstack_lb_monitor_v2" "monitor_1" {
+  name = "monitor_1"
+  type = "TCP"
+  delay = 20
+  timeout = 10
+  max_retries = 5
+  max_retries_down = 5
+  pool_id = "${openstack_lb_pool_v2.pool_1.id}"
+}
+`, testAccLBV2PoolConfig)
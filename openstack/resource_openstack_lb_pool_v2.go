@@ -55,9 +55,9 @@ func resourcePoolV2() *schema.Resource {
 				ForceNew: true,
 				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 					value := v.(string)
-					if value != "TCP" && value != "HTTP" && value != "HTTPS" {
+					if value != "TCP" && value != "UDP" && value != "HTTP" && value != "HTTPS" && value != "PROXY" {
 						errors = append(errors, fmt.Errorf(
-							"Only 'TCP', 'HTTP', and 'HTTPS' are supported values for 'protocol'"))
+							"Only 'TCP', 'UDP', 'HTTP', 'HTTPS', and 'PROXY' are supported values for 'protocol'"))
 					}
 					return
 				},
@@ -93,13 +93,12 @@ func resourcePoolV2() *schema.Resource {
 			"persistence": &schema.Schema{
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
+				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": &schema.Schema{
 							Type:     schema.TypeString,
 							Required: true,
-							ForceNew: true,
 							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
 								value := v.(string)
 								if value != "SOURCE_IP" && value != "HTTP_COOKIE" && value != "APP_COOKIE" {
@@ -113,7 +112,6 @@ func resourcePoolV2() *schema.Resource {
 						"cookie_name": &schema.Schema{
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 					},
 				},
@@ -136,27 +134,9 @@ func resourcePoolV2Create(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	adminStateUp := d.Get("admin_state_up").(bool)
-	var persistence pools.SessionPersistence
-	if p, ok := d.GetOk("persistence"); ok {
-		pV := (p.([]interface{}))[0].(map[string]interface{})
-
-		persistence = pools.SessionPersistence{
-			Type: pV["type"].(string),
-		}
-
-		if persistence.Type == "APP_COOKIE" {
-			if pV["cookie_name"].(string) == "" {
-				return fmt.Errorf(
-					"Persistence cookie_name needs to be set if using 'APP_COOKIE' persistence type.")
-			} else {
-				persistence.CookieName = pV["cookie_name"].(string)
-			}
-		} else {
-			if pV["cookie_name"].(string) != "" {
-				return fmt.Errorf(
-					"Persistence cookie_name can only be set if using 'APP_COOKIE' persistence type.")
-			}
-		}
+	persistence, err := resourcePoolV2Persistence(d)
+	if err != nil {
+		return err
 	}
 
 	createOpts := pools.CreateOpts{
@@ -168,11 +148,7 @@ func resourcePoolV2Create(d *schema.ResourceData, meta interface{}) error {
 		ListenerID:     d.Get("listener_id").(string),
 		LBMethod:       pools.LBMethod(d.Get("lb_method").(string)),
 		AdminStateUp:   &adminStateUp,
-	}
-
-	// Must omit if not set
-	if persistence != (pools.SessionPersistence{}) {
-		createOpts.Persistence = &persistence
+		Persistence:    persistence,
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -244,7 +220,7 @@ func resourcePoolV2Read(d *schema.ResourceData, meta interface{}) error {
 	d.Set("tenant_id", pool.TenantID)
 	d.Set("admin_state_up", pool.AdminStateUp)
 	d.Set("name", pool.Name)
-	d.Set("persistence", pool.Persistence)
+	d.Set("persistence", flattenLBPoolPersistenceV2(pool.Persistence))
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -257,7 +233,7 @@ func resourcePoolV2Update(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts pools.UpdateOpts
+	var updateOpts PoolV2UpdateOpts
 	if d.HasChange("lb_method") {
 		updateOpts.LBMethod = pools.LBMethod(d.Get("lb_method").(string))
 	}
@@ -271,6 +247,14 @@ func resourcePoolV2Update(d *schema.ResourceData, meta interface{}) error {
 		asu := d.Get("admin_state_up").(bool)
 		updateOpts.AdminStateUp = &asu
 	}
+	if d.HasChange("persistence") {
+		persistence, err := resourcePoolV2Persistence(d)
+		if err != nil {
+			return err
+		}
+		updateOpts.Persistence = persistence
+		updateOpts.ClearPersistence = persistence == nil
+	}
 
 	// Wait for LoadBalancer to become active before continuing
 	timeout := d.Timeout(schema.TimeoutUpdate)
@@ -286,7 +270,7 @@ func resourcePoolV2Update(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Updating pool %s with options: %#v", d.Id(), updateOpts)
 	err = resource.Retry(timeout, func() *resource.RetryError {
-		_, err = pools.Update(lbClient, d.Id(), updateOpts).Extract()
+		_, err = lbV2PoolUpdate(lbClient, d.Id(), updateOpts).Extract()
 		if err != nil {
 			return checkForRetryableError(err)
 		}
@@ -348,3 +332,43 @@ func resourcePoolV2Delete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// resourcePoolV2Persistence returns the session persistence of a pool, or nil
+// if it has none.
+func resourcePoolV2Persistence(d *schema.ResourceData) (*pools.SessionPersistence, error) {
+	p, ok := d.GetOk("persistence")
+	if !ok {
+		return nil, nil
+	}
+
+	pV := (p.([]interface{}))[0].(map[string]interface{})
+	persistence := pools.SessionPersistence{
+		Type: pV["type"].(string),
+	}
+
+	if persistence.Type == "APP_COOKIE" {
+		if pV["cookie_name"].(string) == "" {
+			return nil, fmt.Errorf(
+				"Persistence cookie_name needs to be set if using 'APP_COOKIE' persistence type.")
+		}
+		persistence.CookieName = pV["cookie_name"].(string)
+	} else if pV["cookie_name"].(string) != "" {
+		return nil, fmt.Errorf(
+			"Persistence cookie_name can only be set if using 'APP_COOKIE' persistence type.")
+	}
+
+	return &persistence, nil
+}
+
+func flattenLBPoolPersistenceV2(persistence pools.SessionPersistence) []map[string]interface{} {
+	if persistence.Type == "" {
+		return nil
+	}
+
+	return []map[string]interface{}{
+		{
+			"type":        persistence.Type,
+			"cookie_name": persistence.CookieName,
+		},
+	}
+}
@@ -0,0 +1,175 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingAddressGroupV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingAddressGroupV2Create,
+		Read:   resourceNetworkingAddressGroupV2Read,
+		Update: resourceNetworkingAddressGroupV2Update,
+		Delete: resourceNetworkingAddressGroupV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"addresses": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingAddressGroupV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := AddressGroupCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Addresses:   resourceNetworkingAddressGroupV2Addresses(d.Get("addresses").(*schema.Set).List()),
+		ProjectID:   d.Get("project_id").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	group, err := networkingV2AddressGroupCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron address group: %s", err)
+	}
+
+	log.Printf("[INFO] Address group ID: %s", group.ID)
+
+	d.SetId(group.ID)
+
+	return resourceNetworkingAddressGroupV2Read(d, meta)
+}
+
+func resourceNetworkingAddressGroupV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	group, err := networkingV2AddressGroupGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "address group")
+	}
+
+	log.Printf("[DEBUG] Retrieved address group %s: %+v", d.Id(), group)
+
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+	d.Set("addresses", group.Addresses)
+	d.Set("project_id", group.ProjectID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingAddressGroupV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") {
+		var updateOpts AddressGroupUpdateOpts
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			updateOpts.Name = &name
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+
+		log.Printf("[DEBUG] Updating address group %s with options: %+v", d.Id(), updateOpts)
+
+		_, err = networkingV2AddressGroupUpdate(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack Neutron address group: %s", err)
+		}
+	}
+
+	if d.HasChange("addresses") {
+		o, n := d.GetChange("addresses")
+		oldAddresses, newAddresses := o.(*schema.Set), n.(*schema.Set)
+
+		// Addresses are added before others are removed, so that an
+		// address group used by security group rules is never empty.
+		if add := newAddresses.Difference(oldAddresses).List(); len(add) > 0 {
+			log.Printf("[DEBUG] Adding addresses %v to address group %s", add, d.Id())
+			_, err = networkingV2AddressGroupAddAddresses(networkingClient, d.Id(), resourceNetworkingAddressGroupV2Addresses(add)).Extract()
+			if err != nil {
+				return fmt.Errorf("Error adding addresses to OpenStack Neutron address group: %s", err)
+			}
+		}
+
+		if remove := oldAddresses.Difference(newAddresses).List(); len(remove) > 0 {
+			log.Printf("[DEBUG] Removing addresses %v from address group %s", remove, d.Id())
+			_, err = networkingV2AddressGroupRemoveAddresses(networkingClient, d.Id(), resourceNetworkingAddressGroupV2Addresses(remove)).Extract()
+			if err != nil {
+				return fmt.Errorf("Error removing addresses from OpenStack Neutron address group: %s", err)
+			}
+		}
+	}
+
+	return resourceNetworkingAddressGroupV2Read(d, meta)
+}
+
+func resourceNetworkingAddressGroupV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2AddressGroupDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron address group")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceNetworkingAddressGroupV2Addresses(rawAddresses []interface{}) []string {
+	addresses := make([]string, len(rawAddresses))
+	for i, raw := range rawAddresses {
+		addresses[i] = raw.(string)
+	}
+	return addresses
+}
@@ -0,0 +1,113 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2AddressGroup_basic(t *testing.T) {
+	var group AddressGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2AddressGroupDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2AddressGroup_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2AddressGroupExists(
+						"openstack_networking_address_group_v2.group_1", &group),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_address_group_v2.group_1", "name", "group_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_address_group_v2.group_1", "addresses.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2AddressGroup_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_networking_address_group_v2.group_1", "id", &group.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_address_group_v2.group_1", "name", "group_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_address_group_v2.group_1", "description", "updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_address_group_v2.group_1", "addresses.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2AddressGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_address_group_v2" {
+			continue
+		}
+
+		_, err := networkingV2AddressGroupGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Address group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2AddressGroupExists(n string, group *AddressGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2AddressGroupGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Address group not found")
+		}
+
+		*group = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2AddressGroup_basic = `
+resource "openstack_networking_address_group_v2" "group_1" {
+  name = "group_1"
+  addresses = ["192.168.199.0/24", "10.0.0.1/32"]
+}
+`
+
+const testAccNetworkingV2AddressGroup_update = `
+resource "openstack_networking_address_group_v2" "group_1" {
+  name = "group_2"
+  description = "updated"
+  addresses = ["192.168.199.0/24", "10.0.0.2/32"]
+}
+`
@@ -0,0 +1,157 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingAddressScopeV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingAddressScopeV2Create,
+		Read:   resourceNetworkingAddressScopeV2Read,
+		Update: resourceNetworkingAddressScopeV2Update,
+		Delete: resourceNetworkingAddressScopeV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ip_version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Default:  4,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(int)
+					if value != 4 && value != 6 {
+						errors = append(errors, fmt.Errorf(
+							"Only 4 and 6 are supported values for 'ip_version'"))
+					}
+					return
+				},
+			},
+			"shared": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingAddressScopeV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := AddressScopeCreateOpts{
+		Name:       d.Get("name").(string),
+		IPVersion:  d.Get("ip_version").(int),
+		Shared:     d.Get("shared").(bool),
+		TenantID:   d.Get("tenant_id").(string),
+		ValueSpecs: MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	scope, err := networkingV2AddressScopeCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron address scope: %s", err)
+	}
+
+	log.Printf("[INFO] Address scope ID: %s", scope.ID)
+
+	d.SetId(scope.ID)
+
+	return resourceNetworkingAddressScopeV2Read(d, meta)
+}
+
+func resourceNetworkingAddressScopeV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	scope, err := networkingV2AddressScopeGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "address scope")
+	}
+
+	log.Printf("[DEBUG] Retrieved address scope %s: %+v", d.Id(), scope)
+
+	d.Set("name", scope.Name)
+	d.Set("ip_version", scope.IPVersion)
+	d.Set("shared", scope.Shared)
+	d.Set("tenant_id", scope.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingAddressScopeV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts AddressScopeUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("shared") {
+		shared := d.Get("shared").(bool)
+		updateOpts.Shared = &shared
+	}
+
+	log.Printf("[DEBUG] Updating address scope %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2AddressScopeUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron address scope: %s", err)
+	}
+
+	return resourceNetworkingAddressScopeV2Read(d, meta)
+}
+
+func resourceNetworkingAddressScopeV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2AddressScopeDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron address scope")
+	}
+
+	d.SetId("")
+	return nil
+}
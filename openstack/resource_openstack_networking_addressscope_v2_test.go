@@ -0,0 +1,146 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2AddressScope_basic(t *testing.T) {
+	var scope AddressScope
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2AddressScopeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2AddressScope_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2AddressScopeExists(
+						"openstack_networking_addressscope_v2.addressscope_1", &scope),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_addressscope_v2.addressscope_1", "name", "addressscope_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_addressscope_v2.addressscope_1", "ip_version", "4"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2AddressScope_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_networking_addressscope_v2.addressscope_1", "id", &scope.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_addressscope_v2.addressscope_1", "name", "addressscope_2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2AddressScope_subnetPool(t *testing.T) {
+	var scope AddressScope
+	var pool SubnetPool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2AddressScopeDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2AddressScope_subnetPool,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2AddressScopeExists(
+						"openstack_networking_addressscope_v2.addressscope_1", &scope),
+					testAccCheckNetworkingV2SubnetPoolExists(
+						"openstack_networking_subnetpool_v2.subnetpool_1", &pool),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_subnetpool_v2.subnetpool_1", "address_scope_id",
+						"openstack_networking_addressscope_v2.addressscope_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2AddressScopeDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_addressscope_v2" {
+			continue
+		}
+
+		_, err := networkingV2AddressScopeGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Address scope still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2AddressScopeExists(n string, scope *AddressScope) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2AddressScopeGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Address scope not found")
+		}
+
+		*scope = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2AddressScope_basic = `
+resource "openstack_networking_addressscope_v2" "addressscope_1" {
+  name = "addressscope_1"
+  ip_version = 4
+}
+`
+
+const testAccNetworkingV2AddressScope_update = `
+resource "openstack_networking_addressscope_v2" "addressscope_1" {
+  name = "addressscope_2"
+  ip_version = 4
+}
+`
+
+const testAccNetworkingV2AddressScope_subnetPool = `
+resource "openstack_networking_addressscope_v2" "addressscope_1" {
+  name = "addressscope_1"
+  ip_version = 4
+}
+
+resource "openstack_networking_subnetpool_v2" "subnetpool_1" {
+  name = "subnetpool_1"
+  prefixes = ["10.10.0.0/16"]
+  address_scope_id = "${openstack_networking_addressscope_v2.addressscope_1.id}"
+}
+`
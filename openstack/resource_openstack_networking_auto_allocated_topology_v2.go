@@ -0,0 +1,130 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/networks"
+)
+
+func resourceNetworkingAutoAllocatedTopologyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingAutoAllocatedTopologyV2Create,
+		Read:   resourceNetworkingAutoAllocatedTopologyV2Read,
+		Delete: resourceNetworkingAutoAllocatedTopologyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"subnet_ids": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceNetworkingAutoAllocatedTopologyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	projectID := d.Get("project_id").(string)
+	if projectID == "" {
+		projectID, err = tokenProjectID(config, GetRegion(d, config))
+		if err != nil {
+			return fmt.Errorf("Error determining the project of the auto-allocated topology: %s", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Allocating topology for project %s", projectID)
+	topology, err := networkingV2AutoAllocatedTopologyGet(networkingClient, projectID).Extract()
+	if err != nil {
+		return fmt.Errorf("Error allocating OpenStack Neutron topology for project %s: %s", projectID, err)
+	}
+
+	log.Printf("[INFO] Auto-allocated network ID: %s", topology.ID)
+
+	d.SetId(projectID)
+
+	return resourceNetworkingAutoAllocatedTopologyV2Read(d, meta)
+}
+
+func resourceNetworkingAutoAllocatedTopologyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	// Getting the topology would allocate a new one if it was deleted
+	// outside of Terraform, so look up its network instead.
+	listOpts := networks.ListOpts{
+		Name:     "auto_allocated_network",
+		TenantID: d.Id(),
+	}
+	pages, err := networks.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return fmt.Errorf("Unable to retrieve networks of project %s: %s", d.Id(), err)
+	}
+
+	allNetworks, err := networks.ExtractNetworks(pages)
+	if err != nil {
+		return fmt.Errorf("Unable to extract networks of project %s: %s", d.Id(), err)
+	}
+
+	if len(allNetworks) < 1 {
+		log.Printf("[DEBUG] No auto-allocated network found for project %s", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	network := allNetworks[0]
+
+	log.Printf("[DEBUG] Retrieved auto-allocated network %s: %+v", network.ID, network)
+
+	d.Set("project_id", d.Id())
+	d.Set("network_id", network.ID)
+	d.Set("subnet_ids", network.Subnets)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingAutoAllocatedTopologyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2AutoAllocatedTopologyDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron auto-allocated topology")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,194 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+func resourceNetworkingFlavorV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingFlavorV2Create,
+		Read:   resourceNetworkingFlavorV2Read,
+		Update: resourceNetworkingFlavorV2Update,
+		Delete: resourceNetworkingFlavorV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"service_profile_ids": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+		},
+	}
+}
+
+func resourceNetworkingFlavorV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := NetworkingFlavorCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		ServiceType: d.Get("service_type").(string),
+		Enabled:     &enabled,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	flavor, err := networkingV2FlavorCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron flavor: %s", err)
+	}
+
+	log.Printf("[INFO] Flavor ID: %s", flavor.ID)
+
+	d.SetId(flavor.ID)
+
+	for _, profileID := range d.Get("service_profile_ids").(*schema.Set).List() {
+		err = networkingV2FlavorAssociateServiceProfile(networkingClient, flavor.ID, profileID.(string)).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error associating service profile %s with OpenStack Neutron flavor %s: %s", profileID, flavor.ID, err)
+		}
+	}
+
+	return resourceNetworkingFlavorV2Read(d, meta)
+}
+
+func resourceNetworkingFlavorV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	flavor, err := networkingV2FlavorGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "flavor")
+	}
+
+	log.Printf("[DEBUG] Retrieved flavor %s: %+v", d.Id(), flavor)
+
+	d.Set("name", flavor.Name)
+	d.Set("description", flavor.Description)
+	d.Set("service_type", flavor.ServiceType)
+	d.Set("enabled", flavor.Enabled)
+	d.Set("service_profile_ids", flavor.ServiceProfileIDs)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingFlavorV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("enabled") {
+		var updateOpts NetworkingFlavorUpdateOpts
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			updateOpts.Name = &name
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChange("enabled") {
+			enabled := d.Get("enabled").(bool)
+			updateOpts.Enabled = &enabled
+		}
+
+		log.Printf("[DEBUG] Updating flavor %s with options: %+v", d.Id(), updateOpts)
+
+		_, err = networkingV2FlavorUpdate(networkingClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack Neutron flavor: %s", err)
+		}
+	}
+
+	if d.HasChange("service_profile_ids") {
+		o, n := d.GetChange("service_profile_ids")
+		oldProfiles, newProfiles := o.(*schema.Set), n.(*schema.Set)
+
+		for _, profileID := range newProfiles.Difference(oldProfiles).List() {
+			log.Printf("[DEBUG] Associating service profile %s with flavor %s", profileID, d.Id())
+			err = networkingV2FlavorAssociateServiceProfile(networkingClient, d.Id(), profileID.(string)).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error associating service profile %s with OpenStack Neutron flavor %s: %s", profileID, d.Id(), err)
+			}
+		}
+
+		for _, profileID := range oldProfiles.Difference(newProfiles).List() {
+			log.Printf("[DEBUG] Disassociating service profile %s from flavor %s", profileID, d.Id())
+			err = networkingV2FlavorDisassociateServiceProfile(networkingClient, d.Id(), profileID.(string)).ExtractErr()
+			if err != nil {
+				return fmt.Errorf("Error disassociating service profile %s from OpenStack Neutron flavor %s: %s", profileID, d.Id(), err)
+			}
+		}
+	}
+
+	return resourceNetworkingFlavorV2Read(d, meta)
+}
+
+func resourceNetworkingFlavorV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	// A flavor can't be deleted while service profiles are associated with it.
+	for _, profileID := range d.Get("service_profile_ids").(*schema.Set).List() {
+		err = networkingV2FlavorDisassociateServiceProfile(networkingClient, d.Id(), profileID.(string)).ExtractErr()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); !ok {
+				return fmt.Errorf("Error disassociating service profile %s from OpenStack Neutron flavor %s: %s", profileID, d.Id(), err)
+			}
+		}
+	}
+
+	err = networkingV2FlavorDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron flavor")
+	}
+
+	d.SetId("")
+	return nil
+}
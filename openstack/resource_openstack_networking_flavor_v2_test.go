@@ -0,0 +1,120 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2Flavor_basic(t *testing.T) {
+	var flavor NetworkingFlavor
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2FlavorDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Flavor_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FlavorExists(
+						"openstack_networking_flavor_v2.flavor_1", &flavor),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_flavor_v2.flavor_1", "name", "flavor_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_flavor_v2.flavor_1", "service_type", "LOADBALANCERV2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_flavor_v2.flavor_1", "service_profile_ids.#", "0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Flavor_serviceProfile,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_networking_flavor_v2.flavor_1", "id", &flavor.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_flavor_v2.flavor_1", "name", "flavor_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_flavor_v2.flavor_1", "service_profile_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2FlavorDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_flavor_v2" {
+			continue
+		}
+
+		_, err := networkingV2FlavorGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Flavor still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2FlavorExists(n string, flavor *NetworkingFlavor) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2FlavorGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Flavor not found")
+		}
+
+		*flavor = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2Flavor_basic = `
+resource "openstack_networking_flavor_v2" "flavor_1" {
+  name = "flavor_1"
+  service_type = "LOADBALANCERV2"
+}
+`
+
+const testAccNetworkingV2Flavor_serviceProfile = `
+resource "openstack_networking_service_profile_v2" "profile_1" {
+  driver = "neutron_lbaas.drivers.haproxy.plugin_driver.HaproxyOnHostPluginDriver"
+}
+
+resource "openstack_networking_flavor_v2" "flavor_1" {
+  name = "flavor_2"
+  service_type = "LOADBALANCERV2"
+  service_profile_ids = ["${openstack_networking_service_profile_v2.profile_1.id}"]
+}
+`
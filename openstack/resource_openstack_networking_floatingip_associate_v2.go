@@ -0,0 +1,190 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+	"github.com/gophercloud/gophercloud/pagination"
+)
+
+func resourceNetworkingFloatingIPAssociateV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingFloatingIPAssociateV2Create,
+		Read:   resourceNetworkingFloatingIPAssociateV2Read,
+		Update: resourceNetworkingFloatingIPAssociateV2Update,
+		Delete: resourceNetworkingFloatingIPAssociateV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"floating_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"port_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"fixed_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingFloatingIPAssociateV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack network client: %s", err)
+	}
+
+	floatingIP := d.Get("floating_ip").(string)
+	floatingIPID, err := networkingFloatingIPV2ID(networkingClient, floatingIP)
+	if err != nil {
+		return fmt.Errorf("Error retrieving floating IP %s: %s", floatingIP, err)
+	}
+
+	portID := d.Get("port_id").(string)
+	updateOpts := FloatingIPUpdateOpts{
+		UpdateOpts: floatingips.UpdateOpts{
+			PortID: &portID,
+		},
+	}
+	if v, ok := d.GetOk("fixed_ip"); ok {
+		fixedIP := v.(string)
+		updateOpts.FixedIP = &fixedIP
+	}
+
+	log.Printf("[DEBUG] Associate Options: %#v", updateOpts)
+	_, err = floatingips.Update(networkingClient, floatingIPID, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error associating floating IP %s with port %s: %s", floatingIP, portID, err)
+	}
+
+	d.SetId(floatingIPID)
+
+	return resourceNetworkingFloatingIPAssociateV2Read(d, meta)
+}
+
+func resourceNetworkingFloatingIPAssociateV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack network client: %s", err)
+	}
+
+	floatingIP, err := floatingips.Get(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "floating IP association")
+	}
+
+	// The floating IP was disassociated outside of Terraform.
+	if floatingIP.PortID == "" {
+		log.Printf("[DEBUG] Floating IP %s is not associated with a port", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("floating_ip", floatingIP.FloatingIP)
+	d.Set("port_id", floatingIP.PortID)
+	d.Set("fixed_ip", floatingIP.FixedIP)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingFloatingIPAssociateV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack network client: %s", err)
+	}
+
+	portID := d.Get("port_id").(string)
+	updateOpts := FloatingIPUpdateOpts{
+		UpdateOpts: floatingips.UpdateOpts{
+			PortID: &portID,
+		},
+	}
+	if d.HasChange("fixed_ip") {
+		fixedIP := d.Get("fixed_ip").(string)
+		updateOpts.FixedIP = &fixedIP
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	_, err = floatingips.Update(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating floating IP association %s: %s", d.Id(), err)
+	}
+
+	return resourceNetworkingFloatingIPAssociateV2Read(d, meta)
+}
+
+func resourceNetworkingFloatingIPAssociateV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack network client: %s", err)
+	}
+
+	// A nil PortID disassociates the floating IP.
+	updateOpts := floatingips.UpdateOpts{}
+
+	log.Printf("[DEBUG] Disassociating floating IP %s", d.Id())
+	_, err = floatingips.Update(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error disassociating floating IP")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// networkingFloatingIPV2ID returns the ID of the floating IP with the given
+// address.
+func networkingFloatingIPV2ID(client *gophercloud.ServiceClient, floatingIP string) (string, error) {
+	listOpts := floatingips.ListOpts{
+		FloatingIP: floatingIP,
+	}
+
+	var floatingIPID string
+	err := floatingips.List(client, listOpts).EachPage(func(page pagination.Page) (bool, error) {
+		floatingIPList, err := floatingips.ExtractFloatingIPs(page)
+		if err != nil {
+			return false, err
+		}
+
+		for _, f := range floatingIPList {
+			if f.FloatingIP == floatingIP {
+				floatingIPID = f.ID
+				return false, nil
+			}
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if floatingIPID == "" {
+		return "", fmt.Errorf("No floating IP found")
+	}
+
+	return floatingIPID, nil
+}
@@ -0,0 +1,163 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/layer3/floatingips"
+)
+
+func TestAccNetworkingV2FloatingIPAssociate_basic(t *testing.T) {
+	var fip floatingips.FloatingIP
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2FloatingIPAssociateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2FloatingIPAssociate_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists(
+						"openstack_networking_floatingip_associate_v2.fip_1", &fip),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_floatingip_associate_v2.fip_1", "floating_ip",
+						"openstack_networking_floatingip_v2.fip_1", "address"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_floatingip_associate_v2.fip_1", "port_id",
+						"openstack_networking_port_v2.port_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_floatingip_associate_v2.fip_1", "fixed_ip", "192.168.199.10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2FloatingIPAssociate_switchPort(t *testing.T) {
+	var fip floatingips.FloatingIP
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2FloatingIPAssociateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2FloatingIPAssociate_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists(
+						"openstack_networking_floatingip_associate_v2.fip_1", &fip),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_floatingip_associate_v2.fip_1", "port_id",
+						"openstack_networking_port_v2.port_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2FloatingIPAssociate_switchPort,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2FloatingIPExists(
+						"openstack_networking_floatingip_associate_v2.fip_1", &fip),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_floatingip_associate_v2.fip_1", "port_id",
+						"openstack_networking_port_v2.port_2", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_floatingip_associate_v2.fip_1", "fixed_ip", "192.168.199.20"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2FloatingIPAssociateDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_floatingip_associate_v2" {
+			continue
+		}
+
+		fip, err := floatingips.Get(networkClient, rs.Primary.ID).Extract()
+		if err == nil && fip.PortID != "" {
+			return fmt.Errorf("Floating IP %s is still associated with port %s", rs.Primary.ID, fip.PortID)
+		}
+	}
+
+	return nil
+}
+
+const testAccNetworkingV2FloatingIPAssociate_base = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  external_gateway = "%s"
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_subnet_v2.subnet_1.network_id}"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.10"
+  }
+}
+
+resource "openstack_networking_port_v2" "port_2" {
+  name = "port_2"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_subnet_v2.subnet_1.network_id}"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.20"
+  }
+}
+
+resource "openstack_networking_floatingip_v2" "fip_1" {
+  pool = "%s"
+}
+`
+
+var testAccNetworkingV2FloatingIPAssociate_basic = fmt.Sprintf(`
+%s
+
+resource "openstack_networking_floatingip_associate_v2" "fip_1" {
+  floating_ip = "${openstack_networking_floatingip_v2.fip_1.address}"
+  port_id = "${openstack_networking_port_v2.port_1.id}"
+  depends_on = ["openstack_networking_router_interface_v2.router_interface_1"]
+}
+`, fmt.Sprintf(testAccNetworkingV2FloatingIPAssociate_base, OS_EXTGW_ID, OS_POOL_NAME))
+
+var testAccNetworkingV2FloatingIPAssociate_switchPort = fmt.Sprintf(`
+%s
+
+resource "openstack_networking_floatingip_associate_v2" "fip_1" {
+  floating_ip = "${openstack_networking_floatingip_v2.fip_1.address}"
+  port_id = "${openstack_networking_port_v2.port_2.id}"
+  depends_on = ["openstack_networking_router_interface_v2.router_interface_1"]
+}
+`, fmt.Sprintf(testAccNetworkingV2FloatingIPAssociate_base, OS_EXTGW_ID, OS_POOL_NAME))
@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingL2GatewayConnectionV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingL2GatewayConnectionV2Create,
+		Read:   resourceNetworkingL2GatewayConnectionV2Read,
+		Delete: resourceNetworkingL2GatewayConnectionV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"l2_gateway_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"segmentation_id": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingL2GatewayConnectionV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := L2GatewayConnectionCreateOpts{
+		L2GatewayID:    d.Get("l2_gateway_id").(string),
+		NetworkID:      d.Get("network_id").(string),
+		SegmentationID: d.Get("segmentation_id").(int),
+		TenantID:       d.Get("tenant_id").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	connection, err := networkingV2L2GatewayConnectionCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron L2 gateway connection: %s", err)
+	}
+
+	log.Printf("[INFO] L2 gateway connection ID: %s", connection.ID)
+
+	d.SetId(connection.ID)
+
+	return resourceNetworkingL2GatewayConnectionV2Read(d, meta)
+}
+
+func resourceNetworkingL2GatewayConnectionV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	connection, err := networkingV2L2GatewayConnectionGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "L2 gateway connection")
+	}
+
+	log.Printf("[DEBUG] Retrieved L2 gateway connection %s: %+v", d.Id(), connection)
+
+	d.Set("l2_gateway_id", connection.L2GatewayID)
+	d.Set("network_id", connection.NetworkID)
+	d.Set("segmentation_id", connection.SegmentationID)
+	d.Set("tenant_id", connection.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingL2GatewayConnectionV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2L2GatewayConnectionDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron L2 gateway connection")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,207 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingL2GatewayV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingL2GatewayV2Create,
+		Read:   resourceNetworkingL2GatewayV2Read,
+		Update: resourceNetworkingL2GatewayV2Update,
+		Delete: resourceNetworkingL2GatewayV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"device": &schema.Schema{
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"device_name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"interface": &schema.Schema{
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": &schema.Schema{
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"segmentation_ids": &schema.Schema{
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeInt},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingL2GatewayV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := L2GatewayCreateOpts{
+		Name:     d.Get("name").(string),
+		Devices:  resourceNetworkingL2GatewayV2Devices(d.Get("device").([]interface{})),
+		TenantID: d.Get("tenant_id").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	l2gw, err := networkingV2L2GatewayCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron L2 gateway: %s", err)
+	}
+
+	log.Printf("[INFO] L2 gateway ID: %s", l2gw.ID)
+
+	d.SetId(l2gw.ID)
+
+	return resourceNetworkingL2GatewayV2Read(d, meta)
+}
+
+func resourceNetworkingL2GatewayV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	l2gw, err := networkingV2L2GatewayGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "L2 gateway")
+	}
+
+	log.Printf("[DEBUG] Retrieved L2 gateway %s: %+v", d.Id(), l2gw)
+
+	d.Set("name", l2gw.Name)
+	d.Set("device", resourceNetworkingL2GatewayV2FlattenDevices(l2gw.Devices))
+	d.Set("tenant_id", l2gw.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingL2GatewayV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts L2GatewayUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("device") {
+		devices := resourceNetworkingL2GatewayV2Devices(d.Get("device").([]interface{}))
+		updateOpts.Devices = &devices
+	}
+
+	log.Printf("[DEBUG] Updating L2 gateway %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2L2GatewayUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron L2 gateway: %s", err)
+	}
+
+	return resourceNetworkingL2GatewayV2Read(d, meta)
+}
+
+func resourceNetworkingL2GatewayV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2L2GatewayDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron L2 gateway")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceNetworkingL2GatewayV2Devices(rawDevices []interface{}) []L2GatewayDevice {
+	devices := make([]L2GatewayDevice, len(rawDevices))
+	for i, raw := range rawDevices {
+		rawMap := raw.(map[string]interface{})
+
+		rawInterfaces := rawMap["interface"].([]interface{})
+		interfaces := make([]L2GatewayInterface, len(rawInterfaces))
+		for j, rawInterface := range rawInterfaces {
+			rawInterfaceMap := rawInterface.(map[string]interface{})
+
+			var segmentationIDs []int
+			for _, id := range rawInterfaceMap["segmentation_ids"].([]interface{}) {
+				segmentationIDs = append(segmentationIDs, id.(int))
+			}
+
+			interfaces[j] = L2GatewayInterface{
+				Name:           rawInterfaceMap["name"].(string),
+				SegmentationID: segmentationIDs,
+			}
+		}
+
+		devices[i] = L2GatewayDevice{
+			DeviceName: rawMap["device_name"].(string),
+			Interfaces: interfaces,
+		}
+	}
+	return devices
+}
+
+func resourceNetworkingL2GatewayV2FlattenDevices(devices []L2GatewayDevice) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(devices))
+	for i, device := range devices {
+		interfaces := make([]map[string]interface{}, len(device.Interfaces))
+		for j, iface := range device.Interfaces {
+			interfaces[j] = map[string]interface{}{
+				"name":             iface.Name,
+				"segmentation_ids": iface.SegmentationID,
+			}
+		}
+
+		result[i] = map[string]interface{}{
+			"device_name": device.DeviceName,
+			"interface":   interfaces,
+		}
+	}
+	return result
+}
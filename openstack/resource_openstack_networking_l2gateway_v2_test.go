@@ -0,0 +1,240 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2L2Gateway_basic(t *testing.T) {
+	var l2gw L2Gateway
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckL2GW(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2L2GatewayDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2L2Gateway_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2L2GatewayExists(
+						"openstack_networking_l2gateway_v2.l2gw_1", &l2gw),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_l2gateway_v2.l2gw_1", "name", "l2gw_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_l2gateway_v2.l2gw_1", "device.0.device_name", "switch_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_l2gateway_v2.l2gw_1", "device.0.interface.0.name", "eth1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2L2Gateway_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_networking_l2gateway_v2.l2gw_1", "id", &l2gw.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_l2gateway_v2.l2gw_1", "name", "l2gw_2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2L2Gateway_connection(t *testing.T) {
+	var l2gw L2Gateway
+	var connection L2GatewayConnection
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckL2GW(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2L2GatewayConnectionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2L2Gateway_connection,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2L2GatewayExists(
+						"openstack_networking_l2gateway_v2.l2gw_1", &l2gw),
+					testAccCheckNetworkingV2L2GatewayConnectionExists(
+						"openstack_networking_l2gateway_connection_v2.connection_1", &connection),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_l2gateway_connection_v2.connection_1", "l2_gateway_id",
+						"openstack_networking_l2gateway_v2.l2gw_1", "id"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_l2gateway_connection_v2.connection_1", "network_id",
+						"openstack_networking_network_v2.network_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_l2gateway_connection_v2.connection_1", "segmentation_id", "100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2L2GatewayDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_l2gateway_v2" {
+			continue
+		}
+
+		_, err := networkingV2L2GatewayGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("L2 gateway still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2L2GatewayConnectionDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_l2gateway_connection_v2" {
+			continue
+		}
+
+		_, err := networkingV2L2GatewayConnectionGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("L2 gateway connection still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2L2GatewayExists(n string, l2gw *L2Gateway) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2L2GatewayGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("L2 gateway not found")
+		}
+
+		*l2gw = *found
+
+		return nil
+	}
+}
+
+func testAccCheckNetworkingV2L2GatewayConnectionExists(n string, connection *L2GatewayConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2L2GatewayConnectionGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("L2 gateway connection not found")
+		}
+
+		*connection = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2L2Gateway_basic = `
+resource "openstack_networking_l2gateway_v2" "l2gw_1" {
+  name = "l2gw_1"
+
+  device {
+    device_name = "switch_1"
+
+    interface {
+      name = "eth1"
+    }
+  }
+}
+`
+
+const testAccNetworkingV2L2Gateway_update = `
+resource "openstack_networking_l2gateway_v2" "l2gw_1" {
+  name = "l2gw_2"
+
+  device {
+    device_name = "switch_1"
+
+    interface {
+      name = "eth1"
+    }
+  }
+}
+`
+
+const testAccNetworkingV2L2Gateway_connection = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_l2gateway_v2" "l2gw_1" {
+  name = "l2gw_1"
+
+  device {
+    device_name = "switch_1"
+
+    interface {
+      name = "eth1"
+    }
+  }
+}
+
+resource "openstack_networking_l2gateway_connection_v2" "connection_1" {
+  l2_gateway_id = "${openstack_networking_l2gateway_v2.l2gw_1.id}"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  segmentation_id = 100
+}
+`
@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingMeteringLabelRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingMeteringLabelRuleV2Create,
+		Read:   resourceNetworkingMeteringLabelRuleV2Read,
+		Delete: resourceNetworkingMeteringLabelRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"metering_label_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"direction": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					switch v.(string) {
+					case "ingress", "egress":
+						return
+					}
+					errors = append(errors, fmt.Errorf(
+						"Only 'ingress' and 'egress' are supported values for '%s'", k))
+					return
+				},
+			},
+			"remote_ip_prefix": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"excluded": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceNetworkingMeteringLabelRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := MeteringLabelRuleCreateOpts{
+		MeteringLabelID: d.Get("metering_label_id").(string),
+		Direction:       d.Get("direction").(string),
+		RemoteIPPrefix:  d.Get("remote_ip_prefix").(string),
+		Excluded:        d.Get("excluded").(bool),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	rule, err := networkingV2MeteringLabelRuleCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron metering label rule: %s", err)
+	}
+
+	log.Printf("[INFO] Metering label rule ID: %s", rule.ID)
+
+	d.SetId(rule.ID)
+
+	return resourceNetworkingMeteringLabelRuleV2Read(d, meta)
+}
+
+func resourceNetworkingMeteringLabelRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	rule, err := networkingV2MeteringLabelRuleGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "metering label rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved metering label rule %s: %+v", d.Id(), rule)
+
+	d.Set("metering_label_id", rule.MeteringLabelID)
+	d.Set("direction", rule.Direction)
+	d.Set("remote_ip_prefix", rule.RemoteIPPrefix)
+	d.Set("excluded", rule.Excluded)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingMeteringLabelRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2MeteringLabelRuleDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron metering label rule")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,115 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2MeteringLabelRule_basic(t *testing.T) {
+	var label MeteringLabel
+	var rule MeteringLabelRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2MeteringLabelRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2MeteringLabelRule_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2MeteringLabelExists(
+						"openstack_networking_metering_label_v2.label_1", &label),
+					testAccCheckNetworkingV2MeteringLabelRuleExists(
+						"openstack_networking_metering_label_rule_v2.rule_1", &rule),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_metering_label_rule_v2.rule_1", "metering_label_id",
+						"openstack_networking_metering_label_v2.label_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_metering_label_rule_v2.rule_1", "direction", "ingress"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_metering_label_rule_v2.rule_1", "remote_ip_prefix", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_metering_label_rule_v2.rule_2", "excluded", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2MeteringLabelRuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_metering_label_rule_v2" {
+			continue
+		}
+
+		_, err := networkingV2MeteringLabelRuleGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Metering label rule still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2MeteringLabelRuleExists(n string, rule *MeteringLabelRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2MeteringLabelRuleGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Metering label rule not found")
+		}
+
+		*rule = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2MeteringLabelRule_basic = `
+resource "openstack_networking_metering_label_v2" "label_1" {
+  name = "label_1"
+}
+
+resource "openstack_networking_metering_label_rule_v2" "rule_1" {
+  metering_label_id = "${openstack_networking_metering_label_v2.label_1.id}"
+  direction = "ingress"
+  remote_ip_prefix = "10.0.0.0/8"
+}
+
+resource "openstack_networking_metering_label_rule_v2" "rule_2" {
+  metering_label_id = "${openstack_networking_metering_label_v2.label_1.id}"
+  direction = "ingress"
+  remote_ip_prefix = "10.0.1.0/24"
+  excluded = true
+}
+`
@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingMeteringLabelV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingMeteringLabelV2Create,
+		Read:   resourceNetworkingMeteringLabelV2Read,
+		Delete: resourceNetworkingMeteringLabelV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"shared": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingMeteringLabelV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := MeteringLabelCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Shared:      d.Get("shared").(bool),
+		TenantID:    d.Get("tenant_id").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	label, err := networkingV2MeteringLabelCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron metering label: %s", err)
+	}
+
+	log.Printf("[INFO] Metering label ID: %s", label.ID)
+
+	d.SetId(label.ID)
+
+	return resourceNetworkingMeteringLabelV2Read(d, meta)
+}
+
+func resourceNetworkingMeteringLabelV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	label, err := networkingV2MeteringLabelGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "metering label")
+	}
+
+	log.Printf("[DEBUG] Retrieved metering label %s: %+v", d.Id(), label)
+
+	d.Set("name", label.Name)
+	d.Set("description", label.Description)
+	d.Set("shared", label.Shared)
+	d.Set("tenant_id", label.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingMeteringLabelV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2MeteringLabelDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron metering label")
+	}
+
+	d.SetId("")
+	return nil
+}
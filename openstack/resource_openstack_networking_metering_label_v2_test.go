@@ -0,0 +1,97 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2MeteringLabel_basic(t *testing.T) {
+	var label MeteringLabel
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2MeteringLabelDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2MeteringLabel_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2MeteringLabelExists(
+						"openstack_networking_metering_label_v2.label_1", &label),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_metering_label_v2.label_1", "name", "label_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_metering_label_v2.label_1", "description", "traffic of tenant_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_metering_label_v2.label_1", "shared", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2MeteringLabelDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_metering_label_v2" {
+			continue
+		}
+
+		_, err := networkingV2MeteringLabelGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Metering label still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2MeteringLabelExists(n string, label *MeteringLabel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2MeteringLabelGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Metering label not found")
+		}
+
+		*label = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2MeteringLabel_basic = `
+resource "openstack_networking_metering_label_v2" "label_1" {
+  name = "label_1"
+  description = "traffic of tenant_1"
+}
+`
@@ -63,6 +63,7 @@ func resourceNetworkingNetworkV2() *schema.Resource {
 				Type:     schema.TypeList,
 				Optional: true,
 				ForceNew: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"physical_network": &schema.Schema{
@@ -83,6 +84,34 @@ func resourceNetworkingNetworkV2() *schema.Resource {
 					},
 				},
 			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"mtu": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"dns_domain": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"availability_zone_hints": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"availability_zones": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -100,11 +129,18 @@ func resourceNetworkingNetworkV2Create(d *schema.ResourceData, meta interface{})
 	}
 
 	createOpts := NetworkCreateOpts{
-		networks.CreateOpts{
+		CreateOpts: networks.CreateOpts{
 			Name:     d.Get("name").(string),
 			TenantID: d.Get("tenant_id").(string),
 		},
-		MapValueSpecs(d),
+		QoSPolicyID: d.Get("qos_policy_id").(string),
+		MTU:         d.Get("mtu").(int),
+		DNSDomain:   d.Get("dns_domain").(string),
+		ValueSpecs:  MapValueSpecs(d),
+	}
+
+	if v, ok := d.GetOk("availability_zone_hints"); ok {
+		createOpts.AvailabilityZoneHints = resourceNetworkingAvailabilityZoneHintsV2(v.(*schema.Set))
 	}
 
 	asuRaw := d.Get("admin_state_up").(string)
@@ -171,17 +207,39 @@ func resourceNetworkingNetworkV2Read(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	n, err := networks.Get(networkingClient, d.Id()).Extract()
+	r := networks.Get(networkingClient, d.Id())
+	n, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "network")
 	}
 
+	qosPolicyID, err := networkingV2NetworkQoSPolicyID(r)
+	if err != nil {
+		return err
+	}
+
+	providerAttrs, err := provider.ExtractGet(r)
+	if err != nil {
+		return err
+	}
+
+	extAttrs, err := networkingV2NetworkExtAttrs(r)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Retrieved Network %s: %+v", d.Id(), n)
 
 	d.Set("name", n.Name)
 	d.Set("admin_state_up", strconv.FormatBool(n.AdminStateUp))
 	d.Set("shared", strconv.FormatBool(n.Shared))
 	d.Set("tenant_id", n.TenantID)
+	d.Set("qos_policy_id", qosPolicyID)
+	d.Set("segments", resourceNetworkingNetworkV2FlattenSegments(providerAttrs))
+	d.Set("mtu", extAttrs.MTU)
+	d.Set("dns_domain", extAttrs.DNSDomain)
+	d.Set("availability_zone_hints", extAttrs.AvailabilityZoneHints)
+	d.Set("availability_zones", extAttrs.AvailabilityZones)
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -194,7 +252,7 @@ func resourceNetworkingNetworkV2Update(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts networks.UpdateOpts
+	var updateOpts NetworkUpdateOpts
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
 	}
@@ -219,6 +277,21 @@ func resourceNetworkingNetworkV2Update(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("qos_policy_id") {
+		qosPolicyID := d.Get("qos_policy_id").(string)
+		updateOpts.QoSPolicyID = &qosPolicyID
+	}
+
+	if d.HasChange("mtu") {
+		mtu := d.Get("mtu").(int)
+		updateOpts.MTU = &mtu
+	}
+
+	if d.HasChange("dns_domain") {
+		dnsDomain := d.Get("dns_domain").(string)
+		updateOpts.DNSDomain = &dnsDomain
+	}
+
 	log.Printf("[DEBUG] Updating Network %s with options: %+v", d.Id(), updateOpts)
 
 	_, err = networks.Update(networkingClient, d.Id(), updateOpts).Extract()
@@ -277,6 +350,61 @@ func resourceNetworkingNetworkV2Segments(d *schema.ResourceData) (providerSegmen
 	return
 }
 
+// resourceNetworkingNetworkV2FlattenSegments returns the provider segments
+// of a network. A network with a single segment is reported through the
+// provider attributes of the network rather than through a segments list.
+// The provider attributes are only visible to admins.
+func resourceNetworkingNetworkV2FlattenSegments(n *provider.NetworkExtAttrs) []map[string]interface{} {
+	segments := make([]map[string]interface{}, 0, len(n.Segments))
+	for _, segment := range n.Segments {
+		segments = append(segments, map[string]interface{}{
+			"physical_network": segment.PhysicalNetwork,
+			"network_type":     segment.NetworkType,
+			"segmentation_id":  segment.SegmentationID,
+		})
+	}
+
+	if len(segments) == 0 && n.NetworkType != "" {
+		segmentationID, _ := strconv.Atoi(n.SegmentationID)
+		segments = append(segments, map[string]interface{}{
+			"physical_network": n.PhysicalNetwork,
+			"network_type":     n.NetworkType,
+			"segmentation_id":  segmentationID,
+		})
+	}
+
+	return segments
+}
+
+// NetworkExtAttrs represents the attributes of a network which are provided
+// by extensions and are not exposed by Gophercloud's Network.
+type NetworkExtAttrs struct {
+	MTU                   int      `json:"mtu"`
+	DNSDomain             string   `json:"dns_domain"`
+	AvailabilityZoneHints []string `json:"availability_zone_hints"`
+	AvailabilityZones     []string `json:"availability_zones"`
+}
+
+// networkingV2NetworkExtAttrs extracts the extension attributes of a
+// network.
+func networkingV2NetworkExtAttrs(r networks.GetResult) (*NetworkExtAttrs, error) {
+	var s struct {
+		Network *NetworkExtAttrs `json:"network"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Network, err
+}
+
+// resourceNetworkingAvailabilityZoneHintsV2 converts the availability zone
+// hints of a network or router into a list.
+func resourceNetworkingAvailabilityZoneHintsV2(v *schema.Set) []string {
+	var hints []string
+	for _, hint := range v.List() {
+		hints = append(hints, hint.(string))
+	}
+	return hints
+}
+
 func waitForNetworkActive(networkingClient *gophercloud.ServiceClient, networkId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		n, err := networks.Get(networkingClient, networkId).Extract()
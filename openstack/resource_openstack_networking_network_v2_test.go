@@ -123,6 +123,111 @@ func TestAccNetworkingV2Network_multipleSegmentMappings(t *testing.T) {
 				Config: testAccNetworkingV2Network_multipleSegmentMappings,
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "segments.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "segments.0.network_type", "vxlan"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "segments.0.segmentation_id", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Network_multipleSegments(t *testing.T) {
+	var network networks.Network
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Network_multipleSegments,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "segments.#", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "segments.1.segmentation_id", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Network_qosPolicy(t *testing.T) {
+	var network networks.Network
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Network_qosPolicy,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_network_v2.network_1", "qos_policy_id",
+						"openstack_networking_qos_policy_v2.qos_policy_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Network_mtu(t *testing.T) {
+	var network networks.Network
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Network_mtu,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "mtu", "1400"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Network_mtuUpdate,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "mtu", "1350"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Network_availabilityZoneHints(t *testing.T) {
+	var network networks.Network
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2NetworkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Network_availabilityZoneHints,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2NetworkExists("openstack_networking_network_v2.network_1", &network),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "availability_zone_hints.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_network_v2.network_1", "availability_zone_hints.4209400975", "nova"),
 				),
 			},
 		},
@@ -289,3 +394,56 @@ resource "openstack_networking_network_v2" "network_1" {
   admin_state_up = "true"
 }
 `
+
+const testAccNetworkingV2Network_multipleSegments = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+
+  segments {
+    network_type = "vxlan"
+    segmentation_id = 2
+  }
+
+  segments {
+    network_type = "vxlan"
+    segmentation_id = 3
+  }
+}
+`
+
+const testAccNetworkingV2Network_qosPolicy = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+}
+`
+
+const testAccNetworkingV2Network_mtu = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+  mtu = 1400
+}
+`
+
+const testAccNetworkingV2Network_mtuUpdate = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+  mtu = 1350
+}
+`
+
+const testAccNetworkingV2Network_availabilityZoneHints = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+  availability_zone_hints = ["nova"]
+}
+`
@@ -2,8 +2,10 @@ package openstack
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
 	"time"
 
 	"github.com/hashicorp/terraform/helper/hashcode"
@@ -11,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform/helper/schema"
 
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/ports"
 )
 
@@ -124,6 +127,63 @@ func resourceNetworkingPortV2() *schema.Resource {
 					},
 				},
 			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"binding": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_id": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"profile": &schema.Schema{
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateFunc:     validateJSONObject,
+							DiffSuppressFunc: suppressNetworkingPortV2BindingProfileDiffs,
+						},
+						"vnic_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "normal",
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								switch v.(string) {
+								case "normal", "direct", "direct-physical", "macvtap", "baremetal", "virtio-forwarder":
+									return
+								}
+								errors = append(errors, fmt.Errorf(
+									"Only 'normal', 'direct', 'direct-physical', 'macvtap', 'baremetal' and 'virtio-forwarder' are supported values for '%s'", k))
+								return
+							},
+						},
+						"vif_type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vif_details": &schema.Schema{
+							Type:     schema.TypeMap,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dns_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"dns_assignment": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeMap},
+			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -162,7 +222,7 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 	}
 
 	createOpts := PortCreateOpts{
-		ports.CreateOpts{
+		CreateOpts: ports.CreateOpts{
 			Name:                d.Get("name").(string),
 			AdminStateUp:        resourcePortAdminStateUpV2(d),
 			NetworkID:           d.Get("network_id").(string),
@@ -173,7 +233,19 @@ func resourceNetworkingPortV2Create(d *schema.ResourceData, meta interface{}) er
 			FixedIPs:            resourcePortFixedIpsV2(d),
 			AllowedAddressPairs: resourceAllowedAddressPairsV2(d),
 		},
-		MapValueSpecs(d),
+		QoSPolicyID: d.Get("qos_policy_id").(string),
+		DNSName:     d.Get("dns_name").(string),
+		ValueSpecs:  MapValueSpecs(d),
+	}
+
+	if binding, ok := resourceNetworkingPortV2Binding(d); ok {
+		profile, err := resourceNetworkingPortV2BindingProfile(binding)
+		if err != nil {
+			return err
+		}
+		createOpts.HostID = binding["host_id"].(string)
+		createOpts.VNICType = binding["vnic_type"].(string)
+		createOpts.Profile = profile
 	}
 
 	if noSecurityGroups {
@@ -218,11 +290,27 @@ func resourceNetworkingPortV2Read(d *schema.ResourceData, meta interface{}) erro
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	p, err := ports.Get(networkingClient, d.Id()).Extract()
+	r := ports.Get(networkingClient, d.Id())
+	p, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "port")
 	}
 
+	qosPolicyID, err := networkingV2PortQoSPolicyID(r)
+	if err != nil {
+		return err
+	}
+
+	binding, err := networkingV2PortBinding(r)
+	if err != nil {
+		return err
+	}
+
+	dns, err := networkingV2PortDNS(r)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Retrieved Port %s: %+v", d.Id(), p)
 
 	d.Set("name", p.Name)
@@ -232,6 +320,10 @@ func resourceNetworkingPortV2Read(d *schema.ResourceData, meta interface{}) erro
 	d.Set("tenant_id", p.TenantID)
 	d.Set("device_owner", p.DeviceOwner)
 	d.Set("device_id", p.DeviceID)
+	d.Set("qos_policy_id", qosPolicyID)
+	d.Set("binding", resourceNetworkingPortV2FlattenBinding(binding))
+	d.Set("dns_name", dns.DNSName)
+	d.Set("dns_assignment", dns.DNSAssignment)
 
 	// Create a slice of all returned Fixed IPs.
 	// This will be in the order returned by the API,
@@ -247,15 +339,7 @@ func resourceNetworkingPortV2Read(d *schema.ResourceData, meta interface{}) erro
 	// the port can have the "default" group automatically applied.
 	d.Set("all_security_group_ids", p.SecurityGroups)
 
-	// Convert AllowedAddressPairs to list of map
-	var pairs []map[string]interface{}
-	for _, pairObject := range p.AllowedAddressPairs {
-		pair := make(map[string]interface{})
-		pair["ip_address"] = pairObject.IPAddress
-		pair["mac_address"] = pairObject.MACAddress
-		pairs = append(pairs, pair)
-	}
-	d.Set("allowed_address_pairs", pairs)
+	d.Set("allowed_address_pairs", resourceNetworkingPortV2FlattenAllowedAddressPairs(d, p))
 
 	d.Set("region", GetRegion(d, config))
 
@@ -279,7 +363,7 @@ func resourceNetworkingPortV2Update(d *schema.ResourceData, meta interface{}) er
 	}
 
 	var hasChange bool
-	var updateOpts ports.UpdateOpts
+	var updateOpts PortUpdateOpts
 
 	if d.HasChange("allowed_address_pairs") {
 		hasChange = true
@@ -287,18 +371,27 @@ func resourceNetworkingPortV2Update(d *schema.ResourceData, meta interface{}) er
 		updateOpts.AllowedAddressPairs = &aap
 	}
 
-	if d.HasChange("no_security_groups") {
-		if noSecurityGroups {
-			hasChange = true
-			v := []string{}
-			updateOpts.SecurityGroups = &v
+	if d.HasChange("no_security_groups") || d.HasChange("security_group_ids") {
+		hasChange = true
+
+		// An explicit empty list removes all security groups from the
+		// port, whereas omitting it would leave them unchanged.
+		if securityGroups == nil {
+			securityGroups = []string{}
+		}
+
+		// When no_security_groups is switched off without any
+		// security_group_ids given, fall back to the default security
+		// group of the port's tenant, like the Networking service does
+		// when a port is created.
+		if d.HasChange("no_security_groups") && !noSecurityGroups && len(securityGroups) == 0 {
+			defaultGroup, err := resourceNetworkingPortV2DefaultSecGroup(networkingClient, d.Get("tenant_id").(string))
+			if err != nil {
+				return err
+			}
+			securityGroups = append(securityGroups, defaultGroup)
 		}
-	}
 
-	if d.HasChange("security_group_ids") {
-		hasChange = true
-		sgs := d.Get("security_group_ids").(*schema.Set)
-		securityGroups := resourcePortSecurityGroupsV2(sgs)
 		updateOpts.SecurityGroups = &securityGroups
 	}
 
@@ -327,6 +420,48 @@ func resourceNetworkingPortV2Update(d *schema.ResourceData, meta interface{}) er
 		updateOpts.FixedIPs = resourcePortFixedIpsV2(d)
 	}
 
+	if d.HasChange("qos_policy_id") {
+		hasChange = true
+		qosPolicyID := d.Get("qos_policy_id").(string)
+		updateOpts.QoSPolicyID = &qosPolicyID
+	}
+
+	// Only send the binding attributes which changed, since some of them
+	// can't be changed while the port is bound to a host.
+	if d.HasChange("binding.0.host_id") {
+		hasChange = true
+		hostID := d.Get("binding.0.host_id").(string)
+		updateOpts.HostID = &hostID
+	}
+
+	if d.HasChange("binding.0.vnic_type") {
+		hasChange = true
+		vnicType := d.Get("binding.0.vnic_type").(string)
+		updateOpts.VNICType = &vnicType
+	}
+
+	if d.HasChange("binding.0.profile") {
+		hasChange = true
+		binding, _ := resourceNetworkingPortV2Binding(d)
+		profile, err := resourceNetworkingPortV2BindingProfile(binding)
+		if err != nil {
+			return err
+		}
+
+		// An empty profile removes the existing binding profile.
+		if profile == nil {
+			profile = map[string]interface{}{}
+		}
+
+		updateOpts.Profile = &profile
+	}
+
+	if d.HasChange("dns_name") {
+		hasChange = true
+		dnsName := d.Get("dns_name").(string)
+		updateOpts.DNSName = &dnsName
+	}
+
 	if hasChange {
 		log.Printf("[DEBUG] Updating Port %s with options: %+v", d.Id(), updateOpts)
 
@@ -415,10 +550,199 @@ func resourcePortAdminStateUpV2(d *schema.ResourceData) *bool {
 	return &value
 }
 
+// resourceNetworkingPortV2FlattenAllowedAddressPairs converts the allowed
+// address pairs of a port into a list of maps. The Networking service
+// fills in the MAC address of the port itself for pairs without a MAC
+// address, so it is left empty for pairs which were configured that way.
+func resourceNetworkingPortV2FlattenAllowedAddressPairs(d *schema.ResourceData, p *ports.Port) []map[string]interface{} {
+	configuredMACs := make(map[string]string)
+	for _, raw := range d.Get("allowed_address_pairs").(*schema.Set).List() {
+		rawMap := raw.(map[string]interface{})
+		configuredMACs[rawMap["ip_address"].(string)] = rawMap["mac_address"].(string)
+	}
+
+	var pairs []map[string]interface{}
+	for _, pairObject := range p.AllowedAddressPairs {
+		pair := make(map[string]interface{})
+		pair["ip_address"] = pairObject.IPAddress
+		pair["mac_address"] = pairObject.MACAddress
+
+		if mac, ok := configuredMACs[pairObject.IPAddress]; ok && mac == "" && pairObject.MACAddress == p.MACAddress {
+			pair["mac_address"] = ""
+		}
+
+		pairs = append(pairs, pair)
+	}
+
+	return pairs
+}
+
+// PortBinding represents the binding attributes of a port, which are not
+// exposed by Gophercloud's Port.
+type PortBinding struct {
+	HostID     string                 `json:"binding:host_id"`
+	VNICType   string                 `json:"binding:vnic_type"`
+	Profile    map[string]interface{} `json:"binding:profile"`
+	VIFType    string                 `json:"binding:vif_type"`
+	VIFDetails map[string]interface{} `json:"binding:vif_details"`
+}
+
+// networkingV2PortBinding extracts the binding attributes of a port.
+func networkingV2PortBinding(r ports.GetResult) (*PortBinding, error) {
+	var s struct {
+		Port *PortBinding `json:"port"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Port, err
+}
+
+// PortDNS represents the DNS integration attributes of a port, which are not
+// exposed by Gophercloud's Port.
+type PortDNS struct {
+	DNSName       string              `json:"dns_name"`
+	DNSAssignment []map[string]string `json:"dns_assignment"`
+}
+
+// networkingV2PortDNS extracts the DNS integration attributes of a port.
+// They are empty if the DNS integration extension is not enabled.
+func networkingV2PortDNS(r ports.GetResult) (*PortDNS, error) {
+	var s struct {
+		Port *PortDNS `json:"port"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Port, err
+}
+
+// resourceNetworkingPortV2Binding returns the binding block of a port, with
+// the defaults of a port without a binding block if none was given.
+func resourceNetworkingPortV2Binding(d *schema.ResourceData) (map[string]interface{}, bool) {
+	rawBinding := d.Get("binding").([]interface{})
+	if len(rawBinding) == 0 || rawBinding[0] == nil {
+		return map[string]interface{}{
+			"host_id":   "",
+			"vnic_type": "normal",
+			"profile":   "",
+		}, false
+	}
+
+	return rawBinding[0].(map[string]interface{}), true
+}
+
+// resourceNetworkingPortV2BindingProfile decodes the JSON binding profile of
+// a binding block.
+func resourceNetworkingPortV2BindingProfile(binding map[string]interface{}) (map[string]interface{}, error) {
+	rawProfile := binding["profile"].(string)
+	if rawProfile == "" {
+		return nil, nil
+	}
+
+	var profile map[string]interface{}
+	if err := json.Unmarshal([]byte(rawProfile), &profile); err != nil {
+		return nil, fmt.Errorf("Unable to parse the binding profile of the port: %s", err)
+	}
+
+	return profile, nil
+}
+
+// networkingPortV2BindingProfileServerKeys are the binding profile keys which
+// Neutron and Nova set themselves, for example when binding an SR-IOV port.
+var networkingPortV2BindingProfileServerKeys = []string{
+	"pci_slot",
+	"pci_vendor_info",
+	"physical_network",
+}
+
+// suppressNetworkingPortV2BindingProfileDiffs suppresses the differences
+// between equivalent binding profiles, ignoring the keys which were added
+// to the profile of the port by the server rather than configured.
+func suppressNetworkingPortV2BindingProfileDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldProfile := map[string]interface{}{}
+	if old != "" {
+		if err := json.Unmarshal([]byte(old), &oldProfile); err != nil {
+			return false
+		}
+	}
+
+	newProfile := map[string]interface{}{}
+	if new != "" {
+		if err := json.Unmarshal([]byte(new), &newProfile); err != nil {
+			return false
+		}
+	}
+
+	for _, key := range networkingPortV2BindingProfileServerKeys {
+		if _, ok := newProfile[key]; !ok {
+			delete(oldProfile, key)
+		}
+	}
+
+	return reflect.DeepEqual(oldProfile, newProfile)
+}
+
+// resourceNetworkingPortV2FlattenBinding converts the binding attributes of
+// a port into a binding block. An empty binding profile is reported as an
+// empty string rather than as an empty JSON object.
+func resourceNetworkingPortV2FlattenBinding(binding *PortBinding) []map[string]interface{} {
+	var profile string
+	if len(binding.Profile) > 0 {
+		rawProfile, err := json.Marshal(binding.Profile)
+		if err != nil {
+			log.Printf("[DEBUG] Unable to marshal the binding profile %+v: %s", binding.Profile, err)
+		}
+		profile = string(rawProfile)
+	}
+
+	vifDetails := make(map[string]string, len(binding.VIFDetails))
+	for k, v := range binding.VIFDetails {
+		vifDetails[k] = fmt.Sprintf("%v", v)
+	}
+
+	return []map[string]interface{}{
+		map[string]interface{}{
+			"host_id":     binding.HostID,
+			"vnic_type":   binding.VNICType,
+			"profile":     profile,
+			"vif_type":    binding.VIFType,
+			"vif_details": vifDetails,
+		},
+	}
+}
+
+// resourceNetworkingPortV2DefaultSecGroup looks up the ID of the default
+// security group of a tenant.
+func resourceNetworkingPortV2DefaultSecGroup(networkingClient *gophercloud.ServiceClient, tenantID string) (string, error) {
+	listOpts := groups.ListOpts{
+		Name:     "default",
+		TenantID: tenantID,
+	}
+
+	pages, err := groups.List(networkingClient, listOpts).AllPages()
+	if err != nil {
+		return "", fmt.Errorf("Unable to retrieve the default security group: %s", err)
+	}
+
+	allGroups, err := groups.ExtractGroups(pages)
+	if err != nil {
+		return "", fmt.Errorf("Unable to retrieve the default security group: %s", err)
+	}
+
+	if len(allGroups) != 1 {
+		return "", fmt.Errorf("Unable to determine the default security group of tenant %s", tenantID)
+	}
+
+	return allGroups[0].ID, nil
+}
+
 func allowedAddressPairsHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
-	buf.WriteString(fmt.Sprintf("%s", m["ip_address"].(string)))
+	buf.WriteString(fmt.Sprintf("%s-", m["ip_address"].(string)))
+
+	// Only include the MAC address if one was set, since it is filled
+	// in by the Networking service otherwise.
+	if v, ok := m["mac_address"].(string); ok && v != "" {
+		buf.WriteString(fmt.Sprintf("%s-", v))
+	}
 
 	return hashcode.String(buf.String())
 }
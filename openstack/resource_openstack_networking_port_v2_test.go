@@ -361,6 +361,241 @@ func TestAccNetworkingV2Port_noSecurityGroups(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Port_allowedAddressPairsMAC(t *testing.T) {
+	var port ports.Port
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_allowedAddressPairsMAC_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					testAccCheckNetworkingV2PortAllowedAddressPairMAC(&port, "10.0.0.201", "fa:16:3e:00:00:01"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_allowedAddressPairsMAC_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					testAccCheckNetworkingV2PortAllowedAddressPairMAC(&port, "10.0.0.201", "fa:16:3e:00:00:02"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_noSecurityGroupsDefault(t *testing.T) {
+	var port ports.Port
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_noSecurityGroupsDefault_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					testAccCheckNetworkingV2PortCountSecurityGroups(&port, 0),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_noSecurityGroupsDefault_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					testAccCheckNetworkingV2PortCountSecurityGroups(&port, 1),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_qosPolicy(t *testing.T) {
+	var port ports.Port
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_qosPolicy_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_port_v2.port_1", "qos_policy_id",
+						"openstack_networking_qos_policy_v2.qos_policy_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_qosPolicy_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "qos_policy_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_binding(t *testing.T) {
+	var port ports.Port
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_binding_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_port_v2.port_1", "qos_policy_id",
+						"openstack_networking_qos_policy_v2.qos_policy_1", "id"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "binding.0.vnic_type", "direct"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "binding.0.profile", "{\"capabilities\":[\"switchdev\"]}"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_binding_2,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "binding.0.vnic_type", "direct"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "binding.0.profile", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_vnicType(t *testing.T) {
+	var port ports.Port
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_vnicType_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "binding.0.vnic_type", "macvtap"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_vnicType_2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_networking_port_v2.port_1", "id", &port.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "binding.0.vnic_type", "direct-physical"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkingV2Port_dnsName(t *testing.T) {
+	var port ports.Port
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckDNS(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2PortDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_dnsName_1,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2PortExists("openstack_networking_port_v2.port_1", &port),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "dns_name", "port-1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "dns_assignment.0.hostname", "port-1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "dns_assignment.0.fqdn", "port-1.example.com."),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2Port_dnsName_2,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_networking_port_v2.port_1", "id", &port.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "dns_name", "port-2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_port_v2.port_1", "dns_assignment.0.fqdn", "port-2.example.com."),
+				),
+			},
+		},
+	})
+}
+
+func TestSuppressNetworkingPortV2BindingProfileDiffs(t *testing.T) {
+	testCases := []struct {
+		old      string
+		new      string
+		suppress bool
+	}{
+		{
+			old:      `{"a": 1, "b": 2}`,
+			new:      `{"b": 2, "a": 1}`,
+			suppress: true,
+		},
+		{
+			old:      `{"pci_slot": "0000:03:10.1", "physical_network": "physnet1"}`,
+			new:      "",
+			suppress: true,
+		},
+		{
+			old:      `{"pci_slot": "0000:03:10.1", "trusted": true}`,
+			new:      `{"trusted": true}`,
+			suppress: true,
+		},
+		{
+			old:      `{"pci_slot": "0000:03:10.1", "trusted": true}`,
+			new:      `{"trusted": false}`,
+			suppress: false,
+		},
+		{
+			old:      `{"pci_slot": "0000:03:10.1"}`,
+			new:      `{"pci_slot": "0000:03:10.2"}`,
+			suppress: false,
+		},
+		{
+			old:      "",
+			new:      `{"trusted": true}`,
+			suppress: false,
+		},
+	}
+
+	for i, tc := range testCases {
+		suppress := suppressNetworkingPortV2BindingProfileDiffs("binding.0.profile", tc.old, tc.new, nil)
+		if suppress != tc.suppress {
+			t.Fatalf("Test case %d: expected suppress to be %t, got %t", i, tc.suppress, suppress)
+		}
+	}
+}
+
 func testAccCheckNetworkingV2PortDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
@@ -445,6 +680,24 @@ func testAccCheckNetworkingV2PortCountAllowedAddressPairs(
 	}
 }
 
+func testAccCheckNetworkingV2PortAllowedAddressPairMAC(
+	port *ports.Port, ipAddress, macAddress string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, pair := range port.AllowedAddressPairs {
+			if pair.IPAddress == ipAddress {
+				if pair.MACAddress != macAddress {
+					return fmt.Errorf("Expected MAC address %s for Allowed Address Pair %s, got %s",
+						macAddress, ipAddress, pair.MACAddress)
+				}
+
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Allowed Address Pair %s not found", ipAddress)
+	}
+}
+
 const testAccNetworkingV2Port_basic = `
 resource "openstack_networking_network_v2" "network_1" {
   name = "network_1"
@@ -1293,3 +1546,292 @@ resource "openstack_networking_port_v2" "port_1" {
   }
 }
 `
+
+const testAccNetworkingV2Port_allowedAddressPairsMAC_1 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "10.0.0.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+
+  allowed_address_pairs {
+    ip_address = "10.0.0.201"
+    mac_address = "fa:16:3e:00:00:01"
+  }
+}
+`
+
+const testAccNetworkingV2Port_allowedAddressPairsMAC_2 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "10.0.0.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+
+  allowed_address_pairs {
+    ip_address = "10.0.0.201"
+    mac_address = "fa:16:3e:00:00:02"
+  }
+}
+`
+
+const testAccNetworkingV2Port_noSecurityGroupsDefault_1 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  no_security_groups = true
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.23"
+  }
+}
+`
+
+const testAccNetworkingV2Port_noSecurityGroupsDefault_2 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  fixed_ip {
+    subnet_id =  "${openstack_networking_subnet_v2.subnet_1.id}"
+    ip_address = "192.168.199.23"
+  }
+}
+`
+
+const testAccNetworkingV2Port_qosPolicy_1 = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+}
+`
+
+const testAccNetworkingV2Port_qosPolicy_2 = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+`
+
+const testAccNetworkingV2Port_binding_1 = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_minimum_bandwidth_rule_v2" "minimum_bandwidth_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  min_kbps = 1000
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+
+  binding {
+    vnic_type = "direct"
+    profile = <<EOF
+{
+  "capabilities": ["switchdev"]
+}
+EOF
+  }
+}
+`
+
+const testAccNetworkingV2Port_binding_2 = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_minimum_bandwidth_rule_v2" "minimum_bandwidth_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  min_kbps = 1000
+}
+
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+
+  binding {
+    vnic_type = "direct"
+  }
+}
+`
+
+const testAccNetworkingV2Port_vnicType_1 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  binding {
+    vnic_type = "macvtap"
+  }
+}
+`
+
+const testAccNetworkingV2Port_vnicType_2 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+
+  binding {
+    vnic_type = "direct-physical"
+  }
+}
+`
+
+const testAccNetworkingV2Port_dnsName_1 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+  dns_domain = "example.com."
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  dns_name = "port-1"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+`
+
+const testAccNetworkingV2Port_dnsName_2 = `
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+  dns_domain = "example.com."
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_port_v2" "port_1" {
+  name = "port_1"
+  admin_state_up = "true"
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+  dns_name = "port-2"
+
+  fixed_ip {
+    subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  }
+}
+`
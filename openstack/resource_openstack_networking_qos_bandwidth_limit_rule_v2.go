@@ -0,0 +1,160 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingQoSBandwidthLimitRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSBandwidthLimitRuleV2Create,
+		Read:   resourceNetworkingQoSBandwidthLimitRuleV2Read,
+		Update: resourceNetworkingQoSBandwidthLimitRuleV2Update,
+		Delete: resourceNetworkingQoSBandwidthLimitRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"max_kbps": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"max_burst_kbps": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"direction": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "egress",
+				ValidateFunc: validateNetworkingQoSRuleDirection,
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID := d.Get("qos_policy_id").(string)
+	createOpts := map[string]interface{}{
+		"max_kbps":  d.Get("max_kbps").(int),
+		"direction": d.Get("direction").(string),
+	}
+	if v, ok := d.GetOk("max_burst_kbps"); ok {
+		createOpts["max_burst_kbps"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	rule, err := networkingV2QoSRuleCreate(networkingClient, policyID, qosBandwidthLimitRule, createOpts).ExtractBandwidthLimitRule()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS bandwidth limit rule: %s", err)
+	}
+
+	log.Printf("[INFO] QoS bandwidth limit rule ID: %s", rule.ID)
+
+	d.SetId(fmt.Sprintf("%s/%s", policyID, rule.ID))
+
+	return resourceNetworkingQoSBandwidthLimitRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rule, err := networkingV2QoSRuleGet(networkingClient, policyID, qosBandwidthLimitRule, ruleID).ExtractBandwidthLimitRule()
+	if err != nil {
+		return CheckDeleted(d, err, "QoS bandwidth limit rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved QoS bandwidth limit rule %s: %+v", d.Id(), rule)
+
+	d.Set("qos_policy_id", policyID)
+	d.Set("max_kbps", rule.MaxKBps)
+	d.Set("max_burst_kbps", rule.MaxBurstKBps)
+	d.Set("direction", rule.Direction)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateOpts := make(map[string]interface{})
+	if d.HasChange("max_kbps") {
+		updateOpts["max_kbps"] = d.Get("max_kbps").(int)
+	}
+	if d.HasChange("max_burst_kbps") {
+		updateOpts["max_burst_kbps"] = d.Get("max_burst_kbps").(int)
+	}
+	if d.HasChange("direction") {
+		updateOpts["direction"] = d.Get("direction").(string)
+	}
+
+	log.Printf("[DEBUG] Updating QoS bandwidth limit rule %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2QoSRuleUpdate(networkingClient, policyID, qosBandwidthLimitRule, ruleID, updateOpts).ExtractBandwidthLimitRule()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron QoS bandwidth limit rule: %s", err)
+	}
+
+	return resourceNetworkingQoSBandwidthLimitRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSBandwidthLimitRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = networkingV2QoSRuleDelete(networkingClient, policyID, qosBandwidthLimitRule, ruleID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron QoS bandwidth limit rule")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,131 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2QoSBandwidthLimitRule_basic(t *testing.T) {
+	var rule QoSBandwidthLimitRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2QoSBandwidthLimitRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSBandwidthLimitRule_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QoSBandwidthLimitRuleExists(
+						"openstack_networking_qos_bandwidth_limit_rule_v2.bandwidth_limit_rule_1", &rule),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_bandwidth_limit_rule_v2.bandwidth_limit_rule_1", "max_kbps", "3000"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_bandwidth_limit_rule_v2.bandwidth_limit_rule_1", "max_burst_kbps", "300"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSBandwidthLimitRule_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_bandwidth_limit_rule_v2.bandwidth_limit_rule_1", "max_kbps", "2000"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_bandwidth_limit_rule_v2.bandwidth_limit_rule_1", "max_burst_kbps", "200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2QoSBandwidthLimitRuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_qos_bandwidth_limit_rule_v2" {
+			continue
+		}
+
+		policyID, ruleID, err := parseNetworkingQoSRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = networkingV2QoSRuleGet(networkingClient, policyID, qosBandwidthLimitRule, ruleID).ExtractBandwidthLimitRule()
+		if err == nil {
+			return fmt.Errorf("QoS bandwidth limit rule still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2QoSBandwidthLimitRuleExists(n string, rule *QoSBandwidthLimitRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		policyID, ruleID, err := parseNetworkingQoSRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := networkingV2QoSRuleGet(networkingClient, policyID, qosBandwidthLimitRule, ruleID).ExtractBandwidthLimitRule()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != ruleID {
+			return fmt.Errorf("QoS bandwidth limit rule not found")
+		}
+
+		*rule = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2QoSBandwidthLimitRule_basic = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_bandwidth_limit_rule_v2" "bandwidth_limit_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  max_kbps = 3000
+  max_burst_kbps = 300
+}
+`
+
+const testAccNetworkingV2QoSBandwidthLimitRule_update = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_bandwidth_limit_rule_v2" "bandwidth_limit_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  max_kbps = 2000
+  max_burst_kbps = 200
+}
+`
@@ -0,0 +1,136 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingQoSDSCPMarkingRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSDSCPMarkingRuleV2Create,
+		Read:   resourceNetworkingQoSDSCPMarkingRuleV2Read,
+		Update: resourceNetworkingQoSDSCPMarkingRuleV2Update,
+		Delete: resourceNetworkingQoSDSCPMarkingRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dscp_mark": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID := d.Get("qos_policy_id").(string)
+	createOpts := map[string]interface{}{
+		"dscp_mark": d.Get("dscp_mark").(int),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	rule, err := networkingV2QoSRuleCreate(networkingClient, policyID, qosDSCPMarkingRule, createOpts).ExtractDSCPMarkingRule()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS DSCP marking rule: %s", err)
+	}
+
+	log.Printf("[INFO] QoS DSCP marking rule ID: %s", rule.ID)
+
+	d.SetId(fmt.Sprintf("%s/%s", policyID, rule.ID))
+
+	return resourceNetworkingQoSDSCPMarkingRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rule, err := networkingV2QoSRuleGet(networkingClient, policyID, qosDSCPMarkingRule, ruleID).ExtractDSCPMarkingRule()
+	if err != nil {
+		return CheckDeleted(d, err, "QoS DSCP marking rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved QoS DSCP marking rule %s: %+v", d.Id(), rule)
+
+	d.Set("qos_policy_id", policyID)
+	d.Set("dscp_mark", rule.DSCPMark)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateOpts := map[string]interface{}{
+		"dscp_mark": d.Get("dscp_mark").(int),
+	}
+
+	log.Printf("[DEBUG] Updating QoS DSCP marking rule %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2QoSRuleUpdate(networkingClient, policyID, qosDSCPMarkingRule, ruleID, updateOpts).ExtractDSCPMarkingRule()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron QoS DSCP marking rule: %s", err)
+	}
+
+	return resourceNetworkingQoSDSCPMarkingRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSDSCPMarkingRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = networkingV2QoSRuleDelete(networkingClient, policyID, qosDSCPMarkingRule, ruleID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron QoS DSCP marking rule")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,125 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2QoSDSCPMarkingRule_basic(t *testing.T) {
+	var rule QoSDSCPMarkingRule
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2QoSDSCPMarkingRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSDSCPMarkingRule_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2QoSDSCPMarkingRuleExists(
+						"openstack_networking_qos_dscp_marking_rule_v2.dscp_marking_rule_1", &rule),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_dscp_marking_rule_v2.dscp_marking_rule_1", "dscp_mark", "26"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2QoSDSCPMarkingRule_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_networking_qos_dscp_marking_rule_v2.dscp_marking_rule_1", "dscp_mark", "32"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2QoSDSCPMarkingRuleDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_qos_dscp_marking_rule_v2" {
+			continue
+		}
+
+		policyID, ruleID, err := parseNetworkingQoSRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = networkingV2QoSRuleGet(networkingClient, policyID, qosDSCPMarkingRule, ruleID).ExtractDSCPMarkingRule()
+		if err == nil {
+			return fmt.Errorf("QoS DSCP marking rule still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2QoSDSCPMarkingRuleExists(n string, rule *QoSDSCPMarkingRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		policyID, ruleID, err := parseNetworkingQoSRuleID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := networkingV2QoSRuleGet(networkingClient, policyID, qosDSCPMarkingRule, ruleID).ExtractDSCPMarkingRule()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != ruleID {
+			return fmt.Errorf("QoS DSCP marking rule not found")
+		}
+
+		*rule = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2QoSDSCPMarkingRule_basic = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_dscp_marking_rule_v2" "dscp_marking_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  dscp_mark = 26
+}
+`
+
+const testAccNetworkingV2QoSDSCPMarkingRule_update = `
+resource "openstack_networking_qos_policy_v2" "qos_policy_1" {
+  name = "qos_policy_1"
+}
+
+resource "openstack_networking_qos_dscp_marking_rule_v2" "dscp_marking_rule_1" {
+  qos_policy_id = "${openstack_networking_qos_policy_v2.qos_policy_1.id}"
+  dscp_mark = 32
+}
+`
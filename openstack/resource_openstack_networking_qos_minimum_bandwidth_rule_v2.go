@@ -0,0 +1,148 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSMinimumBandwidthRuleV2Create,
+		Read:   resourceNetworkingQoSMinimumBandwidthRuleV2Read,
+		Update: resourceNetworkingQoSMinimumBandwidthRuleV2Update,
+		Delete: resourceNetworkingQoSMinimumBandwidthRuleV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"qos_policy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"min_kbps": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"direction": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "egress",
+				ValidateFunc: validateNetworkingQoSRuleDirection,
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID := d.Get("qos_policy_id").(string)
+	createOpts := map[string]interface{}{
+		"min_kbps":  d.Get("min_kbps").(int),
+		"direction": d.Get("direction").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	rule, err := networkingV2QoSRuleCreate(networkingClient, policyID, qosMinimumBandwidthRule, createOpts).ExtractMinimumBandwidthRule()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS minimum bandwidth rule: %s", err)
+	}
+
+	log.Printf("[INFO] QoS minimum bandwidth rule ID: %s", rule.ID)
+
+	d.SetId(fmt.Sprintf("%s/%s", policyID, rule.ID))
+
+	return resourceNetworkingQoSMinimumBandwidthRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	rule, err := networkingV2QoSRuleGet(networkingClient, policyID, qosMinimumBandwidthRule, ruleID).ExtractMinimumBandwidthRule()
+	if err != nil {
+		return CheckDeleted(d, err, "QoS minimum bandwidth rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved QoS minimum bandwidth rule %s: %+v", d.Id(), rule)
+
+	d.Set("qos_policy_id", policyID)
+	d.Set("min_kbps", rule.MinKBps)
+	d.Set("direction", rule.Direction)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	updateOpts := make(map[string]interface{})
+	if d.HasChange("min_kbps") {
+		updateOpts["min_kbps"] = d.Get("min_kbps").(int)
+	}
+	if d.HasChange("direction") {
+		updateOpts["direction"] = d.Get("direction").(string)
+	}
+
+	log.Printf("[DEBUG] Updating QoS minimum bandwidth rule %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2QoSRuleUpdate(networkingClient, policyID, qosMinimumBandwidthRule, ruleID, updateOpts).ExtractMinimumBandwidthRule()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron QoS minimum bandwidth rule: %s", err)
+	}
+
+	return resourceNetworkingQoSMinimumBandwidthRuleV2Read(d, meta)
+}
+
+func resourceNetworkingQoSMinimumBandwidthRuleV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policyID, ruleID, err := parseNetworkingQoSRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = networkingV2QoSRuleDelete(networkingClient, policyID, qosMinimumBandwidthRule, ruleID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron QoS minimum bandwidth rule")
+	}
+
+	d.SetId("")
+	return nil
+}
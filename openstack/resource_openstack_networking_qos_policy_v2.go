@@ -0,0 +1,162 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingQoSPolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingQoSPolicyV2Create,
+		Read:   resourceNetworkingQoSPolicyV2Read,
+		Update: resourceNetworkingQoSPolicyV2Update,
+		Delete: resourceNetworkingQoSPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"shared": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"is_default": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingQoSPolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := QoSPolicyCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Shared:      d.Get("shared").(bool),
+		IsDefault:   d.Get("is_default").(bool),
+		TenantID:    d.Get("tenant_id").(string),
+		ValueSpecs:  MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	policy, err := networkingV2QoSPolicyCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron QoS policy: %s", err)
+	}
+
+	log.Printf("[INFO] QoS policy ID: %s", policy.ID)
+
+	d.SetId(policy.ID)
+
+	return resourceNetworkingQoSPolicyV2Read(d, meta)
+}
+
+func resourceNetworkingQoSPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policy, err := networkingV2QoSPolicyGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "QoS policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved QoS policy %s: %+v", d.Id(), policy)
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("shared", policy.Shared)
+	d.Set("is_default", policy.IsDefault)
+	d.Set("tenant_id", policy.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingQoSPolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts QoSPolicyUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("shared") {
+		shared := d.Get("shared").(bool)
+		updateOpts.Shared = &shared
+	}
+	if d.HasChange("is_default") {
+		isDefault := d.Get("is_default").(bool)
+		updateOpts.IsDefault = &isDefault
+	}
+
+	log.Printf("[DEBUG] Updating QoS policy %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2QoSPolicyUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron QoS policy: %s", err)
+	}
+
+	return resourceNetworkingQoSPolicyV2Read(d, meta)
+}
+
+func resourceNetworkingQoSPolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2QoSPolicyDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron QoS policy")
+	}
+
+	d.SetId("")
+	return nil
+}
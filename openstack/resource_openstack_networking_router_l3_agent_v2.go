@@ -0,0 +1,137 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingRouterL3AgentV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingRouterL3AgentV2Create,
+		Read:   resourceNetworkingRouterL3AgentV2Read,
+		Delete: resourceNetworkingRouterL3AgentV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"router_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"agent_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"host": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingRouterL3AgentV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID := d.Get("router_id").(string)
+	agentID := d.Get("agent_id").(string)
+
+	log.Printf("[DEBUG] Scheduling router %s to L3 agent %s", routerID, agentID)
+	err = networkingV2L3AgentAddRouter(networkingClient, agentID, routerID).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error scheduling OpenStack Neutron router %s to L3 agent %s: %s", routerID, agentID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", routerID, agentID))
+
+	return resourceNetworkingRouterL3AgentV2Read(d, meta)
+}
+
+func resourceNetworkingRouterL3AgentV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID, agentID, err := parseNetworkingRouterL3AgentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	agents, err := networkingV2RouterL3AgentList(networkingClient, routerID).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "router L3 agent")
+	}
+
+	var agent *Agent
+	for i := range agents {
+		if agents[i].ID == agentID {
+			agent = &agents[i]
+			break
+		}
+	}
+
+	// The router was unscheduled from the L3 agent outside of Terraform.
+	if agent == nil {
+		log.Printf("[DEBUG] Router %s is not scheduled to L3 agent %s", routerID, agentID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("router_id", routerID)
+	d.Set("agent_id", agentID)
+	d.Set("host", agent.Host)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingRouterL3AgentV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	routerID, agentID, err := parseNetworkingRouterL3AgentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Unscheduling router %s from L3 agent %s", routerID, agentID)
+	err = networkingV2L3AgentRemoveRouter(networkingClient, agentID, routerID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error unscheduling OpenStack Neutron router from L3 agent")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// parseNetworkingRouterL3AgentID splits the ID of a router L3 agent
+// resource, which has the form <router id>/<agent id>.
+func parseNetworkingRouterL3AgentID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine router L3 agent ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
@@ -0,0 +1,117 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2RouterL3Agent_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RouterL3AgentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2RouterL3Agent_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterL3AgentExists("openstack_networking_router_l3_agent_v2.router_l3_agent_1"),
+					resource.TestCheckResourceAttrPair(
+						"openstack_networking_router_l3_agent_v2.router_l3_agent_1", "host",
+						"data.openstack_networking_agents_v2.agents", "agents.0.host"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2RouterL3AgentDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_router_l3_agent_v2" {
+			continue
+		}
+
+		routerID, agentID, err := parseNetworkingRouterL3AgentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		agents, err := networkingV2RouterL3AgentList(networkingClient, routerID).Extract()
+		if err != nil {
+			continue
+		}
+
+		for _, agent := range agents {
+			if agent.ID == agentID {
+				return fmt.Errorf("Router is still scheduled to L3 agent")
+			}
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2RouterL3AgentExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		routerID, agentID, err := parseNetworkingRouterL3AgentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		agents, err := networkingV2RouterL3AgentList(networkingClient, routerID).Extract()
+		if err != nil {
+			return err
+		}
+
+		for _, agent := range agents {
+			if agent.ID == agentID {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Router is not scheduled to L3 agent")
+	}
+}
+
+const testAccNetworkingV2RouterL3Agent_basic = `
+data "openstack_networking_agents_v2" "agents" {
+  agent_type = "L3 agent"
+  state = "alive"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_router_l3_agent_v2" "router_l3_agent_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  agent_id = "${data.openstack_networking_agents_v2.agents.ids[0]}"
+}
+`
@@ -100,12 +100,12 @@ func resourceNetworkingRouterRouteV2Create(d *schema.ResourceData, meta interfac
 		if err != nil {
 			return fmt.Errorf("Error updating OpenStack Neutron Router: %s", err)
 		}
-		d.SetId(fmt.Sprintf("%s-route-%s-%s", routerId, destCidr, nextHop))
-
 	} else {
 		log.Printf("[DEBUG] Router %s has route already", routerId)
 	}
 
+	d.SetId(fmt.Sprintf("%s-route-%s-%s", routerId, destCidr, nextHop))
+
 	return resourceNetworkingRouterRouteV2Read(d, meta)
 }
 
@@ -151,18 +151,24 @@ func resourceNetworkingRouterRouteV2Read(d *schema.ResourceData, meta interface{
 
 	log.Printf("[DEBUG] Retrieved Router %s: %+v", routerId, n)
 
-	d.Set("next_hop", "")
-	d.Set("destination_cidr", "")
-
+	var routeExists bool
 	for _, r := range n.Routes {
-
 		if r.DestinationCIDR == destCidr && r.NextHop == nextHop {
-			d.Set("destination_cidr", destCidr)
-			d.Set("next_hop", nextHop)
+			routeExists = true
 			break
 		}
 	}
 
+	// The route may have been removed outside of Terraform, for example
+	// when the router was updated by another configuration.
+	if !routeExists {
+		log.Printf("[DEBUG] Route %s via %s no longer exists on router %s", destCidr, nextHop, routerId)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("destination_cidr", destCidr)
+	d.Set("next_hop", nextHop)
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -218,8 +224,9 @@ func resourceNetworkingRouterRouteV2Delete(d *schema.ResourceData, meta interfac
 			return fmt.Errorf("Error updating OpenStack Neutron Router: %s", err)
 		}
 	} else {
-		return fmt.Errorf("Route did not exist already")
+		log.Printf("[DEBUG] Route %s via %s was already removed from router %s", destCidr, nextHop, routerId)
 	}
 
+	d.SetId("")
 	return nil
 }
@@ -60,6 +60,36 @@ func TestAccNetworkingV2RouterRoute_basic(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2RouterRoute_removedOutside(t *testing.T) {
+	var router routers.Router
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RouterRouteDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2RouterRoute_create,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					testAccCheckNetworkingV2RouterRouteExists(
+						"openstack_networking_router_route_v2.router_route_1"),
+					testAccCheckNetworkingV2RouterRouteRemove(
+						"openstack_networking_router_v2.router_1"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2RouterRoute_create,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterRouteExists(
+						"openstack_networking_router_route_v2.router_route_1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2RouterRouteEmpty(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -134,6 +164,29 @@ func testAccCheckNetworkingV2RouterRouteExists(n string) resource.TestCheckFunc
 	}
 }
 
+// testAccCheckNetworkingV2RouterRouteRemove removes all routes of a router
+// outside of Terraform.
+func testAccCheckNetworkingV2RouterRouteRemove(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		updateOpts := routers.UpdateOpts{
+			Routes: []routers.Route{},
+		}
+		_, err = routers.Update(networkingClient, rs.Primary.ID, updateOpts).Extract()
+		return err
+	}
+}
+
 func testAccCheckNetworkingV2RouterRouteDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
@@ -69,6 +69,20 @@ func resourceNetworkingRouterV2() *schema.Resource {
 				ForceNew: true,
 				Computed: true,
 			},
+			"availability_zone_hints": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"availability_zones": &schema.Schema{
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -86,11 +100,15 @@ func resourceNetworkingRouterV2Create(d *schema.ResourceData, meta interface{})
 	}
 
 	createOpts := RouterCreateOpts{
-		routers.CreateOpts{
+		CreateOpts: routers.CreateOpts{
 			Name:     d.Get("name").(string),
 			TenantID: d.Get("tenant_id").(string),
 		},
-		MapValueSpecs(d),
+		ValueSpecs: MapValueSpecs(d),
+	}
+
+	if v, ok := d.GetOk("availability_zone_hints"); ok {
+		createOpts.AvailabilityZoneHints = resourceNetworkingAvailabilityZoneHintsV2(v.(*schema.Set))
 	}
 
 	if asuRaw, ok := d.GetOk("admin_state_up"); ok {
@@ -150,7 +168,8 @@ func resourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	n, err := routers.Get(networkingClient, d.Id()).Extract()
+	r := routers.Get(networkingClient, d.Id())
+	n, err := r.Extract()
 	if err != nil {
 		if _, ok := err.(gophercloud.ErrDefault404); ok {
 			d.SetId("")
@@ -160,6 +179,11 @@ func resourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error retrieving OpenStack Neutron Router: %s", err)
 	}
 
+	extAttrs, err := networkingV2RouterExtAttrs(r)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Retrieved Router %s: %+v", d.Id(), n)
 
 	d.Set("name", n.Name)
@@ -168,6 +192,8 @@ func resourceNetworkingRouterV2Read(d *schema.ResourceData, meta interface{}) er
 	d.Set("tenant_id", n.TenantID)
 	d.Set("external_gateway", n.GatewayInfo.NetworkID)
 	d.Set("enable_snat", n.GatewayInfo.EnableSNAT)
+	d.Set("availability_zone_hints", extAttrs.AvailabilityZoneHints)
+	d.Set("availability_zones", extAttrs.AvailabilityZones)
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -246,6 +272,22 @@ func resourceNetworkingRouterV2Delete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// RouterExtAttrs represents the attributes of a router which are provided
+// by extensions and are not exposed by Gophercloud's Router.
+type RouterExtAttrs struct {
+	AvailabilityZoneHints []string `json:"availability_zone_hints"`
+	AvailabilityZones     []string `json:"availability_zones"`
+}
+
+// networkingV2RouterExtAttrs extracts the extension attributes of a router.
+func networkingV2RouterExtAttrs(r routers.GetResult) (*RouterExtAttrs, error) {
+	var s struct {
+		Router *RouterExtAttrs `json:"router"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Router, err
+}
+
 func waitForRouterActive(networkingClient *gophercloud.ServiceClient, routerId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		r, err := routers.Get(networkingClient, routerId).Extract()
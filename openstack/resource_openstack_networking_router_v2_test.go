@@ -78,6 +78,28 @@ func TestAccNetworkingV2Router_timeout(t *testing.T) {
 	})
 }
 
+func TestAccNetworkingV2Router_availabilityZoneHints(t *testing.T) {
+	var router routers.Router
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2RouterDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2Router_availabilityZoneHints,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2RouterExists("openstack_networking_router_v2.router_1", &router),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "availability_zone_hints.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_router_v2.router_1", "availability_zone_hints.4209400975", "nova"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckNetworkingV2RouterDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
@@ -176,3 +198,11 @@ resource "openstack_networking_router_v2" "router_1" {
   }
 }
 `
+
+const testAccNetworkingV2Router_availabilityZoneHints = `
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+  availability_zone_hints = ["nova"]
+}
+`
@@ -77,6 +77,13 @@ func resourceNetworkingSecGroupRuleV2() *schema.Resource {
 					return strings.ToLower(v.(string))
 				},
 			},
+			"remote_address_group_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Computed:      true,
+				ConflictsWith: []string{"remote_group_id", "remote_ip_prefix"},
+			},
 			"security_group_id": &schema.Schema{
 				Type:     schema.TypeString,
 				Required: true,
@@ -110,13 +117,16 @@ func resourceNetworkingSecGroupRuleV2Create(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	opts := rules.CreateOpts{
-		SecGroupID:     d.Get("security_group_id").(string),
-		PortRangeMin:   d.Get("port_range_min").(int),
-		PortRangeMax:   d.Get("port_range_max").(int),
-		RemoteGroupID:  d.Get("remote_group_id").(string),
-		RemoteIPPrefix: d.Get("remote_ip_prefix").(string),
-		TenantID:       d.Get("tenant_id").(string),
+	opts := SecGroupRuleCreateOpts{
+		CreateOpts: rules.CreateOpts{
+			SecGroupID:     d.Get("security_group_id").(string),
+			PortRangeMin:   d.Get("port_range_min").(int),
+			PortRangeMax:   d.Get("port_range_max").(int),
+			RemoteGroupID:  d.Get("remote_group_id").(string),
+			RemoteIPPrefix: d.Get("remote_ip_prefix").(string),
+			TenantID:       d.Get("tenant_id").(string),
+		},
+		RemoteAddressGroupID: d.Get("remote_address_group_id").(string),
 	}
 
 	if v, ok := d.GetOk("direction"); ok {
@@ -157,12 +167,18 @@ func resourceNetworkingSecGroupRuleV2Read(d *schema.ResourceData, meta interface
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	security_group_rule, err := rules.Get(networkingClient, d.Id()).Extract()
+	r := rules.Get(networkingClient, d.Id())
+	security_group_rule, err := r.Extract()
 
 	if err != nil {
 		return CheckDeleted(d, err, "OpenStack Security Group Rule")
 	}
 
+	remoteAddressGroupID, err := networkingV2SecGroupRuleRemoteAddressGroupID(r)
+	if err != nil {
+		return err
+	}
+
 	d.Set("direction", security_group_rule.Direction)
 	d.Set("ethertype", security_group_rule.EtherType)
 	d.Set("protocol", security_group_rule.Protocol)
@@ -170,6 +186,7 @@ func resourceNetworkingSecGroupRuleV2Read(d *schema.ResourceData, meta interface
 	d.Set("port_range_max", security_group_rule.PortRangeMax)
 	d.Set("remote_group_id", security_group_rule.RemoteGroupID)
 	d.Set("remote_ip_prefix", security_group_rule.RemoteIPPrefix)
+	d.Set("remote_address_group_id", remoteAddressGroupID)
 	d.Set("security_group_id", security_group_rule.SecGroupID)
 	d.Set("tenant_id", security_group_rule.TenantID)
 	d.Set("region", GetRegion(d, config))
@@ -0,0 +1,150 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingServiceProfileV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingServiceProfileV2Create,
+		Read:   resourceNetworkingServiceProfileV2Read,
+		Update: resourceNetworkingServiceProfileV2Update,
+		Delete: resourceNetworkingServiceProfileV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"driver": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"metainfo": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+			},
+			"enabled": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingServiceProfileV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	enabled := d.Get("enabled").(bool)
+	createOpts := ServiceProfileCreateOpts{
+		Description: d.Get("description").(string),
+		Driver:      d.Get("driver").(string),
+		MetaInfo:    d.Get("metainfo").(string),
+		Enabled:     &enabled,
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	profile, err := networkingV2ServiceProfileCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron service profile: %s", err)
+	}
+
+	log.Printf("[INFO] Service profile ID: %s", profile.ID)
+
+	d.SetId(profile.ID)
+
+	return resourceNetworkingServiceProfileV2Read(d, meta)
+}
+
+func resourceNetworkingServiceProfileV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	profile, err := networkingV2ServiceProfileGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "service profile")
+	}
+
+	log.Printf("[DEBUG] Retrieved service profile %s: %+v", d.Id(), profile)
+
+	d.Set("description", profile.Description)
+	d.Set("driver", profile.Driver)
+	d.Set("metainfo", profile.MetaInfo)
+	d.Set("enabled", profile.Enabled)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingServiceProfileV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts ServiceProfileUpdateOpts
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("driver") {
+		driver := d.Get("driver").(string)
+		updateOpts.Driver = &driver
+	}
+	if d.HasChange("metainfo") {
+		metaInfo := d.Get("metainfo").(string)
+		updateOpts.MetaInfo = &metaInfo
+	}
+	if d.HasChange("enabled") {
+		enabled := d.Get("enabled").(bool)
+		updateOpts.Enabled = &enabled
+	}
+
+	log.Printf("[DEBUG] Updating service profile %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2ServiceProfileUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron service profile: %s", err)
+	}
+
+	return resourceNetworkingServiceProfileV2Read(d, meta)
+}
+
+func resourceNetworkingServiceProfileV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2ServiceProfileDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron service profile")
+	}
+
+	d.SetId("")
+	return nil
+}
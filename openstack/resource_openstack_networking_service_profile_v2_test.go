@@ -0,0 +1,114 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2ServiceProfile_basic(t *testing.T) {
+	var profile ServiceProfile
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2ServiceProfileDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2ServiceProfile_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2ServiceProfileExists(
+						"openstack_networking_service_profile_v2.profile_1", &profile),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_service_profile_v2.profile_1", "description", "haproxy"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_service_profile_v2.profile_1", "enabled", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2ServiceProfile_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_networking_service_profile_v2.profile_1", "id", &profile.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_service_profile_v2.profile_1", "description", "haproxy on host"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_service_profile_v2.profile_1", "enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2ServiceProfileDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_service_profile_v2" {
+			continue
+		}
+
+		_, err := networkingV2ServiceProfileGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Service profile still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2ServiceProfileExists(n string, profile *ServiceProfile) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2ServiceProfileGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Service profile not found")
+		}
+
+		*profile = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2ServiceProfile_basic = `
+resource "openstack_networking_service_profile_v2" "profile_1" {
+  description = "haproxy"
+  driver = "neutron_lbaas.drivers.haproxy.plugin_driver.HaproxyOnHostPluginDriver"
+}
+`
+
+const testAccNetworkingV2ServiceProfile_update = `
+resource "openstack_networking_service_profile_v2" "profile_1" {
+  description = "haproxy on host"
+  driver = "neutron_lbaas.drivers.haproxy.plugin_driver.HaproxyOnHostPluginDriver"
+  enabled = false
+}
+`
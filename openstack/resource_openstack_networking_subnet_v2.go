@@ -122,6 +122,17 @@ func resourceNetworkingSubnetV2() *schema.Resource {
 					},
 				},
 			},
+			"service_types": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"segment_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 			"value_specs": &schema.Schema{
 				Type:     schema.TypeMap,
 				Optional: true,
@@ -139,7 +150,7 @@ func resourceNetworkingSubnetV2Create(d *schema.ResourceData, meta interface{})
 	}
 
 	createOpts := SubnetCreateOpts{
-		subnets.CreateOpts{
+		CreateOpts: subnets.CreateOpts{
 			NetworkID:       d.Get("network_id").(string),
 			CIDR:            d.Get("cidr").(string),
 			Name:            d.Get("name").(string),
@@ -149,7 +160,9 @@ func resourceNetworkingSubnetV2Create(d *schema.ResourceData, meta interface{})
 			HostRoutes:      resourceSubnetHostRoutesV2(d),
 			EnableDHCP:      nil,
 		},
-		MapValueSpecs(d),
+		ServiceTypes: resourceSubnetServiceTypesV2(d),
+		SegmentID:    d.Get("segment_id").(string),
+		ValueSpecs:   MapValueSpecs(d),
 	}
 
 	if v, ok := d.GetOk("gateway_ip"); ok {
@@ -200,11 +213,17 @@ func resourceNetworkingSubnetV2Read(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	s, err := subnets.Get(networkingClient, d.Id()).Extract()
+	r := subnets.Get(networkingClient, d.Id())
+	s, err := r.Extract()
 	if err != nil {
 		return CheckDeleted(d, err, "subnet")
 	}
 
+	extAttrs, err := networkingV2SubnetExtAttrs(r)
+	if err != nil {
+		return err
+	}
+
 	log.Printf("[DEBUG] Retrieved Subnet %s: %#v", d.Id(), s)
 
 	d.Set("network_id", s.NetworkID)
@@ -216,6 +235,8 @@ func resourceNetworkingSubnetV2Read(d *schema.ResourceData, meta interface{}) er
 	d.Set("host_routes", s.HostRoutes)
 	d.Set("enable_dhcp", s.EnableDHCP)
 	d.Set("network_id", s.NetworkID)
+	d.Set("service_types", extAttrs.ServiceTypes)
+	d.Set("segment_id", extAttrs.SegmentID)
 
 	// Set the allocation_pools
 	var allocationPools []map[string]interface{}
@@ -251,7 +272,7 @@ func resourceNetworkingSubnetV2Update(d *schema.ResourceData, meta interface{})
 		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
 	}
 
-	var updateOpts subnets.UpdateOpts
+	var updateOpts SubnetUpdateOpts
 
 	if d.HasChange("name") {
 		updateOpts.Name = d.Get("name").(string)
@@ -289,6 +310,16 @@ func resourceNetworkingSubnetV2Update(d *schema.ResourceData, meta interface{})
 		updateOpts.AllocationPools = resourceSubnetAllocationPoolsV2(d)
 	}
 
+	if d.HasChange("service_types") {
+		serviceTypes := resourceSubnetServiceTypesV2(d)
+		updateOpts.ServiceTypes = &serviceTypes
+	}
+
+	if d.HasChange("segment_id") {
+		segmentID := d.Get("segment_id").(string)
+		updateOpts.SegmentID = &segmentID
+	}
+
 	log.Printf("[DEBUG] Updating Subnet %s with options: %+v", d.Id(), updateOpts)
 
 	_, err = subnets.Update(networkingClient, d.Id(), updateOpts).Extract()
@@ -346,6 +377,15 @@ func resourceSubnetDNSNameserversV2(d *schema.ResourceData) []string {
 	return dnsn
 }
 
+func resourceSubnetServiceTypesV2(d *schema.ResourceData) []string {
+	rawST := d.Get("service_types").(*schema.Set)
+	st := make([]string, rawST.Len())
+	for i, raw := range rawST.List() {
+		st[i] = raw.(string)
+	}
+	return st
+}
+
 func resourceSubnetHostRoutesV2(d *schema.ResourceData) []subnets.HostRoute {
 	rawHR := d.Get("host_routes").([]interface{})
 	hr := make([]subnets.HostRoute, len(rawHR))
@@ -371,6 +411,22 @@ func resourceNetworkingSubnetV2DetermineIPVersion(v int) gophercloud.IPVersion {
 	return ipVersion
 }
 
+// SubnetExtAttrs represents the attributes of a subnet which are provided by
+// extensions and are not exposed by Gophercloud's Subnet.
+type SubnetExtAttrs struct {
+	ServiceTypes []string `json:"service_types"`
+	SegmentID    string   `json:"segment_id"`
+}
+
+// networkingV2SubnetExtAttrs extracts the extension attributes of a subnet.
+func networkingV2SubnetExtAttrs(r subnets.GetResult) (*SubnetExtAttrs, error) {
+	var s struct {
+		Subnet *SubnetExtAttrs `json:"subnet"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Subnet, err
+}
+
 func waitForSubnetActive(networkingClient *gophercloud.ServiceClient, subnetId string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		s, err := subnets.Get(networkingClient, subnetId).Extract()
@@ -0,0 +1,237 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceNetworkingSubnetPoolV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceNetworkingSubnetPoolV2Create,
+		Read:   resourceNetworkingSubnetPoolV2Read,
+		Update: resourceNetworkingSubnetPoolV2Update,
+		Delete: resourceNetworkingSubnetPoolV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"prefixes": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"default_prefixlen": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"min_prefixlen": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"max_prefixlen": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"default_quota": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"address_scope_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ip_version": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"shared": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"is_default": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceNetworkingSubnetPoolV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := SubnetPoolCreateOpts{
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		Prefixes:         resourceNetworkingSubnetPoolV2Prefixes(d),
+		DefaultPrefixLen: d.Get("default_prefixlen").(int),
+		MinPrefixLen:     d.Get("min_prefixlen").(int),
+		MaxPrefixLen:     d.Get("max_prefixlen").(int),
+		DefaultQuota:     d.Get("default_quota").(int),
+		AddressScopeID:   d.Get("address_scope_id").(string),
+		Shared:           d.Get("shared").(bool),
+		IsDefault:        d.Get("is_default").(bool),
+		TenantID:         d.Get("tenant_id").(string),
+		ValueSpecs:       MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	pool, err := networkingV2SubnetPoolCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron subnet pool: %s", err)
+	}
+
+	log.Printf("[INFO] Subnet pool ID: %s", pool.ID)
+
+	d.SetId(pool.ID)
+
+	return resourceNetworkingSubnetPoolV2Read(d, meta)
+}
+
+func resourceNetworkingSubnetPoolV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	pool, err := networkingV2SubnetPoolGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "subnet pool")
+	}
+
+	log.Printf("[DEBUG] Retrieved subnet pool %s: %+v", d.Id(), pool)
+
+	d.Set("name", pool.Name)
+	d.Set("description", pool.Description)
+	d.Set("prefixes", pool.Prefixes)
+	d.Set("default_prefixlen", pool.DefaultPrefixLen)
+	d.Set("min_prefixlen", pool.MinPrefixLen)
+	d.Set("max_prefixlen", pool.MaxPrefixLen)
+	d.Set("default_quota", pool.DefaultQuota)
+	d.Set("address_scope_id", pool.AddressScopeID)
+	d.Set("ip_version", pool.IPVersion)
+	d.Set("shared", pool.Shared)
+	d.Set("is_default", pool.IsDefault)
+	d.Set("tenant_id", pool.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceNetworkingSubnetPoolV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts SubnetPoolUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("prefixes") {
+		updateOpts.Prefixes = resourceNetworkingSubnetPoolV2Prefixes(d)
+	}
+	if d.HasChange("default_prefixlen") {
+		defaultPrefixLen := d.Get("default_prefixlen").(int)
+		updateOpts.DefaultPrefixLen = &defaultPrefixLen
+	}
+	if d.HasChange("min_prefixlen") {
+		minPrefixLen := d.Get("min_prefixlen").(int)
+		updateOpts.MinPrefixLen = &minPrefixLen
+	}
+	if d.HasChange("max_prefixlen") {
+		maxPrefixLen := d.Get("max_prefixlen").(int)
+		updateOpts.MaxPrefixLen = &maxPrefixLen
+	}
+	if d.HasChange("default_quota") {
+		defaultQuota := d.Get("default_quota").(int)
+		updateOpts.DefaultQuota = &defaultQuota
+	}
+	if d.HasChange("address_scope_id") {
+		addressScopeID := d.Get("address_scope_id").(string)
+		updateOpts.AddressScopeID = &addressScopeID
+	}
+	if d.HasChange("is_default") {
+		isDefault := d.Get("is_default").(bool)
+		updateOpts.IsDefault = &isDefault
+	}
+
+	log.Printf("[DEBUG] Updating subnet pool %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2SubnetPoolUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron subnet pool: %s", err)
+	}
+
+	return resourceNetworkingSubnetPoolV2Read(d, meta)
+}
+
+func resourceNetworkingSubnetPoolV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2SubnetPoolDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron subnet pool")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceNetworkingSubnetPoolV2Prefixes(d *schema.ResourceData) []string {
+	rawPrefixes := d.Get("prefixes").(*schema.Set).List()
+	prefixes := make([]string, len(rawPrefixes))
+	for i, raw := range rawPrefixes {
+		prefixes[i] = raw.(string)
+	}
+	return prefixes
+}
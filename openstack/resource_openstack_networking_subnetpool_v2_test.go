@@ -0,0 +1,121 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccNetworkingV2SubnetPool_basic(t *testing.T) {
+	var pool SubnetPool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckNetworkingV2SubnetPoolDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccNetworkingV2SubnetPool_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckNetworkingV2SubnetPoolExists(
+						"openstack_networking_subnetpool_v2.subnetpool_1", &pool),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnetpool_v2.subnetpool_1", "name", "subnetpool_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnetpool_v2.subnetpool_1", "default_prefixlen", "25"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnetpool_v2.subnetpool_1", "ip_version", "4"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccNetworkingV2SubnetPool_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_networking_subnetpool_v2.subnetpool_1", "id", &pool.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnetpool_v2.subnetpool_1", "name", "subnetpool_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnetpool_v2.subnetpool_1", "default_prefixlen", "26"),
+					resource.TestCheckResourceAttr(
+						"openstack_networking_subnetpool_v2.subnetpool_1", "prefixes.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNetworkingV2SubnetPoolDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_networking_subnetpool_v2" {
+			continue
+		}
+
+		_, err := networkingV2SubnetPoolGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Subnet pool still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckNetworkingV2SubnetPoolExists(n string, pool *SubnetPool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2SubnetPoolGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Subnet pool not found")
+		}
+
+		*pool = *found
+
+		return nil
+	}
+}
+
+const testAccNetworkingV2SubnetPool_basic = `
+resource "openstack_networking_subnetpool_v2" "subnetpool_1" {
+  name = "subnetpool_1"
+  prefixes = ["10.10.0.0/16"]
+  default_prefixlen = 25
+  min_prefixlen = 24
+  max_prefixlen = 28
+}
+`
+
+const testAccNetworkingV2SubnetPool_update = `
+resource "openstack_networking_subnetpool_v2" "subnetpool_1" {
+  name = "subnetpool_2"
+  description = "terraform subnet pool acceptance test"
+  prefixes = ["10.10.0.0/16", "10.20.0.0/16"]
+  default_prefixlen = 26
+  min_prefixlen = 24
+  max_prefixlen = 28
+}
+`
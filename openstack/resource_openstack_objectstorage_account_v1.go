@@ -0,0 +1,163 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceObjectStorageAccountV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObjectStorageAccountV1CreateUpdate,
+		Read:   resourceObjectStorageAccountV1Read,
+		Update: resourceObjectStorageAccountV1CreateUpdate,
+		Delete: resourceObjectStorageAccountV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"quota_bytes": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"bytes_used": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"container_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"object_count": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceObjectStorageAccountV1CreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	baseUpdateOpts := accounts.UpdateOpts{
+		Metadata: resourceAccountMetadataV1(d),
+	}
+
+	updateOpts := AccountV1UpdateOpts{
+		UpdateOptsBuilder: &baseUpdateOpts,
+		Headers:           make(map[string]string),
+	}
+
+	if v, ok := d.GetOk("quota_bytes"); ok {
+		baseUpdateOpts.Metadata[objectStorageV1AccountQuotaBytesKey] = strconv.Itoa(v.(int))
+	} else if d.HasChange("quota_bytes") {
+		updateOpts.Headers[objectStorageV1AccountRemoveMetaPrefix+objectStorageV1AccountQuotaBytesKey] = "x"
+	}
+
+	o, _ := d.GetChange("metadata")
+	for key := range o.(map[string]interface{}) {
+		if _, ok := baseUpdateOpts.Metadata[key]; !ok {
+			updateOpts.Headers[objectStorageV1AccountRemoveMetaPrefix+key] = "x"
+		}
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+	_, err = accounts.Update(objectStorageClient, updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack object storage account: %s", err)
+	}
+
+	if d.Id() == "" {
+		d.SetId(objectStorageV1AccountName(objectStorageClient))
+	}
+
+	return resourceObjectStorageAccountV1Read(d, meta)
+}
+
+func resourceObjectStorageAccountV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	result := accounts.Get(objectStorageClient, nil)
+	account, err := result.Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "object storage account")
+	}
+
+	log.Printf("[DEBUG] Retrieved object storage account %s: %+v", d.Id(), account)
+
+	d.Set("metadata", objectStorageV1Metadata(result.Header, objectStorageV1AccountMetaPrefix,
+		d.Get("metadata").(map[string]interface{}),
+		objectStorageV1AccountQuotaBytesKey, objectStorageV1TempURLKeyMetaPrefix))
+
+	quotaBytes := 0
+	if v := result.Header.Get(objectStorageV1AccountMetaPrefix + objectStorageV1AccountQuotaBytesKey); v != "" {
+		quotaBytes, err = strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("Invalid quota of object storage account %s: %s", d.Id(), v)
+		}
+	}
+	d.Set("quota_bytes", quotaBytes)
+
+	d.Set("bytes_used", account.BytesUsed)
+	d.Set("container_count", account.ContainerCount)
+	d.Set("object_count", account.ObjectCount)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceObjectStorageAccountV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	updateOpts := AccountV1UpdateOpts{
+		UpdateOptsBuilder: &accounts.UpdateOpts{},
+		Headers:           make(map[string]string),
+	}
+
+	for key := range d.Get("metadata").(map[string]interface{}) {
+		updateOpts.Headers[objectStorageV1AccountRemoveMetaPrefix+key] = "x"
+	}
+	if _, ok := d.GetOk("quota_bytes"); ok {
+		updateOpts.Headers[objectStorageV1AccountRemoveMetaPrefix+objectStorageV1AccountQuotaBytesKey] = "x"
+	}
+
+	log.Printf("[DEBUG] Removing metadata of object storage account %s", d.Id())
+	_, err = accounts.Update(objectStorageClient, updateOpts).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error removing metadata of object storage account")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceAccountMetadataV1(d *schema.ResourceData) map[string]string {
+	m := make(map[string]string)
+	for key, val := range d.Get("metadata").(map[string]interface{}) {
+		m[key] = val.(string)
+	}
+	return m
+}
@@ -0,0 +1,114 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/accounts"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccObjectStorageV1Account_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObjectStorageV1AccountDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccObjectStorageV1Account_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.%", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.owner", "team-a"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_objectstorage_account_v1.account_1", "container_count"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccObjectStorageV1Account_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.%", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "metadata.owner", "team-b"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectStorageV1Account_quota(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+			testAccPreCheckAdminOnly(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObjectStorageV1AccountDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccObjectStorageV1Account_quota,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_account_v1.account_1", "quota_bytes", "1073741824"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageV1AccountDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_objectstorage_account_v1" {
+			continue
+		}
+
+		metadata, err := accounts.Get(objectStorageClient, nil).ExtractMetadata()
+		if err != nil {
+			return err
+		}
+
+		for _, key := range []string{"Owner", "Purpose", objectStorageV1AccountQuotaBytesKey} {
+			if _, ok := metadata[key]; ok {
+				return fmt.Errorf("Account metadata %s still exists", key)
+			}
+		}
+	}
+
+	return nil
+}
+
+const testAccObjectStorageV1Account_basic = `
+resource "openstack_objectstorage_account_v1" "account_1" {
+  metadata {
+    owner = "team-a"
+    purpose = "testing"
+  }
+}
+`
+
+const testAccObjectStorageV1Account_update = `
+resource "openstack_objectstorage_account_v1" "account_1" {
+  metadata {
+    owner = "team-b"
+  }
+}
+`
+
+const testAccObjectStorageV1Account_quota = `
+resource "openstack_objectstorage_account_v1" "account_1" {
+  quota_bytes = 1073741824
+}
+`
@@ -3,6 +3,7 @@ package openstack
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/gophercloud/gophercloud/openstack/objectstorage/v1/containers"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -28,9 +29,10 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 				ForceNew: false,
 			},
 			"container_read": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: false,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				DiffSuppressFunc: suppressEquivalentObjectStorageACLDiffs,
 			},
 			"container_sync_to": &schema.Schema{
 				Type:     schema.TypeString,
@@ -43,9 +45,10 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 				ForceNew: false,
 			},
 			"container_write": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: false,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         false,
+				DiffSuppressFunc: suppressEquivalentObjectStorageACLDiffs,
 			},
 			"content_type": &schema.Schema{
 				Type:     schema.TypeString,
@@ -57,6 +60,56 @@ func resourceObjectStorageContainerV1() *schema.Resource {
 				Optional: true,
 				ForceNew: false,
 			},
+			"versioning": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+								value := v.(string)
+								if _, ok := objectStorageV1VersioningHeaders[value]; !ok {
+									errors = append(errors, fmt.Errorf(
+										"Only 'versions' and 'history' are supported values for 'type'"))
+								}
+								return
+							},
+						},
+						"location": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"website": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"error": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"listings": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"listings_css": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -70,13 +123,23 @@ func resourceObjectStorageContainerV1Create(d *schema.ResourceData, meta interfa
 
 	cn := d.Get("name").(string)
 
-	createOpts := &containers.CreateOpts{
-		ContainerRead:    d.Get("container_read").(string),
-		ContainerSyncTo:  d.Get("container_sync_to").(string),
-		ContainerSyncKey: d.Get("container_sync_key").(string),
-		ContainerWrite:   d.Get("container_write").(string),
-		ContentType:      d.Get("content_type").(string),
-		Metadata:         resourceContainerMetadataV2(d),
+	createOpts := ContainerV1CreateOpts{
+		CreateOptsBuilder: &containers.CreateOpts{
+			ContainerRead:    d.Get("container_read").(string),
+			ContainerSyncTo:  d.Get("container_sync_to").(string),
+			ContainerSyncKey: d.Get("container_sync_key").(string),
+			ContainerWrite:   d.Get("container_write").(string),
+			ContentType:      d.Get("content_type").(string),
+			Metadata:         resourceContainerMetadataV2(d),
+		},
+		Headers: make(map[string]string),
+	}
+
+	for k, v := range resourceObjectStorageContainerV1VersioningHeaders(d.Get("versioning").([]interface{})) {
+		createOpts.Headers[k] = v
+	}
+	for k, v := range resourceObjectStorageContainerV1WebsiteHeaders(d.Get("website").([]interface{})) {
+		createOpts.Headers[k] = v
 	}
 
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
@@ -94,6 +157,53 @@ func resourceObjectStorageContainerV1Create(d *schema.ResourceData, meta interfa
 
 func resourceObjectStorageContainerV1Read(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	result := containers.Get(objectStorageClient, d.Id())
+	if result.Err != nil {
+		return CheckDeleted(d, result.Err, "container")
+	}
+
+	log.Printf("[DEBUG] Retrieved container %s: %+v", d.Id(), result.Header)
+
+	d.Set("name", d.Id())
+	d.Set("container_read", result.Header.Get("X-Container-Read"))
+	d.Set("container_write", result.Header.Get("X-Container-Write"))
+	d.Set("container_sync_to", result.Header.Get("X-Container-Sync-To"))
+	d.Set("container_sync_key", result.Header.Get("X-Container-Sync-Key"))
+	d.Set("metadata", objectStorageV1Metadata(result.Header, objectStorageV1ContainerMetaPrefix,
+		d.Get("metadata").(map[string]interface{}),
+		objectStorageV1ContainerWebMetaPrefix, objectStorageV1TempURLKeyMetaPrefix))
+
+	var versioning []map[string]interface{}
+	for versioningType, header := range objectStorageV1VersioningHeaders {
+		if location := result.Header.Get(header); location != "" {
+			versioning = append(versioning, map[string]interface{}{
+				"type":     versioningType,
+				"location": location,
+			})
+		}
+	}
+	d.Set("versioning", versioning)
+
+	var website []map[string]interface{}
+	index := result.Header.Get(objectStorageV1ContainerMetaPrefix + "Web-Index")
+	errorPage := result.Header.Get(objectStorageV1ContainerMetaPrefix + "Web-Error")
+	listings := result.Header.Get(objectStorageV1ContainerMetaPrefix + "Web-Listings")
+	listingsCSS := result.Header.Get(objectStorageV1ContainerMetaPrefix + "Web-Listings-Css")
+	if index != "" || errorPage != "" || listings != "" || listingsCSS != "" {
+		website = append(website, map[string]interface{}{
+			"index":        index,
+			"error":        errorPage,
+			"listings":     strings.ToLower(listings) == "true",
+			"listings_css": listingsCSS,
+		})
+	}
+	d.Set("website", website)
+
 	d.Set("region", GetRegion(d, config))
 
 	return nil
@@ -106,7 +216,7 @@ func resourceObjectStorageContainerV1Update(d *schema.ResourceData, meta interfa
 		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
 	}
 
-	updateOpts := containers.UpdateOpts{
+	baseUpdateOpts := containers.UpdateOpts{
 		ContainerRead:    d.Get("container_read").(string),
 		ContainerSyncTo:  d.Get("container_sync_to").(string),
 		ContainerSyncKey: d.Get("container_sync_key").(string),
@@ -114,10 +224,63 @@ func resourceObjectStorageContainerV1Update(d *schema.ResourceData, meta interfa
 		ContentType:      d.Get("content_type").(string),
 	}
 
+	updateOpts := ContainerV1UpdateOpts{
+		UpdateOptsBuilder: &baseUpdateOpts,
+		Headers:           make(map[string]string),
+	}
+
+	// Empty headers are not sent by Gophercloud, so cleared ACLs and
+	// container sync settings are sent explicitly.
+	if d.HasChange("container_read") && baseUpdateOpts.ContainerRead == "" {
+		updateOpts.Headers["X-Container-Read"] = ""
+	}
+	if d.HasChange("container_write") && baseUpdateOpts.ContainerWrite == "" {
+		updateOpts.Headers["X-Container-Write"] = ""
+	}
+	if d.HasChange("container_sync_to") && baseUpdateOpts.ContainerSyncTo == "" {
+		updateOpts.Headers["X-Container-Sync-To"] = ""
+	}
+	if d.HasChange("container_sync_key") && baseUpdateOpts.ContainerSyncKey == "" {
+		updateOpts.Headers["X-Container-Sync-Key"] = ""
+	}
+
 	if d.HasChange("metadata") {
-		updateOpts.Metadata = resourceContainerMetadataV2(d)
+		baseUpdateOpts.Metadata = resourceContainerMetadataV2(d)
+
+		o, _ := d.GetChange("metadata")
+		for key := range o.(map[string]interface{}) {
+			if _, ok := baseUpdateOpts.Metadata[key]; !ok {
+				updateOpts.Headers[objectStorageV1ContainerRemoveMetaPrefix+key] = "x"
+			}
+		}
+	}
+
+	if d.HasChange("versioning") {
+		o, n := d.GetChange("versioning")
+		for k, v := range resourceObjectStorageContainerV1VersioningHeaders(o.([]interface{})) {
+			updateOpts.Headers[strings.Replace(k, "X-", "X-Remove-", 1)] = v
+		}
+		for k, v := range resourceObjectStorageContainerV1VersioningHeaders(n.([]interface{})) {
+			delete(updateOpts.Headers, strings.Replace(k, "X-", "X-Remove-", 1))
+			updateOpts.Headers[k] = v
+		}
 	}
 
+	if d.HasChange("website") {
+		o, n := d.GetChange("website")
+		for k := range resourceObjectStorageContainerV1WebsiteHeaders(o.([]interface{})) {
+			key := strings.TrimPrefix(k, objectStorageV1ContainerMetaPrefix)
+			updateOpts.Headers[objectStorageV1ContainerRemoveMetaPrefix+key] = "x"
+		}
+		for k, v := range resourceObjectStorageContainerV1WebsiteHeaders(n.([]interface{})) {
+			key := strings.TrimPrefix(k, objectStorageV1ContainerMetaPrefix)
+			delete(updateOpts.Headers, objectStorageV1ContainerRemoveMetaPrefix+key)
+			updateOpts.Headers[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Update Options: %#v", updateOpts)
+
 	_, err = containers.Update(objectStorageClient, d.Id(), updateOpts).Extract()
 	if err != nil {
 		return fmt.Errorf("Error updating OpenStack container: %s", err)
@@ -149,3 +312,42 @@ func resourceContainerMetadataV2(d *schema.ResourceData) map[string]string {
 	}
 	return m
 }
+
+// resourceObjectStorageContainerV1VersioningHeaders returns the headers
+// which enable the configured object versioning.
+func resourceObjectStorageContainerV1VersioningHeaders(versioning []interface{}) map[string]string {
+	headers := make(map[string]string)
+	if len(versioning) == 0 || versioning[0] == nil {
+		return headers
+	}
+
+	v := versioning[0].(map[string]interface{})
+	headers[objectStorageV1VersioningHeaders[v["type"].(string)]] = v["location"].(string)
+
+	return headers
+}
+
+// resourceObjectStorageContainerV1WebsiteHeaders returns the metadata
+// headers which configure the static website middleware.
+func resourceObjectStorageContainerV1WebsiteHeaders(website []interface{}) map[string]string {
+	headers := make(map[string]string)
+	if len(website) == 0 || website[0] == nil {
+		return headers
+	}
+
+	w := website[0].(map[string]interface{})
+	if v := w["index"].(string); v != "" {
+		headers[objectStorageV1ContainerMetaPrefix+"Web-Index"] = v
+	}
+	if v := w["error"].(string); v != "" {
+		headers[objectStorageV1ContainerMetaPrefix+"Web-Error"] = v
+	}
+	if w["listings"].(bool) {
+		headers[objectStorageV1ContainerMetaPrefix+"Web-Listings"] = "true"
+	}
+	if v := w["listings_css"].(string); v != "" {
+		headers[objectStorageV1ContainerMetaPrefix+"Web-Listings-Css"] = v
+	}
+
+	return headers
+}
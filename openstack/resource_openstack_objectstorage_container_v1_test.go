@@ -38,6 +38,110 @@ func TestAccObjectStorageV1Container_basic(t *testing.T) {
 	})
 }
 
+func TestAccObjectStorageV1Container_versioning(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccObjectStorageV1Container_versioning("versions"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "versioning.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "versioning.0.type", "versions"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "versioning.0.location", "container_1_versions"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccObjectStorageV1Container_versioning("history"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "versioning.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "versioning.0.type", "history"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccObjectStorageV1Container_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "versioning.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectStorageV1Container_website(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccObjectStorageV1Container_website,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "website.0.index", "index.html"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "website.0.error", "error.html"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "website.0.listings", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "metadata.%", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccObjectStorageV1Container_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "website.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccObjectStorageV1Container_acls(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObjectStorageV1ContainerDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccObjectStorageV1Container_acls,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "container_read", ".r:*,.rlistings"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "container_write", "*:*"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccObjectStorageV1Container_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "container_read", ""),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_container_v1.container_1", "container_write", ""),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectStorageV1ContainerDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 	objectStorageClient, err := config.objectStorageV1Client(OS_REGION_NAME)
@@ -78,3 +182,52 @@ resource "openstack_objectstorage_container_v1" "container_1" {
   content_type = "text/plain"
 }
 `
+
+func testAccObjectStorageV1Container_versioning(versioningType string) string {
+	return fmt.Sprintf(`
+resource "openstack_objectstorage_container_v1" "container_1_versions" {
+  name = "container_1_versions"
+}
+
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+  metadata {
+    test = "true"
+  }
+  content_type = "application/json"
+
+  versioning {
+    type = "%s"
+    location = "${openstack_objectstorage_container_v1.container_1_versions.name}"
+  }
+}
+`, versioningType)
+}
+
+const testAccObjectStorageV1Container_website = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+  metadata {
+    test = "true"
+  }
+  content_type = "application/json"
+
+  website {
+    index = "index.html"
+    error = "error.html"
+    listings = true
+  }
+}
+`
+
+const testAccObjectStorageV1Container_acls = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+  metadata {
+    test = "true"
+  }
+  content_type = "application/json"
+  container_read = ".r:*, .rlistings"
+  container_write = "*:*"
+}
+`
@@ -16,7 +16,7 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceObjectStorageObjectV1Put,
 		Read:   resourceObjectStorageObjectV1Read,
-		Update: resourceObjectStorageObjectV1Put,
+		Update: resourceObjectStorageObjectV1Update,
 		Delete: resourceObjectStorageObjectV1Delete,
 
 		Schema: map[string]*schema.Schema{
@@ -116,6 +116,23 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 				ConflictsWith: []string{"content", "copy_from", "object_manifest"},
 			},
 
+			"large_object_threshold_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validatePositiveInt,
+			},
+
+			"segment_size_mb": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validatePositiveInt,
+			},
+
+			"segment_container": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			// Read Only
 			"content_length": {
 				Type:     schema.TypeInt,
@@ -132,6 +149,11 @@ func resourceObjectStorageObjectV1() *schema.Resource {
 				Computed: true,
 			},
 
+			"static_large_object": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
 			"trans_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -156,6 +178,8 @@ func resourceObjectStorageObjectV1Put(d *schema.ResourceData, meta interface{})
 	}
 
 	var isValid bool
+	var largeObject *os.File
+	var largeObjectSize int64
 	if v, ok := d.GetOk("source"); ok {
 		isValid = true
 		source := v.(string)
@@ -173,8 +197,18 @@ func resourceObjectStorageObjectV1Put(d *schema.ResourceData, meta interface{})
 			return fmt.Errorf("Error opening openstack swift object source (%s): %s", source, err)
 		}
 
-		createOpts.Content = file
-		createOpts.ContentLength = fileinfo.Size()
+		// Files above the threshold are uploaded as a Static Large Object.
+		threshold := d.Get("large_object_threshold_mb").(int)
+		if threshold == 0 {
+			threshold = objectStorageV1SLODefaultThresholdMB
+		}
+		if fileinfo.Size() > int64(threshold)*1024*1024 {
+			largeObject = file
+			largeObjectSize = fileinfo.Size()
+		} else {
+			createOpts.Content = file
+			createOpts.ContentLength = fileinfo.Size()
+		}
 	}
 
 	if v, ok := d.GetOk("content"); ok {
@@ -234,13 +268,81 @@ func resourceObjectStorageObjectV1Put(d *schema.ResourceData, meta interface{})
 		createOpts.ETag = v.(string)
 	}
 
+	// The segments of a replaced Static Large Object are deleted once the
+	// new object is in place.
+	var oldSegments []string
+	if d.Id() != "" {
+		oldSegments, err = objectStorageV1SLOSegmentPaths(objectStorageClient, cn, name)
+		if err != nil {
+			return fmt.Errorf("Error retrieving segments of OpenStack container object: %s", err)
+		}
+	}
+
 	log.Printf("[DEBUG] Create Options: %#v", createOpts)
-	result, err := objects.Create(objectStorageClient, cn, name, createOpts).Extract()
+	var result *objects.CreateHeader
+	var newSegments []SLOSegment
+	if largeObject != nil {
+		segmentContainer := d.Get("segment_container").(string)
+		if segmentContainer == "" {
+			segmentContainer = objectStorageV1SLOSegmentContainer(cn)
+		}
+		segmentSizeMB := d.Get("segment_size_mb").(int)
+		if segmentSizeMB == 0 {
+			segmentSizeMB = objectStorageV1SLODefaultSegmentSizeMB
+		}
+		segmentSize := int64(segmentSizeMB) * 1024 * 1024
+
+		log.Printf("[DEBUG] Uploading %s as segments of %d bytes into container %s", largeObject.Name(), segmentSize, segmentContainer)
+		newSegments, err = objectStorageV1SLOUploadSegments(objectStorageClient, largeObject, largeObjectSize, segmentContainer, name, segmentSize)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack container object: %s", err)
+		}
+
+		// The etag of a Static Large Object is the checksum of the
+		// checksums of its segments. The etag in the state is the one of
+		// the previous upload, so only a configured etag is checked.
+		if etag := createOpts.ETag; etag != "" && (d.Id() == "" || d.HasChange("etag")) {
+			if manifestETag := objectStorageV1SLOManifestETag(newSegments); etag != manifestETag {
+				paths := make([]string, len(newSegments))
+				for i, segment := range newSegments {
+					paths[i] = segment.Path
+				}
+				if err := objectStorageV1SLOSegmentsDelete(objectStorageClient, paths); err != nil {
+					log.Printf("[WARN] Unable to delete segments of object %s: %s", name, err)
+				}
+
+				return fmt.Errorf("The etag of OpenStack container object %s is %s, but etag is set to %s", name, manifestETag, etag)
+			}
+		}
+
+		result, err = objectStorageV1SLOManifestPut(objectStorageClient, cn, name, createOpts, newSegments).Extract()
+	} else {
+		result, err = objects.Create(objectStorageClient, cn, name, createOpts).Extract()
+	}
 	if err != nil {
 		return fmt.Errorf("Error creating OpenStack container object: %s", err)
 	}
 	log.Printf("[INFO] Object %s has been added to container : %s", name, cn)
 
+	if len(oldSegments) > 0 {
+		uploaded := make(map[string]bool)
+		for _, segment := range newSegments {
+			uploaded[segment.Path] = true
+		}
+
+		var obsoleteSegments []string
+		for _, path := range oldSegments {
+			if !uploaded[path] {
+				obsoleteSegments = append(obsoleteSegments, path)
+			}
+		}
+
+		log.Printf("[DEBUG] Deleting obsolete segments of object %s: %v", name, obsoleteSegments)
+		if err := objectStorageV1SLOSegmentsDelete(objectStorageClient, obsoleteSegments); err != nil {
+			return err
+		}
+	}
+
 	d.Set("etag", result.ETag)
 	d.Set("content_length", result.ContentLength)
 	d.Set("content_type", result.ContentType)
@@ -299,11 +401,27 @@ func resourceObjectStorageObjectV1Read(d *schema.ResourceData, meta interface{})
 		d.Set("last_modified", result.LastModified.Format(time.RFC3339))
 	}
 	d.Set("object_manifest", result.ObjectManifest)
+	d.Set("static_large_object", result.StaticLargeObject)
 	d.Set("trans_id", result.TransID)
 
 	return nil
 }
 
+func resourceObjectStorageObjectV1Update(d *schema.ResourceData, meta interface{}) error {
+	// The large object settings only apply to the next upload, so the
+	// object isn't uploaded again if nothing else changed.
+	for k := range resourceObjectStorageObjectV1().Schema {
+		if k == "large_object_threshold_mb" || k == "segment_size_mb" {
+			continue
+		}
+		if d.HasChange(k) {
+			return resourceObjectStorageObjectV1Put(d, meta)
+		}
+	}
+
+	return resourceObjectStorageObjectV1Read(d, meta)
+}
+
 func resourceObjectStorageObjectV1Delete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
@@ -315,6 +433,13 @@ func resourceObjectStorageObjectV1Delete(d *schema.ResourceData, meta interface{
 	cn := d.Get("container_name").(string)
 	deleteOpts := &objects.DeleteOpts{}
 
+	// Deleting the manifest of a Static Large Object deletes its segments
+	// too.
+	object, err := objects.Get(objectStorageClient, cn, name, nil).Extract()
+	if err == nil && object.StaticLargeObject {
+		deleteOpts.MultipartManifest = "delete"
+	}
+
 	_, err = objects.Delete(objectStorageClient, cn, name, deleteOpts).Extract()
 	if err != nil {
 		return fmt.Errorf("Error getting OpenStack container object: %s", err)
@@ -1,6 +1,7 @@
 package openstack
 
 import (
+	"bytes"
 	"crypto/md5"
 	"fmt"
 	"io/ioutil"
@@ -123,6 +124,55 @@ func TestAccObjectStorageV1Object_fromSource(t *testing.T) {
 	})
 }
 
+func TestAccObjectStorageV1Object_largeObject(t *testing.T) {
+	// The file is slightly larger than the threshold of 1 MB, so it is
+	// uploaded as two segments.
+	content := bytes.Repeat([]byte("foo"), 350000)
+	tmpfile, err := ioutil.TempFile("", "tf_test_objectstorage_object")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.Remove(tmpfile.Name())
+	if _, err := tmpfile.Write(content); err != nil {
+		log.Fatal(err)
+	}
+	if err := tmpfile.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	var object1, object2 objects.GetHeader
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckSwift(t) },
+		Providers: testAccProviders,
+		CheckDestroy: func(s *terraform.State) error {
+			return testAccCheckObjectStorageV1ObjectDestroy(s, "terraform/test/mylargefile")
+		},
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: fmt.Sprintf(testAccObjectStorageV1Object_largeObject, 1, tmpfile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "static_large_object", "true"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "content_length", fmt.Sprintf("%v", len(content))),
+					testAccCheckObjectStorageV1ObjectExists("openstack_objectstorage_object_v1.myfile", &object1),
+				),
+			},
+			resource.TestStep{
+				// Changing the segment size alone doesn't upload the
+				// object again.
+				Config: fmt.Sprintf(testAccObjectStorageV1Object_largeObject, 2, tmpfile.Name()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_object_v1.myfile", "segment_size_mb", "2"),
+					testAccCheckObjectStorageV1ObjectExists("openstack_objectstorage_object_v1.myfile", &object2),
+					testAccCheckObjectStorageV1ObjectNotUploaded(&object1, &object2),
+				),
+			},
+		},
+	})
+}
+
 func TestAccObjectStorageV1Object_detectContentType(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheckSwift(t) },
@@ -273,6 +323,16 @@ func testAccCheckObjectStorageV1ObjectDeleteAtMatches(expected string, object *o
 	}
 }
 
+func testAccCheckObjectStorageV1ObjectNotUploaded(object1, object2 *objects.GetHeader) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if !object1.LastModified.Equal(object2.LastModified) {
+			return fmt.Errorf("Object was uploaded again.")
+		}
+
+		return nil
+	}
+}
+
 var testAccObjectStorageV1Object_basic = fmt.Sprintf(`
 resource "openstack_objectstorage_container_v1" "container_1" {
   name = "tf_test_container_1"
@@ -368,6 +428,25 @@ resource "openstack_objectstorage_object_v1" "myfile" {
 }
 `
 
+const testAccObjectStorageV1Object_largeObject = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "tf_test_container_1"
+}
+
+resource "openstack_objectstorage_container_v1" "segments_1" {
+  name = "tf_test_container_1_segments"
+}
+
+resource "openstack_objectstorage_object_v1" "myfile" {
+  name = "terraform/test/mylargefile"
+  container_name = "${openstack_objectstorage_container_v1.container_1.name}"
+  segment_container = "${openstack_objectstorage_container_v1.segments_1.name}"
+  large_object_threshold_mb = 1
+  segment_size_mb = %d
+  source = "%s"
+}
+`
+
 const testAccObjectStorageV1Object_copyFrom = `
 resource "openstack_objectstorage_container_v1" "container_1" {
   name = "tf_test_container_1"
@@ -0,0 +1,130 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceObjectStorageTempURLKeyV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceObjectStorageTempURLKeyV1CreateUpdate,
+		Read:   resourceObjectStorageTempURLKeyV1Read,
+		Update: resourceObjectStorageTempURLKeyV1CreateUpdate,
+		Delete: resourceObjectStorageTempURLKeyV1Delete,
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"container": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"key": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"key_2": &schema.Schema{
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceObjectStorageTempURLKeyV1CreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	container := d.Get("container").(string)
+	keys := TempURLKeys{
+		Key:  d.Get("key").(string),
+		Key2: d.Get("key_2").(string),
+	}
+
+	log.Printf("[DEBUG] Setting temporary URL keys of %s", resourceObjectStorageTempURLKeyV1Target(container))
+	err = objectStorageV1TempURLKeysUpdate(objectStorageClient, container, keys).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error setting temporary URL keys of %s: %s", resourceObjectStorageTempURLKeyV1Target(container), err)
+	}
+
+	if d.Id() == "" {
+		d.SetId(resourceObjectStorageTempURLKeyV1ID(objectStorageV1AccountName(objectStorageClient), container))
+	}
+
+	return resourceObjectStorageTempURLKeyV1Read(d, meta)
+}
+
+func resourceObjectStorageTempURLKeyV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	container := d.Get("container").(string)
+	keys, err := objectStorageV1TempURLKeysGet(objectStorageClient, container).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "temporary URL keys")
+	}
+
+	// The keys were removed outside of Terraform.
+	if keys.Key == "" {
+		log.Printf("[DEBUG] %s has no temporary URL key", resourceObjectStorageTempURLKeyV1Target(container))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("key", keys.Key)
+	d.Set("key_2", keys.Key2)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceObjectStorageTempURLKeyV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	container := d.Get("container").(string)
+
+	log.Printf("[DEBUG] Removing temporary URL keys of %s", resourceObjectStorageTempURLKeyV1Target(container))
+	err = objectStorageV1TempURLKeysUpdate(objectStorageClient, container, TempURLKeys{}).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error removing temporary URL keys")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceObjectStorageTempURLKeyV1ID returns the ID of a temporary URL key
+// resource, which is the name of the account, followed by the name of the
+// container if the keys are set on a container.
+func resourceObjectStorageTempURLKeyV1ID(account, container string) string {
+	if container == "" {
+		return account
+	}
+	return fmt.Sprintf("%s/%s", account, container)
+}
+
+func resourceObjectStorageTempURLKeyV1Target(container string) string {
+	if container == "" {
+		return "the account"
+	}
+	return fmt.Sprintf("container %s", container)
+}
@@ -0,0 +1,114 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccObjectStorageV1TempURLKey_basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSwift(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckObjectStorageV1TempURLKeyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccObjectStorageV1TempURLKey_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1TempURLKeyExists("openstack_objectstorage_tempurl_key_v1.key_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_tempurl_key_v1.key_1", "key", "secret-1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccObjectStorageV1TempURLKey_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectStorageV1TempURLKeyExists("openstack_objectstorage_tempurl_key_v1.key_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_tempurl_key_v1.key_1", "key", "secret-2"),
+					resource.TestCheckResourceAttr(
+						"openstack_objectstorage_tempurl_key_v1.key_1", "key_2", "secret-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckObjectStorageV1TempURLKeyDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	objectStorageClient, err := config.objectStorageV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_objectstorage_tempurl_key_v1" {
+			continue
+		}
+
+		keys, err := objectStorageV1TempURLKeysGet(objectStorageClient, rs.Primary.Attributes["container"]).Extract()
+		if err == nil && (keys.Key != "" || keys.Key2 != "") {
+			return fmt.Errorf("Temporary URL keys still exist")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckObjectStorageV1TempURLKeyExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		objectStorageClient, err := config.objectStorageV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack object storage client: %s", err)
+		}
+
+		keys, err := objectStorageV1TempURLKeysGet(objectStorageClient, rs.Primary.Attributes["container"]).Extract()
+		if err != nil {
+			return err
+		}
+
+		if keys.Key != rs.Primary.Attributes["key"] {
+			return fmt.Errorf("Temporary URL key not set")
+		}
+
+		return nil
+	}
+}
+
+const testAccObjectStorageV1TempURLKey_basic = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+}
+
+resource "openstack_objectstorage_tempurl_key_v1" "key_1" {
+  container = "${openstack_objectstorage_container_v1.container_1.name}"
+  key = "secret-1"
+}
+`
+
+const testAccObjectStorageV1TempURLKey_update = `
+resource "openstack_objectstorage_container_v1" "container_1" {
+  name = "container_1"
+}
+
+resource "openstack_objectstorage_tempurl_key_v1" "key_1" {
+  container = "${openstack_objectstorage_container_v1.container_1.name}"
+  key = "secret-2"
+  key_2 = "secret-1"
+}
+`
@@ -0,0 +1,297 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceOrchestrationSoftwareConfigV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOrchestrationSoftwareConfigV1Create,
+		Read:   resourceOrchestrationSoftwareConfigV1Read,
+		Delete: resourceOrchestrationSoftwareConfigV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"group": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "script",
+			},
+			"config": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"input": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "String",
+							ValidateFunc: resourceOrchestrationSoftwareConfigV1ValidType,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"default": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"output": &schema.Schema{
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"type": &schema.Schema{
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							Default:      "String",
+							ValidateFunc: resourceOrchestrationSoftwareConfigV1ValidType,
+						},
+						"description": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"error_output": &schema.Schema{
+							Type:     schema.TypeBool,
+							Optional: true,
+							ForceNew: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"options": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+			},
+			"creation_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrchestrationSoftwareConfigV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	createOpts := SoftwareConfigV1CreateOpts{
+		Name:    d.Get("name").(string),
+		Group:   d.Get("group").(string),
+		Config:  d.Get("config").(string),
+		Inputs:  resourceOrchestrationSoftwareConfigV1Inputs(d),
+		Outputs: resourceOrchestrationSoftwareConfigV1Outputs(d),
+	}
+
+	if v := d.Get("options").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &createOpts.Options); err != nil {
+			return fmt.Errorf("Error decoding options: %s", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	softwareConfig, err := orchestrationV1SoftwareConfigCreate(orchestrationClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack software config: %s", err)
+	}
+
+	log.Printf("[INFO] Software config ID: %s", softwareConfig.ID)
+
+	d.SetId(softwareConfig.ID)
+
+	return resourceOrchestrationSoftwareConfigV1Read(d, meta)
+}
+
+func resourceOrchestrationSoftwareConfigV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	softwareConfig, err := orchestrationV1SoftwareConfigGet(orchestrationClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "software config")
+	}
+
+	log.Printf("[DEBUG] Retrieved software config %s: %+v", d.Id(), softwareConfig)
+
+	inputs := make([]map[string]interface{}, len(softwareConfig.Inputs))
+	for i, input := range softwareConfig.Inputs {
+		defaultValue, err := orchestrationV1ValueString(input.Default)
+		if err != nil {
+			return fmt.Errorf("Error encoding default of input %s: %s", input.Name, err)
+		}
+		inputs[i] = map[string]interface{}{
+			"name":        input.Name,
+			"type":        input.Type,
+			"description": input.Description,
+			"default":     defaultValue,
+		}
+	}
+
+	outputs := make([]map[string]interface{}, len(softwareConfig.Outputs))
+	for i, output := range softwareConfig.Outputs {
+		outputs[i] = map[string]interface{}{
+			"name":         output.Name,
+			"type":         output.Type,
+			"description":  output.Description,
+			"error_output": output.ErrorOutput,
+		}
+	}
+
+	var options string
+	if len(softwareConfig.Options) > 0 {
+		b, err := json.Marshal(softwareConfig.Options)
+		if err != nil {
+			return fmt.Errorf("Error encoding options: %s", err)
+		}
+		options = string(b)
+	}
+
+	d.Set("name", softwareConfig.Name)
+	d.Set("group", softwareConfig.Group)
+	d.Set("config", softwareConfig.Config)
+	d.Set("input", inputs)
+	d.Set("output", outputs)
+	d.Set("options", options)
+	d.Set("creation_time", softwareConfig.CreationTime)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceOrchestrationSoftwareConfigV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	err = orchestrationV1SoftwareConfigDelete(orchestrationClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack software config")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceOrchestrationSoftwareConfigV1Inputs(d *schema.ResourceData) []SoftwareConfigV1Input {
+	var inputs []SoftwareConfigV1Input
+	for _, raw := range d.Get("input").([]interface{}) {
+		rawInput := raw.(map[string]interface{})
+		input := SoftwareConfigV1Input{
+			Name:        rawInput["name"].(string),
+			Type:        rawInput["type"].(string),
+			Description: rawInput["description"].(string),
+		}
+		if v := rawInput["default"].(string); v != "" {
+			input.Default = v
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
+
+func resourceOrchestrationSoftwareConfigV1Outputs(d *schema.ResourceData) []SoftwareConfigV1Output {
+	var outputs []SoftwareConfigV1Output
+	for _, raw := range d.Get("output").([]interface{}) {
+		rawOutput := raw.(map[string]interface{})
+		outputs = append(outputs, SoftwareConfigV1Output{
+			Name:        rawOutput["name"].(string),
+			Type:        rawOutput["type"].(string),
+			Description: rawOutput["description"].(string),
+			ErrorOutput: rawOutput["error_output"].(bool),
+		})
+	}
+	return outputs
+}
+
+func resourceOrchestrationSoftwareConfigV1ValidType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validTypes := []string{
+		"String",
+		"Number",
+		"CommaDelimitedList",
+		"Json",
+		"Boolean",
+	}
+
+	for _, v := range validTypes {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validTypes)
+	errors = append(errors, err)
+	return
+}
+
+// orchestrationV1ValueString returns an input or output value of Heat as a
+// string. Values which are not strings are encoded as JSON.
+func orchestrationV1ValueString(v interface{}) (string, error) {
+	switch value := v.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,112 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOrchestrationSoftwareConfigV1_basic(t *testing.T) {
+	var softwareConfig SoftwareConfigV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckOrchestration(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOrchestrationSoftwareConfigV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrchestrationSoftwareConfigV1_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationSoftwareConfigV1Exists("openstack_orchestration_software_config_v1.config_1", &softwareConfig),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_software_config_v1.config_1", "name", "config_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_software_config_v1.config_1", "group", "script"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_software_config_v1.config_1", "input.#", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_software_config_v1.config_1", "input.0.default", "world"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_software_config_v1.config_1", "output.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrchestrationSoftwareConfigV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_orchestration_software_config_v1" {
+			continue
+		}
+
+		_, err := orchestrationV1SoftwareConfigGet(orchestrationClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Software config still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckOrchestrationSoftwareConfigV1Exists(n string, softwareConfig *SoftwareConfigV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		orchestrationClient, err := config.orchestrationV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+		}
+
+		found, err := orchestrationV1SoftwareConfigGet(orchestrationClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Software config not found")
+		}
+
+		*softwareConfig = *found
+
+		return nil
+	}
+}
+
+const testAccOrchestrationSoftwareConfigV1_basic = `
+resource "openstack_orchestration_software_config_v1" "config_1" {
+  name = "config_1"
+  config = <<EOF
+#!/bin/sh
+echo "Hello, $name" > $heat_outputs_path.greeting
+EOF
+
+  input {
+    name = "name"
+    default = "world"
+  }
+
+  output {
+    name = "greeting"
+  }
+}
+`
@@ -0,0 +1,250 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceOrchestrationSoftwareDeploymentV1() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceOrchestrationSoftwareDeploymentV1Create,
+		Read:   resourceOrchestrationSoftwareDeploymentV1Read,
+		Update: resourceOrchestrationSoftwareDeploymentV1Update,
+		Delete: resourceOrchestrationSoftwareDeploymentV1Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"server_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"config_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"input_values": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"wait_for_completion": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"action": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output_values": &schema.Schema{
+				Type:     schema.TypeMap,
+				Computed: true,
+			},
+			"creation_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceOrchestrationSoftwareDeploymentV1Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	createOpts := SoftwareDeploymentV1CreateOpts{
+		ConfigID:     d.Get("config_id").(string),
+		ServerID:     d.Get("server_id").(string),
+		Action:       "CREATE",
+		Status:       "IN_PROGRESS",
+		StatusReason: "Deploy data available",
+		InputValues:  d.Get("input_values").(map[string]interface{}),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	deployment, err := orchestrationV1SoftwareDeploymentCreate(orchestrationClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack software deployment: %s", err)
+	}
+
+	log.Printf("[INFO] Software deployment ID: %s", deployment.ID)
+
+	d.SetId(deployment.ID)
+
+	if d.Get("wait_for_completion").(bool) {
+		err = resourceOrchestrationSoftwareDeploymentV1Wait(orchestrationClient, deployment.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceOrchestrationSoftwareDeploymentV1Read(d, meta)
+}
+
+func resourceOrchestrationSoftwareDeploymentV1Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	deployment, err := orchestrationV1SoftwareDeploymentGet(orchestrationClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "software deployment")
+	}
+
+	log.Printf("[DEBUG] Retrieved software deployment %s: %+v", d.Id(), deployment)
+
+	inputValues := make(map[string]string)
+	for k, v := range deployment.InputValues {
+		value, err := orchestrationV1ValueString(v)
+		if err != nil {
+			return fmt.Errorf("Error encoding input value %s: %s", k, err)
+		}
+		inputValues[k] = value
+	}
+
+	outputValues := make(map[string]string)
+	for k, v := range deployment.OutputValues {
+		value, err := orchestrationV1ValueString(v)
+		if err != nil {
+			return fmt.Errorf("Error encoding output value %s: %s", k, err)
+		}
+		outputValues[k] = value
+	}
+
+	d.Set("server_id", deployment.ServerID)
+	d.Set("config_id", deployment.ConfigID)
+	d.Set("input_values", inputValues)
+	d.Set("action", deployment.Action)
+	d.Set("status", deployment.Status)
+	d.Set("status_reason", deployment.StatusReason)
+	d.Set("output_values", outputValues)
+	d.Set("creation_time", deployment.CreationTime)
+	d.Set("updated_time", deployment.UpdatedTime)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceOrchestrationSoftwareDeploymentV1Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	// Only a new config or new input values are delivered to the server
+	// again.
+	if !d.HasChange("config_id") && !d.HasChange("input_values") {
+		return resourceOrchestrationSoftwareDeploymentV1Read(d, meta)
+	}
+
+	updateOpts := SoftwareDeploymentV1UpdateOpts{
+		ConfigID:     d.Get("config_id").(string),
+		Action:       "UPDATE",
+		Status:       "IN_PROGRESS",
+		StatusReason: "Deploy data available",
+		InputValues:  d.Get("input_values").(map[string]interface{}),
+	}
+
+	log.Printf("[DEBUG] Updating software deployment %s with options: %#v", d.Id(), updateOpts)
+	_, err = orchestrationV1SoftwareDeploymentUpdate(orchestrationClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack software deployment %s: %s", d.Id(), err)
+	}
+
+	if d.Get("wait_for_completion").(bool) {
+		err = resourceOrchestrationSoftwareDeploymentV1Wait(orchestrationClient, d.Id(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceOrchestrationSoftwareDeploymentV1Read(d, meta)
+}
+
+func resourceOrchestrationSoftwareDeploymentV1Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	err = orchestrationV1SoftwareDeploymentDelete(orchestrationClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack software deployment")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceOrchestrationSoftwareDeploymentV1Wait waits until the agent on the
+// server has signaled the outcome of a software deployment.
+func resourceOrchestrationSoftwareDeploymentV1Wait(orchestrationClient *gophercloud.ServiceClient, deploymentID string, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Target:     []string{"COMPLETE"},
+		Pending:    []string{"IN_PROGRESS"},
+		Refresh:    resourceOrchestrationSoftwareDeploymentV1RefreshFunc(orchestrationClient, deploymentID),
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+
+	_, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack software deployment %s to complete: %s", deploymentID, err)
+	}
+
+	return nil
+}
+
+func resourceOrchestrationSoftwareDeploymentV1RefreshFunc(orchestrationClient *gophercloud.ServiceClient, deploymentID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		deployment, err := orchestrationV1SoftwareDeploymentGet(orchestrationClient, deploymentID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if deployment.Status == "FAILED" {
+			return deployment, deployment.Status, fmt.Errorf("The deployment failed: %s", deployment.StatusReason)
+		}
+
+		log.Printf("[DEBUG] OpenStack software deployment %s current status: %s", deploymentID, deployment.Status)
+		return deployment, deployment.Status, nil
+	}
+}
@@ -0,0 +1,124 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccOrchestrationSoftwareDeploymentV1_basic(t *testing.T) {
+	var deployment SoftwareDeploymentV1
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckOrchestration(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckOrchestrationSoftwareDeploymentV1Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccOrchestrationSoftwareDeploymentV1_basic("Alice"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationSoftwareDeploymentV1Exists("openstack_orchestration_software_deployment_v1.deployment_1", &deployment),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_software_deployment_v1.deployment_1", "action", "CREATE"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_software_deployment_v1.deployment_1", "input_values.name", "Alice"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccOrchestrationSoftwareDeploymentV1_basic("Bob"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOrchestrationSoftwareDeploymentV1Exists("openstack_orchestration_software_deployment_v1.deployment_1", &deployment),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_software_deployment_v1.deployment_1", "action", "UPDATE"),
+					resource.TestCheckResourceAttr(
+						"openstack_orchestration_software_deployment_v1.deployment_1", "input_values.name", "Bob"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckOrchestrationSoftwareDeploymentV1Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	orchestrationClient, err := config.orchestrationV1Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_orchestration_software_deployment_v1" {
+			continue
+		}
+
+		_, err := orchestrationV1SoftwareDeploymentGet(orchestrationClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Software deployment still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckOrchestrationSoftwareDeploymentV1Exists(n string, deployment *SoftwareDeploymentV1) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		orchestrationClient, err := config.orchestrationV1Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack orchestration client: %s", err)
+		}
+
+		found, err := orchestrationV1SoftwareDeploymentGet(orchestrationClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Software deployment not found")
+		}
+
+		*deployment = *found
+
+		return nil
+	}
+}
+
+func testAccOrchestrationSoftwareDeploymentV1_basic(name string) string {
+	return fmt.Sprintf(`
+resource "openstack_compute_instance_v2" "instance_1" {
+  name = "instance_1"
+  security_groups = ["default"]
+}
+
+resource "openstack_orchestration_software_config_v1" "config_1" {
+  name = "config_1"
+  config = "#!/bin/sh\necho \"Hello, $name\""
+
+  input {
+    name = "name"
+  }
+}
+
+resource "openstack_orchestration_software_deployment_v1" "deployment_1" {
+  server_id = "${openstack_compute_instance_v2.instance_1.id}"
+  config_id = "${openstack_orchestration_software_config_v1.config_1.id}"
+
+  input_values {
+    name = "%s"
+  }
+}
+`, name)
+}
@@ -0,0 +1,241 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSharedFilesystemShareAccessV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedFilesystemShareAccessV2Create,
+		Read:   resourceSharedFilesystemShareAccessV2Read,
+		Delete: resourceSharedFilesystemShareAccessV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"share_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceSharedFilesystemShareAccessV2ValidAccessType,
+			},
+			"access_to": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"access_level": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceSharedFilesystemShareAccessV2ValidAccessLevel,
+			},
+			"access_key": &schema.Schema{
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareAccessV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	shareID := d.Get("share_id").(string)
+	accessOpts := ShareAccessV2Opts{
+		AccessType:  d.Get("access_type").(string),
+		AccessTo:    d.Get("access_to").(string),
+		AccessLevel: d.Get("access_level").(string),
+	}
+
+	log.Printf("[DEBUG] Granting access to share %s with options: %#v", shareID, accessOpts)
+	access, err := sharedfilesystemV2ShareAccessAllow(sfsClient, shareID, accessOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error granting access to OpenStack share %s: %s", shareID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", shareID, access.ID))
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"new", "queued_to_apply", "applying"},
+		Target:     []string{"active"},
+		Refresh:    resourceSharedFilesystemShareAccessV2RefreshFunc(sfsClient, shareID, access.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack share access rule %s to become active: %s", access.ID, err)
+	}
+
+	return resourceSharedFilesystemShareAccessV2Read(d, meta)
+}
+
+func resourceSharedFilesystemShareAccessV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	shareID, accessID, err := parseSharedFilesystemShareAccessV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	access, err := sharedfilesystemV2ShareAccessGet(sfsClient, shareID, accessID)
+	if err != nil {
+		return CheckDeleted(d, err, "share access rule")
+	}
+
+	log.Printf("[DEBUG] Retrieved share access rule %s: %+v", d.Id(), access)
+
+	d.Set("share_id", shareID)
+	d.Set("access_type", access.AccessType)
+	d.Set("access_to", access.AccessTo)
+	d.Set("access_level", access.AccessLevel)
+	d.Set("access_key", access.AccessKey)
+	d.Set("state", access.State)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareAccessV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	shareID, accessID, err := parseSharedFilesystemShareAccessV2ID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Revoking access rule %s of share %s", accessID, shareID)
+	err = sharedfilesystemV2ShareAccessDeny(sfsClient, shareID, accessID).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error revoking OpenStack share access rule")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"active", "queued_to_deny", "denying"},
+		Target:     []string{"deleted"},
+		Refresh:    resourceSharedFilesystemShareAccessV2RefreshFunc(sfsClient, shareID, accessID),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      2 * time.Second,
+		MinTimeout: 2 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack share access rule %s to be revoked: %s", accessID, err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceSharedFilesystemShareAccessV2RefreshFunc(sfsClient *gophercloud.ServiceClient, shareID, accessID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		access, err := sharedfilesystemV2ShareAccessGet(sfsClient, shareID, accessID)
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return access, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if access.State == "error" {
+			return access, access.State, fmt.Errorf("The share access rule is in error state")
+		}
+
+		log.Printf("[DEBUG] OpenStack share access rule %s current state: %s", accessID, access.State)
+		return access, access.State, nil
+	}
+}
+
+func resourceSharedFilesystemShareAccessV2ValidAccessType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validAccessTypes := []string{
+		"ip",
+		"user",
+		"cert",
+		"cephx",
+	}
+
+	for _, v := range validAccessTypes {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validAccessTypes)
+	errors = append(errors, err)
+	return
+}
+
+func resourceSharedFilesystemShareAccessV2ValidAccessLevel(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validAccessLevels := []string{
+		"rw",
+		"ro",
+	}
+
+	for _, v := range validAccessLevels {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validAccessLevels)
+	errors = append(errors, err)
+	return
+}
+
+// parseSharedFilesystemShareAccessV2ID splits the ID of a share access
+// resource, which has the form <share id>/<access id>.
+func parseSharedFilesystemShareAccessV2ID(id string) (string, string, error) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		return "", "", fmt.Errorf("Unable to determine share access ID from %s", id)
+	}
+
+	return idParts[0], idParts[1], nil
+}
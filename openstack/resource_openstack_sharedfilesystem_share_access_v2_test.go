@@ -0,0 +1,110 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSharedFilesystemShareAccessV2_basic(t *testing.T) {
+	var access ShareAccessV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSharedFilesystem(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedFilesystemShareAccessV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSharedFilesystemShareAccessV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSharedFilesystemShareAccessV2Exists("openstack_sharedfilesystem_share_access_v2.access_1", &access),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_share_access_v2.access_1", "access_to", "192.168.199.0/24"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_share_access_v2.access_1", "access_level", "ro"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_share_access_v2.access_1", "state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSharedFilesystemShareAccessV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_access_v2" {
+			continue
+		}
+
+		shareID, accessID, err := parseSharedFilesystemShareAccessV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		_, err = sharedfilesystemV2ShareAccessGet(sfsClient, shareID, accessID)
+		if err == nil {
+			return fmt.Errorf("Share access rule still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSharedFilesystemShareAccessV2Exists(n string, access *ShareAccessV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+		}
+
+		shareID, accessID, err := parseSharedFilesystemShareAccessV2ID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		found, err := sharedfilesystemV2ShareAccessGet(sfsClient, shareID, accessID)
+		if err != nil {
+			return err
+		}
+
+		*access = *found
+
+		return nil
+	}
+}
+
+const testAccSharedFilesystemShareAccessV2_basic = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "share_1"
+  share_proto = "NFS"
+  size = 1
+}
+
+resource "openstack_sharedfilesystem_share_access_v2" "access_1" {
+  share_id = "${openstack_sharedfilesystem_share_v2.share_1.id}"
+  access_type = "ip"
+  access_to = "192.168.199.0/24"
+  access_level = "ro"
+}
+`
@@ -0,0 +1,135 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSharedFilesystemShareReplicaPromoteV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedFilesystemShareReplicaPromoteV2Create,
+		Read:   resourceSharedFilesystemShareReplicaPromoteV2Read,
+		Delete: resourceSharedFilesystemShareReplicaPromoteV2Delete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"replica_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"share_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replica_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareReplicaPromoteV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	replicaID := d.Get("replica_id").(string)
+
+	log.Printf("[DEBUG] Promoting share replica %s", replicaID)
+	err = sharedfilesystemV2ShareReplicaPromote(sfsClient, replicaID).ExtractErr()
+	if err != nil {
+		return fmt.Errorf("Error promoting OpenStack share replica %s: %s", replicaID, err)
+	}
+
+	d.SetId(replicaID)
+
+	// The replica is in status replication_change until the promotion has
+	// finished.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "replication_change"},
+		Target:     []string{"active"},
+		Refresh:    resourceSharedFilesystemShareReplicaPromoteV2RefreshFunc(sfsClient, replicaID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack share replica %s to be promoted: %s", replicaID, err)
+	}
+
+	return resourceSharedFilesystemShareReplicaPromoteV2Read(d, meta)
+}
+
+func resourceSharedFilesystemShareReplicaPromoteV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	replica, err := sharedfilesystemV2ShareReplicaGet(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "share replica")
+	}
+
+	log.Printf("[DEBUG] Retrieved share replica %s: %+v", d.Id(), replica)
+
+	d.Set("replica_id", replica.ID)
+	d.Set("share_id", replica.ShareID)
+	d.Set("replica_state", replica.ReplicaState)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+// resourceSharedFilesystemShareReplicaPromoteV2Delete only removes the
+// promotion from the state. A promotion can't be undone; another replica
+// has to be promoted instead.
+func resourceSharedFilesystemShareReplicaPromoteV2Delete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// resourceSharedFilesystemShareReplicaPromoteV2RefreshFunc reports the status
+// of a replica which is being promoted, or "active" once it has become the
+// active replica of its share.
+func resourceSharedFilesystemShareReplicaPromoteV2RefreshFunc(sfsClient *gophercloud.ServiceClient, replicaID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		replica, err := sharedfilesystemV2ShareReplicaGet(sfsClient, replicaID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if replica.Status == "error" || replica.ReplicaState == "error" {
+			return replica, replica.Status, fmt.Errorf("The share replica is in error state")
+		}
+
+		log.Printf("[DEBUG] OpenStack share replica %s current status: %s, replica state: %s", replicaID, replica.Status, replica.ReplicaState)
+
+		if replica.Status == "available" && replica.ReplicaState == "active" {
+			return replica, "active", nil
+		}
+
+		return replica, replica.Status, nil
+	}
+}
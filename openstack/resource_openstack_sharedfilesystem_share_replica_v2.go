@@ -0,0 +1,174 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSharedFilesystemShareReplicaV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedFilesystemShareReplicaV2Create,
+		Read:   resourceSharedFilesystemShareReplicaV2Read,
+		Delete: resourceSharedFilesystemShareReplicaV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"share_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"share_network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"share_server_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"replica_state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareReplicaV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	createOpts := ShareReplicaV2CreateOpts{
+		ShareID:          d.Get("share_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		ShareNetworkID:   d.Get("share_network_id").(string),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	replica, err := sharedfilesystemV2ShareReplicaCreate(sfsClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack share replica: %s", err)
+	}
+
+	log.Printf("[INFO] Share replica ID: %s", replica.ID)
+
+	d.SetId(replica.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    resourceSharedFilesystemShareReplicaV2RefreshFunc(sfsClient, replica.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack share replica %s to become available: %s", replica.ID, err)
+	}
+
+	return resourceSharedFilesystemShareReplicaV2Read(d, meta)
+}
+
+func resourceSharedFilesystemShareReplicaV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	replica, err := sharedfilesystemV2ShareReplicaGet(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "share replica")
+	}
+
+	log.Printf("[DEBUG] Retrieved share replica %s: %+v", d.Id(), replica)
+
+	d.Set("share_id", replica.ShareID)
+	d.Set("availability_zone", replica.AvailabilityZone)
+	d.Set("share_network_id", replica.ShareNetworkID)
+	d.Set("share_server_id", replica.ShareServerID)
+	d.Set("replica_state", replica.ReplicaState)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareReplicaV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	err = sharedfilesystemV2ShareReplicaDelete(sfsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack share replica")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    resourceSharedFilesystemShareReplicaV2RefreshFunc(sfsClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack share replica %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceSharedFilesystemShareReplicaV2RefreshFunc(sfsClient *gophercloud.ServiceClient, replicaID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		replica, err := sharedfilesystemV2ShareReplicaGet(sfsClient, replicaID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return replica, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if replica.Status == "error" || replica.Status == "error_deleting" {
+			return replica, replica.Status, fmt.Errorf("The share replica is in status %s", replica.Status)
+		}
+
+		log.Printf("[DEBUG] OpenStack share replica %s current status: %s", replicaID, replica.Status)
+		return replica, replica.Status, nil
+	}
+}
@@ -0,0 +1,134 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSharedFilesystemShareReplicaV2_basic(t *testing.T) {
+	var replica ShareReplicaV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSharedFilesystemReplication(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedFilesystemShareReplicaV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSharedFilesystemShareReplicaV2_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSharedFilesystemShareReplicaV2Exists("openstack_sharedfilesystem_share_replica_v2.replica_1", &replica),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_share_replica_v2.replica_1", "availability_zone", OS_SHAREDFILESYSTEM_REPLICA_AZ),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSharedFilesystemShareReplicaV2_promote(t *testing.T) {
+	var replica ShareReplicaV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSharedFilesystemReplication(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedFilesystemShareReplicaV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSharedFilesystemShareReplicaV2_promote(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSharedFilesystemShareReplicaV2Exists("openstack_sharedfilesystem_share_replica_v2.replica_1", &replica),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_share_replica_promote_v2.promote_1", "replica_state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSharedFilesystemShareReplicaV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_replica_v2" {
+			continue
+		}
+
+		_, err := sharedfilesystemV2ShareReplicaGet(sfsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Share replica still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSharedFilesystemShareReplicaV2Exists(n string, replica *ShareReplicaV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+		}
+
+		found, err := sharedfilesystemV2ShareReplicaGet(sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share replica not found")
+		}
+
+		*replica = *found
+
+		return nil
+	}
+}
+
+func testAccSharedFilesystemShareReplicaV2_basic() string {
+	return fmt.Sprintf(`
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "share_1"
+  share_proto = "NFS"
+  share_type = "%s"
+  size = 1
+}
+
+resource "openstack_sharedfilesystem_share_replica_v2" "replica_1" {
+  share_id = "${openstack_sharedfilesystem_share_v2.share_1.id}"
+  availability_zone = "%s"
+}
+`, OS_SHAREDFILESYSTEM_REPLICATION_SHARE_TYPE, OS_SHAREDFILESYSTEM_REPLICA_AZ)
+}
+
+func testAccSharedFilesystemShareReplicaV2_promote() string {
+	return fmt.Sprintf(`
+%s
+
+resource "openstack_sharedfilesystem_share_replica_promote_v2" "promote_1" {
+  replica_id = "${openstack_sharedfilesystem_share_replica_v2.replica_1.id}"
+}
+`, testAccSharedFilesystemShareReplicaV2_basic())
+}
@@ -0,0 +1,357 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSharedFilesystemShareV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedFilesystemShareV2Create,
+		Read:   resourceSharedFilesystemShareV2Read,
+		Update: resourceSharedFilesystemShareV2Update,
+		Delete: resourceSharedFilesystemShareV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"share_proto": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: resourceSharedFilesystemShareV2ValidShareProto,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"share_type": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"share_network_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"snapshot_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"availability_zone": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"is_public": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"metadata": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+			},
+			"share_server_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"export_locations": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"path": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"preferred": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemShareV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	createOpts := ShareV2CreateOpts{
+		ShareProto:       d.Get("share_proto").(string),
+		Size:             d.Get("size").(int),
+		Name:             d.Get("name").(string),
+		Description:      d.Get("description").(string),
+		ShareType:        d.Get("share_type").(string),
+		ShareNetworkID:   d.Get("share_network_id").(string),
+		SnapshotID:       d.Get("snapshot_id").(string),
+		AvailabilityZone: d.Get("availability_zone").(string),
+		IsPublic:         d.Get("is_public").(bool),
+		Metadata:         resourceSharedFilesystemShareV2Metadata(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	share, err := sharedfilesystemV2ShareCreate(sfsClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack share: %s", err)
+	}
+
+	log.Printf("[INFO] Share ID: %s", share.ID)
+
+	d.SetId(share.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating", "creating_from_snapshot"},
+		Target:     []string{"available"},
+		Refresh:    resourceSharedFilesystemShareV2RefreshFunc(sfsClient, share.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack share %s to become available: %s", share.ID, err)
+	}
+
+	return resourceSharedFilesystemShareV2Read(d, meta)
+}
+
+func resourceSharedFilesystemShareV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	share, err := sharedfilesystemV2ShareGet(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "share")
+	}
+
+	log.Printf("[DEBUG] Retrieved share %s: %+v", d.Id(), share)
+
+	exportLocations, err := sharedfilesystemV2ShareExportLocations(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return fmt.Errorf("Error retrieving export locations of OpenStack share %s: %s", d.Id(), err)
+	}
+
+	locations := make([]map[string]interface{}, len(exportLocations))
+	for i, location := range exportLocations {
+		locations[i] = map[string]interface{}{
+			"path":      location.Path,
+			"preferred": location.Preferred,
+		}
+	}
+
+	d.Set("name", share.Name)
+	d.Set("description", share.Description)
+	d.Set("share_proto", share.ShareProto)
+	d.Set("size", share.Size)
+	d.Set("share_type", share.ShareType)
+	d.Set("share_network_id", share.ShareNetworkID)
+	d.Set("snapshot_id", share.SnapshotID)
+	d.Set("availability_zone", share.AvailabilityZone)
+	d.Set("is_public", share.IsPublic)
+	d.Set("metadata", share.Metadata)
+	d.Set("share_server_id", share.ShareServerID)
+	d.Set("project_id", share.ProjectID)
+	d.Set("export_locations", locations)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemShareV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	if d.HasChange("name") || d.HasChange("description") || d.HasChange("is_public") {
+		var updateOpts ShareV2UpdateOpts
+		if d.HasChange("name") {
+			name := d.Get("name").(string)
+			updateOpts.Name = &name
+		}
+		if d.HasChange("description") {
+			description := d.Get("description").(string)
+			updateOpts.Description = &description
+		}
+		if d.HasChange("is_public") {
+			isPublic := d.Get("is_public").(bool)
+			updateOpts.IsPublic = &isPublic
+		}
+
+		log.Printf("[DEBUG] Updating share %s with options: %+v", d.Id(), updateOpts)
+		_, err = sharedfilesystemV2ShareUpdate(sfsClient, d.Id(), updateOpts).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack share %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("metadata") {
+		metadata := resourceSharedFilesystemShareV2Metadata(d)
+
+		log.Printf("[DEBUG] Replacing metadata of share %s with %+v", d.Id(), metadata)
+		err = sharedfilesystemV2ShareMetadataReplace(sfsClient, d.Id(), metadata).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error updating metadata of OpenStack share %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("size") {
+		o, n := d.GetChange("size")
+		action, pending := "extend", "extending"
+		if n.(int) < o.(int) {
+			action, pending = "shrink", "shrinking"
+		}
+
+		log.Printf("[DEBUG] Resizing share %s from %d to %d GB", d.Id(), o.(int), n.(int))
+		err = sharedfilesystemV2ShareResize(sfsClient, d.Id(), action, n.(int)).ExtractErr()
+		if err != nil {
+			return fmt.Errorf("Error resizing OpenStack share %s: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{pending},
+			Target:     []string{"available"},
+			Refresh:    resourceSharedFilesystemShareV2RefreshFunc(sfsClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutUpdate),
+			Delay:      10 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for OpenStack share %s to be resized: %s", d.Id(), err)
+		}
+	}
+
+	return resourceSharedFilesystemShareV2Read(d, meta)
+}
+
+func resourceSharedFilesystemShareV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	err = sharedfilesystemV2ShareDelete(sfsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack share")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    resourceSharedFilesystemShareV2RefreshFunc(sfsClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack share %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceSharedFilesystemShareV2RefreshFunc(sfsClient *gophercloud.ServiceClient, shareID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		share, err := sharedfilesystemV2ShareGet(sfsClient, shareID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return share, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		// Failed operations leave the share in "error" or in an error
+		// status of the operation, e.g. "extending_error" or
+		// "error_deleting".
+		if strings.HasPrefix(share.Status, "error") || strings.HasSuffix(share.Status, "_error") {
+			return share, share.Status, fmt.Errorf("The share is in status %s", share.Status)
+		}
+
+		log.Printf("[DEBUG] OpenStack share %s current status: %s", shareID, share.Status)
+		return share, share.Status, nil
+	}
+}
+
+func resourceSharedFilesystemShareV2Metadata(d *schema.ResourceData) map[string]string {
+	metadata := make(map[string]string)
+	for k, v := range d.Get("metadata").(map[string]interface{}) {
+		metadata[k] = v.(string)
+	}
+	return metadata
+}
+
+func resourceSharedFilesystemShareV2ValidShareProto(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validShareProtos := []string{
+		"NFS",
+		"CIFS",
+		"CEPHFS",
+		"GLUSTERFS",
+		"HDFS",
+		"MAPRFS",
+	}
+
+	for _, v := range validShareProtos {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validShareProtos)
+	errors = append(errors, err)
+	return
+}
@@ -0,0 +1,123 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSharedFilesystemShareV2_basic(t *testing.T) {
+	var share ShareV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSharedFilesystem(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedFilesystemShareV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSharedFilesystemShareV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSharedFilesystemShareV2Exists("openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_share_v2.share_1", "name", "share_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_share_v2.share_1", "size", "1"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_share_v2.share_1", "metadata.foo", "bar"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSharedFilesystemShareV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSharedFilesystemShareV2Exists("openstack_sharedfilesystem_share_v2.share_1", &share),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_share_v2.share_1", "name", "share_1_updated"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_share_v2.share_1", "size", "2"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_share_v2.share_1", "metadata.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSharedFilesystemShareV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_share_v2" {
+			continue
+		}
+
+		_, err := sharedfilesystemV2ShareGet(sfsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Share still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSharedFilesystemShareV2Exists(n string, share *ShareV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+		}
+
+		found, err := sharedfilesystemV2ShareGet(sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share not found")
+		}
+
+		*share = *found
+
+		return nil
+	}
+}
+
+const testAccSharedFilesystemShareV2_basic = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "share_1"
+  description = "test share description"
+  share_proto = "NFS"
+  size = 1
+
+  metadata {
+    foo = "bar"
+  }
+}
+`
+
+const testAccSharedFilesystemShareV2_update = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "share_1_updated"
+  description = "test share description"
+  share_proto = "NFS"
+  size = 2
+}
+`
@@ -0,0 +1,209 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSharedFilesystemSnapshotV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSharedFilesystemSnapshotV2Create,
+		Read:   resourceSharedFilesystemSnapshotV2Read,
+		Update: resourceSharedFilesystemSnapshotV2Update,
+		Delete: resourceSharedFilesystemSnapshotV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"share_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"force": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"share_size": &schema.Schema{
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"share_proto": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSharedFilesystemSnapshotV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	createOpts := ShareSnapshotV2CreateOpts{
+		ShareID:     d.Get("share_id").(string),
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Force:       d.Get("force").(bool),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	snapshot, err := sharedfilesystemV2SnapshotCreate(sfsClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack share snapshot: %s", err)
+	}
+
+	log.Printf("[INFO] Share snapshot ID: %s", snapshot.ID)
+
+	d.SetId(snapshot.ID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"creating"},
+		Target:     []string{"available"},
+		Refresh:    resourceSharedFilesystemSnapshotV2RefreshFunc(sfsClient, snapshot.ID),
+		Timeout:    d.Timeout(schema.TimeoutCreate),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack share snapshot %s to become available: %s", snapshot.ID, err)
+	}
+
+	return resourceSharedFilesystemSnapshotV2Read(d, meta)
+}
+
+func resourceSharedFilesystemSnapshotV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	snapshot, err := sharedfilesystemV2SnapshotGet(sfsClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "share snapshot")
+	}
+
+	log.Printf("[DEBUG] Retrieved share snapshot %s: %+v", d.Id(), snapshot)
+
+	d.Set("share_id", snapshot.ShareID)
+	d.Set("name", snapshot.Name)
+	d.Set("description", snapshot.Description)
+	d.Set("size", snapshot.Size)
+	d.Set("share_size", snapshot.ShareSize)
+	d.Set("share_proto", snapshot.ShareProto)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSharedFilesystemSnapshotV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	var updateOpts ShareSnapshotV2UpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] Updating share snapshot %s with options: %+v", d.Id(), updateOpts)
+	_, err = sharedfilesystemV2SnapshotUpdate(sfsClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack share snapshot %s: %s", d.Id(), err)
+	}
+
+	return resourceSharedFilesystemSnapshotV2Read(d, meta)
+}
+
+func resourceSharedFilesystemSnapshotV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	err = sharedfilesystemV2SnapshotDelete(sfsClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack share snapshot")
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"available", "deleting"},
+		Target:     []string{"deleted"},
+		Refresh:    resourceSharedFilesystemSnapshotV2RefreshFunc(sfsClient, d.Id()),
+		Timeout:    d.Timeout(schema.TimeoutDelete),
+		Delay:      10 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+
+	_, err = stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for OpenStack share snapshot %s to delete: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceSharedFilesystemSnapshotV2RefreshFunc(sfsClient *gophercloud.ServiceClient, snapshotID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := sharedfilesystemV2SnapshotGet(sfsClient, snapshotID).Extract()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				return snapshot, "deleted", nil
+			}
+			return nil, "", err
+		}
+
+		if snapshot.Status == "error" || snapshot.Status == "error_deleting" {
+			return snapshot, snapshot.Status, fmt.Errorf("The share snapshot is in status %s", snapshot.Status)
+		}
+
+		log.Printf("[DEBUG] OpenStack share snapshot %s current status: %s", snapshotID, snapshot.Status)
+		return snapshot, snapshot.Status, nil
+	}
+}
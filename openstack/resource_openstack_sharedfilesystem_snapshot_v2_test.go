@@ -0,0 +1,167 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccSharedFilesystemSnapshotV2_basic(t *testing.T) {
+	var snapshot ShareSnapshotV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSharedFilesystem(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedFilesystemSnapshotV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSharedFilesystemSnapshotV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSharedFilesystemSnapshotV2Exists("openstack_sharedfilesystem_snapshot_v2.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_snapshot_v2.snapshot_1", "name", "snapshot_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_snapshot_v2.snapshot_1", "share_size", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSharedFilesystemSnapshotV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSharedFilesystemSnapshotV2Exists("openstack_sharedfilesystem_snapshot_v2.snapshot_1", &snapshot),
+					resource.TestCheckResourceAttr(
+						"openstack_sharedfilesystem_snapshot_v2.snapshot_1", "name", "snapshot_1_updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSharedFilesystemSnapshotV2_createShare(t *testing.T) {
+	var share ShareV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckSharedFilesystem(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSharedFilesystemSnapshotV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSharedFilesystemSnapshotV2_createShare,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSharedFilesystemShareV2Exists("openstack_sharedfilesystem_share_v2.share_2", &share),
+					resource.TestCheckResourceAttrPair(
+						"openstack_sharedfilesystem_share_v2.share_2", "snapshot_id",
+						"openstack_sharedfilesystem_snapshot_v2.snapshot_1", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSharedFilesystemSnapshotV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_sharedfilesystem_snapshot_v2" {
+			continue
+		}
+
+		_, err := sharedfilesystemV2SnapshotGet(sfsClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Share snapshot still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSharedFilesystemSnapshotV2Exists(n string, snapshot *ShareSnapshotV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		sfsClient, err := config.sharedfilesystemV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack shared file system client: %s", err)
+		}
+
+		found, err := sharedfilesystemV2SnapshotGet(sfsClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Share snapshot not found")
+		}
+
+		*snapshot = *found
+
+		return nil
+	}
+}
+
+const testAccSharedFilesystemSnapshotV2_basic = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "share_1"
+  share_proto = "NFS"
+  size = 1
+}
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  share_id = "${openstack_sharedfilesystem_share_v2.share_1.id}"
+  name = "snapshot_1"
+  description = "test snapshot description"
+}
+`
+
+const testAccSharedFilesystemSnapshotV2_update = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "share_1"
+  share_proto = "NFS"
+  size = 1
+}
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  share_id = "${openstack_sharedfilesystem_share_v2.share_1.id}"
+  name = "snapshot_1_updated"
+  description = "test snapshot description"
+}
+`
+
+const testAccSharedFilesystemSnapshotV2_createShare = `
+resource "openstack_sharedfilesystem_share_v2" "share_1" {
+  name = "share_1"
+  share_proto = "NFS"
+  size = 1
+}
+
+resource "openstack_sharedfilesystem_snapshot_v2" "snapshot_1" {
+  share_id = "${openstack_sharedfilesystem_share_v2.share_1.id}"
+  name = "snapshot_1"
+}
+
+resource "openstack_sharedfilesystem_share_v2" "share_2" {
+  name = "share_2"
+  share_proto = "NFS"
+  size = 1
+  snapshot_id = "${openstack_sharedfilesystem_snapshot_v2.snapshot_1.id}"
+}
+`
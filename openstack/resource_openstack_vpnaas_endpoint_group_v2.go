@@ -0,0 +1,170 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceEndpointGroupV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceEndpointGroupV2Create,
+		Read:   resourceEndpointGroupV2Read,
+		Update: resourceEndpointGroupV2Update,
+		Delete: resourceEndpointGroupV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "subnet" && value != "cidr" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'subnet' and 'cidr' are supported values for 'type'"))
+					}
+					return
+				},
+			},
+			"endpoints": &schema.Schema{
+				Type:     schema.TypeSet,
+				Required: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Set:      schema.HashString,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceEndpointGroupV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	rawEndpoints := d.Get("endpoints").(*schema.Set).List()
+	endpoints := make([]string, len(rawEndpoints))
+	for i, raw := range rawEndpoints {
+		endpoints[i] = raw.(string)
+	}
+
+	createOpts := EndpointGroupCreateOpts{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Type:        d.Get("type").(string),
+		Endpoints:   endpoints,
+		TenantID:    d.Get("tenant_id").(string),
+		ValueSpecs:  MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	group, err := networkingV2EndpointGroupCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron endpoint group: %s", err)
+	}
+
+	log.Printf("[INFO] Endpoint group ID: %s", group.ID)
+
+	d.SetId(group.ID)
+
+	return resourceEndpointGroupV2Read(d, meta)
+}
+
+func resourceEndpointGroupV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	group, err := networkingV2EndpointGroupGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "endpoint group")
+	}
+
+	log.Printf("[DEBUG] Retrieved endpoint group %s: %+v", d.Id(), group)
+
+	d.Set("name", group.Name)
+	d.Set("description", group.Description)
+	d.Set("type", group.Type)
+	d.Set("endpoints", group.Endpoints)
+	d.Set("tenant_id", group.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceEndpointGroupV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts EndpointGroupUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+
+	log.Printf("[DEBUG] Updating endpoint group %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2EndpointGroupUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron endpoint group: %s", err)
+	}
+
+	return resourceEndpointGroupV2Read(d, meta)
+}
+
+func resourceEndpointGroupV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2EndpointGroupDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron endpoint group")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,111 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccVPNaaSEndpointGroupV2_basic(t *testing.T) {
+	var group EndpointGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckVPN(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckEndpointGroupV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccEndpointGroupV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointGroupV2Exists(
+						"openstack_vpnaas_endpoint_group_v2.group_1", &group),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_endpoint_group_v2.group_1", "type", "cidr"),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_endpoint_group_v2.group_1", "endpoints.#", "2"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccEndpointGroupV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_vpnaas_endpoint_group_v2.group_1", "id", &group.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_endpoint_group_v2.group_1", "name", "group_2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckEndpointGroupV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_vpnaas_endpoint_group_v2" {
+			continue
+		}
+
+		_, err := networkingV2EndpointGroupGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Endpoint group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckEndpointGroupV2Exists(n string, group *EndpointGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2EndpointGroupGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Endpoint group not found")
+		}
+
+		*group = *found
+
+		return nil
+	}
+}
+
+const testAccEndpointGroupV2_basic = `
+resource "openstack_vpnaas_endpoint_group_v2" "group_1" {
+  name = "group_1"
+  type = "cidr"
+  endpoints = ["10.2.0.0/24", "10.3.0.0/24"]
+}
+`
+
+const testAccEndpointGroupV2_update = `
+resource "openstack_vpnaas_endpoint_group_v2" "group_1" {
+  name = "group_2"
+  description = "terraform endpoint group acceptance test"
+  type = "cidr"
+  endpoints = ["10.2.0.0/24", "10.3.0.0/24"]
+}
+`
@@ -0,0 +1,201 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIKEPolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIKEPolicyV2Create,
+		Read:   resourceIKEPolicyV2Read,
+		Update: resourceIKEPolicyV2Update,
+		Delete: resourceIKEPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auth_algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "sha1",
+			},
+			"encryption_algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "aes-128",
+			},
+			"ike_version": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "v1",
+			},
+			"pfs": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "group5",
+			},
+			"phase1_negotiation_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "main",
+			},
+			"lifetime": vpnaasLifetimeSchema(),
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIKEPolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := IKEPolicyCreateOpts{
+		Name:                  d.Get("name").(string),
+		Description:           d.Get("description").(string),
+		AuthAlgorithm:         d.Get("auth_algorithm").(string),
+		EncryptionAlgorithm:   d.Get("encryption_algorithm").(string),
+		IKEVersion:            d.Get("ike_version").(string),
+		Lifetime:              expandVPNaaSLifetime(d),
+		PFS:                   d.Get("pfs").(string),
+		Phase1NegotiationMode: d.Get("phase1_negotiation_mode").(string),
+		TenantID:              d.Get("tenant_id").(string),
+		ValueSpecs:            MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	policy, err := networkingV2IKEPolicyCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron IKE policy: %s", err)
+	}
+
+	log.Printf("[INFO] IKE policy ID: %s", policy.ID)
+
+	d.SetId(policy.ID)
+
+	return resourceIKEPolicyV2Read(d, meta)
+}
+
+func resourceIKEPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policy, err := networkingV2IKEPolicyGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "IKE policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved IKE policy %s: %+v", d.Id(), policy)
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("auth_algorithm", policy.AuthAlgorithm)
+	d.Set("encryption_algorithm", policy.EncryptionAlgorithm)
+	d.Set("ike_version", policy.IKEVersion)
+	d.Set("pfs", policy.PFS)
+	d.Set("phase1_negotiation_mode", policy.Phase1NegotiationMode)
+	d.Set("lifetime", flattenVPNaaSLifetime(policy.Lifetime))
+	d.Set("tenant_id", policy.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIKEPolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts IKEPolicyUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("auth_algorithm") {
+		authAlgorithm := d.Get("auth_algorithm").(string)
+		updateOpts.AuthAlgorithm = &authAlgorithm
+	}
+	if d.HasChange("encryption_algorithm") {
+		encryptionAlgorithm := d.Get("encryption_algorithm").(string)
+		updateOpts.EncryptionAlgorithm = &encryptionAlgorithm
+	}
+	if d.HasChange("ike_version") {
+		ikeVersion := d.Get("ike_version").(string)
+		updateOpts.IKEVersion = &ikeVersion
+	}
+	if d.HasChange("pfs") {
+		pfs := d.Get("pfs").(string)
+		updateOpts.PFS = &pfs
+	}
+	if d.HasChange("phase1_negotiation_mode") {
+		phase1NegotiationMode := d.Get("phase1_negotiation_mode").(string)
+		updateOpts.Phase1NegotiationMode = &phase1NegotiationMode
+	}
+	if d.HasChange("lifetime") {
+		updateOpts.Lifetime = expandVPNaaSLifetime(d)
+	}
+
+	log.Printf("[DEBUG] Updating IKE policy %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2IKEPolicyUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron IKE policy: %s", err)
+	}
+
+	return resourceIKEPolicyV2Read(d, meta)
+}
+
+func resourceIKEPolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2IKEPolicyDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron IKE policy")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,119 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccVPNaaSIKEPolicyV2_basic(t *testing.T) {
+	var policy IKEPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckVPN(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIKEPolicyV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIKEPolicyV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIKEPolicyV2Exists(
+						"openstack_vpnaas_ike_policy_v2.policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_ike_policy_v2.policy_1", "auth_algorithm", "sha1"),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_ike_policy_v2.policy_1", "pfs", "group5"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIKEPolicyV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_vpnaas_ike_policy_v2.policy_1", "id", &policy.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_ike_policy_v2.policy_1", "name", "policy_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_ike_policy_v2.policy_1", "auth_algorithm", "sha256"),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_ike_policy_v2.policy_1", "pfs", "group14"),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_ike_policy_v2.policy_1", "lifetime.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIKEPolicyV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_vpnaas_ike_policy_v2" {
+			continue
+		}
+
+		_, err := networkingV2IKEPolicyGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("IKE policy still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIKEPolicyV2Exists(n string, policy *IKEPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2IKEPolicyGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("IKE policy not found")
+		}
+
+		*policy = *found
+
+		return nil
+	}
+}
+
+const testAccIKEPolicyV2_basic = `
+resource "openstack_vpnaas_ike_policy_v2" "policy_1" {
+  name = "policy_1"
+}
+`
+
+const testAccIKEPolicyV2_update = `
+resource "openstack_vpnaas_ike_policy_v2" "policy_1" {
+  name = "policy_2"
+  auth_algorithm = "sha256"
+  pfs = "group14"
+
+  lifetime {
+    units = "seconds"
+    value = 1200
+  }
+}
+`
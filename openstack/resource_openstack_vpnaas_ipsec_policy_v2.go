@@ -0,0 +1,201 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceIPSecPolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIPSecPolicyV2Create,
+		Read:   resourceIPSecPolicyV2Read,
+		Update: resourceIPSecPolicyV2Update,
+		Delete: resourceIPSecPolicyV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"auth_algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "sha1",
+			},
+			"encapsulation_mode": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "tunnel",
+			},
+			"encryption_algorithm": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "aes-128",
+			},
+			"pfs": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "group5",
+			},
+			"transform_protocol": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "esp",
+			},
+			"lifetime": vpnaasLifetimeSchema(),
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceIPSecPolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	createOpts := IPSecPolicyCreateOpts{
+		Name:                d.Get("name").(string),
+		Description:         d.Get("description").(string),
+		AuthAlgorithm:       d.Get("auth_algorithm").(string),
+		EncapsulationMode:   d.Get("encapsulation_mode").(string),
+		EncryptionAlgorithm: d.Get("encryption_algorithm").(string),
+		Lifetime:            expandVPNaaSLifetime(d),
+		PFS:                 d.Get("pfs").(string),
+		TransformProtocol:   d.Get("transform_protocol").(string),
+		TenantID:            d.Get("tenant_id").(string),
+		ValueSpecs:          MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	policy, err := networkingV2IPSecPolicyCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron IPsec policy: %s", err)
+	}
+
+	log.Printf("[INFO] IPsec policy ID: %s", policy.ID)
+
+	d.SetId(policy.ID)
+
+	return resourceIPSecPolicyV2Read(d, meta)
+}
+
+func resourceIPSecPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	policy, err := networkingV2IPSecPolicyGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "IPsec policy")
+	}
+
+	log.Printf("[DEBUG] Retrieved IPsec policy %s: %+v", d.Id(), policy)
+
+	d.Set("name", policy.Name)
+	d.Set("description", policy.Description)
+	d.Set("auth_algorithm", policy.AuthAlgorithm)
+	d.Set("encapsulation_mode", policy.EncapsulationMode)
+	d.Set("encryption_algorithm", policy.EncryptionAlgorithm)
+	d.Set("pfs", policy.PFS)
+	d.Set("transform_protocol", policy.TransformProtocol)
+	d.Set("lifetime", flattenVPNaaSLifetime(policy.Lifetime))
+	d.Set("tenant_id", policy.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceIPSecPolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts IPSecPolicyUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("auth_algorithm") {
+		authAlgorithm := d.Get("auth_algorithm").(string)
+		updateOpts.AuthAlgorithm = &authAlgorithm
+	}
+	if d.HasChange("encapsulation_mode") {
+		encapsulationMode := d.Get("encapsulation_mode").(string)
+		updateOpts.EncapsulationMode = &encapsulationMode
+	}
+	if d.HasChange("encryption_algorithm") {
+		encryptionAlgorithm := d.Get("encryption_algorithm").(string)
+		updateOpts.EncryptionAlgorithm = &encryptionAlgorithm
+	}
+	if d.HasChange("pfs") {
+		pfs := d.Get("pfs").(string)
+		updateOpts.PFS = &pfs
+	}
+	if d.HasChange("transform_protocol") {
+		transformProtocol := d.Get("transform_protocol").(string)
+		updateOpts.TransformProtocol = &transformProtocol
+	}
+	if d.HasChange("lifetime") {
+		updateOpts.Lifetime = expandVPNaaSLifetime(d)
+	}
+
+	log.Printf("[DEBUG] Updating IPsec policy %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2IPSecPolicyUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron IPsec policy: %s", err)
+	}
+
+	return resourceIPSecPolicyV2Read(d, meta)
+}
+
+func resourceIPSecPolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2IPSecPolicyDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron IPsec policy")
+	}
+
+	d.SetId("")
+	return nil
+}
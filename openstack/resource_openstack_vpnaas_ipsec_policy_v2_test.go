@@ -0,0 +1,116 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccVPNaaSIPSecPolicyV2_basic(t *testing.T) {
+	var policy IPSecPolicy
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckVPN(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIPSecPolicyV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccIPSecPolicyV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIPSecPolicyV2Exists(
+						"openstack_vpnaas_ipsec_policy_v2.policy_1", &policy),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_ipsec_policy_v2.policy_1", "transform_protocol", "esp"),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_ipsec_policy_v2.policy_1", "encapsulation_mode", "tunnel"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccIPSecPolicyV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_vpnaas_ipsec_policy_v2.policy_1", "id", &policy.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_ipsec_policy_v2.policy_1", "name", "policy_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_ipsec_policy_v2.policy_1", "encryption_algorithm", "aes-256"),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_ipsec_policy_v2.policy_1", "lifetime.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIPSecPolicyV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_vpnaas_ipsec_policy_v2" {
+			continue
+		}
+
+		_, err := networkingV2IPSecPolicyGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("IPsec policy still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIPSecPolicyV2Exists(n string, policy *IPSecPolicy) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2IPSecPolicyGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("IPsec policy not found")
+		}
+
+		*policy = *found
+
+		return nil
+	}
+}
+
+const testAccIPSecPolicyV2_basic = `
+resource "openstack_vpnaas_ipsec_policy_v2" "policy_1" {
+  name = "policy_1"
+}
+`
+
+const testAccIPSecPolicyV2_update = `
+resource "openstack_vpnaas_ipsec_policy_v2" "policy_1" {
+  name = "policy_2"
+  encryption_algorithm = "aes-256"
+
+  lifetime {
+    units = "seconds"
+    value = 1200
+  }
+}
+`
@@ -0,0 +1,181 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceVPNServiceV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceVPNServiceV2Create,
+		Read:   resourceVPNServiceV2Read,
+		Update: resourceVPNServiceV2Update,
+		Delete: resourceVPNServiceV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"router_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"subnet_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"external_v4_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_v6_ip": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceVPNServiceV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := VPNServiceCreateOpts{
+		Name:         d.Get("name").(string),
+		Description:  d.Get("description").(string),
+		AdminStateUp: &adminStateUp,
+		RouterID:     d.Get("router_id").(string),
+		SubnetID:     d.Get("subnet_id").(string),
+		TenantID:     d.Get("tenant_id").(string),
+		ValueSpecs:   MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	service, err := networkingV2VPNServiceCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron VPN service: %s", err)
+	}
+
+	log.Printf("[INFO] VPN service ID: %s", service.ID)
+
+	d.SetId(service.ID)
+
+	return resourceVPNServiceV2Read(d, meta)
+}
+
+func resourceVPNServiceV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	service, err := networkingV2VPNServiceGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "VPN service")
+	}
+
+	log.Printf("[DEBUG] Retrieved VPN service %s: %+v", d.Id(), service)
+
+	d.Set("name", service.Name)
+	d.Set("description", service.Description)
+	d.Set("admin_state_up", service.AdminStateUp)
+	d.Set("router_id", service.RouterID)
+	d.Set("subnet_id", service.SubnetID)
+	d.Set("external_v4_ip", service.ExternalV4IP)
+	d.Set("external_v6_ip", service.ExternalV6IP)
+	d.Set("status", service.Status)
+	d.Set("tenant_id", service.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceVPNServiceV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts VPNServiceUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+
+	log.Printf("[DEBUG] Updating VPN service %s with options: %+v", d.Id(), updateOpts)
+
+	_, err = networkingV2VPNServiceUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron VPN service: %s", err)
+	}
+
+	return resourceVPNServiceV2Read(d, meta)
+}
+
+func resourceVPNServiceV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2VPNServiceDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron VPN service")
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,160 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccVPNaaSServiceV2_basic(t *testing.T) {
+	var service VPNService
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckVPN(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPNServiceV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccVPNServiceV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPNServiceV2Exists(
+						"openstack_vpnaas_service_v2.service_1", &service),
+					resource.TestCheckResourceAttrPair(
+						"openstack_vpnaas_service_v2.service_1", "router_id",
+						"openstack_networking_router_v2.router_1", "id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccVPNServiceV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_vpnaas_service_v2.service_1", "id", &service.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_service_v2.service_1", "name", "service_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_service_v2.service_1", "admin_state_up", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckVPNServiceV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_vpnaas_service_v2" {
+			continue
+		}
+
+		_, err := networkingV2VPNServiceGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("VPN service still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckVPNServiceV2Exists(n string, service *VPNService) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2VPNServiceGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("VPN service not found")
+		}
+
+		*service = *found
+
+		return nil
+	}
+}
+
+var testAccVPNServiceV2_basic = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+  external_gateway = "%s"
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_vpnaas_service_v2" "service_1" {
+  name = "service_1"
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  depends_on = ["openstack_networking_router_interface_v2.router_interface_1"]
+}
+`, OS_EXTGW_ID)
+
+var testAccVPNServiceV2_update = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+  external_gateway = "%s"
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_vpnaas_service_v2" "service_1" {
+  name = "service_2"
+  admin_state_up = "false"
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+  depends_on = ["openstack_networking_router_interface_v2.router_interface_1"]
+}
+`, OS_EXTGW_ID)
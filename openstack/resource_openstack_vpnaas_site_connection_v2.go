@@ -0,0 +1,344 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceSiteConnectionV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSiteConnectionV2Create,
+		Read:   resourceSiteConnectionV2Read,
+		Update: resourceSiteConnectionV2Update,
+		Delete: resourceSiteConnectionV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ikepolicy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"ipsecpolicy_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"vpnservice_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"local_ep_group_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"peer_ep_group_id": &schema.Schema{
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"peer_cidrs"},
+			},
+			"local_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"peer_address": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"peer_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"peer_cidrs": &schema.Schema{
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				Set:           schema.HashString,
+				ConflictsWith: []string{"peer_ep_group_id"},
+			},
+			"psk": &schema.Schema{
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"mtu": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"initiator": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "bi-directional",
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					value := v.(string)
+					if value != "bi-directional" && value != "response-only" {
+						errors = append(errors, fmt.Errorf(
+							"Only 'bi-directional' and 'response-only' are supported values for 'initiator'"))
+					}
+					return
+				},
+			},
+			"admin_state_up": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"dpd": &schema.Schema{
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"action": &schema.Schema{
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "hold",
+						},
+						"interval": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  30,
+						},
+						"timeout": &schema.Schema{
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  120,
+						},
+					},
+				},
+			},
+			"status": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tenant_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"value_specs": &schema.Schema{
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSiteConnectionV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	adminStateUp := d.Get("admin_state_up").(bool)
+	createOpts := SiteConnectionCreateOpts{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		IKEPolicyID:    d.Get("ikepolicy_id").(string),
+		IPSecPolicyID:  d.Get("ipsecpolicy_id").(string),
+		VPNServiceID:   d.Get("vpnservice_id").(string),
+		LocalEPGroupID: d.Get("local_ep_group_id").(string),
+		PeerEPGroupID:  d.Get("peer_ep_group_id").(string),
+		LocalID:        d.Get("local_id").(string),
+		PeerAddress:    d.Get("peer_address").(string),
+		PeerID:         d.Get("peer_id").(string),
+		PeerCIDRs:      resourceSiteConnectionV2PeerCIDRs(d),
+		PSK:            d.Get("psk").(string),
+		MTU:            d.Get("mtu").(int),
+		Initiator:      d.Get("initiator").(string),
+		AdminStateUp:   &adminStateUp,
+		DPD:            resourceSiteConnectionV2DPD(d),
+		TenantID:       d.Get("tenant_id").(string),
+		ValueSpecs:     MapValueSpecs(d),
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	conn, err := networkingV2SiteConnectionCreate(networkingClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack Neutron IPsec site connection: %s", err)
+	}
+
+	log.Printf("[INFO] IPsec site connection ID: %s", conn.ID)
+
+	d.SetId(conn.ID)
+
+	return resourceSiteConnectionV2Read(d, meta)
+}
+
+func resourceSiteConnectionV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	conn, err := networkingV2SiteConnectionGet(networkingClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "IPsec site connection")
+	}
+
+	log.Printf("[DEBUG] Retrieved IPsec site connection %s", d.Id())
+
+	dpd := []interface{}{
+		map[string]interface{}{
+			"action":   conn.DPD.Action,
+			"interval": conn.DPD.Interval,
+			"timeout":  conn.DPD.Timeout,
+		},
+	}
+
+	d.Set("name", conn.Name)
+	d.Set("description", conn.Description)
+	d.Set("ikepolicy_id", conn.IKEPolicyID)
+	d.Set("ipsecpolicy_id", conn.IPSecPolicyID)
+	d.Set("vpnservice_id", conn.VPNServiceID)
+	d.Set("local_ep_group_id", conn.LocalEPGroupID)
+	d.Set("peer_ep_group_id", conn.PeerEPGroupID)
+	d.Set("local_id", conn.LocalID)
+	d.Set("peer_address", conn.PeerAddress)
+	d.Set("peer_id", conn.PeerID)
+	d.Set("peer_cidrs", conn.PeerCIDRs)
+	d.Set("psk", conn.PSK)
+	d.Set("mtu", conn.MTU)
+	d.Set("initiator", conn.Initiator)
+	d.Set("admin_state_up", conn.AdminStateUp)
+	d.Set("dpd", dpd)
+	d.Set("status", conn.Status)
+	d.Set("tenant_id", conn.TenantID)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceSiteConnectionV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	var updateOpts SiteConnectionUpdateOpts
+	if d.HasChange("name") {
+		name := d.Get("name").(string)
+		updateOpts.Name = &name
+	}
+	if d.HasChange("description") {
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+	}
+	if d.HasChange("local_ep_group_id") {
+		localEPGroupID := d.Get("local_ep_group_id").(string)
+		updateOpts.LocalEPGroupID = &localEPGroupID
+	}
+	if d.HasChange("peer_ep_group_id") {
+		peerEPGroupID := d.Get("peer_ep_group_id").(string)
+		updateOpts.PeerEPGroupID = &peerEPGroupID
+	}
+	if d.HasChange("local_id") {
+		localID := d.Get("local_id").(string)
+		updateOpts.LocalID = &localID
+	}
+	if d.HasChange("peer_address") {
+		peerAddress := d.Get("peer_address").(string)
+		updateOpts.PeerAddress = &peerAddress
+	}
+	if d.HasChange("peer_id") {
+		peerID := d.Get("peer_id").(string)
+		updateOpts.PeerID = &peerID
+	}
+	if d.HasChange("peer_cidrs") {
+		updateOpts.PeerCIDRs = resourceSiteConnectionV2PeerCIDRs(d)
+	}
+	if d.HasChange("psk") {
+		psk := d.Get("psk").(string)
+		updateOpts.PSK = &psk
+	}
+	if d.HasChange("mtu") {
+		mtu := d.Get("mtu").(int)
+		updateOpts.MTU = &mtu
+	}
+	if d.HasChange("initiator") {
+		initiator := d.Get("initiator").(string)
+		updateOpts.Initiator = &initiator
+	}
+	if d.HasChange("admin_state_up") {
+		adminStateUp := d.Get("admin_state_up").(bool)
+		updateOpts.AdminStateUp = &adminStateUp
+	}
+	if d.HasChange("dpd") {
+		updateOpts.DPD = resourceSiteConnectionV2DPD(d)
+	}
+
+	log.Printf("[DEBUG] Updating IPsec site connection %s", d.Id())
+
+	_, err = networkingV2SiteConnectionUpdate(networkingClient, d.Id(), updateOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error updating OpenStack Neutron IPsec site connection: %s", err)
+	}
+
+	return resourceSiteConnectionV2Read(d, meta)
+}
+
+func resourceSiteConnectionV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	networkingClient, err := config.networkingV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	err = networkingV2SiteConnectionDelete(networkingClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack Neutron IPsec site connection")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceSiteConnectionV2PeerCIDRs(d *schema.ResourceData) []string {
+	rawPeerCIDRs := d.Get("peer_cidrs").(*schema.Set).List()
+	peerCIDRs := make([]string, len(rawPeerCIDRs))
+	for i, raw := range rawPeerCIDRs {
+		peerCIDRs[i] = raw.(string)
+	}
+	return peerCIDRs
+}
+
+func resourceSiteConnectionV2DPD(d *schema.ResourceData) *VPNDPD {
+	rawDPD := d.Get("dpd").(*schema.Set).List()
+	if len(rawDPD) == 0 {
+		return nil
+	}
+
+	dpd := rawDPD[0].(map[string]interface{})
+	return &VPNDPD{
+		Action:   dpd["action"].(string),
+		Interval: dpd["interval"].(int),
+		Timeout:  dpd["timeout"].(int),
+	}
+}
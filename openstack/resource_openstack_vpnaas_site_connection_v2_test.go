@@ -0,0 +1,229 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccVPNaaSSiteConnectionV2_basic(t *testing.T) {
+	var conn SiteConnection
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheckVPN(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSiteConnectionV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccSiteConnectionV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSiteConnectionV2Exists(
+						"openstack_vpnaas_site_connection_v2.conn_1", &conn),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_site_connection_v2.conn_1", "initiator", "bi-directional"),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_site_connection_v2.conn_1", "dpd.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccSiteConnectionV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPtr(
+						"openstack_vpnaas_site_connection_v2.conn_1", "id", &conn.ID),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_site_connection_v2.conn_1", "name", "conn_2"),
+					resource.TestCheckResourceAttr(
+						"openstack_vpnaas_site_connection_v2.conn_1", "mtu", "1400"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSiteConnectionV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_vpnaas_site_connection_v2" {
+			continue
+		}
+
+		_, err := networkingV2SiteConnectionGet(networkingClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("IPsec site connection still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSiteConnectionV2Exists(n string, conn *SiteConnection) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		networkingClient, err := config.networkingV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack networking client: %s", err)
+		}
+
+		found, err := networkingV2SiteConnectionGet(networkingClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("IPsec site connection not found")
+		}
+
+		*conn = *found
+
+		return nil
+	}
+}
+
+var testAccSiteConnectionV2_basic = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+  external_gateway = "%s"
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_vpnaas_ike_policy_v2" "policy_1" {
+  name = "policy_1"
+}
+
+resource "openstack_vpnaas_ipsec_policy_v2" "policy_1" {
+  name = "policy_1"
+}
+
+resource "openstack_vpnaas_service_v2" "service_1" {
+  name = "service_1"
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  depends_on = ["openstack_networking_router_interface_v2.router_interface_1"]
+}
+
+resource "openstack_vpnaas_endpoint_group_v2" "group_1" {
+  name = "group_1"
+  type = "subnet"
+  endpoints = ["${openstack_networking_subnet_v2.subnet_1.id}"]
+}
+
+resource "openstack_vpnaas_endpoint_group_v2" "group_2" {
+  name = "group_2"
+  type = "cidr"
+  endpoints = ["10.2.0.0/24", "10.3.0.0/24"]
+}
+
+resource "openstack_vpnaas_site_connection_v2" "conn_1" {
+  name = "conn_1"
+  ikepolicy_id = "${openstack_vpnaas_ike_policy_v2.policy_1.id}"
+  ipsecpolicy_id = "${openstack_vpnaas_ipsec_policy_v2.policy_1.id}"
+  vpnservice_id = "${openstack_vpnaas_service_v2.service_1.id}"
+  local_ep_group_id = "${openstack_vpnaas_endpoint_group_v2.group_1.id}"
+  peer_ep_group_id = "${openstack_vpnaas_endpoint_group_v2.group_2.id}"
+  peer_address = "172.24.4.233"
+  peer_id = "172.24.4.233"
+  psk = "secret"
+}
+`, OS_EXTGW_ID)
+
+var testAccSiteConnectionV2_update = fmt.Sprintf(`
+resource "openstack_networking_network_v2" "network_1" {
+  name = "network_1"
+  admin_state_up = "true"
+}
+
+resource "openstack_networking_subnet_v2" "subnet_1" {
+  name = "subnet_1"
+  cidr = "192.168.199.0/24"
+  ip_version = 4
+  network_id = "${openstack_networking_network_v2.network_1.id}"
+}
+
+resource "openstack_networking_router_v2" "router_1" {
+  name = "router_1"
+  admin_state_up = "true"
+  external_gateway = "%s"
+}
+
+resource "openstack_networking_router_interface_v2" "router_interface_1" {
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  subnet_id = "${openstack_networking_subnet_v2.subnet_1.id}"
+}
+
+resource "openstack_vpnaas_ike_policy_v2" "policy_1" {
+  name = "policy_1"
+}
+
+resource "openstack_vpnaas_ipsec_policy_v2" "policy_1" {
+  name = "policy_1"
+}
+
+resource "openstack_vpnaas_service_v2" "service_1" {
+  name = "service_1"
+  router_id = "${openstack_networking_router_v2.router_1.id}"
+  depends_on = ["openstack_networking_router_interface_v2.router_interface_1"]
+}
+
+resource "openstack_vpnaas_endpoint_group_v2" "group_1" {
+  name = "group_1"
+  type = "subnet"
+  endpoints = ["${openstack_networking_subnet_v2.subnet_1.id}"]
+}
+
+resource "openstack_vpnaas_endpoint_group_v2" "group_2" {
+  name = "group_2"
+  type = "cidr"
+  endpoints = ["10.2.0.0/24", "10.3.0.0/24"]
+}
+
+resource "openstack_vpnaas_site_connection_v2" "conn_1" {
+  name = "conn_2"
+  ikepolicy_id = "${openstack_vpnaas_ike_policy_v2.policy_1.id}"
+  ipsecpolicy_id = "${openstack_vpnaas_ipsec_policy_v2.policy_1.id}"
+  vpnservice_id = "${openstack_vpnaas_service_v2.service_1.id}"
+  local_ep_group_id = "${openstack_vpnaas_endpoint_group_v2.group_1.id}"
+  peer_ep_group_id = "${openstack_vpnaas_endpoint_group_v2.group_2.id}"
+  peer_address = "172.24.4.233"
+  peer_id = "172.24.4.233"
+  psk = "secret"
+  mtu = 1400
+
+  dpd {
+    action = "restart"
+    interval = 15
+    timeout = 60
+  }
+}
+`, OS_EXTGW_ID)
@@ -0,0 +1,190 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceWorkflowCronTriggerV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkflowCronTriggerV2Create,
+		Read:   resourceWorkflowCronTriggerV2Read,
+		Delete: resourceWorkflowCronTriggerV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"workflow_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"pattern": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workflow_input": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+			},
+			"workflow_params": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+			},
+			"first_execution_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"remaining_executions": &schema.Schema{
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workflow_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_execution_time": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWorkflowCronTriggerV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	workflowClient, err := config.workflowV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	createOpts := CronTriggerV2CreateOpts{
+		Name:                d.Get("name").(string),
+		WorkflowID:          d.Get("workflow_id").(string),
+		Pattern:             d.Get("pattern").(string),
+		FirstExecutionTime:  d.Get("first_execution_time").(string),
+		RemainingExecutions: d.Get("remaining_executions").(int),
+	}
+
+	if createOpts.Pattern == "" && createOpts.FirstExecutionTime == "" {
+		return fmt.Errorf("One of pattern or first_execution_time must be set")
+	}
+
+	if v := d.Get("workflow_input").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &createOpts.WorkflowInput); err != nil {
+			return fmt.Errorf("Error decoding workflow_input: %s", err)
+		}
+	}
+
+	if v := d.Get("workflow_params").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &createOpts.WorkflowParams); err != nil {
+			return fmt.Errorf("Error decoding workflow_params: %s", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	cronTrigger, err := workflowV2CronTriggerCreate(workflowClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow cron trigger: %s", err)
+	}
+
+	log.Printf("[INFO] Workflow cron trigger ID: %s", cronTrigger.ID)
+
+	d.SetId(cronTrigger.ID)
+
+	return resourceWorkflowCronTriggerV2Read(d, meta)
+}
+
+func resourceWorkflowCronTriggerV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	workflowClient, err := config.workflowV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	cronTrigger, err := workflowV2CronTriggerGet(workflowClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "workflow cron trigger")
+	}
+
+	log.Printf("[DEBUG] Retrieved workflow cron trigger %s: %+v", d.Id(), cronTrigger)
+
+	// Mistral normalizes the workflow input and params and counts down the
+	// remaining executions, so these arguments are only read from the API
+	// when importing.
+	if d.Get("workflow_id").(string) == "" {
+		workflowInput, err := workflowV2JSONString(cronTrigger.WorkflowInput)
+		if err != nil {
+			return fmt.Errorf("Error decoding workflow input of cron trigger %s: %s", d.Id(), err)
+		}
+
+		workflowParams, err := workflowV2JSONString(cronTrigger.WorkflowParams)
+		if err != nil {
+			return fmt.Errorf("Error decoding workflow params of cron trigger %s: %s", d.Id(), err)
+		}
+
+		d.Set("workflow_input", workflowInput)
+		d.Set("workflow_params", workflowParams)
+		d.Set("first_execution_time", cronTrigger.FirstExecutionTime)
+		d.Set("remaining_executions", cronTrigger.RemainingExecutions)
+	}
+
+	d.Set("name", cronTrigger.Name)
+	d.Set("workflow_id", cronTrigger.WorkflowID)
+	d.Set("pattern", cronTrigger.Pattern)
+	d.Set("workflow_name", cronTrigger.WorkflowName)
+	d.Set("next_execution_time", cronTrigger.NextExecutionTime)
+	d.Set("project_id", cronTrigger.ProjectID)
+	d.Set("created_at", cronTrigger.CreatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceWorkflowCronTriggerV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	workflowClient, err := config.workflowV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	err = workflowV2CronTriggerDelete(workflowClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack workflow cron trigger")
+	}
+
+	d.SetId("")
+	return nil
+}
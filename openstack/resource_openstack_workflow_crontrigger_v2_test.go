@@ -0,0 +1,115 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccWorkflowCronTriggerV2_basic(t *testing.T) {
+	var cronTrigger CronTriggerV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWorkflow(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkflowCronTriggerV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccWorkflowCronTriggerV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowCronTriggerV2Exists("openstack_workflow_crontrigger_v2.crontrigger_1", &cronTrigger),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_crontrigger_v2.crontrigger_1", "name", "crontrigger_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_crontrigger_v2.crontrigger_1", "pattern", "0 3 * * *"),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_crontrigger_v2.crontrigger_1", "workflow_name", "crontrigger_1"),
+					resource.TestCheckResourceAttrSet(
+						"openstack_workflow_crontrigger_v2.crontrigger_1", "next_execution_time"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkflowCronTriggerV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	workflowClient, err := config.workflowV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_workflow_crontrigger_v2" {
+			continue
+		}
+
+		_, err := workflowV2CronTriggerGet(workflowClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Workflow cron trigger still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckWorkflowCronTriggerV2Exists(n string, cronTrigger *CronTriggerV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		workflowClient, err := config.workflowV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+		}
+
+		found, err := workflowV2CronTriggerGet(workflowClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Workflow cron trigger not found")
+		}
+
+		*cronTrigger = *found
+
+		return nil
+	}
+}
+
+const testAccWorkflowCronTriggerV2_basic = `
+resource "openstack_workflow_workflow_v2" "workflow_1" {
+  definition = <<EOF
+---
+version: "2.0"
+
+crontrigger_1:
+  input:
+    - message
+
+  tasks:
+    echo:
+      action: std.echo output=<% $.message %>
+EOF
+}
+
+resource "openstack_workflow_crontrigger_v2" "crontrigger_1" {
+  name = "crontrigger_1"
+  workflow_id = "${openstack_workflow_workflow_v2.workflow_1.id}"
+  pattern = "0 3 * * *"
+  workflow_input = "{\"message\": \"nightly\"}"
+}
+`
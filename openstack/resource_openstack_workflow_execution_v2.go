@@ -0,0 +1,269 @@
+package openstack
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceWorkflowExecutionV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkflowExecutionV2Create,
+		Read:   resourceWorkflowExecutionV2Read,
+		Delete: resourceWorkflowExecutionV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"workflow_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"input": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+			},
+			"params": &schema.Schema{
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validateJSONObject,
+				DiffSuppressFunc: suppressEquivalentJSONDiffs,
+			},
+			"wait_for_completion": &schema.Schema{
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+			"workflow_name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_info": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"output": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWorkflowExecutionV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	workflowClient, err := config.workflowV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	createOpts := ExecutionV2CreateOpts{
+		WorkflowID:  d.Get("workflow_id").(string),
+		Description: d.Get("description").(string),
+	}
+
+	if v := d.Get("input").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &createOpts.Input); err != nil {
+			return fmt.Errorf("Error decoding input: %s", err)
+		}
+	}
+
+	if v := d.Get("params").(string); v != "" {
+		if err := json.Unmarshal([]byte(v), &createOpts.Params); err != nil {
+			return fmt.Errorf("Error decoding params: %s", err)
+		}
+	}
+
+	log.Printf("[DEBUG] Create Options: %#v", createOpts)
+	execution, err := workflowV2ExecutionCreate(workflowClient, createOpts).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow execution: %s", err)
+	}
+
+	log.Printf("[INFO] Workflow execution ID: %s", execution.ID)
+
+	d.SetId(execution.ID)
+
+	if d.Get("wait_for_completion").(bool) {
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"IDLE", "RUNNING", "PAUSED"},
+			Target:     []string{"SUCCESS"},
+			Refresh:    resourceWorkflowExecutionV2RefreshFunc(workflowClient, execution.ID),
+			Timeout:    d.Timeout(schema.TimeoutCreate),
+			Delay:      5 * time.Second,
+			MinTimeout: 3 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for OpenStack workflow execution %s to succeed: %s", execution.ID, err)
+		}
+	}
+
+	return resourceWorkflowExecutionV2Read(d, meta)
+}
+
+func resourceWorkflowExecutionV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	workflowClient, err := config.workflowV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	execution, err := workflowV2ExecutionGet(workflowClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "workflow execution")
+	}
+
+	log.Printf("[DEBUG] Retrieved workflow execution %s: %+v", d.Id(), execution)
+
+	input, err := workflowV2JSONString(execution.Input)
+	if err != nil {
+		return fmt.Errorf("Error decoding input of workflow execution %s: %s", d.Id(), err)
+	}
+
+	params, err := workflowV2JSONString(execution.Params)
+	if err != nil {
+		return fmt.Errorf("Error decoding params of workflow execution %s: %s", d.Id(), err)
+	}
+
+	output, err := workflowV2JSONString(execution.Output)
+	if err != nil {
+		return fmt.Errorf("Error decoding output of workflow execution %s: %s", d.Id(), err)
+	}
+
+	// Mistral adds the defaults of the workflow inputs and its own
+	// parameters, e.g. the namespace, to an execution, so the input and
+	// params are only read from the API when importing.
+	if d.Get("workflow_id").(string) == "" {
+		d.Set("input", input)
+		d.Set("params", params)
+	}
+
+	d.Set("workflow_id", execution.WorkflowID)
+	d.Set("workflow_name", execution.WorkflowName)
+	d.Set("description", execution.Description)
+	d.Set("state", execution.State)
+	d.Set("state_info", execution.StateInfo)
+	d.Set("output", output)
+	d.Set("created_at", execution.CreatedAt)
+	d.Set("updated_at", execution.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceWorkflowExecutionV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	workflowClient, err := config.workflowV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	execution, err := workflowV2ExecutionGet(workflowClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "Error retrieving OpenStack workflow execution")
+	}
+
+	// Executions which haven't finished yet can't be deleted, so they are
+	// cancelled first.
+	if execution.State == "IDLE" || execution.State == "RUNNING" || execution.State == "PAUSED" {
+		log.Printf("[DEBUG] Cancelling workflow execution %s", d.Id())
+		_, err = workflowV2ExecutionCancel(workflowClient, d.Id()).Extract()
+		if err != nil {
+			return fmt.Errorf("Error cancelling OpenStack workflow execution %s: %s", d.Id(), err)
+		}
+
+		stateConf := &resource.StateChangeConf{
+			Pending:    []string{"IDLE", "RUNNING", "PAUSED"},
+			Target:     []string{"CANCELLED", "SUCCESS", "ERROR"},
+			Refresh:    resourceWorkflowExecutionV2StateFunc(workflowClient, d.Id()),
+			Timeout:    d.Timeout(schema.TimeoutDelete),
+			Delay:      2 * time.Second,
+			MinTimeout: 2 * time.Second,
+		}
+
+		_, err = stateConf.WaitForState()
+		if err != nil {
+			return fmt.Errorf("Error waiting for OpenStack workflow execution %s to be cancelled: %s", d.Id(), err)
+		}
+	}
+
+	err = workflowV2ExecutionDelete(workflowClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack workflow execution")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// resourceWorkflowExecutionV2RefreshFunc reports the state of an execution
+// and fails once the execution did not succeed.
+func resourceWorkflowExecutionV2RefreshFunc(workflowClient *gophercloud.ServiceClient, executionID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		execution, state, err := resourceWorkflowExecutionV2StateFunc(workflowClient, executionID)()
+		if err != nil {
+			return nil, "", err
+		}
+
+		if state == "ERROR" || state == "CANCELLED" {
+			e := execution.(*ExecutionV2)
+			return execution, state, fmt.Errorf("The execution is in state %s: %s", state, e.StateInfo)
+		}
+
+		return execution, state, nil
+	}
+}
+
+func resourceWorkflowExecutionV2StateFunc(workflowClient *gophercloud.ServiceClient, executionID string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		execution, err := workflowV2ExecutionGet(workflowClient, executionID).Extract()
+		if err != nil {
+			return nil, "", err
+		}
+
+		log.Printf("[DEBUG] OpenStack workflow execution %s current state: %s", executionID, execution.State)
+		return execution, execution.State, nil
+	}
+}
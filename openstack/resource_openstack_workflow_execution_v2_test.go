@@ -0,0 +1,118 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccWorkflowExecutionV2_basic(t *testing.T) {
+	var execution ExecutionV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWorkflow(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkflowExecutionV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccWorkflowExecutionV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowExecutionV2Exists("openstack_workflow_execution_v2.execution_1", &execution),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_execution_v2.execution_1", "workflow_name", "execution_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_execution_v2.execution_1", "state", "SUCCESS"),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_execution_v2.execution_1", "output", `{"greeting": "Hello, world"}`),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkflowExecutionV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	workflowClient, err := config.workflowV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_workflow_execution_v2" {
+			continue
+		}
+
+		_, err := workflowV2ExecutionGet(workflowClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Workflow execution still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckWorkflowExecutionV2Exists(n string, execution *ExecutionV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		workflowClient, err := config.workflowV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+		}
+
+		found, err := workflowV2ExecutionGet(workflowClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Workflow execution not found")
+		}
+
+		*execution = *found
+
+		return nil
+	}
+}
+
+const testAccWorkflowExecutionV2_basic = `
+resource "openstack_workflow_workflow_v2" "workflow_1" {
+  definition = <<EOF
+---
+version: "2.0"
+
+execution_1:
+  input:
+    - name
+
+  output:
+    greeting: <% $.greeting %>
+
+  tasks:
+    greet:
+      action: std.echo output="Hello, <% $.name %>"
+      publish:
+        greeting: <% task().result %>
+EOF
+}
+
+resource "openstack_workflow_execution_v2" "execution_1" {
+  workflow_id = "${openstack_workflow_workflow_v2.workflow_1.id}"
+  description = "execution_1"
+  input = "{\"name\": \"world\"}"
+  wait_for_completion = true
+}
+`
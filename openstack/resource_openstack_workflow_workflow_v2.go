@@ -0,0 +1,179 @@
+package openstack
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceWorkflowWorkflowV2() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWorkflowWorkflowV2Create,
+		Read:   resourceWorkflowWorkflowV2Read,
+		Update: resourceWorkflowWorkflowV2Update,
+		Delete: resourceWorkflowWorkflowV2Delete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"definition": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"scope": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "private",
+				ValidateFunc: resourceWorkflowWorkflowV2ValidScope,
+			},
+			"name": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"input": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": &schema.Schema{
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"project_id": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"updated_at": &schema.Schema{
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceWorkflowWorkflowV2Create(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	workflowClient, err := config.workflowV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	log.Printf("[DEBUG] Creating workflow with scope %s", d.Get("scope").(string))
+	workflows, err := workflowV2WorkflowCreate(workflowClient, d.Get("definition").(string), d.Get("scope").(string)).Extract()
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow: %s", err)
+	}
+
+	// A definition can hold several workflows, but this resource manages
+	// exactly one of them. Workflows which were registered anyway are
+	// removed again.
+	if len(workflows) != 1 {
+		for _, workflow := range workflows {
+			if err := workflowV2WorkflowDelete(workflowClient, workflow.ID).ExtractErr(); err != nil {
+				log.Printf("[WARN] Error deleting OpenStack workflow %s: %s", workflow.ID, err)
+			}
+		}
+		return fmt.Errorf("The definition must contain exactly one workflow, found %d", len(workflows))
+	}
+
+	log.Printf("[INFO] Workflow ID: %s", workflows[0].ID)
+
+	d.SetId(workflows[0].ID)
+
+	return resourceWorkflowWorkflowV2Read(d, meta)
+}
+
+func resourceWorkflowWorkflowV2Read(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	workflowClient, err := config.workflowV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	workflow, err := workflowV2WorkflowGet(workflowClient, d.Id()).Extract()
+	if err != nil {
+		return CheckDeleted(d, err, "workflow")
+	}
+
+	log.Printf("[DEBUG] Retrieved workflow %s: %+v", d.Id(), workflow)
+
+	d.Set("definition", workflow.Definition)
+	d.Set("scope", workflow.Scope)
+	d.Set("name", workflow.Name)
+	d.Set("input", workflow.Input)
+	d.Set("tags", workflow.Tags)
+	d.Set("project_id", workflow.ProjectID)
+	d.Set("created_at", workflow.CreatedAt)
+	d.Set("updated_at", workflow.UpdatedAt)
+	d.Set("region", GetRegion(d, config))
+
+	return nil
+}
+
+func resourceWorkflowWorkflowV2Update(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	workflowClient, err := config.workflowV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	if d.HasChange("definition") || d.HasChange("scope") {
+		log.Printf("[DEBUG] Updating workflow %s", d.Id())
+		workflows, err := workflowV2WorkflowUpdate(workflowClient, d.Id(), d.Get("definition").(string), d.Get("scope").(string)).Extract()
+		if err != nil {
+			return fmt.Errorf("Error updating OpenStack workflow %s: %s", d.Id(), err)
+		}
+
+		if len(workflows) != 1 {
+			return fmt.Errorf("The definition must contain exactly one workflow, found %d", len(workflows))
+		}
+	}
+
+	return resourceWorkflowWorkflowV2Read(d, meta)
+}
+
+func resourceWorkflowWorkflowV2Delete(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	workflowClient, err := config.workflowV2Client(GetRegion(d, config))
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	err = workflowV2WorkflowDelete(workflowClient, d.Id()).ExtractErr()
+	if err != nil {
+		return CheckDeleted(d, err, "Error deleting OpenStack workflow")
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceWorkflowWorkflowV2ValidScope(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	validScopes := []string{
+		"private",
+		"public",
+	}
+
+	for _, v := range validScopes {
+		if value == v {
+			return
+		}
+	}
+
+	err := fmt.Errorf("%s must be one of %s", k, validScopes)
+	errors = append(errors, err)
+	return
+}
@@ -0,0 +1,138 @@
+package openstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccWorkflowWorkflowV2_basic(t *testing.T) {
+	var workflow WorkflowV2
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+			testAccPreCheckWorkflow(t)
+		},
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckWorkflowWorkflowV2Destroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccWorkflowWorkflowV2_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowWorkflowV2Exists("openstack_workflow_workflow_v2.workflow_1", &workflow),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_workflow_v2.workflow_1", "name", "workflow_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_workflow_v2.workflow_1", "scope", "private"),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_workflow_v2.workflow_1", "input", "message=Hello"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccWorkflowWorkflowV2_update,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkflowWorkflowV2Exists("openstack_workflow_workflow_v2.workflow_1", &workflow),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_workflow_v2.workflow_1", "name", "workflow_1"),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_workflow_v2.workflow_1", "input", "message=Goodbye"),
+					resource.TestCheckResourceAttr(
+						"openstack_workflow_workflow_v2.workflow_1", "tags.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkflowWorkflowV2Destroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+	workflowClient, err := config.workflowV2Client(OS_REGION_NAME)
+	if err != nil {
+		return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+	}
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "openstack_workflow_workflow_v2" {
+			continue
+		}
+
+		_, err := workflowV2WorkflowGet(workflowClient, rs.Primary.ID).Extract()
+		if err == nil {
+			return fmt.Errorf("Workflow still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckWorkflowWorkflowV2Exists(n string, workflow *WorkflowV2) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		workflowClient, err := config.workflowV2Client(OS_REGION_NAME)
+		if err != nil {
+			return fmt.Errorf("Error creating OpenStack workflow client: %s", err)
+		}
+
+		found, err := workflowV2WorkflowGet(workflowClient, rs.Primary.ID).Extract()
+		if err != nil {
+			return err
+		}
+
+		if found.ID != rs.Primary.ID {
+			return fmt.Errorf("Workflow not found")
+		}
+
+		*workflow = *found
+
+		return nil
+	}
+}
+
+const testAccWorkflowWorkflowV2_basic = `
+resource "openstack_workflow_workflow_v2" "workflow_1" {
+  definition = <<EOF
+---
+version: "2.0"
+
+workflow_1:
+  input:
+    - message: Hello
+
+  tasks:
+    echo:
+      action: std.echo output=<% $.message %>
+EOF
+}
+`
+
+const testAccWorkflowWorkflowV2_update = `
+resource "openstack_workflow_workflow_v2" "workflow_1" {
+  definition = <<EOF
+---
+version: "2.0"
+
+workflow_1:
+  tags:
+    - runbook
+
+  input:
+    - message: Goodbye
+
+  tasks:
+    echo:
+      action: std.echo output=<% $.message %>
+EOF
+}
+`
@@ -0,0 +1,99 @@
+// This set of code handles share replicas of the Shared File Systems
+// (Manila) v2 API. Share replication is an experimental API of Manila, so
+// every request has to opt in to it.
+// Gophercloud does not support the Shared File Systems API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// sharedfilesystemV2ExperimentalHeaders are the headers of requests to the
+// experimental APIs of Manila.
+var sharedfilesystemV2ExperimentalHeaders = map[string]string{
+	"X-OpenStack-Manila-API-Experimental": "true",
+}
+
+// ShareReplicaV2 is a Shared File Systems v2 share replica.
+type ShareReplicaV2 struct {
+	ID               string `json:"id"`
+	ShareID          string `json:"share_id"`
+	AvailabilityZone string `json:"availability_zone"`
+	ShareNetworkID   string `json:"share_network_id"`
+	ShareServerID    string `json:"share_server_id"`
+	Status           string `json:"status"`
+	ReplicaState     string `json:"replica_state"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
+}
+
+// ShareReplicaV2CreateOpts represents the attributes used when creating a
+// new Shared File Systems v2 share replica.
+type ShareReplicaV2CreateOpts struct {
+	ShareID          string `json:"share_id" required:"true"`
+	AvailabilityZone string `json:"availability_zone,omitempty"`
+	ShareNetworkID   string `json:"share_network_id,omitempty"`
+}
+
+// ToShareReplicaV2CreateMap casts a ShareReplicaV2CreateOpts struct to a
+// map.
+func (opts ShareReplicaV2CreateOpts) ToShareReplicaV2CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "share_replica")
+}
+
+// ShareReplicaV2Result is the result of a create or get request.
+type ShareReplicaV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ShareReplicaV2Result as a ShareReplicaV2.
+func (r ShareReplicaV2Result) Extract() (*ShareReplicaV2, error) {
+	var s struct {
+		ShareReplica *ShareReplicaV2 `json:"share_replica"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ShareReplica, err
+}
+
+func sharedfilesystemV2ShareReplicaCreate(client *gophercloud.ServiceClient, opts ShareReplicaV2CreateOpts) (r ShareReplicaV2Result) {
+	b, err := opts.ToShareReplicaV2CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("share-replicas"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes:     []int{202},
+		MoreHeaders: sharedfilesystemV2ExperimentalHeaders,
+	})
+	return
+}
+
+func sharedfilesystemV2ShareReplicaGet(client *gophercloud.ServiceClient, replicaID string) (r ShareReplicaV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("share-replicas", replicaID), &r.Body, &gophercloud.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: sharedfilesystemV2ExperimentalHeaders,
+	})
+	return
+}
+
+func sharedfilesystemV2ShareReplicaDelete(client *gophercloud.ServiceClient, replicaID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("share-replicas", replicaID), &gophercloud.RequestOpts{
+		OkCodes:     []int{202},
+		MoreHeaders: sharedfilesystemV2ExperimentalHeaders,
+	})
+	return
+}
+
+// sharedfilesystemV2ShareReplicaPromote makes a replica the active replica
+// of its share. The previously active replica becomes a secondary replica.
+// Promoting the active replica succeeds with 200 and changes nothing.
+func sharedfilesystemV2ShareReplicaPromote(client *gophercloud.ServiceClient, replicaID string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"promote": nil,
+	}
+	_, r.Err = client.Post(client.ServiceURL("share-replicas", replicaID, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes:     []int{200, 202},
+		MoreHeaders: sharedfilesystemV2ExperimentalHeaders,
+	})
+	return
+}
@@ -0,0 +1,66 @@
+// This set of code handles the share types and availability zones of the
+// Shared File Systems (Manila) v2 API, which are used by the
+// openstack_sharedfilesystem_share_type_v2 and
+// openstack_sharedfilesystem_availability_zones_v2 data sources.
+// Gophercloud does not support the Shared File Systems API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ShareTypeV2 is a Shared File Systems v2 share type.
+type ShareTypeV2 struct {
+	ID                 string            `json:"id"`
+	Name               string            `json:"name"`
+	IsPublic           bool              `json:"share_type_access:is_public"`
+	ExtraSpecs         map[string]string `json:"extra_specs"`
+	RequiredExtraSpecs map[string]string `json:"required_extra_specs"`
+}
+
+// ShareTypeV2ListResult is the result of a list request.
+type ShareTypeV2ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ShareTypeV2ListResult as a list of ShareTypeV2.
+func (r ShareTypeV2ListResult) Extract() ([]ShareTypeV2, error) {
+	var s struct {
+		ShareTypes []ShareTypeV2 `json:"share_types"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ShareTypes, err
+}
+
+// sharedfilesystemV2ShareTypeList lists the share types which are
+// accessible by the project.
+func sharedfilesystemV2ShareTypeList(client *gophercloud.ServiceClient) (r ShareTypeV2ListResult) {
+	_, r.Err = client.Get(client.ServiceURL("types"), &r.Body, nil)
+	return
+}
+
+// AvailabilityZoneV2 is a Shared File Systems v2 availability zone.
+type AvailabilityZoneV2 struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// AvailabilityZoneV2ListResult is the result of a list request.
+type AvailabilityZoneV2ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets an AvailabilityZoneV2ListResult as a list of
+// AvailabilityZoneV2.
+func (r AvailabilityZoneV2ListResult) Extract() ([]AvailabilityZoneV2, error) {
+	var s struct {
+		AvailabilityZones []AvailabilityZoneV2 `json:"availability_zones"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AvailabilityZones, err
+}
+
+func sharedfilesystemV2AvailabilityZoneList(client *gophercloud.ServiceClient) (r AvailabilityZoneV2ListResult) {
+	_, r.Err = client.Get(client.ServiceURL("availability-zones"), &r.Body, nil)
+	return
+}
@@ -0,0 +1,275 @@
+// This set of code handles shares of the Shared File Systems (Manila) v2
+// API. A share is a file system which is exported to clients over a
+// protocol such as NFS or CIFS.
+// Gophercloud does not support the Shared File Systems API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// sharedfilesystemV2Microversion is the Shared File Systems API microversion
+// used by this provider. Microversion 2.28 added the transitional states of
+// access rules.
+const sharedfilesystemV2Microversion = "2.28"
+
+// ShareV2 is a Shared File Systems v2 share.
+type ShareV2 struct {
+	ID               string            `json:"id"`
+	Name             string            `json:"name"`
+	Description      string            `json:"description"`
+	Size             int               `json:"size"`
+	ShareProto       string            `json:"share_proto"`
+	Status           string            `json:"status"`
+	ShareType        string            `json:"share_type"`
+	ShareTypeName    string            `json:"share_type_name"`
+	ShareNetworkID   string            `json:"share_network_id"`
+	ShareServerID    string            `json:"share_server_id"`
+	SnapshotID       string            `json:"snapshot_id"`
+	AvailabilityZone string            `json:"availability_zone"`
+	IsPublic         bool              `json:"is_public"`
+	Metadata         map[string]string `json:"metadata"`
+	ProjectID        string            `json:"project_id"`
+	CreatedAt        string            `json:"created_at"`
+}
+
+// ShareV2CreateOpts represents the attributes used when creating a new
+// Shared File Systems v2 share.
+type ShareV2CreateOpts struct {
+	ShareProto       string            `json:"share_proto" required:"true"`
+	Size             int               `json:"size" required:"true"`
+	Name             string            `json:"name,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	ShareType        string            `json:"share_type,omitempty"`
+	ShareNetworkID   string            `json:"share_network_id,omitempty"`
+	SnapshotID       string            `json:"snapshot_id,omitempty"`
+	AvailabilityZone string            `json:"availability_zone,omitempty"`
+	IsPublic         bool              `json:"is_public,omitempty"`
+	Metadata         map[string]string `json:"metadata,omitempty"`
+}
+
+// ToShareV2CreateMap casts a ShareV2CreateOpts struct to a map.
+func (opts ShareV2CreateOpts) ToShareV2CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "share")
+}
+
+// ShareV2UpdateOpts represents the attributes used when updating an existing
+// Shared File Systems v2 share.
+type ShareV2UpdateOpts struct {
+	Name        *string `json:"display_name,omitempty"`
+	Description *string `json:"display_description,omitempty"`
+	IsPublic    *bool   `json:"is_public,omitempty"`
+}
+
+// ToShareV2UpdateMap casts a ShareV2UpdateOpts struct to a map.
+func (opts ShareV2UpdateOpts) ToShareV2UpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "share")
+}
+
+// ShareV2Result is the result of a create, get or update request.
+type ShareV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ShareV2Result as a ShareV2.
+func (r ShareV2Result) Extract() (*ShareV2, error) {
+	var s struct {
+		Share *ShareV2 `json:"share"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Share, err
+}
+
+// ShareV2ExportLocation is a path which a share is exported at.
+type ShareV2ExportLocation struct {
+	ID        string `json:"id"`
+	Path      string `json:"path"`
+	Preferred bool   `json:"preferred"`
+}
+
+// ShareV2ExportLocationsResult is the result of an export locations request.
+type ShareV2ExportLocationsResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ShareV2ExportLocationsResult as a list of
+// ShareV2ExportLocation.
+func (r ShareV2ExportLocationsResult) Extract() ([]ShareV2ExportLocation, error) {
+	var s struct {
+		ExportLocations []ShareV2ExportLocation `json:"export_locations"`
+	}
+	err := r.ExtractInto(&s)
+	return s.ExportLocations, err
+}
+
+func sharedfilesystemV2ShareCreate(client *gophercloud.ServiceClient, opts ShareV2CreateOpts) (r ShareV2Result) {
+	b, err := opts.ToShareV2CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("shares"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func sharedfilesystemV2ShareGet(client *gophercloud.ServiceClient, shareID string) (r ShareV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("shares", shareID), &r.Body, nil)
+	return
+}
+
+func sharedfilesystemV2ShareUpdate(client *gophercloud.ServiceClient, shareID string, opts ShareV2UpdateOpts) (r ShareV2Result) {
+	b, err := opts.ToShareV2UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("shares", shareID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func sharedfilesystemV2ShareDelete(client *gophercloud.ServiceClient, shareID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("shares", shareID), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// sharedfilesystemV2ShareMetadataReplace replaces all metadata of a share.
+func sharedfilesystemV2ShareMetadataReplace(client *gophercloud.ServiceClient, shareID string, metadata map[string]string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"metadata": metadata,
+	}
+	_, r.Err = client.Put(client.ServiceURL("shares", shareID, "metadata"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// sharedfilesystemV2ShareResize extends or shrinks a share to the given
+// size in GB.
+func sharedfilesystemV2ShareResize(client *gophercloud.ServiceClient, shareID string, action string, newSize int) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		action: map[string]interface{}{
+			"new_size": newSize,
+		},
+	}
+	_, r.Err = client.Post(client.ServiceURL("shares", shareID, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func sharedfilesystemV2ShareExportLocations(client *gophercloud.ServiceClient, shareID string) (r ShareV2ExportLocationsResult) {
+	_, r.Err = client.Get(client.ServiceURL("shares", shareID, "export_locations"), &r.Body, nil)
+	return
+}
+
+// ShareAccessV2 is an access rule of a Shared File Systems v2 share.
+type ShareAccessV2 struct {
+	ID          string `json:"id"`
+	ShareID     string `json:"share_id"`
+	AccessType  string `json:"access_type"`
+	AccessTo    string `json:"access_to"`
+	AccessLevel string `json:"access_level"`
+	AccessKey   string `json:"access_key"`
+	State       string `json:"state"`
+}
+
+// ShareAccessV2Opts represents the attributes used when granting access to
+// a Shared File Systems v2 share.
+type ShareAccessV2Opts struct {
+	AccessType  string `json:"access_type" required:"true"`
+	AccessTo    string `json:"access_to" required:"true"`
+	AccessLevel string `json:"access_level" required:"true"`
+}
+
+// ToShareAccessV2Map casts a ShareAccessV2Opts struct to a map.
+func (opts ShareAccessV2Opts) ToShareAccessV2Map() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "allow_access")
+}
+
+// ShareAccessV2Result is the result of an allow access request.
+type ShareAccessV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ShareAccessV2Result as a ShareAccessV2.
+func (r ShareAccessV2Result) Extract() (*ShareAccessV2, error) {
+	var s struct {
+		Access *ShareAccessV2 `json:"access"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Access, err
+}
+
+// ShareAccessV2ListResult is the result of an access list request.
+type ShareAccessV2ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ShareAccessV2ListResult as a list of ShareAccessV2.
+func (r ShareAccessV2ListResult) Extract() ([]ShareAccessV2, error) {
+	var s struct {
+		AccessList []ShareAccessV2 `json:"access_list"`
+	}
+	err := r.ExtractInto(&s)
+	return s.AccessList, err
+}
+
+func sharedfilesystemV2ShareAccessAllow(client *gophercloud.ServiceClient, shareID string, opts ShareAccessV2Opts) (r ShareAccessV2Result) {
+	b, err := opts.ToShareAccessV2Map()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("shares", shareID, "action"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+// sharedfilesystemV2ShareAccessList lists the access rules of a share.
+// Access rules can't be retrieved one by one.
+func sharedfilesystemV2ShareAccessList(client *gophercloud.ServiceClient, shareID string) (r ShareAccessV2ListResult) {
+	b := map[string]interface{}{
+		"access_list": nil,
+	}
+	_, r.Err = client.Post(client.ServiceURL("shares", shareID, "action"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func sharedfilesystemV2ShareAccessDeny(client *gophercloud.ServiceClient, shareID, accessID string) (r gophercloud.ErrResult) {
+	b := map[string]interface{}{
+		"deny_access": map[string]interface{}{
+			"access_id": accessID,
+		},
+	}
+	_, r.Err = client.Post(client.ServiceURL("shares", shareID, "action"), b, nil, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+// sharedfilesystemV2ShareAccessGet looks up an access rule in the access
+// rules of its share. A gophercloud.ErrDefault404 is returned if the share
+// or the rule does not exist.
+func sharedfilesystemV2ShareAccessGet(client *gophercloud.ServiceClient, shareID, accessID string) (*ShareAccessV2, error) {
+	accessList, err := sharedfilesystemV2ShareAccessList(client, shareID).Extract()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, access := range accessList {
+		if access.ID == accessID {
+			return &access, nil
+		}
+	}
+
+	return nil, gophercloud.ErrDefault404{}
+}
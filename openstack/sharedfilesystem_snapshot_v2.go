@@ -0,0 +1,100 @@
+// This set of code handles share snapshots of the Shared File Systems
+// (Manila) v2 API. New shares can be created from a snapshot by setting its
+// ID as the snapshot_id of the share.
+// Gophercloud does not support the Shared File Systems API yet.
+package openstack
+
+import (
+	"github.com/gophercloud/gophercloud"
+)
+
+// ShareSnapshotV2 is a Shared File Systems v2 share snapshot.
+type ShareSnapshotV2 struct {
+	ID          string `json:"id"`
+	ShareID     string `json:"share_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Status      string `json:"status"`
+	Size        int    `json:"size"`
+	ShareSize   int    `json:"share_size"`
+	ShareProto  string `json:"share_proto"`
+	CreatedAt   string `json:"created_at"`
+}
+
+// ShareSnapshotV2CreateOpts represents the attributes used when creating a
+// new Shared File Systems v2 share snapshot.
+type ShareSnapshotV2CreateOpts struct {
+	ShareID     string `json:"share_id" required:"true"`
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Force       bool   `json:"force,omitempty"`
+}
+
+// ToShareSnapshotV2CreateMap casts a ShareSnapshotV2CreateOpts struct to a
+// map.
+func (opts ShareSnapshotV2CreateOpts) ToShareSnapshotV2CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "snapshot")
+}
+
+// ShareSnapshotV2UpdateOpts represents the attributes used when updating an
+// existing Shared File Systems v2 share snapshot.
+type ShareSnapshotV2UpdateOpts struct {
+	Name        *string `json:"display_name,omitempty"`
+	Description *string `json:"display_description,omitempty"`
+}
+
+// ToShareSnapshotV2UpdateMap casts a ShareSnapshotV2UpdateOpts struct to a
+// map.
+func (opts ShareSnapshotV2UpdateOpts) ToShareSnapshotV2UpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "snapshot")
+}
+
+// ShareSnapshotV2Result is the result of a create, get or update request.
+type ShareSnapshotV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a ShareSnapshotV2Result as a ShareSnapshotV2.
+func (r ShareSnapshotV2Result) Extract() (*ShareSnapshotV2, error) {
+	var s struct {
+		Snapshot *ShareSnapshotV2 `json:"snapshot"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Snapshot, err
+}
+
+func sharedfilesystemV2SnapshotCreate(client *gophercloud.ServiceClient, opts ShareSnapshotV2CreateOpts) (r ShareSnapshotV2Result) {
+	b, err := opts.ToShareSnapshotV2CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("snapshots"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
+
+func sharedfilesystemV2SnapshotGet(client *gophercloud.ServiceClient, snapshotID string) (r ShareSnapshotV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("snapshots", snapshotID), &r.Body, nil)
+	return
+}
+
+func sharedfilesystemV2SnapshotUpdate(client *gophercloud.ServiceClient, snapshotID string, opts ShareSnapshotV2UpdateOpts) (r ShareSnapshotV2Result) {
+	b, err := opts.ToShareSnapshotV2UpdateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Put(client.ServiceURL("snapshots", snapshotID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func sharedfilesystemV2SnapshotDelete(client *gophercloud.ServiceClient, snapshotID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("snapshots", snapshotID), &gophercloud.RequestOpts{
+		OkCodes: []int{202},
+	})
+	return
+}
@@ -10,8 +10,12 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/bootfromvolume"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/keypairs"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/schedulerhints"
 	"github.com/gophercloud/gophercloud/openstack/compute/v2/extensions/servergroups"
+	"github.com/gophercloud/gophercloud/openstack/compute/v2/servers"
 	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
 	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
 	"github.com/gophercloud/gophercloud/openstack/networking/v2/extensions/fwaas/firewalls"
@@ -153,6 +157,115 @@ func (lrt *LogRoundTripper) formatJSON(raw []byte) string {
 	return string(pretty)
 }
 
+// BlockDevice represents a block device mapping of a server.
+// It extends bootfromvolume.BlockDevice with fields Gophercloud does not
+// support yet.
+type BlockDevice struct {
+	bootfromvolume.BlockDevice
+
+	// VolumeType is the volume type of the block device.
+	// It requires Compute API microversion 2.67.
+	VolumeType string `json:"volume_type,omitempty"`
+}
+
+// BlockDeviceCreateOptsExt extends the server create options with a block
+// device mapping.
+type BlockDeviceCreateOptsExt struct {
+	servers.CreateOptsBuilder
+	BlockDevice []BlockDevice
+}
+
+// ToServerCreateMap adds the block device mapping to the base server
+// creation options.
+// It mirrors bootfromvolume.ToServerCreateMap but uses BlockDevice.
+func (opts BlockDeviceCreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(opts.BlockDevice) == 0 {
+		err := gophercloud.ErrMissingInput{}
+		err.Argument = "BlockDeviceCreateOptsExt.BlockDevice"
+		return nil, err
+	}
+
+	serverMap := base["server"].(map[string]interface{})
+
+	blockDevice := make([]map[string]interface{}, len(opts.BlockDevice))
+	for i, bd := range opts.BlockDevice {
+		b, err := gophercloud.BuildRequestBody(bd, "")
+		if err != nil {
+			return nil, err
+		}
+		blockDevice[i] = b
+	}
+	serverMap["block_device_mapping_v2"] = blockDevice
+
+	return base, nil
+}
+
+// DescriptionCreateOptsExt extends the server create options with a
+// description.
+type DescriptionCreateOptsExt struct {
+	servers.CreateOptsBuilder
+	Description string
+}
+
+// ToServerCreateMap adds the description to the base server creation options.
+func (opts DescriptionCreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	serverMap := base["server"].(map[string]interface{})
+	serverMap["description"] = opts.Description
+
+	return base, nil
+}
+
+// TrustedImageCertificatesCreateOptsExt extends the server create options
+// with the IDs of the certificates used to validate the image signature.
+type TrustedImageCertificatesCreateOptsExt struct {
+	servers.CreateOptsBuilder
+	TrustedImageCertificates []string
+}
+
+// ToServerCreateMap adds the trusted image certificates to the base server
+// creation options.
+func (opts TrustedImageCertificatesCreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	serverMap := base["server"].(map[string]interface{})
+	serverMap["trusted_image_certificates"] = opts.TrustedImageCertificates
+
+	return base, nil
+}
+
+// NetworksCreateOptsExt extends the server create options with networks
+// given as "auto" or "none" instead of a list.
+type NetworksCreateOptsExt struct {
+	servers.CreateOptsBuilder
+	Networks string
+}
+
+// ToServerCreateMap adds the networks to the base server creation options.
+func (opts NetworksCreateOptsExt) ToServerCreateMap() (map[string]interface{}, error) {
+	base, err := opts.CreateOptsBuilder.ToServerCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	serverMap := base["server"].(map[string]interface{})
+	serverMap["networks"] = opts.Networks
+
+	return base, nil
+}
+
 // Firewall is an OpenStack firewall.
 type Firewall struct {
 	firewalls.Firewall
@@ -192,6 +305,19 @@ func (opts FloatingIPCreateOpts) ToFloatingIPCreateMap() (map[string]interface{}
 	return BuildRequest(opts, "floatingip")
 }
 
+// FloatingIPUpdateOpts represents the attributes used when updating an
+// existing floating ip.
+type FloatingIPUpdateOpts struct {
+	floatingips.UpdateOpts
+	FixedIP *string `json:"fixed_ip_address,omitempty"`
+}
+
+// ToFloatingIPUpdateMap casts an UpdateOpts struct to a map.
+// It overrides floatingips.ToFloatingIPUpdateMap to add the FixedIP field.
+func (opts FloatingIPUpdateOpts) ToFloatingIPUpdateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "floatingip")
+}
+
 // KeyPairCreateOpts represents the attributes used when creating a new keypair.
 type KeyPairCreateOpts struct {
 	keypairs.CreateOpts
@@ -207,7 +333,11 @@ func (opts KeyPairCreateOpts) ToKeyPairCreateMap() (map[string]interface{}, erro
 // NetworkCreateOpts represents the attributes used when creating a new network.
 type NetworkCreateOpts struct {
 	networks.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	QoSPolicyID           string            `json:"qos_policy_id,omitempty"`
+	MTU                   int               `json:"mtu,omitempty"`
+	DNSDomain             string            `json:"dns_domain,omitempty"`
+	AvailabilityZoneHints []string          `json:"availability_zone_hints,omitempty"`
+	ValueSpecs            map[string]string `json:"value_specs,omitempty"`
 }
 
 // ToNetworkCreateMap casts a CreateOpts struct to a map.
@@ -216,6 +346,32 @@ func (opts NetworkCreateOpts) ToNetworkCreateMap() (map[string]interface{}, erro
 	return BuildRequest(opts, "network")
 }
 
+// NetworkUpdateOpts represents the attributes used when updating an existing
+// network.
+type NetworkUpdateOpts struct {
+	networks.UpdateOpts
+	QoSPolicyID *string `json:"qos_policy_id,omitempty"`
+	MTU         *int    `json:"mtu,omitempty"`
+	DNSDomain   *string `json:"dns_domain,omitempty"`
+}
+
+// ToNetworkUpdateMap casts an UpdateOpts struct to a map.
+// It overrides networks.ToNetworkUpdateMap to add the QoSPolicyID, MTU and
+// DNSDomain fields.
+// An empty QoSPolicyID removes the QoS policy from the network.
+func (opts NetworkUpdateOpts) ToNetworkUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "network")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.QoSPolicyID != nil && *opts.QoSPolicyID == "" {
+		b["network"].(map[string]interface{})["qos_policy_id"] = nil
+	}
+
+	return b, nil
+}
+
 // PolicyCreateOpts represents the attributes used when creating a new firewall policy.
 type PolicyCreateOpts struct {
 	policies.CreateOpts
@@ -231,7 +387,12 @@ func (opts PolicyCreateOpts) ToFirewallPolicyCreateMap() (map[string]interface{}
 // PortCreateOpts represents the attributes used when creating a new port.
 type PortCreateOpts struct {
 	ports.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	QoSPolicyID string                 `json:"qos_policy_id,omitempty"`
+	HostID      string                 `json:"binding:host_id,omitempty"`
+	VNICType    string                 `json:"binding:vnic_type,omitempty"`
+	Profile     map[string]interface{} `json:"binding:profile,omitempty"`
+	DNSName     string                 `json:"dns_name,omitempty"`
+	ValueSpecs  map[string]string      `json:"value_specs,omitempty"`
 }
 
 // ToPortCreateMap casts a CreateOpts struct to a map.
@@ -240,6 +401,34 @@ func (opts PortCreateOpts) ToPortCreateMap() (map[string]interface{}, error) {
 	return BuildRequest(opts, "port")
 }
 
+// PortUpdateOpts represents the attributes used when updating an existing
+// port.
+type PortUpdateOpts struct {
+	ports.UpdateOpts
+	QoSPolicyID *string                 `json:"qos_policy_id,omitempty"`
+	HostID      *string                 `json:"binding:host_id,omitempty"`
+	VNICType    *string                 `json:"binding:vnic_type,omitempty"`
+	Profile     *map[string]interface{} `json:"binding:profile,omitempty"`
+	DNSName     *string                 `json:"dns_name,omitempty"`
+}
+
+// ToPortUpdateMap casts an UpdateOpts struct to a map.
+// It overrides ports.ToPortUpdateMap to add the QoSPolicyID, binding and
+// DNSName fields.
+// An empty QoSPolicyID removes the QoS policy from the port.
+func (opts PortUpdateOpts) ToPortUpdateMap() (map[string]interface{}, error) {
+	b, err := gophercloud.BuildRequestBody(opts, "port")
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.QoSPolicyID != nil && *opts.QoSPolicyID == "" {
+		b["port"].(map[string]interface{})["qos_policy_id"] = nil
+	}
+
+	return b, nil
+}
+
 // RecordSetCreateOpts represents the attributes used when creating a new DNS record set.
 type RecordSetCreateOpts struct {
 	recordsets.CreateOpts
@@ -264,7 +453,8 @@ func (opts RecordSetCreateOpts) ToRecordSetCreateMap() (map[string]interface{},
 // RouterCreateOpts represents the attributes used when creating a new router.
 type RouterCreateOpts struct {
 	routers.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	AvailabilityZoneHints []string          `json:"availability_zone_hints,omitempty"`
+	ValueSpecs            map[string]string `json:"value_specs,omitempty"`
 }
 
 // ToRouterCreateMap casts a CreateOpts struct to a map.
@@ -294,6 +484,29 @@ func (opts RuleCreateOpts) ToRuleCreateMap() (map[string]interface{}, error) {
 	return b, nil
 }
 
+// SchedulerHints represents a set of scheduler hints which can also
+// contain hints for custom scheduler filters.
+type SchedulerHints struct {
+	schedulerhints.SchedulerHints
+	AdditionalProperties map[string]interface{}
+}
+
+// ToServerSchedulerHintsCreateMap casts a SchedulerHints struct to a map.
+// It overrides schedulerhints.ToServerSchedulerHintsCreateMap to add the
+// AdditionalProperties field.
+func (opts SchedulerHints) ToServerSchedulerHintsCreateMap() (map[string]interface{}, error) {
+	sh, err := opts.SchedulerHints.ToServerSchedulerHintsCreateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range opts.AdditionalProperties {
+		sh[k] = v
+	}
+
+	return sh, nil
+}
+
 // ServerGroupCreateOpts represents the attributes used when creating a new router.
 type ServerGroupCreateOpts struct {
 	servergroups.CreateOpts
@@ -309,7 +522,9 @@ func (opts ServerGroupCreateOpts) ToServerGroupCreateMap() (map[string]interface
 // SubnetCreateOpts represents the attributes used when creating a new subnet.
 type SubnetCreateOpts struct {
 	subnets.CreateOpts
-	ValueSpecs map[string]string `json:"value_specs,omitempty"`
+	ServiceTypes []string          `json:"service_types,omitempty"`
+	SegmentID    string            `json:"segment_id,omitempty"`
+	ValueSpecs   map[string]string `json:"value_specs,omitempty"`
 }
 
 // ToSubnetCreateMap casts a CreateOpts struct to a map.
@@ -327,6 +542,35 @@ func (opts SubnetCreateOpts) ToSubnetCreateMap() (map[string]interface{}, error)
 	return b, nil
 }
 
+// SubnetUpdateOpts represents the attributes used when updating an existing
+// subnet.
+type SubnetUpdateOpts struct {
+	subnets.UpdateOpts
+	ServiceTypes *[]string
+	SegmentID    *string
+}
+
+// ToSubnetUpdateMap casts an UpdateOpts struct to a map.
+// It overrides subnets.ToSubnetUpdateMap to add the ServiceTypes and
+// SegmentID fields. An empty ServiceTypes list removes all service types of
+// the subnet.
+func (opts SubnetUpdateOpts) ToSubnetUpdateMap() (map[string]interface{}, error) {
+	b, err := opts.UpdateOpts.ToSubnetUpdateMap()
+	if err != nil {
+		return nil, err
+	}
+
+	m := b["subnet"].(map[string]interface{})
+	if opts.ServiceTypes != nil {
+		m["service_types"] = *opts.ServiceTypes
+	}
+	if opts.SegmentID != nil {
+		m["segment_id"] = *opts.SegmentID
+	}
+
+	return b, nil
+}
+
 // ZoneCreateOpts represents the attributes used when creating a new DNS zone.
 type ZoneCreateOpts struct {
 	zones.CreateOpts
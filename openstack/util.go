@@ -1,14 +1,19 @@
 package openstack
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"reflect"
+	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/Unknwon/com"
 	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/tokens"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 )
@@ -127,3 +132,82 @@ func suppressEquivilentTimeDiffs(k, old, new string, d *schema.ResourceData) boo
 
 	return oldTime.Equal(newTime)
 }
+
+// suppressEquivalentJSONDiffs suppresses diffs between two JSON documents
+// which only differ in formatting or key order.
+func suppressEquivalentJSONDiffs(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+// validateJSONObject validates that a value is a JSON object.
+func validateJSONObject(v interface{}, k string) (ws []string, errors []error) {
+	var value map[string]interface{}
+	if err := json.Unmarshal([]byte(v.(string)), &value); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a JSON object: %s", k, err))
+	}
+	return
+}
+
+// validateRegexp validates that a value is a valid regular expression.
+func validateRegexp(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid regular expression: %s", k, err))
+	}
+	return
+}
+
+// tokenProjectID returns the ID of the project of the current token.
+func tokenProjectID(config *Config, region string) (string, error) {
+	if config.TenantID != "" {
+		return config.TenantID, nil
+	}
+
+	identityClient, err := config.identityV3Client(region)
+	if err != nil {
+		return "", err
+	}
+
+	project, err := tokens.Get(identityClient, config.OsClient.TokenID).ExtractProject()
+	if err != nil {
+		return "", err
+	}
+
+	return project.ID, nil
+}
+
+// configuredInts returns the values of the given Optional and Computed
+// integer attributes of a new resource which are set in the configuration,
+// including those which are set to 0. d.GetOk can't tell an attribute which
+// is set to 0 from one which is not set, but the state of a new resource
+// only contains the Computed attributes which are set. The ID of d must
+// already be set.
+func configuredInts(d *schema.ResourceData, keys ...string) (map[string]int, error) {
+	state := d.State()
+	if state == nil {
+		return nil, fmt.Errorf("Unable to determine the configured attributes of a resource without an ID")
+	}
+
+	values := make(map[string]int)
+	for _, k := range keys {
+		raw, ok := state.Attributes[k]
+		if !ok {
+			continue
+		}
+
+		v, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing %s: %s", k, err)
+		}
+		values[k] = v
+	}
+
+	return values, nil
+}
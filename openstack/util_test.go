@@ -0,0 +1,43 @@
+package openstack
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestConfiguredInts(t *testing.T) {
+	raw := map[string]interface{}{
+		"project_id": "a7d8b2ac-9b4e-4e7f-8d3c-46c1b1e4d4a1",
+		"instances":  0,
+		"cores":      8,
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceComputeQuotaSetV2().Schema, raw)
+	d.SetId("a7d8b2ac-9b4e-4e7f-8d3c-46c1b1e4d4a1")
+
+	expected := map[string]int{
+		"instances": 0,
+		"cores":     8,
+	}
+
+	actual, err := configuredInts(d, "instances", "cores", "ram")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %#v, got %#v", expected, actual)
+	}
+}
+
+func TestConfiguredInts_noID(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceComputeQuotaSetV2().Schema, map[string]interface{}{
+		"project_id": "a7d8b2ac-9b4e-4e7f-8d3c-46c1b1e4d4a1",
+	})
+
+	if _, err := configuredInts(d, "instances"); err == nil {
+		t.Fatal("Expected an error for a resource without an ID")
+	}
+}
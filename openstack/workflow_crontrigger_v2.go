@@ -0,0 +1,82 @@
+// This set of code handles cron triggers of the Workflow (Mistral) v2 API.
+// A cron trigger runs a workflow on a schedule.
+// Gophercloud does not support the Workflow API yet.
+package openstack
+
+import (
+	"encoding/json"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// CronTriggerV2 is a Workflow v2 cron trigger. The workflow input and params
+// are JSON objects, which Mistral returns as encoded strings.
+type CronTriggerV2 struct {
+	ID                  string          `json:"id"`
+	Name                string          `json:"name"`
+	WorkflowID          string          `json:"workflow_id"`
+	WorkflowName        string          `json:"workflow_name"`
+	WorkflowInput       json.RawMessage `json:"workflow_input"`
+	WorkflowParams      json.RawMessage `json:"workflow_params"`
+	Pattern             string          `json:"pattern"`
+	FirstExecutionTime  string          `json:"first_execution_time"`
+	NextExecutionTime   string          `json:"next_execution_time"`
+	RemainingExecutions int             `json:"remaining_executions"`
+	Scope               string          `json:"scope"`
+	ProjectID           string          `json:"project_id"`
+	CreatedAt           string          `json:"created_at"`
+	UpdatedAt           string          `json:"updated_at"`
+}
+
+// CronTriggerV2CreateOpts represents the attributes used when creating a new
+// Workflow v2 cron trigger.
+type CronTriggerV2CreateOpts struct {
+	Name                string                 `json:"name" required:"true"`
+	WorkflowID          string                 `json:"workflow_id" required:"true"`
+	WorkflowInput       map[string]interface{} `json:"workflow_input,omitempty"`
+	WorkflowParams      map[string]interface{} `json:"workflow_params,omitempty"`
+	Pattern             string                 `json:"pattern,omitempty"`
+	FirstExecutionTime  string                 `json:"first_execution_time,omitempty"`
+	RemainingExecutions int                    `json:"remaining_executions,omitempty"`
+}
+
+// ToCronTriggerV2CreateMap casts a CronTriggerV2CreateOpts struct to a map.
+func (opts CronTriggerV2CreateOpts) ToCronTriggerV2CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// CronTriggerV2Result is the result of a create or get request.
+type CronTriggerV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a CronTriggerV2Result as a CronTriggerV2.
+func (r CronTriggerV2Result) Extract() (*CronTriggerV2, error) {
+	var s *CronTriggerV2
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func workflowV2CronTriggerCreate(client *gophercloud.ServiceClient, opts CronTriggerV2CreateOpts) (r CronTriggerV2Result) {
+	b, err := opts.ToCronTriggerV2CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("cron_triggers"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func workflowV2CronTriggerGet(client *gophercloud.ServiceClient, cronTriggerID string) (r CronTriggerV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("cron_triggers", cronTriggerID), &r.Body, nil)
+	return
+}
+
+func workflowV2CronTriggerDelete(client *gophercloud.ServiceClient, cronTriggerID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("cron_triggers", cronTriggerID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
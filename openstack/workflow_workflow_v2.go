@@ -0,0 +1,189 @@
+// This set of code handles workflows and executions of the Workflow
+// (Mistral) v2 API. Workflows are defined in the YAML based Mistral
+// workflow language and are uploaded as text.
+// Gophercloud does not support the Workflow API yet.
+package openstack
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// WorkflowV2 is a Workflow v2 workflow.
+type WorkflowV2 struct {
+	ID         string   `json:"id"`
+	Name       string   `json:"name"`
+	Definition string   `json:"definition"`
+	Input      string   `json:"input"`
+	Tags       []string `json:"tags"`
+	Scope      string   `json:"scope"`
+	ProjectID  string   `json:"project_id"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+}
+
+// WorkflowV2Result is the result of a get request.
+type WorkflowV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets a WorkflowV2Result as a WorkflowV2.
+func (r WorkflowV2Result) Extract() (*WorkflowV2, error) {
+	var s *WorkflowV2
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+// WorkflowV2ListResult is the result of a create or update request, which
+// returns every workflow of the definition.
+type WorkflowV2ListResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a WorkflowV2ListResult as a list of WorkflowV2.
+func (r WorkflowV2ListResult) Extract() ([]WorkflowV2, error) {
+	var s struct {
+		Workflows []WorkflowV2 `json:"workflows"`
+	}
+	err := r.ExtractInto(&s)
+	return s.Workflows, err
+}
+
+// workflowV2DefinitionHeaders are the headers of requests which upload a
+// workflow definition.
+var workflowV2DefinitionHeaders = map[string]string{
+	"Content-Type": "text/plain",
+}
+
+func workflowV2WorkflowCreate(client *gophercloud.ServiceClient, definition, scope string) (r WorkflowV2ListResult) {
+	q := url.Values{}
+	q.Set("scope", scope)
+	_, r.Err = client.Post(client.ServiceURL("workflows")+"?"+q.Encode(), strings.NewReader(definition), &r.Body, &gophercloud.RequestOpts{
+		OkCodes:     []int{201},
+		MoreHeaders: workflowV2DefinitionHeaders,
+	})
+	return
+}
+
+func workflowV2WorkflowGet(client *gophercloud.ServiceClient, workflowID string) (r WorkflowV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("workflows", workflowID), &r.Body, nil)
+	return
+}
+
+// workflowV2WorkflowUpdate replaces the definition of a workflow. The
+// workflow is identified by its ID, so that it can also be renamed.
+func workflowV2WorkflowUpdate(client *gophercloud.ServiceClient, workflowID, definition, scope string) (r WorkflowV2ListResult) {
+	q := url.Values{}
+	q.Set("identifier", workflowID)
+	q.Set("scope", scope)
+	_, r.Err = client.Put(client.ServiceURL("workflows")+"?"+q.Encode(), strings.NewReader(definition), &r.Body, &gophercloud.RequestOpts{
+		OkCodes:     []int{200},
+		MoreHeaders: workflowV2DefinitionHeaders,
+	})
+	return
+}
+
+func workflowV2WorkflowDelete(client *gophercloud.ServiceClient, workflowID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("workflows", workflowID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// ExecutionV2 is a Workflow v2 execution of a workflow. The input, output
+// and params are JSON objects, which Mistral returns as encoded strings.
+type ExecutionV2 struct {
+	ID           string          `json:"id"`
+	WorkflowID   string          `json:"workflow_id"`
+	WorkflowName string          `json:"workflow_name"`
+	Description  string          `json:"description"`
+	State        string          `json:"state"`
+	StateInfo    string          `json:"state_info"`
+	Input        json.RawMessage `json:"input"`
+	Output       json.RawMessage `json:"output"`
+	Params       json.RawMessage `json:"params"`
+	CreatedAt    string          `json:"created_at"`
+	UpdatedAt    string          `json:"updated_at"`
+}
+
+// ExecutionV2CreateOpts represents the attributes used when starting a new
+// Workflow v2 execution.
+type ExecutionV2CreateOpts struct {
+	WorkflowID  string                 `json:"workflow_id" required:"true"`
+	Description string                 `json:"description,omitempty"`
+	Input       map[string]interface{} `json:"input,omitempty"`
+	Params      map[string]interface{} `json:"params,omitempty"`
+}
+
+// ToExecutionV2CreateMap casts an ExecutionV2CreateOpts struct to a map.
+func (opts ExecutionV2CreateOpts) ToExecutionV2CreateMap() (map[string]interface{}, error) {
+	return gophercloud.BuildRequestBody(opts, "")
+}
+
+// ExecutionV2Result is the result of a create, get or update request.
+type ExecutionV2Result struct {
+	gophercloud.Result
+}
+
+// Extract interprets an ExecutionV2Result as an ExecutionV2.
+func (r ExecutionV2Result) Extract() (*ExecutionV2, error) {
+	var s *ExecutionV2
+	err := r.ExtractInto(&s)
+	return s, err
+}
+
+func workflowV2ExecutionCreate(client *gophercloud.ServiceClient, opts ExecutionV2CreateOpts) (r ExecutionV2Result) {
+	b, err := opts.ToExecutionV2CreateMap()
+	if err != nil {
+		r.Err = err
+		return
+	}
+	_, r.Err = client.Post(client.ServiceURL("executions"), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{201},
+	})
+	return
+}
+
+func workflowV2ExecutionGet(client *gophercloud.ServiceClient, executionID string) (r ExecutionV2Result) {
+	_, r.Err = client.Get(client.ServiceURL("executions", executionID), &r.Body, nil)
+	return
+}
+
+// workflowV2ExecutionCancel cancels a running or paused execution.
+func workflowV2ExecutionCancel(client *gophercloud.ServiceClient, executionID string) (r ExecutionV2Result) {
+	b := map[string]interface{}{
+		"state": "CANCELLED",
+	}
+	_, r.Err = client.Put(client.ServiceURL("executions", executionID), b, &r.Body, &gophercloud.RequestOpts{
+		OkCodes: []int{200},
+	})
+	return
+}
+
+func workflowV2ExecutionDelete(client *gophercloud.ServiceClient, executionID string) (r gophercloud.ErrResult) {
+	_, r.Err = client.Delete(client.ServiceURL("executions", executionID), &gophercloud.RequestOpts{
+		OkCodes: []int{204},
+	})
+	return
+}
+
+// workflowV2JSONString returns a JSON object of Mistral as a string. Mistral
+// returns JSON objects either as encoded strings or as objects.
+func workflowV2JSONString(raw json.RawMessage) (string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil
+	}
+
+	if raw[0] == '"' {
+		var s string
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return "", err
+		}
+		return s, nil
+	}
+
+	return string(raw), nil
+}